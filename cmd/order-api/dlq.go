@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/krivenkov/order/internal/di"
+	"github.com/krivenkov/order/internal/server/bus"
+	"github.com/krivenkov/order/internal/server/bus/dlq"
+	"github.com/krivenkov/pkg/bus/builder"
+	"github.com/krivenkov/pkg/busapi/topics"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// dlqReplay implements `order-api [-cfg path] dlq replay -topic <topic> [-limit n] [-idle d]`
+func dlqReplay(args []string) int {
+	fs := flag.NewFlagSet("dlq replay", flag.ContinueOnError)
+
+	topic := fs.String("topic", "", "source topic of dead-lettered messages, e.g. user.update.user.1")
+	limit := fs.Int("limit", 0, "max number of replayed messages, 0 replays all")
+	idle := fs.Duration("idle", 10*time.Second, "stop when no dead-lettered messages arrive for this long")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if *topic == "" {
+		fmt.Fprintln(os.Stderr, "-topic is required")
+		fs.Usage()
+		return 2
+	}
+
+	app := fx.New(
+		di.FXBaseModule,
+		fx.NopLogger,
+
+		fx.Invoke(func(ctx context.Context, logger *zap.Logger, cfg bus.Config, busCfg builder.Config) error {
+			replayed, err := bus.ReplayDLQ(ctx, logger, cfg, busCfg, topics.Topic(*topic), dlq.ReplayConfig{
+				Limit: *limit,
+				Idle:  *idle,
+			})
+
+			logger.Info("dlq replay finished", zap.String("topic", *topic), zap.Int("replayed", replayed))
+
+			return err
+		}),
+	)
+
+	if err := app.Err(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	return 0
+}
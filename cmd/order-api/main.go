@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/krivenkov/order/internal/di"
 	"github.com/krivenkov/order/internal/server"
 	"github.com/krivenkov/order/internal/service"
@@ -9,6 +11,10 @@ import (
 )
 
 func main() {
+	if args, ok := subcommand(os.Args[1:], "dlq", "replay"); ok {
+		os.Exit(dlqReplay(args))
+	}
+
 	fx.New(
 		di.FXBaseModule,
 
@@ -17,3 +23,27 @@ func main() {
 		server.FXModule,
 	).Run()
 }
+
+// subcommand looks for the command words after the global flags (-cfg)
+// and returns the rest of arguments
+func subcommand(args []string, words ...string) ([]string, bool) {
+	for i := range args {
+		if len(args)-i < len(words) {
+			return nil, false
+		}
+
+		match := true
+		for j, w := range words {
+			if args[i+j] != w {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			return args[i+len(words):], true
+		}
+	}
+
+	return nil, false
+}
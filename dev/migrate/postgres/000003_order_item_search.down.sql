@@ -0,0 +1,4 @@
+drop index if exists "order".items_search_index;
+
+alter table "order".items
+    drop column if exists search;
\ No newline at end of file
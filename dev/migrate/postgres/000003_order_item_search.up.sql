@@ -0,0 +1,9 @@
+alter table "order".items
+    add column search tsvector
+        generated always as (
+            to_tsvector('english', name || ' ' || description) ||
+            to_tsvector('russian', name || ' ' || description)
+        ) stored;
+
+create index items_search_index
+    on "order".items using gin (search);
\ No newline at end of file
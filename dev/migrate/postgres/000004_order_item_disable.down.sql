@@ -0,0 +1,2 @@
+alter table "order".items
+    drop column if exists status_before_disable;
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table "order".items
+    add column status_before_disable smallint;
\ No newline at end of file
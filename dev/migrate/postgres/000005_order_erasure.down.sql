@@ -0,0 +1 @@
+drop table if exists "order".erasures;
\ No newline at end of file
@@ -0,0 +1,17 @@
+create table "order".erasures
+(
+    id           uuid                    not null
+        constraint erasures_pk
+            primary key,
+    ts_create    timestamp default now() not null,
+    user_id      uuid                    not null,
+    source       varchar(16)             not null,
+    requested_by varchar(64)             not null,
+    orders       integer                 not null
+);
+
+alter table "order".erasures
+    owner to krivenkov;
+
+create index erasures_user_id_index
+    on "order".erasures (user_id);
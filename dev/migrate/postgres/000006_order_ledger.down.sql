@@ -0,0 +1,3 @@
+drop table if exists "order".user_event_versions;
+
+drop table if exists "order".processed_messages;
\ No newline at end of file
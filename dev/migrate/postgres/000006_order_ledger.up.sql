@@ -0,0 +1,24 @@
+create table "order".processed_messages
+(
+    topic        varchar(128)            not null,
+    message_id   varchar(64)             not null,
+    key          varchar(128)            not null,
+    ts_processed timestamp default now() not null,
+    constraint processed_messages_pk
+        primary key (topic, message_id)
+);
+
+alter table "order".processed_messages
+    owner to krivenkov;
+
+create table "order".user_event_versions
+(
+    topic   varchar(128) not null,
+    user_id uuid         not null,
+    version timestamp    not null,
+    constraint user_event_versions_pk
+        primary key (topic, user_id)
+);
+
+alter table "order".user_event_versions
+    owner to krivenkov;
@@ -0,0 +1 @@
+drop table if exists "order".history;
\ No newline at end of file
@@ -0,0 +1,21 @@
+create table "order".history
+(
+    id        uuid                    not null
+        constraint history_pk
+            primary key,
+    ts_create timestamp default now() not null,
+    order_id  uuid                    not null
+        constraint history_items_id_fk
+            references "order".items
+            on delete cascade,
+    actor     varchar(64)             not null,
+    source    varchar(16)             not null,
+    action    varchar(16)             not null,
+    changes   jsonb                   not null
+);
+
+alter table "order".history
+    owner to krivenkov;
+
+create index history_order_id_ts_create_index
+    on "order".history (order_id, ts_create);
\ No newline at end of file
@@ -0,0 +1 @@
+drop index if exists "order".items_deleted_ts_modify_index;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- trash listing and the purge job look up deleted orders by modification time
+create index items_deleted_ts_modify_index
+    on "order".items (ts_modify)
+    where status = 2;
\ No newline at end of file
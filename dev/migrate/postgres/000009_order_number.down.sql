@@ -0,0 +1,6 @@
+drop index if exists "order".items_number_uindex;
+
+alter table "order".items
+    drop column if exists number;
+
+drop sequence if exists "order".items_number_seq;
\ No newline at end of file
@@ -0,0 +1,20 @@
+create sequence "order".items_number_seq;
+
+alter sequence "order".items_number_seq
+    owner to krivenkov;
+
+alter table "order".items
+    add column number varchar(32);
+
+-- existing orders are numbered in creation order
+update "order".items i
+set number = 'ORD-' || to_char(n.ts_create, 'YYYY') || '-' || lpad(n.seq::text, greatest(6, length(n.seq::text)), '0')
+from (select s.id, s.ts_create, nextval('"order".items_number_seq') as seq
+      from (select id, ts_create from "order".items order by ts_create, id) s) n
+where i.id = n.id;
+
+alter table "order".items
+    alter column number set not null;
+
+create unique index items_number_uindex
+    on "order".items (number);
\ No newline at end of file
@@ -0,0 +1,3 @@
+alter table "order".items
+    drop column if exists shipping_address,
+    drop column if exists billing_address;
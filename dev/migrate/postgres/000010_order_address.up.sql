@@ -0,0 +1,3 @@
+alter table "order".items
+    add column shipping_address jsonb,
+    add column billing_address  jsonb;
@@ -0,0 +1,10 @@
+drop table if exists "order".shipment_events;
+
+drop table if exists "order".shipment_lines;
+
+drop table if exists "order".shipments;
+
+drop table if exists "order".lines;
+
+alter table "order".items
+    drop column if exists state;
@@ -0,0 +1,83 @@
+alter table "order".items
+    add column state smallint default 1 not null;
+
+create table "order".lines
+(
+    id        uuid                    not null
+        constraint lines_pk
+            primary key,
+    ts_create timestamp default now() not null,
+    order_id  uuid                    not null
+        constraint lines_items_id_fk
+            references "order".items
+            on delete cascade,
+    sku       varchar(64)             not null,
+    name      varchar(256)            not null,
+    quantity  integer                 not null
+        constraint lines_quantity_check
+            check (quantity > 0)
+);
+
+alter table "order".lines
+    owner to krivenkov;
+
+create index lines_order_id_index
+    on "order".lines (order_id);
+
+create table "order".shipments
+(
+    id              uuid                    not null
+        constraint shipments_pk
+            primary key,
+    ts_create       timestamp default now() not null,
+    ts_modify       timestamp default now() not null,
+    order_id        uuid                    not null
+        constraint shipments_items_id_fk
+            references "order".items
+            on delete cascade,
+    carrier         varchar(64)             not null,
+    tracking_number varchar(128)            not null,
+    status          smallint                not null
+);
+
+alter table "order".shipments
+    owner to krivenkov;
+
+create index shipments_order_id_index
+    on "order".shipments (order_id);
+
+create table "order".shipment_lines
+(
+    shipment_id uuid    not null
+        constraint shipment_lines_shipments_id_fk
+            references "order".shipments
+            on delete cascade,
+    line_id     uuid    not null
+        constraint shipment_lines_lines_id_fk
+            references "order".lines
+            on delete cascade,
+    quantity    integer not null
+        constraint shipment_lines_quantity_check
+            check (quantity > 0),
+    constraint shipment_lines_pk
+        primary key (shipment_id, line_id)
+);
+
+alter table "order".shipment_lines
+    owner to krivenkov;
+
+create table "order".shipment_events
+(
+    shipment_id uuid                    not null
+        constraint shipment_events_shipments_id_fk
+            references "order".shipments
+            on delete cascade,
+    ts_create   timestamp default now() not null,
+    status      smallint                not null
+);
+
+alter table "order".shipment_events
+    owner to krivenkov;
+
+create index shipment_events_shipment_id_ts_create_index
+    on "order".shipment_events (shipment_id, ts_create);
@@ -0,0 +1,27 @@
+create table "order".payments
+(
+    id           uuid                    not null
+        constraint payments_pk
+            primary key,
+    ts_create    timestamp default now() not null,
+    order_id     uuid                    not null
+        constraint payments_items_id_fk
+            references "order".items
+            on delete cascade,
+    provider     varchar(64)             not null,
+    external_ref varchar(128)            not null,
+    amount       bigint                  not null
+        constraint payments_amount_check
+            check (amount > 0),
+    currency     char(3)                 not null,
+    status       smallint                not null,
+    reason       text      default ''    not null,
+    constraint payments_provider_external_ref_key
+        unique (provider, external_ref)
+);
+
+alter table "order".payments
+    owner to krivenkov;
+
+create index payments_order_id_index
+    on "order".payments (order_id);
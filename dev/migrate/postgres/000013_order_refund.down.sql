@@ -0,0 +1,14 @@
+drop table if exists "order".refunds;
+
+drop table if exists "order".returns;
+
+alter table "order".lines
+    drop column if exists unit_price;
+
+alter table "order".items
+    drop constraint if exists items_refunded_check,
+    drop column if exists refunded,
+    drop column if exists paid,
+    drop column if exists total,
+    drop column if exists subtotal,
+    drop column if exists currency;
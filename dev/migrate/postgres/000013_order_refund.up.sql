@@ -0,0 +1,76 @@
+alter table "order".items
+    add column currency varchar(3) default '' not null,
+    add column subtotal bigint     default 0  not null,
+    add column total    bigint     default 0  not null,
+    add column paid     bigint     default 0  not null,
+    add column refunded bigint     default 0  not null,
+    add constraint items_refunded_check
+        check (refunded <= paid);
+
+alter table "order".lines
+    add column unit_price bigint default 0 not null
+        constraint lines_unit_price_check
+            check (unit_price >= 0);
+
+create table "order".returns
+(
+    id         uuid                    not null
+        constraint returns_pk
+            primary key,
+    ts_create  timestamp default now() not null,
+    ts_modify  timestamp default now() not null,
+    order_id   uuid                    not null
+        constraint returns_items_id_fk
+            references "order".items
+            on delete cascade,
+    line_id    uuid                    not null
+        constraint returns_lines_id_fk
+            references "order".lines
+            on delete cascade,
+    quantity   integer                 not null
+        constraint returns_quantity_check
+            check (quantity > 0),
+    reason     text                    not null,
+    status     smallint                not null,
+    comment    text      default ''    not null,
+    decided_by varchar(64) default ''  not null
+);
+
+alter table "order".returns
+    owner to krivenkov;
+
+create index returns_order_id_index
+    on "order".returns (order_id);
+
+create table "order".refunds
+(
+    id         uuid                    not null
+        constraint refunds_pk
+            primary key,
+    ts_create  timestamp default now() not null,
+    order_id   uuid                    not null
+        constraint refunds_items_id_fk
+            references "order".items
+            on delete cascade,
+    return_id  uuid                    not null
+        constraint refunds_returns_id_fk
+            references "order".returns
+            on delete cascade,
+    payment_id uuid                    not null
+        constraint refunds_payments_id_fk
+            references "order".payments
+            on delete cascade,
+    amount     bigint                  not null
+        constraint refunds_amount_check
+            check (amount > 0),
+    currency   char(3)                 not null
+);
+
+alter table "order".refunds
+    owner to krivenkov;
+
+create index refunds_order_id_index
+    on "order".refunds (order_id);
+
+create index refunds_payment_id_index
+    on "order".refunds (payment_id);
@@ -0,0 +1,10 @@
+alter table "order".lines
+    drop column if exists discount;
+
+alter table "order".items
+    drop column if exists discounts,
+    drop column if exists discount;
+
+drop table if exists "order".promo_redemptions;
+
+drop table if exists "order".promos;
@@ -0,0 +1,63 @@
+create table "order".promos
+(
+    id                uuid                    not null
+        constraint promos_pk
+            primary key,
+    ts_create         timestamp default now() not null,
+    ts_modify         timestamp default now() not null,
+    code              varchar(32)             not null
+        constraint promos_code_key
+            unique,
+    kind              smallint                not null,
+    scope             smallint                not null,
+    value             bigint                  not null,
+    currency          varchar(3) default ''   not null,
+    skus              text[]    default '{}'  not null,
+    min_amount        bigint    default 0     not null,
+    valid_from        timestamp,
+    valid_to          timestamp,
+    max_uses          integer   default 0     not null,
+    max_uses_per_user integer   default 0     not null,
+    uses              integer   default 0     not null,
+    constraint promos_uses_check
+        check (max_uses = 0 or uses <= max_uses)
+);
+
+alter table "order".promos
+    owner to krivenkov;
+
+create table "order".promo_redemptions
+(
+    id        uuid                    not null
+        constraint promo_redemptions_pk
+            primary key,
+    ts_create timestamp default now() not null,
+    promo_id  uuid                    not null
+        constraint promo_redemptions_promos_id_fk
+            references "order".promos
+            on delete cascade,
+    order_id  uuid                    not null
+        constraint promo_redemptions_items_id_fk
+            references "order".items
+            on delete cascade,
+    user_id   uuid                    not null,
+    amount    bigint                  not null
+);
+
+alter table "order".promo_redemptions
+    owner to krivenkov;
+
+create index promo_redemptions_promo_id_user_id_index
+    on "order".promo_redemptions (promo_id, user_id);
+
+create index promo_redemptions_order_id_index
+    on "order".promo_redemptions (order_id);
+
+alter table "order".items
+    add column discount  bigint default 0     not null,
+    add column discounts jsonb  default '[]'  not null;
+
+alter table "order".lines
+    add column discount bigint default 0 not null
+        constraint lines_discount_check
+            check (discount >= 0);
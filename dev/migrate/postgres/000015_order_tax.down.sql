@@ -0,0 +1,8 @@
+alter table "order".lines
+    drop column if exists tax,
+    drop column if exists tax_category;
+
+alter table "order".items
+    drop column if exists taxes,
+    drop column if exists tax_inclusive,
+    drop column if exists tax;
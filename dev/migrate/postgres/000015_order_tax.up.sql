@@ -0,0 +1,10 @@
+alter table "order".items
+    add column tax           bigint  default 0     not null,
+    add column tax_inclusive boolean default false not null,
+    add column taxes         jsonb   default '[]'  not null;
+
+alter table "order".lines
+    add column tax_category varchar(32) default '' not null,
+    add column tax          bigint      default 0  not null
+        constraint lines_tax_check
+            check (tax >= 0);
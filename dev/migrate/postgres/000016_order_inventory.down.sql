@@ -0,0 +1,3 @@
+drop table if exists "order".reservations;
+
+drop table if exists "order".stock;
@@ -0,0 +1,47 @@
+create table "order".stock
+(
+    sku       varchar(64)             not null
+        constraint stock_pk
+            primary key,
+    ts_modify timestamp default now() not null,
+    available integer   default 0     not null
+        constraint stock_available_check
+            check (available >= 0),
+    reserved  integer   default 0     not null
+        constraint stock_reserved_check
+            check (reserved >= 0)
+);
+
+alter table "order".stock
+    owner to krivenkov;
+
+create table "order".reservations
+(
+    id        uuid                    not null
+        constraint reservations_pk
+            primary key,
+    ts_create timestamp default now() not null,
+    ts_expire timestamp,
+    order_id  uuid                    not null
+        constraint reservations_items_id_fk
+            references "order".items
+            on delete cascade,
+    sku       varchar(64)             not null
+        constraint reservations_stock_sku_fk
+            references "order".stock
+            on delete cascade,
+    quantity  integer                 not null
+        constraint reservations_quantity_check
+            check (quantity > 0),
+    status    smallint                not null
+);
+
+alter table "order".reservations
+    owner to krivenkov;
+
+create index reservations_order_id_index
+    on "order".reservations (order_id);
+
+create index reservations_ts_expire_index
+    on "order".reservations (ts_expire)
+    where status = 1;
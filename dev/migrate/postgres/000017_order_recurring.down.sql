@@ -0,0 +1,3 @@
+drop table if exists "order".recurring_runs;
+
+drop table if exists "order".recurring_templates;
@@ -0,0 +1,51 @@
+create table "order".recurring_templates
+(
+    id        uuid                    not null
+        constraint recurring_templates_pk
+            primary key,
+    ts_create timestamp default now() not null,
+    ts_modify timestamp default now() not null,
+    user_id   uuid                    not null,
+    name      varchar(256)            not null,
+    cron      varchar(128) default '' not null,
+    interval  bigint       default 0  not null,
+    next_run  timestamp               not null,
+    paused    boolean default false   not null,
+    form      jsonb                   not null,
+    constraint recurring_templates_schedule_check
+        check ((cron = '') <> (interval = 0))
+);
+
+alter table "order".recurring_templates
+    owner to krivenkov;
+
+create index recurring_templates_user_id_index
+    on "order".recurring_templates (user_id);
+
+create index recurring_templates_next_run_index
+    on "order".recurring_templates (next_run)
+    where not paused;
+
+create table "order".recurring_runs
+(
+    id           uuid                    not null
+        constraint recurring_runs_pk
+            primary key,
+    ts_create    timestamp default now() not null,
+    template_id  uuid                    not null
+        constraint recurring_runs_recurring_templates_id_fk
+            references "order".recurring_templates
+            on delete cascade,
+    scheduled_at timestamp               not null,
+    order_id     uuid
+        constraint recurring_runs_items_id_fk
+            references "order".items
+            on delete set null,
+    error        text      default ''    not null
+);
+
+alter table "order".recurring_runs
+    owner to krivenkov;
+
+create index recurring_runs_template_id_ts_create_index
+    on "order".recurring_runs (template_id, ts_create);
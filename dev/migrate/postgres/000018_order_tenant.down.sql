@@ -0,0 +1,6 @@
+alter table "order".items
+    drop column if exists tenant_id;
+
+drop table if exists "order".tenant_members;
+
+drop table if exists "order".tenants;
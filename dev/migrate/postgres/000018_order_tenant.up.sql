@@ -0,0 +1,41 @@
+create table "order".tenants
+(
+    id        uuid                    not null
+        constraint tenants_pk
+            primary key,
+    ts_create timestamp default now() not null,
+    name      varchar(256)            not null
+);
+
+alter table "order".tenants
+    owner to krivenkov;
+
+create table "order".tenant_members
+(
+    tenant_id uuid                    not null
+        constraint tenant_members_tenants_id_fk
+            references "order".tenants
+            on delete cascade,
+    user_id   uuid                    not null,
+    role      varchar(16)             not null
+        constraint tenant_members_role_check
+            check (role in ('viewer', 'editor', 'approver')),
+    ts_create timestamp default now() not null,
+    constraint tenant_members_pk
+        primary key (tenant_id, user_id)
+);
+
+alter table "order".tenant_members
+    owner to krivenkov;
+
+create index tenant_members_user_id_index
+    on "order".tenant_members (user_id);
+
+alter table "order".items
+    add column tenant_id uuid
+        constraint items_tenants_id_fk
+            references "order".tenants;
+
+create index items_tenant_id_index
+    on "order".items (tenant_id)
+    where tenant_id is not null;
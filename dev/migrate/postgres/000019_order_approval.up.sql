@@ -0,0 +1,33 @@
+create table "order".approvals
+(
+    id           uuid                     not null
+        constraint approvals_pk
+            primary key,
+    ts_create    timestamp default now()  not null,
+    ts_modify    timestamp default now()  not null,
+    order_id     uuid                     not null
+        constraint approvals_items_id_fk
+            references "order".items
+            on delete cascade,
+    tenant_id    uuid                     not null
+        constraint approvals_tenants_id_fk
+            references "order".tenants
+            on delete cascade,
+    requested_by uuid                     not null,
+    approvers    uuid[]    default '{}'   not null,
+    status       smallint                 not null,
+    level        integer   default 0      not null,
+    ts_due       timestamp                not null,
+    comment      text      default ''     not null,
+    decided_by   varchar(64) default ''   not null
+);
+
+alter table "order".approvals
+    owner to krivenkov;
+
+create index approvals_order_id_index
+    on "order".approvals (order_id);
+
+create index approvals_ts_due_index
+    on "order".approvals (ts_due)
+    where status = 1;
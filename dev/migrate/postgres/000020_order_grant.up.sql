@@ -0,0 +1,22 @@
+create table "order".grants
+(
+    order_id   uuid                    not null
+        constraint grants_items_id_fk
+            references "order".items
+            on delete cascade,
+    user_id    uuid                    not null,
+    permission varchar(16)             not null
+        constraint grants_permission_check
+            check (permission in ('read', 'edit')),
+    granted_by uuid                    not null,
+    ts_create  timestamp default now() not null,
+    ts_expire  timestamp,
+    constraint grants_pk
+        primary key (order_id, user_id)
+);
+
+alter table "order".grants
+    owner to krivenkov;
+
+create index grants_user_id_index
+    on "order".grants (user_id);
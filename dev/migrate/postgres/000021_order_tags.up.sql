@@ -0,0 +1,5 @@
+alter table "order".items
+    add column tags text[] default '{}' not null;
+
+create index items_tags_index
+    on "order".items using gin (tags);
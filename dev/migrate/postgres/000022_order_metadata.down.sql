@@ -0,0 +1,4 @@
+drop index if exists "order".items_metadata_index;
+
+alter table "order".items
+    drop column if exists metadata;
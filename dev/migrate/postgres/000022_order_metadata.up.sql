@@ -0,0 +1,5 @@
+alter table "order".items
+    add column metadata jsonb default '{}' not null;
+
+create index items_metadata_index
+    on "order".items using gin (metadata jsonb_path_ops);
@@ -0,0 +1,21 @@
+create table "order".attachments
+(
+    id           uuid                    not null
+        constraint attachments_pk
+            primary key,
+    ts_create    timestamp default now() not null,
+    order_id     uuid                    not null
+        constraint attachments_items_id_fk
+            references "order".items
+            on delete cascade,
+    user_id      uuid                    not null,
+    file_name    varchar(255)            not null,
+    content_type varchar(128)            not null,
+    size         bigint                  not null
+);
+
+alter table "order".attachments
+    owner to krivenkov;
+
+create index attachments_order_id_index
+    on "order".attachments (order_id);
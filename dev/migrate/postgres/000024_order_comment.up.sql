@@ -0,0 +1,21 @@
+create table "order".comments
+(
+    id        uuid                    not null
+        constraint comments_pk
+            primary key,
+    ts_create timestamp default now() not null,
+    ts_edit   timestamp,
+    order_id  uuid                    not null
+        constraint comments_items_id_fk
+            references "order".items
+            on delete cascade,
+    author_id uuid                    not null,
+    body      text                    not null,
+    internal  boolean default false   not null
+);
+
+alter table "order".comments
+    owner to krivenkov;
+
+create index comments_order_id_ts_create_index
+    on "order".comments (order_id, ts_create);
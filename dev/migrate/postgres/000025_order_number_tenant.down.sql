@@ -0,0 +1,36 @@
+-- the global sequence goes on past every tenant one, so the numbers issued from now on are unique again
+select setval('"order".items_number_seq', max(last_value))
+from pg_sequences
+where schemaname = 'order'
+  and sequencename like 'items_number_seq%'
+  and last_value is not null;
+
+drop trigger if exists tenants_number_sequence on "order".tenants;
+
+drop function if exists "order".tenants_number_sequence();
+
+do
+$$
+    declare
+        s record;
+    begin
+        for s in select sequencename
+                 from pg_sequences
+                 where schemaname = 'order'
+                   and sequencename like 'items\_number\_seq\_%'
+            loop
+                execute format('drop sequence "order".%I', s.sequencename);
+            end loop;
+    end
+$$;
+
+drop function if exists "order".number_sequence(text);
+
+drop index if exists "order".items_personal_number_uindex;
+
+drop index if exists "order".items_tenant_id_number_uindex;
+
+-- not reversible once two tenants issued the same number: the index fails until one of the orders is
+-- renumbered by hand
+create unique index items_number_uindex
+    on "order".items (number);
@@ -0,0 +1,65 @@
+-- order numbers are counted per tenant from a sequence of its own, the personal orders keep the
+-- global one. nextval is not rolled back, so a failed create leaves a gap and no create waits for another.
+create function "order".number_sequence(scope text) returns text
+    language sql
+    immutable
+as
+$$
+select case
+           when scope = '' then '"order".items_number_seq'
+           else '"order".items_number_seq_' || replace(scope::uuid::text, '-', '_')
+           end
+$$;
+
+alter function "order".number_sequence(text)
+    owner to krivenkov;
+
+create function "order".tenants_number_sequence() returns trigger
+    language plpgsql
+as
+$$
+begin
+    if tg_op = 'INSERT' then
+        execute 'create sequence ' || "order".number_sequence(new.id::text);
+        return new;
+    end if;
+
+    execute 'drop sequence if exists ' || "order".number_sequence(old.id::text);
+    return old;
+end
+$$;
+
+alter function "order".tenants_number_sequence()
+    owner to krivenkov;
+
+create trigger tenants_number_sequence
+    after insert or delete
+    on "order".tenants
+    for each row
+execute function "order".tenants_number_sequence();
+
+-- the sequences of the existing tenants go on from the global one, so the numbers issued before stay
+-- unique in their tenant
+do
+$$
+    declare
+        t record;
+    begin
+        for t in select id from "order".tenants
+            loop
+                execute format('create sequence %s start with %s',
+                               "order".number_sequence(t.id::text),
+                               (select last_value + 1 from "order".items_number_seq));
+            end loop;
+    end
+$$;
+
+drop index if exists "order".items_number_uindex;
+
+create unique index items_tenant_id_number_uindex
+    on "order".items (tenant_id, number)
+    where tenant_id is not null;
+
+create unique index items_personal_number_uindex
+    on "order".items (number)
+    where tenant_id is null;
@@ -0,0 +1,4 @@
+drop index if exists "order".refunds_unpublished_index;
+
+alter table "order".refunds
+    drop column if exists ts_published;
@@ -0,0 +1,10 @@
+alter table "order".refunds
+    add column ts_published timestamp;
+
+-- the refunds made so far were published right after they were approved
+update "order".refunds
+set ts_published = ts_create;
+
+create index refunds_unpublished_index
+    on "order".refunds (ts_create)
+    where ts_published is null;
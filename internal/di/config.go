@@ -2,6 +2,8 @@ package di
 
 import (
 	"github.com/krivenkov/order/internal/server"
+	"github.com/krivenkov/order/internal/service"
+	"github.com/krivenkov/order/internal/storage"
 	"github.com/krivenkov/pkg/auth"
 	busBuilder "github.com/krivenkov/pkg/bus/builder"
 	"github.com/krivenkov/pkg/clients/database"
@@ -21,5 +23,7 @@ type Config struct {
 	ES   es.Config         `json:"es" yaml:"es" envPrefix:"ES_"`
 	Auth auth.Config       `json:"auth" yaml:"auth" envPrefix:"AUTH_"`
 
-	Server server.Config `json:"server" yaml:"server" envPrefix:"SERVER_"`
+	Storage storage.Config `json:"storage" yaml:"storage" envPrefix:"STORAGE_"`
+	Service service.Config `json:"service" yaml:"service" envPrefix:"SERVICE_"`
+	Server  server.Config  `json:"server" yaml:"server" envPrefix:"SERVER_"`
 }
@@ -0,0 +1,17 @@
+package approval
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	Create(ctx context.Context, item *Approval) error
+	// Decide stores the decision, it returns model.ErrConflict when the request was decided since it was read
+	Decide(ctx context.Context, item *Approval) error
+	// Escalate stores the escalation of the request due by before, it returns model.ErrConflict
+	// when the request was decided or escalated since it was read
+	Escalate(ctx context.Context, item *Approval, before time.Time) error
+}
@@ -0,0 +1,167 @@
+package approval
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/pkg/busapi/topics"
+)
+
+const NotifiedTopic topics.Topic = "order.approval.order.1"
+
+const maxCommentLen = 1024
+
+type Status int
+
+const (
+	StatusPending  Status = 1
+	StatusApproved Status = 2
+	StatusRejected Status = 3
+)
+
+var statusNames = map[Status]string{
+	StatusPending:  "pending",
+	StatusApproved: "approved",
+	StatusRejected: "rejected",
+}
+
+func (s Status) String() string {
+	if s == 0 {
+		return ""
+	}
+
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(s))
+}
+
+// Approval is the request to approve a tenant order above the threshold before it is placed
+type Approval struct {
+	ID       string
+	TSCreate time.Time
+	TSModify time.Time
+
+	OrderID     string
+	TenantID    string
+	RequestedBy string
+	// Approvers are the approvers of the tenant when the request was made or last escalated,
+	// the requester is never one of them
+	Approvers []string
+	Status    Status
+	// Level counts the escalations
+	Level int
+	// TSDue is when the request escalates unless decided
+	TSDue time.Time
+
+	// Comment and DecidedBy are set by the approver who approved or rejected the order
+	Comment   string
+	DecidedBy string
+}
+
+func New(orderID, tenantID, requestedBy string, approvers []string, timeout time.Duration, now func() time.Time, newID func() uuid.UUID) *Approval {
+	return &Approval{
+		ID:          newID().String(),
+		TSCreate:    now(),
+		TSModify:    now(),
+		OrderID:     orderID,
+		TenantID:    tenantID,
+		RequestedBy: requestedBy,
+		Approvers:   approvers,
+		Status:      StatusPending,
+		TSDue:       now().Add(timeout),
+	}
+}
+
+func (a *Approval) IsApprover(userID string) bool {
+	for _, id := range a.Approvers {
+		if id == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Decide approves or rejects the pending request, a rejection must be explained
+func (a *Approval) Decide(status Status, actorID, comment string, now time.Time) error {
+	if a.Status != StatusPending {
+		return fmt.Errorf("%w: approval is already %s", model.ErrConflict, a.Status)
+	}
+
+	comment = strings.TrimSpace(comment)
+
+	switch {
+	case status == StatusRejected && comment == "":
+		return fmt.Errorf("%w: comment is required to reject", model.ErrInvalidArgument)
+	case utf8.RuneCountInString(comment) > maxCommentLen:
+		return fmt.Errorf("%w: comment is longer than %d characters", model.ErrInvalidArgument, maxCommentLen)
+	}
+
+	a.Status = status
+	a.Comment = comment
+	a.DecidedBy = actorID
+	a.TSModify = now
+
+	return nil
+}
+
+// Escalate raises the level of the pending request and gives the approvers another timeout
+func (a *Approval) Escalate(approvers []string, timeout time.Duration, now time.Time) {
+	a.Approvers = approvers
+	a.Level++
+	a.TSDue = now.Add(timeout)
+	a.TSModify = now
+}
+
+// Diff lists the changes of the approval to record them in the history of its order,
+// there are none for an order without an approval
+func Diff(before, after *Approval) []*history.Change {
+	if after == nil {
+		return nil
+	}
+
+	var prev Approval
+	if before != nil {
+		prev = *before
+	}
+
+	fields := []history.Change{
+		{Field: "approval_status", Old: prev.Status.String(), New: after.Status.String()},
+		{Field: "approval_level", Old: strconv.Itoa(prev.Level), New: strconv.Itoa(after.Level)},
+		{Field: "approvers", Old: strings.Join(prev.Approvers, ","), New: strings.Join(after.Approvers, ",")},
+		{Field: "approval_comment", Old: prev.Comment, New: after.Comment},
+	}
+
+	changes := make([]*history.Change, 0, len(fields))
+	for i := range fields {
+		if fields[i].Old != fields[i].New {
+			changes = append(changes, &fields[i])
+		}
+	}
+
+	return changes
+}
+
+type Event string
+
+const (
+	EventRequested Event = "requested"
+	EventEscalated Event = "escalated"
+	EventApproved  Event = "approved"
+	EventRejected  Event = "rejected"
+)
+
+// Notification is published on every transition of an approval, the approvers of a
+// requested or escalated one are to be told about it
+type Notification struct {
+	Event    Event
+	Approval *Approval
+}
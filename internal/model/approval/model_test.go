@@ -0,0 +1,66 @@
+package approval_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/approval"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecide(t *testing.T) {
+	now := time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		current  approval.Status
+		status   approval.Status
+		comment  string
+		expected error
+	}{
+		{name: "Approve", current: approval.StatusPending, status: approval.StatusApproved},
+		{name: "Reject", current: approval.StatusPending, status: approval.StatusRejected, comment: " too much "},
+		{name: "Reject without comment", current: approval.StatusPending, status: approval.StatusRejected, comment: "  ", expected: model.ErrInvalidArgument},
+		{name: "Long comment", current: approval.StatusPending, status: approval.StatusApproved, comment: strings.Repeat("a", 1025), expected: model.ErrInvalidArgument},
+		{name: "Already decided", current: approval.StatusApproved, status: approval.StatusRejected, comment: "no", expected: model.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &approval.Approval{Status: tt.current}
+
+			err := item.Decide(tt.status, "approver_id", tt.comment, now)
+			if tt.expected != nil {
+				require.ErrorIs(t, err, tt.expected)
+				require.Equal(t, tt.current, item.Status)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.status, item.Status)
+			require.Equal(t, strings.TrimSpace(tt.comment), item.Comment)
+			require.Equal(t, "approver_id", item.DecidedBy)
+			require.Equal(t, now, item.TSModify)
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := &approval.Approval{Status: approval.StatusPending, Approvers: []string{"a", "b"}}
+	after := &approval.Approval{Status: approval.StatusPending, Approvers: []string{"a", "b", "c"}, Level: 1}
+
+	require.Equal(t, []*history.Change{
+		{Field: "approval_level", Old: "0", New: "1"},
+		{Field: "approvers", Old: "a,b", New: "a,b,c"},
+	}, approval.Diff(before, after))
+
+	require.Equal(t, []*history.Change{
+		{Field: "approval_status", Old: "", New: "pending"},
+		{Field: "approvers", Old: "", New: "a,b"},
+	}, approval.Diff(nil, before))
+
+	require.Nil(t, approval.Diff(nil, nil))
+}
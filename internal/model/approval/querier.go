@@ -0,0 +1,24 @@
+package approval
+
+import (
+	"context"
+	"time"
+
+	"github.com/krivenkov/pkg/option"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	// GetItem returns the latest request matching the filter
+	GetItem(ctx context.Context, filter *Filter) (*Approval, error)
+	// GetList returns the requests, the first due first
+	GetList(ctx context.Context, filter *Filter) ([]*Approval, error)
+}
+
+type Filter struct {
+	OrderID option.Option[string]
+	Status  option.Option[Status]
+	// DueBefore keeps the requests due not after the time
+	DueBefore option.Option[time.Time]
+}
@@ -0,0 +1,23 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrContentSize is returned by Put when the content is longer or shorter than its size
+var ErrContentSize = errors.New("content is not of its size")
+
+//go:generate mockgen -source=blob.go -destination=mock/blob.go
+
+// BlobStore keeps the content of the attachments by key
+type BlobStore interface {
+	// Put stores exactly size bytes of the content, replacing what the key held,
+	// it stores nothing and returns ErrContentSize for a content of another size
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	// Get returns model.ErrNotFound when nothing is stored by the key
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete succeeds when nothing is stored by the key
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,14 @@
+package attachment
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	Create(ctx context.Context, item *Attachment) error
+	Delete(ctx context.Context, id string) error
+	// ReplaceUser gives the attachments the user uploaded to another one
+	ReplaceUser(ctx context.Context, userID, by string) error
+}
@@ -0,0 +1,85 @@
+package attachment
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+)
+
+const (
+	// MaxAttachments limits the files attached to a single order
+	MaxAttachments = 20
+
+	maxFileNameLen = 255
+)
+
+// Attachment describes a file attached to an order, its content is kept in a BlobStore
+type Attachment struct {
+	ID       string
+	TSCreate time.Time
+
+	OrderID string
+	// UserID is who uploaded the file
+	UserID      string
+	FileName    string
+	ContentType string
+	Size        int64
+}
+
+func New(orderID, userID string, form *Form, now func() time.Time, newID func() uuid.UUID) *Attachment {
+	return &Attachment{
+		ID:          newID().String(),
+		TSCreate:    now(),
+		OrderID:     orderID,
+		UserID:      userID,
+		FileName:    form.FileName,
+		ContentType: form.ContentType,
+		Size:        form.Size,
+	}
+}
+
+// Key locates the content of the attachment in the BlobStore
+func (a *Attachment) Key() string {
+	return a.OrderID + "/" + a.ID
+}
+
+// Limits are what an uploaded file must fit in
+type Limits struct {
+	MaxSize      int64
+	ContentTypes []string
+}
+
+type Form struct {
+	FileName    string
+	ContentType string
+	Size        int64
+}
+
+func (f *Form) Validate(limits Limits) error {
+	// browsers of some systems send the full path of the file
+	f.FileName = strings.TrimSpace(path.Base(strings.ReplaceAll(f.FileName, `\`, "/")))
+
+	switch {
+	case f.FileName == "" || f.FileName == "." || f.FileName == ".." || f.FileName == "/":
+		return fmt.Errorf("%w: file name is required", model.ErrInvalidArgument)
+	case utf8.RuneCountInString(f.FileName) > maxFileNameLen:
+		return fmt.Errorf("%w: file name is longer than %d characters", model.ErrInvalidArgument, maxFileNameLen)
+	case f.Size <= 0:
+		return fmt.Errorf("%w: file is empty", model.ErrInvalidArgument)
+	case f.Size > limits.MaxSize:
+		return fmt.Errorf("%w: file is larger than %d bytes", model.ErrInvalidArgument, limits.MaxSize)
+	}
+
+	for _, allowed := range limits.ContentTypes {
+		if f.ContentType == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: content type %q is not allowed", model.ErrInvalidArgument, f.ContentType)
+}
@@ -0,0 +1,45 @@
+package attachment_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormValidate(t *testing.T) {
+	limits := attachment.Limits{MaxSize: 100, ContentTypes: []string{"application/pdf", "image/png"}}
+
+	tests := []struct {
+		name     string
+		form     attachment.Form
+		fileName string
+		valid    bool
+	}{
+		{name: "Valid", form: attachment.Form{FileName: "invoice.pdf", ContentType: "application/pdf", Size: 100}, fileName: "invoice.pdf", valid: true},
+		{name: "Path dropped", form: attachment.Form{FileName: `C:\photos\box.png`, ContentType: "image/png", Size: 1}, fileName: "box.png", valid: true},
+		{name: "Path only", form: attachment.Form{FileName: "../", ContentType: "image/png", Size: 1}},
+		{name: "No file name", form: attachment.Form{FileName: " ", ContentType: "image/png", Size: 1}},
+		{name: "File name too long", form: attachment.Form{FileName: strings.Repeat("x", 256), ContentType: "image/png", Size: 1}},
+		{name: "Empty", form: attachment.Form{FileName: "box.png", ContentType: "image/png"}},
+		{name: "Too large", form: attachment.Form{FileName: "box.png", ContentType: "image/png", Size: 101}},
+		{name: "Content type not allowed", form: attachment.Form{FileName: "run.sh", ContentType: "text/plain", Size: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.form.Validate(limits)
+
+			if !tt.valid {
+				require.ErrorIs(t, err, model.ErrInvalidArgument)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.fileName, tt.form.FileName)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/option"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	// GetList returns the attachments, the oldest first
+	GetList(ctx context.Context, filter *Filter) ([]*Attachment, error)
+}
+
+type Filter struct {
+	IDs      option.Option[[]string]
+	OrderIDs option.Option[[]string]
+}
@@ -0,0 +1,17 @@
+package comment
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	Create(ctx context.Context, item *Comment) error
+	Update(ctx context.Context, item *Comment) error
+	Delete(ctx context.Context, id string) error
+	// DeleteByOrders removes the comments of the orders, missing ones are not an error
+	DeleteByOrders(ctx context.Context, orderIDs ...string) error
+	// ReplaceAuthor gives the comments of the author to another one
+	ReplaceAuthor(ctx context.Context, authorID, by string) error
+}
@@ -0,0 +1,112 @@
+package comment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/pkg/busapi/topics"
+)
+
+const AddedTopic topics.Topic = "order.comment_added.order.1"
+
+const maxBodyLen = 4000
+
+// Comment is a message of the thread of an order, an internal one is a note of the staff
+// the customers never see
+type Comment struct {
+	ID       string
+	TSCreate time.Time
+	// TSEdit is when the body was last changed, nil for a comment never edited
+	TSEdit *time.Time
+
+	OrderID  string
+	AuthorID string
+	Body     string
+	Internal bool
+
+	// OrderUserID and OrderTenantID own the order, the search keeps to the orders of the caller
+	// with them, they are not stored along with the comment
+	OrderUserID   string
+	OrderTenantID string
+}
+
+// Actor is who reads or writes the comments, staff act on any order
+type Actor struct {
+	UserID string
+	Staff  bool
+}
+
+func New(orderID string, actor Actor, form *Form, now func() time.Time, newID func() uuid.UUID) *Comment {
+	return &Comment{
+		ID:       newID().String(),
+		TSCreate: now(),
+		OrderID:  orderID,
+		AuthorID: actor.UserID,
+		Body:     form.Body,
+		Internal: form.Internal,
+	}
+}
+
+func (c *Comment) VisibleTo(actor Actor) bool {
+	return actor.Staff || !c.Internal
+}
+
+// Edit changes the body, only its author edits a comment
+func (c *Comment) Edit(actor Actor, body string, now time.Time) error {
+	if c.AuthorID != actor.UserID {
+		return fmt.Errorf("%w: comment of another author", model.ErrPermissionDenied)
+	}
+
+	body, err := validateBody(body)
+	if err != nil {
+		return err
+	}
+
+	c.Body = body
+	c.TSEdit = &now
+
+	return nil
+}
+
+// CanDelete tells whether the actor deletes the comment, staff delete any of them
+func (c *Comment) CanDelete(actor Actor) bool {
+	return actor.Staff || c.AuthorID == actor.UserID
+}
+
+type Form struct {
+	Body string
+	// Internal keeps the comment to the staff
+	Internal bool
+}
+
+func (f *Form) Validate(actor Actor) error {
+	if f.Internal && !actor.Staff {
+		return fmt.Errorf("%w: internal comments are written by staff", model.ErrPermissionDenied)
+	}
+
+	body, err := validateBody(f.Body)
+	if err != nil {
+		return err
+	}
+
+	f.Body = body
+
+	return nil
+}
+
+func validateBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+
+	switch {
+	case body == "":
+		return "", fmt.Errorf("%w: body is required", model.ErrInvalidArgument)
+	case utf8.RuneCountInString(body) > maxBodyLen:
+		return "", fmt.Errorf("%w: body is longer than %d characters", model.ErrInvalidArgument, maxBodyLen)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,74 @@
+package comment_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormValidate(t *testing.T) {
+	var (
+		customer = comment.Actor{UserID: "user_id"}
+		staff    = comment.Actor{UserID: "staff_id", Staff: true}
+	)
+
+	tests := []struct {
+		name  string
+		actor comment.Actor
+		form  comment.Form
+		body  string
+		err   error
+	}{
+		{name: "Trimmed", actor: customer, form: comment.Form{Body: "\n where is it? "}, body: "where is it?"},
+		{name: "Internal by staff", actor: staff, form: comment.Form{Body: "note", Internal: true}, body: "note"},
+		{name: "Internal by a customer", actor: customer, form: comment.Form{Body: "note", Internal: true}, err: model.ErrPermissionDenied},
+		{name: "Empty", actor: customer, form: comment.Form{Body: " \t"}, err: model.ErrInvalidArgument},
+		{name: "Longest", actor: customer, form: comment.Form{Body: strings.Repeat("ё", 4000)}, body: strings.Repeat("ё", 4000)},
+		{name: "Too long", actor: customer, form: comment.Form{Body: strings.Repeat("ё", 4001)}, err: model.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.form.Validate(tt.actor)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.body, tt.form.Body)
+		})
+	}
+}
+
+func TestEdit(t *testing.T) {
+	edited := time.Date(2000, 1, 1, 15, 24, 11, 0, time.UTC)
+
+	t.Run("Author", func(t *testing.T) {
+		item := &comment.Comment{AuthorID: "user_id", Body: "old"}
+
+		require.NoError(t, item.Edit(comment.Actor{UserID: "user_id"}, " new ", edited))
+		require.Equal(t, "new", item.Body)
+		require.Equal(t, &edited, item.TSEdit)
+	})
+
+	t.Run("Staff edits its own comments only", func(t *testing.T) {
+		item := &comment.Comment{AuthorID: "user_id", Body: "old"}
+
+		require.ErrorIs(t, item.Edit(comment.Actor{UserID: "staff_id", Staff: true}, "new", edited), model.ErrPermissionDenied)
+		require.Equal(t, "old", item.Body)
+		require.Nil(t, item.TSEdit)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		item := &comment.Comment{AuthorID: "user_id", Body: "old"}
+
+		require.ErrorIs(t, item.Edit(comment.Actor{UserID: "user_id"}, "", edited), model.ErrInvalidArgument)
+	})
+}
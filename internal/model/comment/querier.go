@@ -0,0 +1,22 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	// GetList returns the comments, the oldest first
+	GetList(ctx context.Context, filter *Filter, pagination *paginator.Pagination) ([]*Comment, error)
+	Count(ctx context.Context, filter *Filter) (int, error)
+}
+
+type Filter struct {
+	IDs      option.Option[[]string]
+	OrderID  option.Option[string]
+	Internal option.Option[bool]
+}
@@ -0,0 +1,25 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/option"
+)
+
+//go:generate mockgen -source=searcher.go -destination=mock/searcher.go
+
+type Searcher interface {
+	// SearchOrders returns the orders of the comments matching the text, the best matches
+	// first and at most limit of them, internal comments are never matched
+	SearchOrders(ctx context.Context, filter *SearchFilter, limit int) ([]string, error)
+}
+
+// SearchFilter keeps the search to the orders a caller sees, as the filter of the orders does
+type SearchFilter struct {
+	Q string
+	// UserID keeps the orders of the user, along with the ones of SharedIDs
+	UserID    option.Option[string]
+	SharedIDs option.Option[[]string]
+	// TenantID keeps the orders of the tenant, an empty one the personal orders
+	TenantID option.Option[string]
+}
@@ -0,0 +1,11 @@
+package erasure
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	Create(ctx context.Context, item *Erasure) error
+}
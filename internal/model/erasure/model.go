@@ -0,0 +1,43 @@
+package erasure
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/pkg/busapi/topics"
+)
+
+const ErasedTopic topics.Topic = "order.erased.user.1"
+
+// ErasedUser stands for an erased user in what is kept of the user on the orders of others,
+// it is a uuid as the user columns are
+const ErasedUser = "00000000-0000-0000-0000-000000000000"
+
+type Source string
+
+const (
+	SourceBus  Source = "bus"
+	SourceHTTP Source = "http"
+)
+
+// Erasure is a receipt of permanently removed user data
+type Erasure struct {
+	ID       string
+	TSCreate time.Time
+
+	UserID      string
+	Source      Source
+	RequestedBy string
+
+	Orders int
+}
+
+func New(userID string, source Source, requestedBy string, now func() time.Time, newID func() uuid.UUID) *Erasure {
+	return &Erasure{
+		ID:          newID().String(),
+		TSCreate:    now(),
+		UserID:      userID,
+		Source:      source,
+		RequestedBy: requestedBy,
+	}
+}
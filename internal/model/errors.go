@@ -8,4 +8,6 @@ var (
 	ErrNotFound         = errors.New("not found")
 	ErrMultiItems       = errors.New("multi items")
 	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrConflict         = errors.New("conflict")
 )
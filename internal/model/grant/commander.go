@@ -0,0 +1,17 @@
+package grant
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	// Save grants the permission or changes the grant the user already has
+	Save(ctx context.Context, item *Grant) error
+	Delete(ctx context.Context, orderID, userID string) error
+	// DeleteByUser removes the grants the user holds
+	DeleteByUser(ctx context.Context, userID string) error
+	// ReplaceGrantor gives the grants made by the user to another one
+	ReplaceGrantor(ctx context.Context, grantedBy, by string) error
+}
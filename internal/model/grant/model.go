@@ -0,0 +1,83 @@
+package grant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+)
+
+// MaxGrants limits the users a single order is shared with
+const MaxGrants = 50
+
+type Permission string
+
+const (
+	// PermissionRead shows the order and its lines, shipments, payments and history
+	PermissionRead Permission = "read"
+	// PermissionEdit also changes it as the owner does, except deleting or sharing it
+	PermissionEdit Permission = "edit"
+)
+
+var permissionRanks = map[Permission]int{
+	PermissionRead: 1,
+	PermissionEdit: 2,
+}
+
+func (p Permission) Validate() error {
+	if _, ok := permissionRanks[p]; !ok {
+		return fmt.Errorf("%w: unknown permission %q", model.ErrInvalidArgument, p)
+	}
+
+	return nil
+}
+
+// Allows tells whether the permission grants what the given one does
+func (p Permission) Allows(required Permission) bool {
+	rank, ok := permissionRanks[p]
+
+	return ok && rank >= permissionRanks[required]
+}
+
+// Grant shares a personal order with another user
+type Grant struct {
+	OrderID    string
+	UserID     string
+	Permission Permission
+	GrantedBy  string
+	TSCreate   time.Time
+	// TSExpire ends the grant, it never ends when nil
+	TSExpire *time.Time
+}
+
+func New(orderID, userID, grantedBy string, form *Form, now func() time.Time) *Grant {
+	return &Grant{
+		OrderID:    orderID,
+		UserID:     userID,
+		Permission: form.Permission,
+		GrantedBy:  grantedBy,
+		TSCreate:   now(),
+		TSExpire:   form.TSExpire,
+	}
+}
+
+func (g *Grant) Active(now time.Time) bool {
+	return g.TSExpire == nil || g.TSExpire.After(now)
+}
+
+type Form struct {
+	Permission Permission
+	TSExpire   *time.Time
+}
+
+func (f *Form) Validate(now time.Time) error {
+	if err := f.Permission.Validate(); err != nil {
+		return err
+	}
+
+	if f.TSExpire != nil && !f.TSExpire.After(now) {
+		return fmt.Errorf("%w: expiry must be in the future", model.ErrInvalidArgument)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package grant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/grant"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionAllows(t *testing.T) {
+	tests := []struct {
+		permission grant.Permission
+		required   grant.Permission
+		expected   bool
+	}{
+		{permission: grant.PermissionRead, required: grant.PermissionRead, expected: true},
+		{permission: grant.PermissionRead, required: grant.PermissionEdit, expected: false},
+		{permission: grant.PermissionEdit, required: grant.PermissionRead, expected: true},
+		{permission: "", required: grant.PermissionRead, expected: false},
+		{permission: "owner", required: grant.PermissionRead, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.permission)+" "+string(tt.required), func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.permission.Allows(tt.required))
+		})
+	}
+}
+
+func TestFormValidate(t *testing.T) {
+	now := time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+
+	require.NoError(t, (&grant.Form{Permission: grant.PermissionRead}).Validate(now))
+	require.NoError(t, (&grant.Form{Permission: grant.PermissionEdit, TSExpire: ptr.Pointer(now.Add(time.Hour))}).Validate(now))
+	require.ErrorIs(t, (&grant.Form{Permission: "owner"}).Validate(now), model.ErrInvalidArgument)
+	require.ErrorIs(t, (&grant.Form{Permission: grant.PermissionRead, TSExpire: ptr.Pointer(now)}).Validate(now), model.ErrInvalidArgument)
+}
@@ -0,0 +1,22 @@
+package grant
+
+import (
+	"context"
+	"time"
+
+	"github.com/krivenkov/pkg/option"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	// GetList returns the grants, the oldest first
+	GetList(ctx context.Context, filter *Filter) ([]*Grant, error)
+}
+
+type Filter struct {
+	OrderID option.Option[string]
+	UserID  option.Option[string]
+	// ActiveAt keeps the grants not expired at the time
+	ActiveAt option.Option[time.Time]
+}
@@ -0,0 +1,13 @@
+package history
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	Create(ctx context.Context, items ...*Entry) error
+	// ReplaceActor gives the entries of the actor to another one
+	ReplaceActor(ctx context.Context, actor, by string) error
+}
@@ -0,0 +1,69 @@
+package history
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Source string
+
+const (
+	SourceHTTP Source = "http"
+	SourceGRPC Source = "grpc"
+	SourceBus  Source = "bus"
+	// SourceScheduler is a change made by a background job, such as the orders of recurring templates
+	SourceScheduler Source = "scheduler"
+)
+
+type Action string
+
+const (
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionStatus  Action = "status"
+	ActionDelete  Action = "delete"
+	ActionDisable Action = "disable"
+	ActionEnable  Action = "enable"
+	ActionRestore Action = "restore"
+	ActionPayment Action = "payment"
+	ActionRefund  Action = "refund"
+	// ActionLines is a change of the lines of a draft
+	ActionLines    Action = "lines"
+	ActionCheckout Action = "checkout"
+	// ActionApprove, ActionReject and ActionEscalate follow the approval of a tenant order
+	ActionApprove  Action = "approve"
+	ActionReject   Action = "reject"
+	ActionEscalate Action = "escalate"
+)
+
+// Change is a field-level diff
+type Change struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// Entry is an append-only record of an order change
+type Entry struct {
+	ID       string
+	TSCreate time.Time
+
+	OrderID string
+	Actor   string
+	Source  Source
+	Action  Action
+	Changes []*Change
+}
+
+func New(orderID string, origin Origin, action Action, changes []*Change, now func() time.Time, newID func() uuid.UUID) *Entry {
+	return &Entry{
+		ID:       newID().String(),
+		TSCreate: now(),
+		OrderID:  orderID,
+		Actor:    origin.Actor,
+		Source:   origin.Source,
+		Action:   action,
+		Changes:  changes,
+	}
+}
@@ -0,0 +1,25 @@
+package history
+
+import "context"
+
+type originCtxKey struct{}
+
+// Origin tells who made a change and through which interface
+type Origin struct {
+	Actor  string
+	Source Source
+}
+
+func CtxWithOrigin(ctx context.Context, origin Origin) context.Context {
+	return context.WithValue(ctx, originCtxKey{}, origin)
+}
+
+// OriginFromContext returns origin stored in ctx, actor defaults to the given user
+func OriginFromContext(ctx context.Context, defaultActor string) Origin {
+	origin, _ := ctx.Value(originCtxKey{}).(Origin)
+	if origin.Actor == "" {
+		origin.Actor = defaultActor
+	}
+
+	return origin
+}
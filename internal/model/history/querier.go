@@ -0,0 +1,19 @@
+package history
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	GetList(ctx context.Context, filter *Filter, pagination *paginator.Pagination) ([]*Entry, error)
+	Count(ctx context.Context, filter *Filter) (int, error)
+}
+
+type Filter struct {
+	OrderID option.Option[string]
+}
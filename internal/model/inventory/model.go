@@ -0,0 +1,85 @@
+package inventory
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+)
+
+// ErrOutOfStock is returned when a tracked SKU has fewer units available than ordered
+var ErrOutOfStock = fmt.Errorf("%w: out of stock", model.ErrConflict)
+
+type Status int
+
+const (
+	StatusHeld      Status = 1
+	StatusReleased  Status = 2
+	StatusConfirmed Status = 3
+	StatusExpired   Status = 4
+)
+
+var statusNames = map[Status]string{
+	StatusHeld:      "held",
+	StatusReleased:  "released",
+	StatusConfirmed: "confirmed",
+	StatusExpired:   "expired",
+}
+
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(s))
+}
+
+// Reservation holds units of a SKU in stock for an order
+type Reservation struct {
+	ID       string
+	TSCreate time.Time
+	// TSExpire is when the held units go back to stock, nil keeps them until the order
+	// is fulfilled or cancelled
+	TSExpire *time.Time
+
+	OrderID  string
+	SKU      string
+	Quantity int
+	Status   Status
+}
+
+// NewReservations holds the units of the order lines, one reservation per SKU. They are
+// sorted by SKU, so concurrent placements lock the stock rows in the same order.
+func NewReservations(orderID string, lines []*line.Line, expire *time.Time, now func() time.Time, newID func() uuid.UUID) []*Reservation {
+	quantities := make(map[string]int, len(lines))
+	for _, l := range lines {
+		quantities[l.SKU] += l.Quantity
+	}
+
+	skus := make([]string, 0, len(quantities))
+	for sku := range quantities {
+		skus = append(skus, sku)
+	}
+
+	sort.Strings(skus)
+
+	res := make([]*Reservation, 0, len(skus))
+
+	for _, sku := range skus {
+		res = append(res, &Reservation{
+			ID:       newID().String(),
+			TSCreate: now(),
+			TSExpire: expire,
+			OrderID:  orderID,
+			SKU:      sku,
+			Quantity: quantities[sku],
+			Status:   StatusHeld,
+		})
+	}
+
+	return res
+}
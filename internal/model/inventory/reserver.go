@@ -0,0 +1,24 @@
+package inventory
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=reserver.go -destination=mock/reserver.go
+
+// Reserver keeps the stock ledger of the SKUs, the units of a SKU are either available
+// or reserved by orders. SKUs which are not in the ledger are not tracked and never run out.
+type Reserver interface {
+	// Reserve takes the units off the available stock, either all of them or none,
+	// it returns ErrOutOfStock when a tracked SKU has not enough units
+	Reserve(ctx context.Context, items ...*Reservation) error
+	// Keep clears the expiry of the held reservations of the order
+	Keep(ctx context.Context, orderID string) error
+	// Confirm removes the held units of the order from stock once they have shipped
+	Confirm(ctx context.Context, orderID string) error
+	// Release puts the held units of the orders back to the available stock
+	Release(ctx context.Context, orderIDs ...string) error
+	// Expire releases the reservations which expired before the given time and returns their number
+	Expire(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,13 @@
+package leader
+
+import "context"
+
+//go:generate mockgen -source=elector.go -destination=mock/elector.go
+
+// Elector picks the single instance of the service which runs a job
+type Elector interface {
+	// Lead reports whether the instance leads, it tries to take the lead over when it does not
+	Lead(ctx context.Context) (bool, error)
+	// Resign gives the lead up so another instance can take it over
+	Resign(ctx context.Context) error
+}
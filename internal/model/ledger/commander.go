@@ -0,0 +1,15 @@
+package ledger
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	// Register records the message as processed, it returns ErrDuplicate or ErrOutdated
+	// when the message must be skipped. Call it in the transaction of the message handling.
+	Register(ctx context.Context, entry *Entry) error
+	// Forget removes the event versions of the user
+	Forget(ctx context.Context, userID string) error
+}
@@ -0,0 +1,29 @@
+package ledger
+
+import (
+	"errors"
+	"time"
+
+	"github.com/krivenkov/pkg/busapi/topics"
+)
+
+var (
+	// ErrDuplicate is returned when the message was already processed
+	ErrDuplicate = errors.New("message already processed")
+	// ErrOutdated is returned when a newer event of the user was already applied
+	ErrOutdated = errors.New("newer event already applied")
+)
+
+// Entry is a processed bus message
+type Entry struct {
+	Topic topics.Topic
+	// MessageID identifies the message in the topic, dedupe is skipped when empty
+	MessageID string
+	Key       string
+
+	UserID string
+	// Version orders events of the user, ordering is skipped when zero
+	Version time.Time
+
+	TSProcessed time.Time
+}
@@ -0,0 +1,15 @@
+package line
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	Create(ctx context.Context, items ...*Line) error
+	// Update saves the quantity and the amounts of the lines, they change in drafts only
+	Update(ctx context.Context, items ...*Line) error
+	// Delete returns model.ErrNotFound when the line is not in the order
+	Delete(ctx context.Context, orderID, id string) error
+}
@@ -0,0 +1,132 @@
+package line
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+)
+
+const (
+	maxSKULen  = 64
+	maxNameLen = 256
+
+	maxTaxCategoryLen = 32
+
+	// MaxLines is the maximum number of lines in one order
+	MaxLines = 100
+)
+
+// Line is an order line item
+type Line struct {
+	ID       string
+	TSCreate time.Time
+
+	OrderID  string
+	SKU      string
+	Name     string
+	Quantity int
+	// UnitPrice in minor units of the order currency
+	UnitPrice int64
+	// Discount is the part of the promo discounts taken off the line
+	Discount int64
+	// TaxCategory of the product, empty for the standard rate
+	TaxCategory string
+	// Tax of the line, a part of Net when the order prices include taxes and on top of it otherwise
+	Tax int64
+}
+
+// Amount is the price of the whole line
+func (l *Line) Amount() int64 {
+	return l.UnitPrice * int64(l.Quantity)
+}
+
+// Net is the price of the whole line after discounts
+func (l *Line) Net() int64 {
+	return l.Amount() - l.Discount
+}
+
+// UnitsNet is the share of Net for the quantity of units, rounded down
+func (l *Line) UnitsNet(quantity int) int64 {
+	if l.Quantity == 0 {
+		return 0
+	}
+
+	return l.Net() * int64(quantity) / int64(l.Quantity)
+}
+
+// UnitsTax is the share of Tax for the quantity of units, rounded down
+func (l *Line) UnitsTax(quantity int) int64 {
+	if l.Quantity == 0 {
+		return 0
+	}
+
+	return l.Tax * int64(quantity) / int64(l.Quantity)
+}
+
+func New(orderID string, form *Form, now func() time.Time, newID func() uuid.UUID) *Line {
+	return &Line{
+		ID:        newID().String(),
+		TSCreate:  now(),
+		OrderID:   orderID,
+		SKU:       form.SKU,
+		Name:      form.Name,
+		Quantity:  form.Quantity,
+		UnitPrice: form.UnitPrice,
+
+		TaxCategory: form.TaxCategory,
+	}
+}
+
+type Form struct {
+	SKU       string
+	Name      string
+	Quantity  int
+	UnitPrice int64
+
+	TaxCategory string
+}
+
+func (f *Form) Validate() error {
+	f.SKU = strings.TrimSpace(f.SKU)
+	f.Name = strings.TrimSpace(f.Name)
+	f.TaxCategory = NormalizeTaxCategory(f.TaxCategory)
+
+	switch {
+	case f.SKU == "":
+		return fmt.Errorf("%w: sku is required", model.ErrInvalidArgument)
+	case utf8.RuneCountInString(f.SKU) > maxSKULen:
+		return fmt.Errorf("%w: sku is longer than %d characters", model.ErrInvalidArgument, maxSKULen)
+	case utf8.RuneCountInString(f.Name) > maxNameLen:
+		return fmt.Errorf("%w: name is longer than %d characters", model.ErrInvalidArgument, maxNameLen)
+	case f.Quantity <= 0:
+		return fmt.Errorf("%w: quantity must be positive", model.ErrInvalidArgument)
+	case f.UnitPrice < 0:
+		return fmt.Errorf("%w: unit price must not be negative", model.ErrInvalidArgument)
+	case len(f.TaxCategory) > maxTaxCategoryLen:
+		return fmt.Errorf("%w: tax category is longer than %d characters", model.ErrInvalidArgument, maxTaxCategoryLen)
+	}
+
+	return nil
+}
+
+// UpdateForm changes a line of a draft
+type UpdateForm struct {
+	Quantity int
+}
+
+func (f *UpdateForm) Validate() error {
+	if f.Quantity <= 0 {
+		return fmt.Errorf("%w: quantity must be positive", model.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// NormalizeTaxCategory makes tax categories case-insensitive
+func NormalizeTaxCategory(category string) string {
+	return strings.ToLower(strings.TrimSpace(category))
+}
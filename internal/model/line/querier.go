@@ -0,0 +1,19 @@
+package line
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/option"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	// GetList returns lines in the order they were added
+	GetList(ctx context.Context, filter *Filter) ([]*Line, error)
+}
+
+type Filter struct {
+	IDs     option.Option[[]string]
+	OrderID option.Option[string]
+}
@@ -0,0 +1,148 @@
+package order
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/krivenkov/order/internal/model"
+)
+
+type Address struct {
+	// Name of the recipient or the payer
+	Name       string
+	Line1      string
+	Line2      string
+	City       string
+	Region     string
+	PostalCode string
+	// Country is ISO 3166-1 alpha-2 code
+	Country string
+	// Phone in E.164 format
+	Phone string
+}
+
+type countryRule struct {
+	// postalCode is nil for countries without postal codes
+	postalCode *regexp.Regexp
+	// region (state, province) is a part of the address
+	region bool
+}
+
+var countryRules = map[string]countryRule{
+	"AE": {},
+	"AU": {postalCode: regexp.MustCompile(`^\d{4}$`), region: true},
+	"BR": {postalCode: regexp.MustCompile(`^\d{5}-?\d{3}$`), region: true},
+	"BY": {postalCode: regexp.MustCompile(`^\d{6}$`)},
+	"CA": {postalCode: regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`), region: true},
+	"CN": {postalCode: regexp.MustCompile(`^\d{6}$`)},
+	"DE": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"ES": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"FR": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"GB": {postalCode: regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`)},
+	"HK": {},
+	"IN": {postalCode: regexp.MustCompile(`^\d{6}$`), region: true},
+	"IT": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"JP": {postalCode: regexp.MustCompile(`^\d{3}-?\d{4}$`), region: true},
+	"NL": {postalCode: regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`)},
+	"PL": {postalCode: regexp.MustCompile(`^\d{2}-\d{3}$`)},
+	"RU": {postalCode: regexp.MustCompile(`^\d{6}$`)},
+	"UA": {postalCode: regexp.MustCompile(`^\d{5}$`)},
+	"US": {postalCode: regexp.MustCompile(`^\d{5}(-\d{4})?$`), region: true},
+}
+
+var (
+	phoneRe       = regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+	phoneReplacer = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+)
+
+const (
+	maxNameLen = 128
+	maxLineLen = 256
+)
+
+// Normalize trims the fields and brings codes and the phone to canonical form
+func (a *Address) Normalize() {
+	a.Name = strings.TrimSpace(a.Name)
+	a.Line1 = strings.TrimSpace(a.Line1)
+	a.Line2 = strings.TrimSpace(a.Line2)
+	a.City = strings.TrimSpace(a.City)
+	a.Region = strings.TrimSpace(a.Region)
+	a.PostalCode = strings.ToUpper(strings.Join(strings.Fields(a.PostalCode), " "))
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+	a.Phone = phoneReplacer.Replace(strings.TrimSpace(a.Phone))
+}
+
+// Validate checks a normalized address against the rules of its country
+func (a *Address) Validate() error {
+	rule, ok := countryRules[a.Country]
+	if !ok {
+		return invalidAddress("unsupported country %q", a.Country)
+	}
+
+	required := []struct{ field, value string }{
+		{"name", a.Name},
+		{"line1", a.Line1},
+		{"city", a.City},
+		{"phone", a.Phone},
+	}
+	for _, r := range required {
+		if r.value == "" {
+			return invalidAddress("%s is required", r.field)
+		}
+	}
+
+	limited := []struct {
+		field, value string
+		max          int
+	}{
+		{"name", a.Name, maxNameLen},
+		{"line1", a.Line1, maxLineLen},
+		{"line2", a.Line2, maxLineLen},
+		{"city", a.City, maxNameLen},
+		{"region", a.Region, maxNameLen},
+	}
+	for _, l := range limited {
+		if utf8.RuneCountInString(l.value) > l.max {
+			return invalidAddress("%s is longer than %d characters", l.field, l.max)
+		}
+	}
+
+	if rule.region && a.Region == "" {
+		return invalidAddress("region is required in %s", a.Country)
+	}
+
+	switch {
+	case rule.postalCode == nil && a.PostalCode != "":
+		return invalidAddress("%s has no postal codes", a.Country)
+	case rule.postalCode != nil && !rule.postalCode.MatchString(a.PostalCode):
+		return invalidAddress("postal code %q is not valid in %s", a.PostalCode, a.Country)
+	}
+
+	if !phoneRe.MatchString(a.Phone) {
+		return invalidAddress("phone %q is not in international format", a.Phone)
+	}
+
+	return nil
+}
+
+// String is a one-line form of the address used in the order history
+func (a *Address) String() string {
+	if a == nil {
+		return ""
+	}
+
+	parts := make([]string, 0, 8)
+	for _, p := range []string{a.Name, a.Line1, a.Line2, a.City, a.Region, a.PostalCode, a.Country, a.Phone} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func invalidAddress(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", model.ErrInvalidArgument, fmt.Sprintf(format, args...))
+}
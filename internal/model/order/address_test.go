@@ -0,0 +1,66 @@
+package order_test
+
+import (
+	"testing"
+
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() orderModel.Address {
+		return orderModel.Address{
+			Name:       "John Smith",
+			Line1:      "1 Main St",
+			City:       "Springfield",
+			Region:     "IL",
+			PostalCode: "62701-1234",
+			Country:    "us",
+			Phone:      "+1 (217) 555-0100",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(a *orderModel.Address)
+		valid  bool
+	}{
+		{name: "Valid US", modify: func(a *orderModel.Address) {}, valid: true},
+		{name: "Valid GB without region", modify: func(a *orderModel.Address) {
+			a.Country, a.Region, a.PostalCode = "GB", "", "sw1a 1aa"
+		}, valid: true},
+		{name: "Valid AE without postal code", modify: func(a *orderModel.Address) {
+			a.Country, a.Region, a.PostalCode = "AE", "", ""
+		}, valid: true},
+		{name: "Unsupported country", modify: func(a *orderModel.Address) { a.Country = "XX" }},
+		{name: "Missing city", modify: func(a *orderModel.Address) { a.City = " " }},
+		{name: "Missing US region", modify: func(a *orderModel.Address) { a.Region = "" }},
+		{name: "Wrong US postal code", modify: func(a *orderModel.Address) { a.PostalCode = "SW1A 1AA" }},
+		{name: "Postal code in AE", modify: func(a *orderModel.Address) {
+			a.Country, a.PostalCode = "AE", "12345"
+		}},
+		{name: "Local phone", modify: func(a *orderModel.Address) { a.Phone = "555-0100" }},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := valid()
+			tt.modify(&a)
+			a.Normalize()
+
+			err := a.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, model.ErrInvalidArgument)
+		})
+	}
+}
@@ -10,5 +10,9 @@ type Commander interface {
 	Create(ctx context.Context, item *Order) error
 	Update(ctx context.Context, item *Order) error
 	Delete(ctx context.Context, item *Order) error
-	Disable(ctx context.Context, userID string) error
+	// Disable hides active personal orders of the user, remembering their status.
+	// The orders the user created in a tenant belong to the tenant and stay visible.
+	Disable(ctx context.Context, userID string) ([]*Transition, error)
+	// Enable restores exactly the orders hidden by Disable
+	Enable(ctx context.Context, userID string) ([]*Transition, error)
 }
@@ -0,0 +1,73 @@
+package order
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/krivenkov/order/internal/model/history"
+)
+
+var statusNames = map[Status]string{
+	StatusCreated:  "created",
+	StatusDeleted:  "deleted",
+	StatusDisabled: "disabled",
+}
+
+func (s Status) String() string {
+	if s == 0 {
+		return ""
+	}
+
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(s))
+}
+
+// Transition is a status change made by a bulk operation
+type Transition struct {
+	ID   string
+	From Status
+	To   Status
+}
+
+func (t *Transition) Changes() []*history.Change {
+	return []*history.Change{{Field: "status", Old: t.From.String(), New: t.To.String()}}
+}
+
+// Diff returns changed fields of the order, before is nil for a new order
+func Diff(before, after *Order) []*history.Change {
+	var prev Order
+	if before != nil {
+		prev = *before
+	}
+
+	fields := []history.Change{
+		{Field: "status", Old: prev.Status.String(), New: after.Status.String()},
+		{Field: "state", Old: prev.State.String(), New: after.State.String()},
+		{Field: "number", Old: prev.Number, New: after.Number},
+		{Field: "name", Old: prev.Name, New: after.Name},
+		{Field: "description", Old: prev.Description, New: after.Description},
+		{Field: "shipping_address", Old: prev.ShippingAddress.String(), New: after.ShippingAddress.String()},
+		{Field: "billing_address", Old: prev.BillingAddress.String(), New: after.BillingAddress.String()},
+		{Field: "tags", Old: strings.Join(prev.Tags, ", "), New: strings.Join(after.Tags, ", ")},
+		{Field: "metadata", Old: formatMetadata(prev.Metadata), New: formatMetadata(after.Metadata)},
+		{Field: "currency", Old: prev.Totals.Currency, New: after.Totals.Currency},
+		{Field: "promo_codes", Old: formatCodes(prev.Discounts), New: formatCodes(after.Discounts)},
+		{Field: "discount", Old: formatAmount(prev.Totals.Discount), New: formatAmount(after.Totals.Discount)},
+		{Field: "tax", Old: formatAmount(prev.Totals.Tax), New: formatAmount(after.Totals.Tax)},
+		{Field: "total", Old: formatAmount(prev.Totals.Total), New: formatAmount(after.Totals.Total)},
+		{Field: "paid", Old: formatAmount(prev.Totals.Paid), New: formatAmount(after.Totals.Paid)},
+		{Field: "refunded", Old: formatAmount(prev.Totals.Refunded), New: formatAmount(after.Totals.Refunded)},
+	}
+
+	changes := make([]*history.Change, 0, len(fields))
+	for i := range fields {
+		if fields[i].Old != fields[i].New {
+			changes = append(changes, &fields[i])
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,69 @@
+package order
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/krivenkov/order/internal/model"
+)
+
+const (
+	// MaxMetadataKeys limits the metadata of an order
+	MaxMetadataKeys = 50
+	// MaxMetadataKeyLength and MaxMetadataValueLength are counted in characters
+	MaxMetadataKeyLength   = 40
+	MaxMetadataValueLength = 500
+)
+
+// metadataKeyRe keeps keys usable as ES field names and in the key:value filters
+var metadataKeyRe = regexp.MustCompile(`^[A-Za-z0-9_\-]+$`)
+
+// ValidateMetadata checks the metadata an integrator attaches to an order
+func ValidateMetadata(metadata map[string]string) error {
+	if len(metadata) > MaxMetadataKeys {
+		return fmt.Errorf("%w: order has more than %d metadata keys", model.ErrInvalidArgument, MaxMetadataKeys)
+	}
+
+	for key, value := range metadata {
+		if err := ValidateMetadataKey(key); err != nil {
+			return err
+		}
+
+		if utf8.RuneCountInString(value) > MaxMetadataValueLength {
+			return fmt.Errorf("%w: metadata %s is longer than %d characters", model.ErrInvalidArgument, key, MaxMetadataValueLength)
+		}
+	}
+
+	return nil
+}
+
+func ValidateMetadataKey(key string) error {
+	if !metadataKeyRe.MatchString(key) {
+		return fmt.Errorf("%w: metadata key %q must be letters, digits, '_' or '-'", model.ErrInvalidArgument, key)
+	}
+
+	if len(key) > MaxMetadataKeyLength {
+		return fmt.Errorf("%w: metadata key %s is longer than %d characters", model.ErrInvalidArgument, key, MaxMetadataKeyLength)
+	}
+
+	return nil
+}
+
+func formatMetadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+metadata[key])
+	}
+
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,53 @@
+package order_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMetadata(t *testing.T) {
+	t.Parallel()
+
+	tooMany := make(map[string]string, orderModel.MaxMetadataKeys+1)
+	for i := range orderModel.MaxMetadataKeys + 1 {
+		tooMany["key_"+strconv.Itoa(i)] = "value"
+	}
+
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		valid    bool
+	}{
+		{name: "Valid", metadata: map[string]string{"crm-id": "42", "channel_name": "web: mobile"}, valid: true},
+		{name: "Empty", metadata: map[string]string{}, valid: true},
+		{name: "Empty value", metadata: map[string]string{"note": ""}, valid: true},
+		{name: "Empty key", metadata: map[string]string{"": "42"}},
+		{name: "Key with a dot", metadata: map[string]string{"crm.id": "42"}},
+		{name: "Key too long", metadata: map[string]string{strings.Repeat("k", orderModel.MaxMetadataKeyLength+1): "42"}},
+		{name: "Value too long", metadata: map[string]string{"note": strings.Repeat("ы", orderModel.MaxMetadataValueLength+1)}},
+		{name: "Too many keys", metadata: tooMany},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := orderModel.ValidateMetadata(tt.metadata)
+
+			if !tt.valid {
+				require.ErrorIs(t, err, model.ErrInvalidArgument)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -64,11 +64,12 @@ func (mr *MockCommanderMockRecorder) Delete(ctx, item interface{}) *gomock.Call
 }
 
 // Disable mocks base method.
-func (m *MockCommander) Disable(ctx context.Context, userID string) error {
+func (m *MockCommander) Disable(ctx context.Context, userID string) ([]*order.Transition, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Disable", ctx, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]*order.Transition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Disable indicates an expected call of Disable.
@@ -77,6 +78,21 @@ func (mr *MockCommanderMockRecorder) Disable(ctx, userID interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockCommander)(nil).Disable), ctx, userID)
 }
 
+// Enable mocks base method.
+func (m *MockCommander) Enable(ctx context.Context, userID string) ([]*order.Transition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, userID)
+	ret0, _ := ret[0].([]*order.Transition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enable indicates an expected call of Enable.
+func (mr *MockCommanderMockRecorder) Enable(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockCommander)(nil).Enable), ctx, userID)
+}
+
 // Update mocks base method.
 func (m *MockCommander) Update(ctx context.Context, item *order.Order) error {
 	m.ctrl.T.Helper()
@@ -52,6 +52,21 @@ func (mr *MockQuerierMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockQuerier)(nil).Count), ctx, filter)
 }
 
+// Facets mocks base method.
+func (m *MockQuerier) Facets(ctx context.Context, filter *order.Filter, interval order.DateInterval) (*order.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Facets", ctx, filter, interval)
+	ret0, _ := ret[0].(*order.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Facets indicates an expected call of Facets.
+func (mr *MockQuerierMockRecorder) Facets(ctx, filter, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Facets", reflect.TypeOf((*MockQuerier)(nil).Facets), ctx, filter, interval)
+}
+
 // GetItem mocks base method.
 func (m *MockQuerier) GetItem(ctx context.Context, filter *order.Filter) (*order.Order, error) {
 	m.ctrl.T.Helper()
@@ -81,3 +96,41 @@ func (mr *MockQuerierMockRecorder) GetList(ctx, filter, orders, pagination inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockQuerier)(nil).GetList), ctx, filter, orders, pagination)
 }
+
+// MockTagger is a mock of Tagger interface.
+type MockTagger struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaggerMockRecorder
+}
+
+// MockTaggerMockRecorder is the mock recorder for MockTagger.
+type MockTaggerMockRecorder struct {
+	mock *MockTagger
+}
+
+// NewMockTagger creates a new mock instance.
+func NewMockTagger(ctrl *gomock.Controller) *MockTagger {
+	mock := &MockTagger{ctrl: ctrl}
+	mock.recorder = &MockTaggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagger) EXPECT() *MockTaggerMockRecorder {
+	return m.recorder
+}
+
+// Tags mocks base method.
+func (m *MockTagger) Tags(ctx context.Context, filter *order.Filter) ([]*order.TagFacet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tags", ctx, filter)
+	ret0, _ := ret[0].([]*order.TagFacet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tags indicates an expected call of Tags.
+func (mr *MockTaggerMockRecorder) Tags(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tags", reflect.TypeOf((*MockTagger)(nil).Tags), ctx, filter)
+}
@@ -6,10 +6,23 @@ package mock_order
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	approval "github.com/krivenkov/order/internal/model/approval"
+	attachment "github.com/krivenkov/order/internal/model/attachment"
+	comment "github.com/krivenkov/order/internal/model/comment"
+	erasure "github.com/krivenkov/order/internal/model/erasure"
+	grant "github.com/krivenkov/order/internal/model/grant"
+	history "github.com/krivenkov/order/internal/model/history"
+	line "github.com/krivenkov/order/internal/model/line"
 	order "github.com/krivenkov/order/internal/model/order"
+	payment "github.com/krivenkov/order/internal/model/payment"
+	refund "github.com/krivenkov/order/internal/model/refund"
+	shipment "github.com/krivenkov/order/internal/model/shipment"
+	paginator "github.com/krivenkov/pkg/paginator"
 )
 
 // MockService is a mock of Service interface.
@@ -35,6 +48,112 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AddComment mocks base method.
+func (m *MockService) AddComment(ctx context.Context, actor comment.Actor, id string, form *comment.Form) (*comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", ctx, actor, id, form)
+	ret0, _ := ret[0].(*comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockServiceMockRecorder) AddComment(ctx, actor, id, form interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockService)(nil).AddComment), ctx, actor, id, form)
+}
+
+// AddLine mocks base method.
+func (m *MockService) AddLine(ctx context.Context, userID, id string, form *line.Form) (*line.Line, *order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLine", ctx, userID, id, form)
+	ret0, _ := ret[0].(*line.Line)
+	ret1, _ := ret[1].(*order.Order)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddLine indicates an expected call of AddLine.
+func (mr *MockServiceMockRecorder) AddLine(ctx, userID, id, form interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLine", reflect.TypeOf((*MockService)(nil).AddLine), ctx, userID, id, form)
+}
+
+// ApplyPaymentResult mocks base method.
+func (m *MockService) ApplyPaymentResult(ctx context.Context, result *payment.Result) (*payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPaymentResult", ctx, result)
+	ret0, _ := ret[0].(*payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyPaymentResult indicates an expected call of ApplyPaymentResult.
+func (mr *MockServiceMockRecorder) ApplyPaymentResult(ctx, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPaymentResult", reflect.TypeOf((*MockService)(nil).ApplyPaymentResult), ctx, result)
+}
+
+// Approve mocks base method.
+func (m *MockService) Approve(ctx context.Context, userID, id, comment string) (*order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, userID, id, comment)
+	ret0, _ := ret[0].(*order.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockServiceMockRecorder) Approve(ctx, userID, id, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockService)(nil).Approve), ctx, userID, id, comment)
+}
+
+// ApproveReturn mocks base method.
+func (m *MockService) ApproveReturn(ctx context.Context, actorID, id, returnID, comment string) (*refund.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveReturn", ctx, actorID, id, returnID, comment)
+	ret0, _ := ret[0].(*refund.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveReturn indicates an expected call of ApproveReturn.
+func (mr *MockServiceMockRecorder) ApproveReturn(ctx, actorID, id, returnID, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveReturn", reflect.TypeOf((*MockService)(nil).ApproveReturn), ctx, actorID, id, returnID, comment)
+}
+
+// Attach mocks base method.
+func (m *MockService) Attach(ctx context.Context, userID, id string, form *attachment.Form, content io.Reader) (*attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attach", ctx, userID, id, form, content)
+	ret0, _ := ret[0].(*attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockServiceMockRecorder) Attach(ctx, userID, id, form, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockService)(nil).Attach), ctx, userID, id, form, content)
+}
+
+// Checkout mocks base method.
+func (m *MockService) Checkout(ctx context.Context, userID, id string) (*order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, userID, id)
+	ret0, _ := ret[0].(*order.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockServiceMockRecorder) Checkout(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockService)(nil).Checkout), ctx, userID, id)
+}
+
 // Count mocks base method.
 func (m *MockService) Count(ctx context.Context, userID string, req *order.GetCountRequest) (int, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +184,64 @@ func (mr *MockServiceMockRecorder) Create(ctx, userID, form interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, userID, form)
 }
 
+// CreateReturn mocks base method.
+func (m *MockService) CreateReturn(ctx context.Context, userID, id string, form *refund.ReturnForm) (*refund.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReturn", ctx, userID, id, form)
+	ret0, _ := ret[0].(*refund.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReturn indicates an expected call of CreateReturn.
+func (mr *MockServiceMockRecorder) CreateReturn(ctx, userID, id, form interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReturn", reflect.TypeOf((*MockService)(nil).CreateReturn), ctx, userID, id, form)
+}
+
+// CreateShipment mocks base method.
+func (m *MockService) CreateShipment(ctx context.Context, actorID, id string, form *shipment.Form) (*shipment.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShipment", ctx, actorID, id, form)
+	ret0, _ := ret[0].(*shipment.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShipment indicates an expected call of CreateShipment.
+func (mr *MockServiceMockRecorder) CreateShipment(ctx, actorID, id, form interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShipment", reflect.TypeOf((*MockService)(nil).CreateShipment), ctx, actorID, id, form)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockService) DeleteAttachment(ctx context.Context, userID, id, attachmentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, userID, id, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockServiceMockRecorder) DeleteAttachment(ctx, userID, id, attachmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockService)(nil).DeleteAttachment), ctx, userID, id, attachmentID)
+}
+
+// DeleteComment mocks base method.
+func (m *MockService) DeleteComment(ctx context.Context, actor comment.Actor, id, commentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, actor, id, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockServiceMockRecorder) DeleteComment(ctx, actor, id, commentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockService)(nil).DeleteComment), ctx, actor, id, commentID)
+}
+
 // Disable mocks base method.
 func (m *MockService) Disable(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
@@ -79,6 +256,188 @@ func (mr *MockServiceMockRecorder) Disable(ctx, userID interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockService)(nil).Disable), ctx, userID)
 }
 
+// Enable mocks base method.
+func (m *MockService) Enable(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enable indicates an expected call of Enable.
+func (mr *MockServiceMockRecorder) Enable(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockService)(nil).Enable), ctx, userID)
+}
+
+// Erase mocks base method.
+func (m *MockService) Erase(ctx context.Context, req *order.EraseRequest) (*erasure.Erasure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Erase", ctx, req)
+	ret0, _ := ret[0].(*erasure.Erasure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Erase indicates an expected call of Erase.
+func (mr *MockServiceMockRecorder) Erase(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Erase", reflect.TypeOf((*MockService)(nil).Erase), ctx, req)
+}
+
+// EscalateApprovals mocks base method.
+func (m *MockService) EscalateApprovals(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EscalateApprovals", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EscalateApprovals indicates an expected call of EscalateApprovals.
+func (mr *MockServiceMockRecorder) EscalateApprovals(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EscalateApprovals", reflect.TypeOf((*MockService)(nil).EscalateApprovals), ctx, before)
+}
+
+// ExpireDrafts mocks base method.
+func (m *MockService) ExpireDrafts(ctx context.Context, modifiedBefore time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireDrafts", ctx, modifiedBefore)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireDrafts indicates an expected call of ExpireDrafts.
+func (mr *MockServiceMockRecorder) ExpireDrafts(ctx, modifiedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireDrafts", reflect.TypeOf((*MockService)(nil).ExpireDrafts), ctx, modifiedBefore)
+}
+
+// ExpireReservations mocks base method.
+func (m *MockService) ExpireReservations(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireReservations", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireReservations indicates an expected call of ExpireReservations.
+func (mr *MockServiceMockRecorder) ExpireReservations(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireReservations", reflect.TypeOf((*MockService)(nil).ExpireReservations), ctx, before)
+}
+
+// GetApproval mocks base method.
+func (m *MockService) GetApproval(ctx context.Context, userID, id string) (*approval.Approval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApproval", ctx, userID, id)
+	ret0, _ := ret[0].(*approval.Approval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApproval indicates an expected call of GetApproval.
+func (mr *MockServiceMockRecorder) GetApproval(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApproval", reflect.TypeOf((*MockService)(nil).GetApproval), ctx, userID, id)
+}
+
+// GetAttachment mocks base method.
+func (m *MockService) GetAttachment(ctx context.Context, userID, id, attachmentID string) (*attachment.Attachment, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, userID, id, attachmentID)
+	ret0, _ := ret[0].(*attachment.Attachment)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockServiceMockRecorder) GetAttachment(ctx, userID, id, attachmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockService)(nil).GetAttachment), ctx, userID, id, attachmentID)
+}
+
+// GetAttachments mocks base method.
+func (m *MockService) GetAttachments(ctx context.Context, userID, id string) ([]*attachment.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachments", ctx, userID, id)
+	ret0, _ := ret[0].([]*attachment.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachments indicates an expected call of GetAttachments.
+func (mr *MockServiceMockRecorder) GetAttachments(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachments", reflect.TypeOf((*MockService)(nil).GetAttachments), ctx, userID, id)
+}
+
+// GetComments mocks base method.
+func (m *MockService) GetComments(ctx context.Context, actor comment.Actor, id string, pagination paginator.Pagination) ([]*comment.Comment, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComments", ctx, actor, id, pagination)
+	ret0, _ := ret[0].([]*comment.Comment)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetComments indicates an expected call of GetComments.
+func (mr *MockServiceMockRecorder) GetComments(ctx, actor, id, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockService)(nil).GetComments), ctx, actor, id, pagination)
+}
+
+// GetFacets mocks base method.
+func (m *MockService) GetFacets(ctx context.Context, userID string, req *order.GetFacetsRequest) (*order.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacets", ctx, userID, req)
+	ret0, _ := ret[0].(*order.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacets indicates an expected call of GetFacets.
+func (mr *MockServiceMockRecorder) GetFacets(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacets", reflect.TypeOf((*MockService)(nil).GetFacets), ctx, userID, req)
+}
+
+// GetGrants mocks base method.
+func (m *MockService) GetGrants(ctx context.Context, userID, id string) ([]*grant.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrants", ctx, userID, id)
+	ret0, _ := ret[0].([]*grant.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrants indicates an expected call of GetGrants.
+func (mr *MockServiceMockRecorder) GetGrants(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrants", reflect.TypeOf((*MockService)(nil).GetGrants), ctx, userID, id)
+}
+
+// GetHistory mocks base method.
+func (m *MockService) GetHistory(ctx context.Context, userID, id string, pagination paginator.Pagination) ([]*history.Entry, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, userID, id, pagination)
+	ret0, _ := ret[0].([]*history.Entry)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockServiceMockRecorder) GetHistory(ctx, userID, id, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockService)(nil).GetHistory), ctx, userID, id, pagination)
+}
+
 // GetItem mocks base method.
 func (m *MockService) GetItem(ctx context.Context, userID, id string) (*order.Order, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +453,36 @@ func (mr *MockServiceMockRecorder) GetItem(ctx, userID, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockService)(nil).GetItem), ctx, userID, id)
 }
 
+// GetItemByNumber mocks base method.
+func (m *MockService) GetItemByNumber(ctx context.Context, userID, number string) (*order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemByNumber", ctx, userID, number)
+	ret0, _ := ret[0].(*order.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemByNumber indicates an expected call of GetItemByNumber.
+func (mr *MockServiceMockRecorder) GetItemByNumber(ctx, userID, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemByNumber", reflect.TypeOf((*MockService)(nil).GetItemByNumber), ctx, userID, number)
+}
+
+// GetLines mocks base method.
+func (m *MockService) GetLines(ctx context.Context, userID, id string) ([]*line.Line, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLines", ctx, userID, id)
+	ret0, _ := ret[0].([]*line.Line)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLines indicates an expected call of GetLines.
+func (mr *MockServiceMockRecorder) GetLines(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLines", reflect.TypeOf((*MockService)(nil).GetLines), ctx, userID, id)
+}
+
 // GetList mocks base method.
 func (m *MockService) GetList(ctx context.Context, userID string, req *order.GetListRequest) ([]*order.Order, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +498,113 @@ func (mr *MockServiceMockRecorder) GetList(ctx, userID, req interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockService)(nil).GetList), ctx, userID, req)
 }
 
+// GetPayments mocks base method.
+func (m *MockService) GetPayments(ctx context.Context, userID, id string) ([]*payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayments", ctx, userID, id)
+	ret0, _ := ret[0].([]*payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayments indicates an expected call of GetPayments.
+func (mr *MockServiceMockRecorder) GetPayments(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayments", reflect.TypeOf((*MockService)(nil).GetPayments), ctx, userID, id)
+}
+
+// GetReturns mocks base method.
+func (m *MockService) GetReturns(ctx context.Context, userID, id string) ([]*refund.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturns", ctx, userID, id)
+	ret0, _ := ret[0].([]*refund.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturns indicates an expected call of GetReturns.
+func (mr *MockServiceMockRecorder) GetReturns(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturns", reflect.TypeOf((*MockService)(nil).GetReturns), ctx, userID, id)
+}
+
+// GetShipments mocks base method.
+func (m *MockService) GetShipments(ctx context.Context, userID, id string) ([]*shipment.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipments", ctx, userID, id)
+	ret0, _ := ret[0].([]*shipment.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShipments indicates an expected call of GetShipments.
+func (mr *MockServiceMockRecorder) GetShipments(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipments", reflect.TypeOf((*MockService)(nil).GetShipments), ctx, userID, id)
+}
+
+// GetTags mocks base method.
+func (m *MockService) GetTags(ctx context.Context, userID string) ([]*order.TagFacet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx, userID)
+	ret0, _ := ret[0].([]*order.TagFacet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockServiceMockRecorder) GetTags(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockService)(nil).GetTags), ctx, userID)
+}
+
+// GetTrash mocks base method.
+func (m *MockService) GetTrash(ctx context.Context, userID string, pagination paginator.Pagination) ([]*order.Order, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*order.Order)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockServiceMockRecorder) GetTrash(ctx, userID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockService)(nil).GetTrash), ctx, userID, pagination)
+}
+
+// InnerGetFacets mocks base method.
+func (m *MockService) InnerGetFacets(ctx context.Context, filter *order.InnerGetFacetsRequest) (*order.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InnerGetFacets", ctx, filter)
+	ret0, _ := ret[0].(*order.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InnerGetFacets indicates an expected call of InnerGetFacets.
+func (mr *MockServiceMockRecorder) InnerGetFacets(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InnerGetFacets", reflect.TypeOf((*MockService)(nil).InnerGetFacets), ctx, filter)
+}
+
+// InnerGetHistory mocks base method.
+func (m *MockService) InnerGetHistory(ctx context.Context, id string, pagination paginator.Pagination) ([]*history.Entry, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InnerGetHistory", ctx, id, pagination)
+	ret0, _ := ret[0].([]*history.Entry)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InnerGetHistory indicates an expected call of InnerGetHistory.
+func (mr *MockServiceMockRecorder) InnerGetHistory(ctx, id, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InnerGetHistory", reflect.TypeOf((*MockService)(nil).InnerGetHistory), ctx, id, pagination)
+}
+
 // InnerGetItem mocks base method.
 func (m *MockService) InnerGetItem(ctx context.Context, filter *order.InnerGetItemRequest) (*order.Order, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +620,21 @@ func (mr *MockServiceMockRecorder) InnerGetItem(ctx, filter interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InnerGetItem", reflect.TypeOf((*MockService)(nil).InnerGetItem), ctx, filter)
 }
 
+// InnerGetLines mocks base method.
+func (m *MockService) InnerGetLines(ctx context.Context, id string) ([]*line.Line, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InnerGetLines", ctx, id)
+	ret0, _ := ret[0].([]*line.Line)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InnerGetLines indicates an expected call of InnerGetLines.
+func (mr *MockServiceMockRecorder) InnerGetLines(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InnerGetLines", reflect.TypeOf((*MockService)(nil).InnerGetLines), ctx, id)
+}
+
 // InnerGetList mocks base method.
 func (m *MockService) InnerGetList(ctx context.Context, filter *order.InnerGetListRequest) ([]*order.Order, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +650,141 @@ func (mr *MockServiceMockRecorder) InnerGetList(ctx, filter interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InnerGetList", reflect.TypeOf((*MockService)(nil).InnerGetList), ctx, filter)
 }
 
+// InnerGetReturns mocks base method.
+func (m *MockService) InnerGetReturns(ctx context.Context, id string) ([]*refund.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InnerGetReturns", ctx, id)
+	ret0, _ := ret[0].([]*refund.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InnerGetReturns indicates an expected call of InnerGetReturns.
+func (mr *MockServiceMockRecorder) InnerGetReturns(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InnerGetReturns", reflect.TypeOf((*MockService)(nil).InnerGetReturns), ctx, id)
+}
+
+// InnerGetShipments mocks base method.
+func (m *MockService) InnerGetShipments(ctx context.Context, id string) ([]*shipment.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InnerGetShipments", ctx, id)
+	ret0, _ := ret[0].([]*shipment.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InnerGetShipments indicates an expected call of InnerGetShipments.
+func (mr *MockServiceMockRecorder) InnerGetShipments(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InnerGetShipments", reflect.TypeOf((*MockService)(nil).InnerGetShipments), ctx, id)
+}
+
+// PublishRefunds mocks base method.
+func (m *MockService) PublishRefunds(ctx context.Context, createdBefore time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishRefunds", ctx, createdBefore)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishRefunds indicates an expected call of PublishRefunds.
+func (mr *MockServiceMockRecorder) PublishRefunds(ctx, createdBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishRefunds", reflect.TypeOf((*MockService)(nil).PublishRefunds), ctx, createdBefore)
+}
+
+// Purge mocks base method.
+func (m *MockService) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, deletedBefore)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockServiceMockRecorder) Purge(ctx, deletedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockService)(nil).Purge), ctx, deletedBefore)
+}
+
+// Reject mocks base method.
+func (m *MockService) Reject(ctx context.Context, userID, id, comment string) (*order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, userID, id, comment)
+	ret0, _ := ret[0].(*order.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockServiceMockRecorder) Reject(ctx, userID, id, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockService)(nil).Reject), ctx, userID, id, comment)
+}
+
+// RejectReturn mocks base method.
+func (m *MockService) RejectReturn(ctx context.Context, actorID, id, returnID, comment string) (*refund.Return, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectReturn", ctx, actorID, id, returnID, comment)
+	ret0, _ := ret[0].(*refund.Return)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectReturn indicates an expected call of RejectReturn.
+func (mr *MockServiceMockRecorder) RejectReturn(ctx, actorID, id, returnID, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectReturn", reflect.TypeOf((*MockService)(nil).RejectReturn), ctx, actorID, id, returnID, comment)
+}
+
+// RemoveLine mocks base method.
+func (m *MockService) RemoveLine(ctx context.Context, userID, id, lineID string) (*order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLine", ctx, userID, id, lineID)
+	ret0, _ := ret[0].(*order.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveLine indicates an expected call of RemoveLine.
+func (mr *MockServiceMockRecorder) RemoveLine(ctx, userID, id, lineID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLine", reflect.TypeOf((*MockService)(nil).RemoveLine), ctx, userID, id, lineID)
+}
+
+// Restore mocks base method.
+func (m *MockService) Restore(ctx context.Context, userID, id string) (*order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, userID, id)
+	ret0, _ := ret[0].(*order.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockServiceMockRecorder) Restore(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockService)(nil).Restore), ctx, userID, id)
+}
+
+// Share mocks base method.
+func (m *MockService) Share(ctx context.Context, userID, id, granteeID string, form *grant.Form) (*grant.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Share", ctx, userID, id, granteeID, form)
+	ret0, _ := ret[0].(*grant.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Share indicates an expected call of Share.
+func (mr *MockServiceMockRecorder) Share(ctx, userID, id, granteeID, form interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockService)(nil).Share), ctx, userID, id, granteeID, form)
+}
+
 // SoftDelete mocks base method.
 func (m *MockService) SoftDelete(ctx context.Context, userID, id string) error {
 	m.ctrl.T.Helper()
@@ -153,6 +799,20 @@ func (mr *MockServiceMockRecorder) SoftDelete(ctx, userID, id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockService)(nil).SoftDelete), ctx, userID, id)
 }
 
+// Unshare mocks base method.
+func (m *MockService) Unshare(ctx context.Context, userID, id, granteeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unshare", ctx, userID, id, granteeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unshare indicates an expected call of Unshare.
+func (mr *MockServiceMockRecorder) Unshare(ctx, userID, id, granteeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unshare", reflect.TypeOf((*MockService)(nil).Unshare), ctx, userID, id, granteeID)
+}
+
 // Update mocks base method.
 func (m *MockService) Update(ctx context.Context, userID, id string, form *order.Form) (*order.Order, error) {
 	m.ctrl.T.Helper()
@@ -167,3 +827,49 @@ func (mr *MockServiceMockRecorder) Update(ctx, userID, id, form interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), ctx, userID, id, form)
 }
+
+// UpdateComment mocks base method.
+func (m *MockService) UpdateComment(ctx context.Context, actor comment.Actor, id, commentID, body string) (*comment.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, actor, id, commentID, body)
+	ret0, _ := ret[0].(*comment.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockServiceMockRecorder) UpdateComment(ctx, actor, id, commentID, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockService)(nil).UpdateComment), ctx, actor, id, commentID, body)
+}
+
+// UpdateLine mocks base method.
+func (m *MockService) UpdateLine(ctx context.Context, userID, id, lineID string, form *line.UpdateForm) (*line.Line, *order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLine", ctx, userID, id, lineID, form)
+	ret0, _ := ret[0].(*line.Line)
+	ret1, _ := ret[1].(*order.Order)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateLine indicates an expected call of UpdateLine.
+func (mr *MockServiceMockRecorder) UpdateLine(ctx, userID, id, lineID, form interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLine", reflect.TypeOf((*MockService)(nil).UpdateLine), ctx, userID, id, lineID, form)
+}
+
+// UpdateShipmentStatus mocks base method.
+func (m *MockService) UpdateShipmentStatus(ctx context.Context, actorID, id, shipmentID string, status shipment.Status) (*shipment.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShipmentStatus", ctx, actorID, id, shipmentID, status)
+	ret0, _ := ret[0].(*shipment.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShipmentStatus indicates an expected call of UpdateShipmentStatus.
+func (mr *MockServiceMockRecorder) UpdateShipmentStatus(ctx, actorID, id, shipmentID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShipmentStatus", reflect.TypeOf((*MockService)(nil).UpdateShipmentStatus), ctx, actorID, id, shipmentID, status)
+}
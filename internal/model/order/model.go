@@ -1,21 +1,28 @@
 package order
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/model/tax"
 )
 
 type Status int
 
 const (
-	StatusCreated Status = 1
-	StatusDeleted Status = 2
+	StatusCreated  Status = 1
+	StatusDeleted  Status = 2
+	StatusDisabled Status = 3
 )
 
 const (
-	NameSortKey = "name"
-	IDSortKey   = "id"
+	NameSortKey   = "name"
+	IDSortKey     = "id"
+	ModifySortKey = "modified"
 )
 
 type Order struct {
@@ -23,11 +30,28 @@ type Order struct {
 	TSCreate time.Time
 	TSModify time.Time
 	Status   Status
+	State    State
+	Number   string
 
 	UserID string
+	// TenantID is the organisation owning the order, personal orders have none
+	TenantID string
 
 	Name        string
 	Description string
+
+	ShippingAddress *Address
+	BillingAddress  *Address
+
+	Totals Totals
+	// Discounts are the promo codes applied to the order
+	Discounts []*promo.Discount
+	// Taxes are charged in the jurisdiction of the order when it is placed
+	Taxes []*tax.Line
+	// Tags are normalized by NormalizeTags
+	Tags []string
+	// Metadata holds the keys and values of integrators, see ValidateMetadata
+	Metadata map[string]string
 }
 
 func New(userID string, now func() time.Time, newID func() uuid.UUID) *Order {
@@ -36,6 +60,7 @@ func New(userID string, now func() time.Time, newID func() uuid.UUID) *Order {
 		TSCreate: now(),
 		TSModify: now(),
 		Status:   StatusCreated,
+		State:    StatePlaced,
 		UserID:   userID,
 	}
 }
@@ -48,9 +73,162 @@ func (o *Order) FillForm(f *Form) {
 	if f.Description != nil {
 		o.Description = *f.Description
 	}
+
+	if f.ShippingAddress != nil {
+		o.ShippingAddress = f.ShippingAddress
+	}
+
+	if f.BillingAddress != nil {
+		o.BillingAddress = f.BillingAddress
+	}
+
+	if f.Currency != nil {
+		o.Totals.Currency = *f.Currency
+	}
+
+	if f.Tags != nil {
+		o.Tags = f.Tags
+	}
+
+	if f.Metadata != nil {
+		o.Metadata = f.Metadata
+	}
 }
 
+func (o *Order) IsDraft() bool {
+	return o.State == StateDraft
+}
+
+// Form is a partial update, nil fields leave the order untouched
 type Form struct {
 	Name        *string
 	Description *string
+
+	ShippingAddress *Address
+	BillingAddress  *Address
+
+	// Tags replace the tags of the order, an empty list removes them
+	Tags []string
+	// Metadata replaces the metadata of the order, an empty map removes it
+	Metadata map[string]string
+
+	// Currency, Lines, PromoCodes and Draft are accepted on create only
+	Currency   *string
+	Lines      []*line.Form
+	PromoCodes []string
+	// Draft creates the order in StateDraft, it is placed by the checkout
+	Draft bool
+}
+
+// Validate normalizes and checks the addresses and lines set in the form
+func (f *Form) Validate() error {
+	if len(f.Lines) > line.MaxLines {
+		return fmt.Errorf("%w: order has more than %d lines", model.ErrInvalidArgument, line.MaxLines)
+	}
+
+	priced := false
+
+	for i, l := range f.Lines {
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		priced = priced || l.UnitPrice > 0
+	}
+
+	if f.Currency != nil {
+		currency, err := normalizeCurrency(*f.Currency)
+		if err != nil {
+			return err
+		}
+
+		f.Currency = &currency
+	} else if priced {
+		return fmt.Errorf("%w: currency is required for priced lines", model.ErrInvalidArgument)
+	}
+
+	if err := f.validatePromoCodes(); err != nil {
+		return err
+	}
+
+	if f.Tags != nil {
+		tags, err := NormalizeTags(f.Tags)
+		if err != nil {
+			return err
+		}
+
+		f.Tags = tags
+	}
+
+	if err := ValidateMetadata(f.Metadata); err != nil {
+		return err
+	}
+
+	addresses := []struct {
+		name    string
+		address *Address
+	}{
+		{"shipping address", f.ShippingAddress},
+		{"billing address", f.BillingAddress},
+	}
+
+	for _, a := range addresses {
+		if a.address == nil {
+			continue
+		}
+
+		a.address.Normalize()
+
+		if err := a.address.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", a.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (f *Form) validatePromoCodes() error {
+	if len(f.PromoCodes) > promo.MaxCodes {
+		return fmt.Errorf("%w: more than %d promo codes", model.ErrInvalidArgument, promo.MaxCodes)
+	}
+
+	seen := make(map[string]struct{}, len(f.PromoCodes))
+
+	for i, code := range f.PromoCodes {
+		code = promo.NormalizeCode(code)
+
+		if _, ok := seen[code]; ok {
+			return fmt.Errorf("%w: promo code %s is applied twice", model.ErrInvalidArgument, code)
+		}
+
+		seen[code] = struct{}{}
+		f.PromoCodes[i] = code
+	}
+
+	return nil
+}
+
+type DateInterval string
+
+const (
+	DateIntervalDay   DateInterval = "day"
+	DateIntervalWeek  DateInterval = "week"
+	DateIntervalMonth DateInterval = "month"
+)
+
+type Facets struct {
+	Statuses []*StatusFacet
+	Dates    []*DateFacet
+	// Tags are the FacetTags most used, the most used first
+	Tags []*TagFacet
+}
+
+type StatusFacet struct {
+	Status Status
+	Count  int
+}
+
+type DateFacet struct {
+	Date  time.Time
+	Count int
 }
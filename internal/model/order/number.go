@@ -0,0 +1,46 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/krivenkov/order/internal/model/tenant"
+)
+
+//go:generate mockgen -source=number.go -destination=mock/number.go
+
+// Numberer issues human-readable order numbers, e.g. ORD-2026-000123.
+// Numbers are unique within a tenant, an empty tenant numbers the personal orders
+type Numberer interface {
+	Next(ctx context.Context, tenantID string, tsCreate time.Time) (string, error)
+}
+
+var numberRe = regexp.MustCompile(`^ORD-\d{4}-\d{6,}$`)
+
+func FormatNumber(year int, seq int64) string {
+	return fmt.Sprintf("ORD-%d-%06d", year, seq)
+}
+
+// ParseNumber returns the number in canonical form, ok is false for anything else
+func ParseNumber(s string) (string, bool) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+
+	return s, numberRe.MatchString(s)
+}
+
+// NumberTenant is the tenant a number of the filter belongs to: the one asked, the one of the
+// request or the personal orders, a number is unique within its tenant only
+func NumberTenant(ctx context.Context, filter *Filter) string {
+	if filter.TenantID.IsSet() {
+		return filter.TenantID.Value()
+	}
+
+	if scope, ok := tenant.ScopeFromContext(ctx); ok {
+		return scope.TenantID
+	}
+
+	return ""
+}
@@ -0,0 +1,39 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/option"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberTenant(t *testing.T) {
+	t.Parallel()
+
+	scoped := tenant.CtxWithScope(context.TODO(), tenant.Scope{TenantID: "t1", UserID: "u1", Role: tenant.RoleViewer})
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		filter   *orderModel.Filter
+		expected string
+	}{
+		{name: "Tenant asked", ctx: scoped, filter: &orderModel.Filter{TenantID: option.New("t2")}, expected: "t2"},
+		{name: "Personal asked", ctx: scoped, filter: &orderModel.Filter{TenantID: option.New("")}, expected: ""},
+		{name: "Tenant of the request", ctx: scoped, filter: &orderModel.Filter{}, expected: "t1"},
+		{name: "Personal orders", ctx: context.TODO(), filter: &orderModel.Filter{}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.expected, orderModel.NumberTenant(tt.ctx, tt.filter))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"time"
 
 	"github.com/krivenkov/pkg/option"
 	"github.com/krivenkov/pkg/order"
@@ -14,11 +15,37 @@ type Querier interface {
 	GetItem(ctx context.Context, filter *Filter) (*Order, error)
 	GetList(ctx context.Context, filter *Filter, orders []*order.Order, pagination *paginator.Pagination) ([]*Order, error)
 	Count(ctx context.Context, filter *Filter) (int, error)
+	Facets(ctx context.Context, filter *Filter, interval DateInterval) (*Facets, error)
+}
+
+// Tagger lists every tag of the orders, Facets count the most used ones only
+type Tagger interface {
+	// Tags counts the orders per tag, the most used first
+	Tags(ctx context.Context, filter *Filter) ([]*TagFacet, error)
 }
 
 type Filter struct {
 	IDs    option.Option[[]string]
 	Status option.Option[int]
 	UserID option.Option[string]
+	// SharedIDs are orders shared with the user of UserID, kept along with its own
+	SharedIDs option.Option[[]string]
+	// TenantID keeps the orders of the tenant, an empty one the personal orders
+	TenantID option.Option[string]
+	// Number matches in the tenant of NumberTenant only
+	Number option.Option[string]
 	Q      option.Option[string]
+	// CommentedIDs are orders with a comment matching Q, they match the search as well
+	CommentedIDs option.Option[[]string]
+	// Draft true keeps the drafts only, false leaves them out
+	Draft option.Option[bool]
+	// Tags keeps the orders carrying every tag, TagsAny the ones carrying at least one
+	Tags    option.Option[[]string]
+	TagsAny option.Option[[]string]
+	// Metadata keeps the orders with every key set to its value
+	Metadata option.Option[map[string]string]
+	// ModifiedBefore is supported by postgres only
+	ModifiedBefore option.Option[time.Time]
+	// ForUpdate locks the item till the end of the running transaction, postgres GetItem only
+	ForUpdate option.Option[bool]
 }
@@ -2,7 +2,19 @@ package order
 
 import (
 	"context"
+	"io"
+	"time"
 
+	"github.com/krivenkov/order/internal/model/approval"
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/order/internal/model/erasure"
+	"github.com/krivenkov/order/internal/model/grant"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/model/shipment"
 	"github.com/krivenkov/pkg/option"
 	"github.com/krivenkov/pkg/order"
 	"github.com/krivenkov/pkg/paginator"
@@ -15,37 +27,171 @@ type Service interface {
 	Update(ctx context.Context, userID, id string, form *Form) (*Order, error)
 	SoftDelete(ctx context.Context, userID, id string) error
 	Disable(ctx context.Context, userID string) error
+	Enable(ctx context.Context, userID string) error
+	// Restore brings a soft-deleted order back
+	Restore(ctx context.Context, userID, id string) (*Order, error)
+	// AddLine adds a line to a draft and returns it with the draft repriced
+	AddLine(ctx context.Context, userID, id string, form *line.Form) (*line.Line, *Order, error)
+	// UpdateLine changes the quantity of a line of a draft
+	UpdateLine(ctx context.Context, userID, id, lineID string, form *line.UpdateForm) (*line.Line, *Order, error)
+	RemoveLine(ctx context.Context, userID, id, lineID string) (*Order, error)
+	// Checkout places the draft, it redeems the promo codes and reserves the stock
+	Checkout(ctx context.Context, userID, id string) (*Order, error)
+	// ExpireDrafts permanently removes drafts last changed before the given time
+	ExpireDrafts(ctx context.Context, modifiedBefore time.Time) (int, error)
+	// Purge permanently removes orders soft-deleted before the given time
+	Purge(ctx context.Context, deletedBefore time.Time) (int, error)
+	// ExpireReservations puts back to stock the reservations of unpaid orders which expired before the given time
+	ExpireReservations(ctx context.Context, before time.Time) (int, error)
+	// Approve places the tenant order waiting for the approval of the user
+	Approve(ctx context.Context, userID, id, comment string) (*Order, error)
+	// Reject ends the tenant order waiting for the approval of the user, the comment is required
+	Reject(ctx context.Context, userID, id, comment string) (*Order, error)
+	// EscalateApprovals escalates the pending approvals due before the given time
+	EscalateApprovals(ctx context.Context, before time.Time) (int, error)
+	// Erase permanently removes all orders of the user and records a receipt
+	Erase(ctx context.Context, req *EraseRequest) (*erasure.Erasure, error)
 
 	GetItem(ctx context.Context, userID string, id string) (*Order, error)
+	GetItemByNumber(ctx context.Context, userID string, number string) (*Order, error)
 	GetList(ctx context.Context, userID string, req *GetListRequest) ([]*Order, error)
 	Count(ctx context.Context, userID string, req *GetCountRequest) (int, error)
+	GetFacets(ctx context.Context, userID string, req *GetFacetsRequest) (*Facets, error)
+	// GetTags returns the tags of the orders the user sees with the number of orders per tag
+	GetTags(ctx context.Context, userID string) ([]*TagFacet, error)
+	// GetTrash returns soft-deleted orders of the user, the most recently deleted first, and their total
+	GetTrash(ctx context.Context, userID string, pagination paginator.Pagination) ([]*Order, int, error)
+	// GetHistory returns the change log of the order, newest first, and its total size
+	GetHistory(ctx context.Context, userID, id string, pagination paginator.Pagination) ([]*history.Entry, int, error)
+	GetLines(ctx context.Context, userID, id string) ([]*line.Line, error)
+	GetShipments(ctx context.Context, userID, id string) ([]*shipment.Shipment, error)
+	GetPayments(ctx context.Context, userID, id string) ([]*payment.Payment, error)
+	GetReturns(ctx context.Context, userID, id string) ([]*refund.Return, error)
+	// GetApproval returns the latest approval request of the order
+	GetApproval(ctx context.Context, userID, id string) (*approval.Approval, error)
+	// GetGrants returns who the personal order is shared with, to its owner only
+	GetGrants(ctx context.Context, userID, id string) ([]*grant.Grant, error)
+	// Share grants another user access to a personal order, or changes the access already granted
+	Share(ctx context.Context, userID, id, granteeID string, form *grant.Form) (*grant.Grant, error)
+	Unshare(ctx context.Context, userID, id, granteeID string) error
+	GetAttachments(ctx context.Context, userID, id string) ([]*attachment.Attachment, error)
+	// GetAttachment returns the attachment with its content, the caller closes it
+	GetAttachment(ctx context.Context, userID, id, attachmentID string) (*attachment.Attachment, io.ReadCloser, error)
+	// Attach stores the file, its content type is detected from the content whatever the form says
+	Attach(ctx context.Context, userID, id string, form *attachment.Form, content io.Reader) (*attachment.Attachment, error)
+	DeleteAttachment(ctx context.Context, userID, id, attachmentID string) error
+	// GetComments returns the comments of the order the actor sees, the oldest first, and their total
+	GetComments(ctx context.Context, actor comment.Actor, id string, pagination paginator.Pagination) ([]*comment.Comment, int, error)
+	// AddComment posts to the thread of the order and publishes the comment
+	AddComment(ctx context.Context, actor comment.Actor, id string, form *comment.Form) (*comment.Comment, error)
+	// UpdateComment changes the body of a comment of the actor
+	UpdateComment(ctx context.Context, actor comment.Actor, id, commentID, body string) (*comment.Comment, error)
+	DeleteComment(ctx context.Context, actor comment.Actor, id, commentID string) error
+	// CreateReturn requests a return of units of a line of a fulfilled order
+	CreateReturn(ctx context.Context, userID, id string, form *refund.ReturnForm) (*refund.Return, error)
+
+	// CreateShipment records a shipment made by staff, the order becomes fulfilled once every line has shipped
+	CreateShipment(ctx context.Context, actorID, id string, form *shipment.Form) (*shipment.Shipment, error)
+	// UpdateShipmentStatus appends a status to the shipment timeline, a cancelled shipment reopens a fulfilled order
+	UpdateShipmentStatus(ctx context.Context, actorID, id, shipmentID string, status shipment.Status) (*shipment.Shipment, error)
+	// ApplyPaymentResult records the payment attempt and marks the order paid once the payments cover
+	// its total, or payment_failed. A success of a reference which failed before is applied over the
+	// failure. It returns payment.ErrDuplicate when the provider reference was already applied,
+	// model.ErrInvalidArgument for a currency other than the order one and model.ErrConflict while
+	// the order is disabled or deleted.
+	ApplyPaymentResult(ctx context.Context, result *payment.Result) (*payment.Payment, error)
+	// ApproveReturn refunds the returned units from the order payments, the order becomes
+	// partially or fully refunded, a refund never exceeds the amount paid
+	ApproveReturn(ctx context.Context, actorID, id, returnID, comment string) (*refund.Return, error)
+	RejectReturn(ctx context.Context, actorID, id, returnID, comment string) (*refund.Return, error)
+	// PublishRefunds publishes the refunds created before the given time which are not published yet
+	PublishRefunds(ctx context.Context, createdBefore time.Time) (int, error)
 
 	// InnerGetItem used in internal GRPC server, without ACL
 	InnerGetItem(ctx context.Context, filter *InnerGetItemRequest) (*Order, error)
 	// InnerGetList used in internal GRPC server, without ACL
 	InnerGetList(ctx context.Context, filter *InnerGetListRequest) ([]*Order, error)
+	// InnerGetFacets used in internal GRPC server, without ACL
+	InnerGetFacets(ctx context.Context, filter *InnerGetFacetsRequest) (*Facets, error)
+	// InnerGetHistory used in internal GRPC server, without ACL
+	InnerGetHistory(ctx context.Context, id string, pagination paginator.Pagination) ([]*history.Entry, int, error)
+	// InnerGetShipments used in internal GRPC server, without ACL
+	InnerGetShipments(ctx context.Context, id string) ([]*shipment.Shipment, error)
+	// InnerGetLines used in internal GRPC server, without ACL
+	InnerGetLines(ctx context.Context, id string) ([]*line.Line, error)
+	// InnerGetReturns used in internal GRPC server, without ACL
+	InnerGetReturns(ctx context.Context, id string) ([]*refund.Return, error)
 }
 
+// GetListRequest leaves the drafts out unless Draft is true, then it lists the drafts only
 type GetListRequest struct {
-	IDs        option.Option[[]string]
-	Q          option.Option[string]
-	Orders     option.Option[[]*order.Order]
-	Pagination option.Option[paginator.Pagination]
+	IDs   option.Option[[]string]
+	Q     option.Option[string]
+	Draft option.Option[bool]
+	// Tags keep the orders carrying every tag, TagsAny the ones carrying at least one
+	Tags     option.Option[[]string]
+	TagsAny  option.Option[[]string]
+	Metadata option.Option[map[string]string]
+	// IncludeShared adds the orders shared with the user to its own ones
+	IncludeShared option.Option[bool]
+	Orders        option.Option[[]*order.Order]
+	Pagination    option.Option[paginator.Pagination]
 }
 
 type GetCountRequest struct {
-	IDs option.Option[[]string]
-	Q   option.Option[string]
+	IDs           option.Option[[]string]
+	Q             option.Option[string]
+	Draft         option.Option[bool]
+	Tags          option.Option[[]string]
+	TagsAny       option.Option[[]string]
+	Metadata      option.Option[map[string]string]
+	IncludeShared option.Option[bool]
+}
+
+type GetFacetsRequest struct {
+	IDs           option.Option[[]string]
+	Q             option.Option[string]
+	Draft         option.Option[bool]
+	Tags          option.Option[[]string]
+	TagsAny       option.Option[[]string]
+	Metadata      option.Option[map[string]string]
+	IncludeShared option.Option[bool]
+	Interval      option.Option[DateInterval]
 }
 
 type InnerGetItemRequest struct {
-	IDs    option.Option[[]string]
-	UserID option.Option[string]
+	IDs      option.Option[[]string]
+	UserID   option.Option[string]
+	TenantID option.Option[string]
+	Number   option.Option[string]
 }
 
 type InnerGetListRequest struct {
 	IDs        option.Option[[]string]
 	UserID     option.Option[string]
+	TenantID   option.Option[string]
+	Number     option.Option[string]
+	Tags       option.Option[[]string]
+	TagsAny    option.Option[[]string]
+	Metadata   option.Option[map[string]string]
 	Orders     option.Option[[]*order.Order]
 	Pagination option.Option[paginator.Pagination]
 }
+
+type InnerGetFacetsRequest struct {
+	IDs      option.Option[[]string]
+	UserID   option.Option[string]
+	TenantID option.Option[string]
+	Number   option.Option[string]
+	Tags     option.Option[[]string]
+	TagsAny  option.Option[[]string]
+	Metadata option.Option[map[string]string]
+	Q        option.Option[string]
+	Interval option.Option[DateInterval]
+}
+
+type EraseRequest struct {
+	UserID      string
+	Source      erasure.Source
+	RequestedBy string
+}
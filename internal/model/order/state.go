@@ -0,0 +1,70 @@
+package order
+
+import "strconv"
+
+// State is the lifecycle stage of an active order, Status tells whether the record is visible at all
+type State int
+
+const (
+	StatePlaced        State = 1
+	StateFulfilled     State = 2
+	StatePaid          State = 3
+	StatePaymentFailed State = 4
+	// StatePartiallyRefunded and StateRefunded follow approved returns
+	StatePartiallyRefunded State = 5
+	StateRefunded          State = 6
+	// StateDraft is an order being built, its lines can change until the checkout places it
+	StateDraft State = 7
+	// StatePendingApproval is a tenant order above the approval threshold, it is placed once approved
+	StatePendingApproval State = 8
+	StateRejected        State = 9
+)
+
+var stateNames = map[State]string{
+	StatePlaced:        "placed",
+	StateFulfilled:     "fulfilled",
+	StatePaid:          "paid",
+	StatePaymentFailed: "payment_failed",
+
+	StatePartiallyRefunded: "partially_refunded",
+	StateRefunded:          "refunded",
+
+	StateDraft: "draft",
+
+	StatePendingApproval: "pending_approval",
+	StateRejected:        "rejected",
+}
+
+func (s State) String() string {
+	if s == 0 {
+		return ""
+	}
+
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(s))
+}
+
+var stateTransitions = map[State][]State{
+	StateDraft:           {StatePlaced, StatePendingApproval},
+	StatePendingApproval: {StatePlaced, StateRejected},
+	StatePlaced:          {StatePaid, StatePaymentFailed},
+	// the customer may retry a failed payment
+	StatePaymentFailed: {StatePaid},
+	StatePaid:          {StateFulfilled},
+	// a cancelled shipment reopens the order, an approved return refunds it
+	StateFulfilled:         {StatePaid, StatePartiallyRefunded, StateRefunded},
+	StatePartiallyRefunded: {StateRefunded},
+}
+
+func (s State) CanTransition(to State) bool {
+	for _, next := range stateTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
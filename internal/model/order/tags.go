@@ -0,0 +1,60 @@
+package order
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/krivenkov/order/internal/model"
+)
+
+const (
+	// MaxTags limits the tags of an order
+	MaxTags = 20
+	// MaxTagLength is counted in characters
+	MaxTagLength = 50
+	// FacetTags is the number of the most used tags in facets
+	FacetTags = 20
+)
+
+// TagFacet is a tag with the number of orders carrying it
+type TagFacet struct {
+	Tag   string
+	Count int
+}
+
+// NormalizeTag trims and lower-cases the tag, tags match regardless of case
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// NormalizeTags normalizes the tags and drops the repeated ones, keeping their order
+func NormalizeTags(tags []string) ([]string, error) {
+	if len(tags) > MaxTags {
+		return nil, fmt.Errorf("%w: order has more than %d tags", model.ErrInvalidArgument, MaxTags)
+	}
+
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+
+		if tag == "" {
+			return nil, fmt.Errorf("%w: tag is empty", model.ErrInvalidArgument)
+		}
+
+		if utf8.RuneCountInString(tag) > MaxTagLength {
+			return nil, fmt.Errorf("%w: tag %q is longer than %d characters", model.ErrInvalidArgument, tag, MaxTagLength)
+		}
+
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+
+		seen[tag] = struct{}{}
+		res = append(res, tag)
+	}
+
+	return res, nil
+}
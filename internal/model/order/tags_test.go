@@ -0,0 +1,47 @@
+package order_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		tags     []string
+		expected []string
+		valid    bool
+	}{
+		{name: "Lower-cased and trimmed", tags: []string{" Urgent", "GIFTS "}, expected: []string{"urgent", "gifts"}, valid: true},
+		{name: "Repeated kept once", tags: []string{"urgent", "Urgent", "gifts"}, expected: []string{"urgent", "gifts"}, valid: true},
+		{name: "No tags", tags: []string{}, expected: []string{}, valid: true},
+		{name: "Empty tag", tags: []string{"urgent", " "}},
+		{name: "Too long", tags: []string{strings.Repeat("x", orderModel.MaxTagLength+1)}},
+		{name: "Too many", tags: strings.Split(strings.Repeat("x,", orderModel.MaxTags)+"y", ",")},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := orderModel.NormalizeTags(tt.tags)
+
+			if !tt.valid {
+				require.ErrorIs(t, err, model.ErrInvalidArgument)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package order
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/order/internal/model/promo"
+)
+
+var currencyRe = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Totals are amounts of the order in minor units of Currency
+type Totals struct {
+	Currency string
+	// Subtotal is the sum of the line amounts
+	Subtotal int64
+	// Discount is the sum of the line discounts
+	Discount int64
+	// Tax is the sum of the line taxes
+	Tax int64
+	// TaxInclusive is true when the prices include Tax, it is added to Total otherwise
+	TaxInclusive bool
+	// Total is the amount due
+	Total    int64
+	Paid     int64
+	Refunded int64
+}
+
+// Calculate recomputes the amounts derived from the lines
+func (t *Totals) Calculate(lines []*line.Line) {
+	t.Subtotal, t.Discount, t.Tax = 0, 0, 0
+	for _, l := range lines {
+		t.Subtotal += l.Amount()
+		t.Discount += l.Discount
+		t.Tax += l.Tax
+	}
+
+	t.Total = t.Subtotal - t.Discount
+	if !t.TaxInclusive {
+		t.Total += t.Tax
+	}
+}
+
+// Refundable is the amount paid and not refunded yet
+func (t *Totals) Refundable() int64 {
+	return t.Paid - t.Refunded
+}
+
+func normalizeCurrency(currency string) (string, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+
+	if !currencyRe.MatchString(currency) {
+		return "", fmt.Errorf("%w: currency must be an ISO 4217 code", model.ErrInvalidArgument)
+	}
+
+	return currency, nil
+}
+
+func formatAmount(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func formatCodes(discounts []*promo.Discount) string {
+	codes := make([]string, 0, len(discounts))
+	for _, d := range discounts {
+		codes = append(codes, d.Code)
+	}
+
+	return strings.Join(codes, ",")
+}
@@ -0,0 +1,13 @@
+package payment
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	// Create stores the payment, it returns ErrDuplicate when the provider reference is already stored.
+	// A success stored over a failure of the same reference takes the id of the failure.
+	Create(ctx context.Context, item *Payment) error
+}
@@ -0,0 +1,108 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/pkg/busapi/topics"
+)
+
+const ResultTopic topics.Topic = "payment.result.order.1"
+
+// ErrDuplicate is returned when the provider reference was already recorded
+var ErrDuplicate = errors.New("payment already recorded")
+
+type Status int
+
+const (
+	StatusSucceeded Status = 1
+	StatusFailed    Status = 2
+)
+
+var statusNames = map[Status]string{
+	StatusSucceeded: "succeeded",
+	StatusFailed:    "failed",
+}
+
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(s))
+}
+
+// Result is the outcome of a payment attempt published by the payment provider integration
+type Result struct {
+	OrderID  string
+	Provider string
+	// ExternalRef identifies the attempt at the provider, results are applied once per reference
+	ExternalRef string
+	// Amount in minor units of the currency
+	Amount    int64
+	Currency  string
+	Succeeded bool
+	Reason    string
+}
+
+var currencyRe = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func (r *Result) Validate() error {
+	r.Provider = strings.TrimSpace(r.Provider)
+	r.ExternalRef = strings.TrimSpace(r.ExternalRef)
+	r.Currency = strings.ToUpper(strings.TrimSpace(r.Currency))
+
+	switch {
+	case r.OrderID == "":
+		return fmt.Errorf("%w: order id is required", model.ErrInvalidArgument)
+	case r.Provider == "" || len(r.Provider) > 64:
+		return fmt.Errorf("%w: provider is required and must be at most 64 characters", model.ErrInvalidArgument)
+	case r.ExternalRef == "" || len(r.ExternalRef) > 128:
+		return fmt.Errorf("%w: external reference is required and must be at most 128 characters", model.ErrInvalidArgument)
+	case r.Amount <= 0:
+		return fmt.Errorf("%w: amount must be positive", model.ErrInvalidArgument)
+	case !currencyRe.MatchString(r.Currency):
+		return fmt.Errorf("%w: currency must be an ISO 4217 code", model.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+type Payment struct {
+	ID       string
+	TSCreate time.Time
+
+	OrderID     string
+	Provider    string
+	ExternalRef string
+	// Amount in minor units of the currency
+	Amount   int64
+	Currency string
+	Status   Status
+	Reason   string
+}
+
+func New(result *Result, now func() time.Time, newID func() uuid.UUID) *Payment {
+	status := StatusFailed
+	if result.Succeeded {
+		status = StatusSucceeded
+	}
+
+	return &Payment{
+		ID:          newID().String(),
+		TSCreate:    now(),
+		OrderID:     result.OrderID,
+		Provider:    result.Provider,
+		ExternalRef: result.ExternalRef,
+		Amount:      result.Amount,
+		Currency:    result.Currency,
+		Status:      status,
+		Reason:      result.Reason,
+	}
+}
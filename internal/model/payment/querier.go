@@ -0,0 +1,18 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/option"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	// GetList returns payments, the oldest first
+	GetList(ctx context.Context, filter *Filter) ([]*Payment, error)
+}
+
+type Filter struct {
+	OrderID option.Option[string]
+}
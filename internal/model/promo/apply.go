@@ -0,0 +1,146 @@
+package promo
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+)
+
+// Apply evaluates the promo codes against the lines of an order in the currency and sets
+// the line discounts. Line discounts go first, then order discounts spread over the lines
+// in proportion to what is left of them, each code taking off the amount left by the
+// previous ones. Usage limits are enforced once more when the codes are redeemed.
+func Apply(promos []*Promo, lines []*line.Line, currency string, now time.Time) ([]*Discount, error) {
+	var subtotal int64
+	for _, l := range lines {
+		l.Discount = 0
+		subtotal += l.Amount()
+	}
+
+	for _, p := range promos {
+		if err := p.check(subtotal, currency, now); err != nil {
+			return nil, err
+		}
+	}
+
+	ordered := make([]*Promo, len(promos))
+	copy(ordered, promos)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Scope == ScopeLine && ordered[j].Scope != ScopeLine
+	})
+
+	res := make([]*Discount, 0, len(ordered))
+
+	for _, p := range ordered {
+		var amount int64
+
+		switch p.Scope {
+		case ScopeLine:
+			matched := false
+			for _, l := range lines {
+				if !p.matches(l) {
+					continue
+				}
+
+				matched = true
+				d := p.lineDiscount(l)
+				l.Discount += d
+				amount += d
+			}
+
+			if !matched {
+				return nil, fmt.Errorf("%w: promo code %s does not apply to any line", model.ErrInvalidArgument, p.Code)
+			}
+		case ScopeOrder:
+			amount = p.orderDiscount(lines)
+			spread(amount, lines)
+		}
+
+		res = append(res, &Discount{
+			PromoID: p.ID,
+			Code:    p.Code,
+			Amount:  amount,
+		})
+	}
+
+	return res, nil
+}
+
+func (p *Promo) check(subtotal int64, currency string, now time.Time) error {
+	switch {
+	case p.ValidFrom != nil && now.Before(*p.ValidFrom):
+		return fmt.Errorf("%w: promo code %s is not valid yet", model.ErrInvalidArgument, p.Code)
+	case p.ValidTo != nil && !now.Before(*p.ValidTo):
+		return fmt.Errorf("%w: promo code %s has expired", model.ErrInvalidArgument, p.Code)
+	case p.Currency != "" && p.Currency != currency:
+		return fmt.Errorf("%w: promo code %s applies to %s orders only", model.ErrInvalidArgument, p.Code, p.Currency)
+	case subtotal < p.MinAmount:
+		return fmt.Errorf("%w: promo code %s requires an order of at least %d", model.ErrInvalidArgument, p.Code, p.MinAmount)
+	case p.MaxUses > 0 && p.Uses >= p.MaxUses:
+		return fmt.Errorf("%s: %w", p.Code, ErrLimitReached)
+	}
+
+	return nil
+}
+
+func (p *Promo) matches(l *line.Line) bool {
+	if len(p.SKUs) == 0 {
+		return true
+	}
+
+	for _, sku := range p.SKUs {
+		if sku == l.SKU {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *Promo) lineDiscount(l *line.Line) int64 {
+	if p.Kind == KindPercentage {
+		return l.Net() * p.Value / 100
+	}
+
+	return min(p.Value*int64(l.Quantity), l.Net())
+}
+
+func (p *Promo) orderDiscount(lines []*line.Line) int64 {
+	var net int64
+	for _, l := range lines {
+		net += l.Net()
+	}
+
+	if p.Kind == KindPercentage {
+		return net * p.Value / 100
+	}
+
+	return min(p.Value, net)
+}
+
+// spread splits the amount over the lines in proportion to their net, the cumulative
+// rounding keeps the sum exact and every share within the net of its line
+func spread(amount int64, lines []*line.Line) {
+	nets := make([]int64, len(lines))
+
+	var net int64
+	for i, l := range lines {
+		nets[i] = l.Net()
+		net += nets[i]
+	}
+
+	if amount == 0 || net == 0 {
+		return
+	}
+
+	var cum, given int64
+	for i, l := range lines {
+		cum += nets[i]
+		share := amount*cum/net - given
+		given += share
+		l.Discount += share
+	}
+}
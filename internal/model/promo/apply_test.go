@@ -0,0 +1,111 @@
+package promo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+
+	lines := func() []*line.Line {
+		return []*line.Line{
+			{ID: "a", SKU: "SKU-A", Quantity: 2, UnitPrice: 500},
+			{ID: "b", SKU: "SKU-B", Quantity: 1, UnitPrice: 333},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		promos    []*promo.Promo
+		discounts []int64
+		lines     []int64
+		err       error
+	}{
+		{
+			name:      "Order percentage",
+			promos:    []*promo.Promo{{Code: "TEN", Kind: promo.KindPercentage, Scope: promo.ScopeOrder, Value: 10}},
+			discounts: []int64{133},
+			lines:     []int64{99, 34},
+		},
+		{
+			name:      "Order fixed capped by the total",
+			promos:    []*promo.Promo{{Code: "BIG", Kind: promo.KindFixed, Scope: promo.ScopeOrder, Value: 5000, Currency: "EUR"}},
+			discounts: []int64{1333},
+			lines:     []int64{1000, 333},
+		},
+		{
+			name:      "Line fixed per unit of a sku",
+			promos:    []*promo.Promo{{Code: "A1", Kind: promo.KindFixed, Scope: promo.ScopeLine, Value: 100, Currency: "EUR", SKUs: []string{"SKU-A"}}},
+			discounts: []int64{200},
+			lines:     []int64{200, 0},
+		},
+		{
+			name: "Line before order",
+			promos: []*promo.Promo{
+				{Code: "HALF", Kind: promo.KindPercentage, Scope: promo.ScopeOrder, Value: 50},
+				{Code: "B", Kind: promo.KindPercentage, Scope: promo.ScopeLine, Value: 100, SKUs: []string{"SKU-B"}},
+			},
+			discounts: []int64{333, 500},
+			lines:     []int64{500, 333},
+		},
+		{
+			name:   "Below the minimal amount",
+			promos: []*promo.Promo{{Code: "MIN", Kind: promo.KindPercentage, Scope: promo.ScopeOrder, Value: 10, Currency: "EUR", MinAmount: 2000}},
+			err:    model.ErrInvalidArgument,
+		},
+		{
+			name:   "Other currency",
+			promos: []*promo.Promo{{Code: "USD", Kind: promo.KindFixed, Scope: promo.ScopeOrder, Value: 100, Currency: "USD"}},
+			err:    model.ErrInvalidArgument,
+		},
+		{
+			name:   "Expired",
+			promos: []*promo.Promo{{Code: "OLD", Kind: promo.KindPercentage, Scope: promo.ScopeOrder, Value: 10, ValidTo: ptr.Pointer(now)}},
+			err:    model.ErrInvalidArgument,
+		},
+		{
+			name:   "No matching line",
+			promos: []*promo.Promo{{Code: "C", Kind: promo.KindPercentage, Scope: promo.ScopeLine, Value: 10, SKUs: []string{"SKU-C"}}},
+			err:    model.ErrInvalidArgument,
+		},
+		{
+			name:   "Used up",
+			promos: []*promo.Promo{{Code: "ONCE", Kind: promo.KindPercentage, Scope: promo.ScopeOrder, Value: 10, MaxUses: 1, Uses: 1}},
+			err:    promo.ErrLimitReached,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ll := lines()
+
+			res, err := promo.Apply(tt.promos, ll, "EUR", now)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			discounts := make([]int64, 0, len(res))
+			for _, d := range res {
+				discounts = append(discounts, d.Amount)
+			}
+
+			require.Equal(t, tt.discounts, discounts)
+			require.Equal(t, tt.lines, []int64{ll[0].Discount, ll[1].Discount})
+		})
+	}
+}
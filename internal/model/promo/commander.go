@@ -0,0 +1,20 @@
+package promo
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	// Create stores the promo code, it returns model.ErrConflict when the code is taken
+	Create(ctx context.Context, item *Promo) error
+	// Update returns model.ErrConflict when the code is taken
+	Update(ctx context.Context, item *Promo) error
+	Delete(ctx context.Context, id string) error
+	// Redeem counts the uses of the promo codes, it returns ErrLimitReached when
+	// a code is used up overall or by the user
+	Redeem(ctx context.Context, items ...*Redemption) error
+	// ReplaceUser gives the redemptions of the user to another one
+	ReplaceUser(ctx context.Context, userID, by string) error
+}
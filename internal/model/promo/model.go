@@ -0,0 +1,246 @@
+package promo
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+)
+
+// ErrLimitReached is returned when a promo code is used up overall or by the user
+var ErrLimitReached = fmt.Errorf("%w: promo code usage limit reached", model.ErrConflict)
+
+type Kind int
+
+const (
+	KindPercentage Kind = 1
+	KindFixed      Kind = 2
+)
+
+var kindNames = map[Kind]string{
+	KindPercentage: "percentage",
+	KindFixed:      "fixed",
+}
+
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(k))
+}
+
+// ParseKind is the reverse of Kind.String
+func ParseKind(name string) (Kind, bool) {
+	for k, n := range kindNames {
+		if n == name {
+			return k, true
+		}
+	}
+
+	return 0, false
+}
+
+type Scope int
+
+const (
+	ScopeOrder Scope = 1
+	ScopeLine  Scope = 2
+)
+
+var scopeNames = map[Scope]string{
+	ScopeOrder: "order",
+	ScopeLine:  "line",
+}
+
+func (s Scope) String() string {
+	if name, ok := scopeNames[s]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(s))
+}
+
+// ParseScope is the reverse of Scope.String
+func ParseScope(name string) (Scope, bool) {
+	for s, n := range scopeNames {
+		if n == name {
+			return s, true
+		}
+	}
+
+	return 0, false
+}
+
+const (
+	maxSKUs = 100
+
+	// MaxCodes is the maximum number of promo codes applied to one order
+	MaxCodes = 5
+)
+
+var (
+	codeRe     = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+	currencyRe = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+type Promo struct {
+	ID       string
+	TSCreate time.Time
+	TSModify time.Time
+
+	Code  string
+	Kind  Kind
+	Scope Scope
+	// Value is a percentage for KindPercentage and an amount in minor units of Currency
+	// for KindFixed, a fixed line discount is taken off every unit of the line
+	Value    int64
+	Currency string
+	// SKUs limit a line discount to the lines with these SKUs, it applies to all lines when empty
+	SKUs []string
+	// MinAmount is the minimal order subtotal in minor units of Currency
+	MinAmount int64
+
+	ValidFrom *time.Time
+	ValidTo   *time.Time
+
+	// MaxUses and MaxUsesPerUser are unlimited when 0
+	MaxUses        int
+	MaxUsesPerUser int
+	Uses           int
+}
+
+func New(form *Form, now func() time.Time, newID func() uuid.UUID) *Promo {
+	item := &Promo{
+		ID:       newID().String(),
+		TSCreate: now(),
+		TSModify: now(),
+	}
+
+	item.FillForm(form)
+
+	return item
+}
+
+func (p *Promo) FillForm(f *Form) {
+	p.Code = f.Code
+	p.Kind = f.Kind
+	p.Scope = f.Scope
+	p.Value = f.Value
+	p.Currency = f.Currency
+	p.SKUs = f.SKUs
+	p.MinAmount = f.MinAmount
+	p.ValidFrom = f.ValidFrom
+	p.ValidTo = f.ValidTo
+	p.MaxUses = f.MaxUses
+	p.MaxUsesPerUser = f.MaxUsesPerUser
+}
+
+// Form replaces all the settings of the promo code
+type Form struct {
+	Code           string
+	Kind           Kind
+	Scope          Scope
+	Value          int64
+	Currency       string
+	SKUs           []string
+	MinAmount      int64
+	ValidFrom      *time.Time
+	ValidTo        *time.Time
+	MaxUses        int
+	MaxUsesPerUser int
+}
+
+func (f *Form) Validate() error {
+	f.Code = NormalizeCode(f.Code)
+	f.Currency = strings.ToUpper(strings.TrimSpace(f.Currency))
+
+	skus := make([]string, 0, len(f.SKUs))
+	seen := make(map[string]struct{}, len(f.SKUs))
+
+	for _, sku := range f.SKUs {
+		sku = strings.TrimSpace(sku)
+		if _, ok := seen[sku]; ok || sku == "" {
+			continue
+		}
+
+		seen[sku] = struct{}{}
+		skus = append(skus, sku)
+	}
+
+	f.SKUs = skus
+
+	switch {
+	case !codeRe.MatchString(f.Code):
+		return fmt.Errorf("%w: code must be 3 to 32 letters, digits, dashes or underscores", model.ErrInvalidArgument)
+	case kindNames[f.Kind] == "":
+		return fmt.Errorf("%w: unknown kind", model.ErrInvalidArgument)
+	case scopeNames[f.Scope] == "":
+		return fmt.Errorf("%w: unknown scope", model.ErrInvalidArgument)
+	case f.Kind == KindPercentage && (f.Value <= 0 || f.Value > 100):
+		return fmt.Errorf("%w: percentage must be from 1 to 100", model.ErrInvalidArgument)
+	case f.Kind == KindFixed && f.Value <= 0:
+		return fmt.Errorf("%w: amount must be positive", model.ErrInvalidArgument)
+	case f.MinAmount < 0:
+		return fmt.Errorf("%w: minimal amount must not be negative", model.ErrInvalidArgument)
+	case f.Currency == "" && (f.Kind == KindFixed || f.MinAmount > 0):
+		return fmt.Errorf("%w: currency is required for amounts", model.ErrInvalidArgument)
+	case f.Currency != "" && !currencyRe.MatchString(f.Currency):
+		return fmt.Errorf("%w: currency must be an ISO 4217 code", model.ErrInvalidArgument)
+	case f.Scope == ScopeOrder && len(f.SKUs) > 0:
+		return fmt.Errorf("%w: skus apply to line discounts only", model.ErrInvalidArgument)
+	case len(f.SKUs) > maxSKUs:
+		return fmt.Errorf("%w: more than %d skus", model.ErrInvalidArgument, maxSKUs)
+	case f.ValidFrom != nil && f.ValidTo != nil && !f.ValidTo.After(*f.ValidFrom):
+		return fmt.Errorf("%w: validity must end after it starts", model.ErrInvalidArgument)
+	case f.MaxUses < 0 || f.MaxUsesPerUser < 0:
+		return fmt.Errorf("%w: usage limits must not be negative", model.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// NormalizeCode makes codes case-insensitive
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Discount is a promo code applied to an order
+type Discount struct {
+	PromoID string
+	Code    string
+	// Amount taken off the order in minor units of its currency
+	Amount int64
+}
+
+// Redemption counts a use of a promo code by the user
+type Redemption struct {
+	ID       string
+	TSCreate time.Time
+
+	PromoID string
+	OrderID string
+	UserID  string
+	Amount  int64
+}
+
+func NewRedemptions(orderID, userID string, discounts []*Discount, now func() time.Time, newID func() uuid.UUID) []*Redemption {
+	res := make([]*Redemption, 0, len(discounts))
+
+	for _, d := range discounts {
+		res = append(res, &Redemption{
+			ID:       newID().String(),
+			TSCreate: now(),
+			PromoID:  d.PromoID,
+			OrderID:  orderID,
+			UserID:   userID,
+			Amount:   d.Amount,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,22 @@
+package promo
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	GetItem(ctx context.Context, filter *Filter) (*Promo, error)
+	// GetList returns promo codes ordered by code
+	GetList(ctx context.Context, filter *Filter, pagination *paginator.Pagination) ([]*Promo, error)
+	Count(ctx context.Context, filter *Filter) (int, error)
+}
+
+type Filter struct {
+	IDs   option.Option[[]string]
+	Codes option.Option[[]string]
+}
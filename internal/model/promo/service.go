@@ -0,0 +1,20 @@
+package promo
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/paginator"
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+
+// Service manages promo codes, it is used by staff only
+type Service interface {
+	Create(ctx context.Context, form *Form) (*Promo, error)
+	Update(ctx context.Context, id string, form *Form) (*Promo, error)
+	Delete(ctx context.Context, id string) error
+
+	GetItem(ctx context.Context, id string) (*Promo, error)
+	// GetList returns promo codes ordered by code and their total
+	GetList(ctx context.Context, pagination paginator.Pagination) ([]*Promo, int, error)
+}
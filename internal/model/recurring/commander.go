@@ -0,0 +1,19 @@
+package recurring
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	Create(ctx context.Context, item *Template) error
+	Update(ctx context.Context, item *Template) error
+	Delete(ctx context.Context, id string) error
+	DeleteByUser(ctx context.Context, userID string) error
+	// Claim moves the next run of the due template on, it returns model.ErrConflict when the
+	// template was paused or its next run changed since it was read
+	Claim(ctx context.Context, id string, scheduledAt, nextRun time.Time) error
+	CreateRun(ctx context.Context, item *Run) error
+}
@@ -0,0 +1,124 @@
+package recurring
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/order"
+)
+
+const maxNameLength = 256
+
+// Template is an order created again and again on its schedule
+type Template struct {
+	ID       string
+	TSCreate time.Time
+	TSModify time.Time
+
+	UserID   string
+	Name     string
+	Schedule Schedule
+	// NextRun is when the scheduler creates the next order
+	NextRun time.Time
+	// Paused templates create no orders, the runs missed meanwhile are skipped
+	Paused bool
+	// Order is the form every order of the template is created with
+	Order *order.Form
+}
+
+func New(userID string, form *Form, now func() time.Time, newID func() uuid.UUID) *Template {
+	item := &Template{
+		ID:       newID().String(),
+		TSCreate: now(),
+		TSModify: now(),
+		UserID:   userID,
+	}
+
+	item.FillForm(form, now())
+
+	return item
+}
+
+// FillForm replaces the settings of the template, a new schedule starts over from now
+func (t *Template) FillForm(f *Form, now time.Time) {
+	t.Name = f.Name
+	t.Order = f.Order
+
+	if t.NextRun.IsZero() || t.Schedule != f.Schedule {
+		t.Schedule = f.Schedule
+		t.NextRun = f.Schedule.Next(now)
+	}
+}
+
+// Resume restarts a paused template, the runs missed while it was paused are skipped
+func (t *Template) Resume(now time.Time) {
+	t.Paused = false
+
+	if t.NextRun.Before(now) {
+		t.NextRun = t.Schedule.Next(now)
+	}
+}
+
+// Form replaces all the settings of the template
+type Form struct {
+	Name     string
+	Schedule Schedule
+	Order    *order.Form
+}
+
+func (f *Form) Validate() error {
+	f.Name = strings.TrimSpace(f.Name)
+
+	switch {
+	case f.Name == "":
+		return fmt.Errorf("%w: name is required", model.ErrInvalidArgument)
+	case len(f.Name) > maxNameLength:
+		return fmt.Errorf("%w: name is longer than %d", model.ErrInvalidArgument, maxNameLength)
+	case f.Order == nil || len(f.Order.Lines) == 0:
+		return fmt.Errorf("%w: order must have lines", model.ErrInvalidArgument)
+	case f.Order.Draft:
+		return fmt.Errorf("%w: recurring orders can not be drafts", model.ErrInvalidArgument)
+	}
+
+	if err := f.Schedule.Validate(); err != nil {
+		return err
+	}
+
+	if err := f.Order.Validate(); err != nil {
+		return fmt.Errorf("order: %w", err)
+	}
+
+	return nil
+}
+
+// Run is an order created by the scheduler from a template, or the error which prevented it
+type Run struct {
+	ID       string
+	TSCreate time.Time
+
+	TemplateID string
+	// ScheduledAt is the run time of the template the order is created for
+	ScheduledAt time.Time
+	// OrderID is empty when the order was not created
+	OrderID string
+	Error   string
+}
+
+func NewRun(item *Template, orderID string, err error, now func() time.Time, newID func() uuid.UUID) *Run {
+	res := &Run{
+		ID:          newID().String(),
+		TSCreate:    now(),
+		TemplateID:  item.ID,
+		ScheduledAt: item.NextRun,
+		OrderID:     orderID,
+	}
+
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res
+}
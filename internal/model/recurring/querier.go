@@ -0,0 +1,32 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	GetItem(ctx context.Context, filter *Filter) (*Template, error)
+	// GetList returns templates ordered by the next run
+	GetList(ctx context.Context, filter *Filter, pagination *paginator.Pagination) ([]*Template, error)
+	Count(ctx context.Context, filter *Filter) (int, error)
+
+	// GetRuns returns the runs of a template, the latest first
+	GetRuns(ctx context.Context, templateID string, pagination *paginator.Pagination) ([]*Run, error)
+	CountRuns(ctx context.Context, templateID string) (int, error)
+}
+
+type Filter struct {
+	IDs    option.Option[[]string]
+	UserID option.Option[string]
+	Paused option.Option[bool]
+	// DueBefore keeps the templates with the next run not after the time
+	DueBefore option.Option[time.Time]
+	// ExcludeIDs leaves the templates out
+	ExcludeIDs option.Option[[]string]
+}
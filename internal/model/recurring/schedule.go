@@ -0,0 +1,191 @@
+package recurring
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+)
+
+// MinInterval is the shortest interval between two orders of a template
+const MinInterval = time.Hour
+
+// Schedule is either a cron expression or a fixed interval, cron times are in UTC
+type Schedule struct {
+	// Cron has the five standard fields: minute, hour, day of month, month and day of week
+	Cron     string
+	Interval time.Duration
+}
+
+func (s Schedule) Validate() error {
+	switch {
+	case s.Cron != "" && s.Interval != 0:
+		return fmt.Errorf("%w: schedule is either a cron expression or an interval", model.ErrInvalidArgument)
+	case s.Cron != "":
+		if _, err := parseCron(s.Cron); err != nil {
+			return err
+		}
+	case s.Interval < MinInterval:
+		return fmt.Errorf("%w: interval must be at least %s", model.ErrInvalidArgument, MinInterval)
+	}
+
+	return nil
+}
+
+// Next returns the first run of the schedule after t, it is zero when the cron expression never matches
+func (s Schedule) Next(t time.Time) time.Time {
+	if s.Cron == "" {
+		return t.Add(s.Interval)
+	}
+
+	spec, err := parseCron(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return spec.next(t)
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [...]cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// cronSpec keeps the allowed values of every field as bits
+type cronSpec struct {
+	minute, hour, dom, month, dow uint64
+	// anyDom and anyDow tell whether the day fields are "*", a day matches when both
+	// restricted fields match as in the classic cron otherwise
+	anyDom, anyDow bool
+}
+
+func parseCron(expr string) (*cronSpec, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("%w: cron expression must have %d fields", model.ErrInvalidArgument, len(cronFields))
+	}
+
+	var values [len(cronFields)]uint64
+
+	for i, part := range parts {
+		v, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = v
+	}
+
+	spec := &cronSpec{
+		minute: values[0],
+		hour:   values[1],
+		dom:    values[2],
+		month:  values[3],
+		// 7 is sunday as well as 0
+		dow:    (values[4] | values[4]>>7) & 0x7f,
+		anyDom: parts[2] == "*",
+		anyDow: parts[4] == "*",
+	}
+
+	if spec.next(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, fmt.Errorf("%w: cron expression never matches", model.ErrInvalidArgument)
+	}
+
+	return spec, nil
+}
+
+// parseCronField parses a list of "*", "n", "a-b" items with an optional "/step"
+func parseCronField(value string, field cronField) (uint64, error) {
+	var res uint64
+
+	for _, item := range strings.Split(value, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+
+		lo, hi := field.min, field.max
+
+		if rng != "*" {
+			from, to, isRange := strings.Cut(rng, "-")
+
+			var err error
+			if lo, err = strconv.Atoi(from); err != nil {
+				return 0, fmt.Errorf("%w: invalid cron %s %q", model.ErrInvalidArgument, field.name, item)
+			}
+
+			hi = lo
+			if isRange {
+				if hi, err = strconv.Atoi(to); err != nil {
+					return 0, fmt.Errorf("%w: invalid cron %s %q", model.ErrInvalidArgument, field.name, item)
+				}
+			} else if hasStep {
+				hi = field.max
+			}
+		}
+
+		inc := 1
+		if hasStep {
+			var err error
+			if inc, err = strconv.Atoi(step); err != nil || inc <= 0 {
+				return 0, fmt.Errorf("%w: invalid cron %s step %q", model.ErrInvalidArgument, field.name, item)
+			}
+		}
+
+		if lo < field.min || hi > field.max || lo > hi {
+			return 0, fmt.Errorf("%w: cron %s %q is out of %d-%d", model.ErrInvalidArgument, field.name, item, field.min, field.max)
+		}
+
+		for v := lo; v <= hi; v += inc {
+			res |= 1 << uint(v)
+		}
+	}
+
+	return res, nil
+}
+
+// next searches the matching minute field by field, the years without one give up the search
+func (c *cronSpec) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (c *cronSpec) matchDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+
+	switch {
+	case c.anyDom && c.anyDow:
+		return true
+	case c.anyDom:
+		return dow
+	case c.anyDow:
+		return dom
+	default:
+		return dom || dow
+	}
+}
@@ -0,0 +1,102 @@
+package recurring_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/recurring"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleNext(t *testing.T) {
+	// a saturday
+	from := time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule recurring.Schedule
+		expected time.Time
+	}{
+		{
+			name:     "Interval",
+			schedule: recurring.Schedule{Interval: 36 * time.Hour},
+			expected: time.Date(2000, time.January, 3, 3, 24, 11, 0, time.UTC),
+		},
+		{
+			name:     "Every minute",
+			schedule: recurring.Schedule{Cron: "* * * * *"},
+			expected: time.Date(2000, time.January, 1, 15, 25, 0, 0, time.UTC),
+		},
+		{
+			name:     "Later today",
+			schedule: recurring.Schedule{Cron: "30 15 * * *"},
+			expected: time.Date(2000, time.January, 1, 15, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "Tomorrow",
+			schedule: recurring.Schedule{Cron: "0 9 * * *"},
+			expected: time.Date(2000, time.January, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Weekdays",
+			schedule: recurring.Schedule{Cron: "0 9 * * 1-5"},
+			expected: time.Date(2000, time.January, 3, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Sunday as 7",
+			schedule: recurring.Schedule{Cron: "0 9 * * 7"},
+			expected: time.Date(2000, time.January, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Steps and lists",
+			schedule: recurring.Schedule{Cron: "*/20 8,20 * * *"},
+			expected: time.Date(2000, time.January, 1, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Day of month or day of week",
+			schedule: recurring.Schedule{Cron: "0 0 15 * 1"},
+			expected: time.Date(2000, time.January, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Leap day",
+			schedule: recurring.Schedule{Cron: "0 0 29 2 *"},
+			expected: time.Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Next year",
+			schedule: recurring.Schedule{Cron: "0 0 1 1 *"},
+			expected: time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.schedule.Validate())
+			require.Equal(t, tt.expected, tt.schedule.Next(from))
+		})
+	}
+}
+
+func TestScheduleValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule recurring.Schedule
+	}{
+		{name: "Empty", schedule: recurring.Schedule{}},
+		{name: "Both", schedule: recurring.Schedule{Cron: "0 9 * * *", Interval: time.Hour}},
+		{name: "Short interval", schedule: recurring.Schedule{Interval: time.Minute}},
+		{name: "Four fields", schedule: recurring.Schedule{Cron: "0 9 * *"}},
+		{name: "Out of range", schedule: recurring.Schedule{Cron: "60 9 * * *"}},
+		{name: "Reversed range", schedule: recurring.Schedule{Cron: "0 9-5 * * *"}},
+		{name: "Zero step", schedule: recurring.Schedule{Cron: "*/0 * * * *"}},
+		{name: "Not a number", schedule: recurring.Schedule{Cron: "0 nine * * *"}},
+		{name: "Never", schedule: recurring.Schedule{Cron: "0 0 31 2 *"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.schedule.Validate(), model.ErrInvalidArgument)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package recurring
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/paginator"
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+
+// Service manages the recurring order templates of the users
+type Service interface {
+	Create(ctx context.Context, userID string, form *Form) (*Template, error)
+	Update(ctx context.Context, userID, id string, form *Form) (*Template, error)
+	Delete(ctx context.Context, userID, id string) error
+	// Pause stops the orders of the template until it is resumed
+	Pause(ctx context.Context, userID, id string) (*Template, error)
+	Resume(ctx context.Context, userID, id string) (*Template, error)
+
+	GetItem(ctx context.Context, userID, id string) (*Template, error)
+	GetList(ctx context.Context, userID string, pagination paginator.Pagination) ([]*Template, int, error)
+	// GetRuns returns the orders created from the template, the latest first
+	GetRuns(ctx context.Context, userID, id string, pagination paginator.Pagination) ([]*Run, int, error)
+
+	// RunDue creates the orders of every template due now, it returns the number of runs.
+	// A template which fails is logged and skipped, the others still run.
+	RunDue(ctx context.Context) (int, error)
+}
@@ -0,0 +1,18 @@
+package refund
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	CreateReturn(ctx context.Context, item *Return) error
+	// UpdateReturn stores the decision on a return still requested,
+	// model.ErrConflict when it is decided already
+	UpdateReturn(ctx context.Context, item *Return) error
+	CreateRefunds(ctx context.Context, items ...*Refund) error
+	// SetPublished marks the refunds as sent to the payment integration
+	SetPublished(ctx context.Context, ts time.Time, ids ...string) error
+}
@@ -0,0 +1,191 @@
+package refund
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/pkg/busapi/topics"
+)
+
+const RefundedTopic topics.Topic = "order.refunded.order.1"
+
+const (
+	maxReasonLen  = 1024
+	maxCommentLen = 1024
+)
+
+type Status int
+
+const (
+	StatusRequested Status = 1
+	StatusApproved  Status = 2
+	StatusRejected  Status = 3
+)
+
+var statusNames = map[Status]string{
+	StatusRequested: "requested",
+	StatusApproved:  "approved",
+	StatusRejected:  "rejected",
+}
+
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(s))
+}
+
+// Return is a customer request to send back units of an order line
+type Return struct {
+	ID       string
+	TSCreate time.Time
+	TSModify time.Time
+
+	OrderID  string
+	LineID   string
+	Quantity int
+	Reason   string
+	Status   Status
+
+	// Comment and DecidedBy are set by the staff member who approved or rejected the return
+	Comment   string
+	DecidedBy string
+
+	Refunds []*Refund
+}
+
+func NewReturn(orderID string, form *ReturnForm, now func() time.Time, newID func() uuid.UUID) *Return {
+	return &Return{
+		ID:       newID().String(),
+		TSCreate: now(),
+		TSModify: now(),
+		OrderID:  orderID,
+		LineID:   form.LineID,
+		Quantity: form.Quantity,
+		Reason:   form.Reason,
+		Status:   StatusRequested,
+	}
+}
+
+// Decide approves or rejects a requested return
+func (r *Return) Decide(status Status, actorID, comment string, now time.Time) error {
+	if r.Status != StatusRequested {
+		return fmt.Errorf("%w: return is already %s", model.ErrConflict, r.Status)
+	}
+
+	comment = strings.TrimSpace(comment)
+	if utf8.RuneCountInString(comment) > maxCommentLen {
+		return fmt.Errorf("%w: comment is longer than %d characters", model.ErrInvalidArgument, maxCommentLen)
+	}
+
+	r.Status = status
+	r.Comment = comment
+	r.DecidedBy = actorID
+	r.TSModify = now
+
+	return nil
+}
+
+type ReturnForm struct {
+	LineID   string
+	Quantity int
+	Reason   string
+}
+
+func (f *ReturnForm) Validate() error {
+	f.Reason = strings.TrimSpace(f.Reason)
+
+	switch {
+	case f.LineID == "":
+		return fmt.Errorf("%w: line id is required", model.ErrInvalidArgument)
+	case f.Quantity <= 0:
+		return fmt.Errorf("%w: quantity must be positive", model.ErrInvalidArgument)
+	case f.Reason == "":
+		return fmt.Errorf("%w: reason is required", model.ErrInvalidArgument)
+	case utf8.RuneCountInString(f.Reason) > maxReasonLen:
+		return fmt.Errorf("%w: reason is longer than %d characters", model.ErrInvalidArgument, maxReasonLen)
+	}
+
+	return nil
+}
+
+// Requested sums quantities of the returns per line, rejected returns are not counted
+func Requested(returns []*Return) map[string]int {
+	res := make(map[string]int)
+
+	for _, r := range returns {
+		if r.Status == StatusRejected {
+			continue
+		}
+
+		res[r.LineID] += r.Quantity
+	}
+
+	return res
+}
+
+// Refund is money sent back for a return, it is always linked to the payment it is taken from
+type Refund struct {
+	ID       string
+	TSCreate time.Time
+
+	OrderID   string
+	ReturnID  string
+	PaymentID string
+	// Amount in minor units of the currency
+	Amount   int64
+	Currency string
+}
+
+// Allocate splits the amount over the succeeded payments, the oldest first,
+// so that no payment is refunded more than it was charged
+func Allocate(ret *Return, amount int64, payments []*payment.Payment, refunds []*Refund, now func() time.Time, newID func() uuid.UUID) ([]*Refund, error) {
+	refunded := make(map[string]int64, len(refunds))
+	for _, r := range refunds {
+		refunded[r.PaymentID] += r.Amount
+	}
+
+	var res []*Refund
+
+	left := amount
+	for _, p := range payments {
+		if left == 0 {
+			break
+		}
+
+		if p.Status != payment.StatusSucceeded {
+			continue
+		}
+
+		available := p.Amount - refunded[p.ID]
+		if available <= 0 {
+			continue
+		}
+
+		part := min(left, available)
+		left -= part
+
+		res = append(res, &Refund{
+			ID:        newID().String(),
+			TSCreate:  now(),
+			OrderID:   ret.OrderID,
+			ReturnID:  ret.ID,
+			PaymentID: p.ID,
+			Amount:    part,
+			Currency:  p.Currency,
+		})
+	}
+
+	if left > 0 {
+		return nil, fmt.Errorf("%w: refund of %d exceeds the amount paid by %d", model.ErrConflict, amount, left)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,26 @@
+package refund
+
+import (
+	"context"
+	"time"
+
+	"github.com/krivenkov/pkg/option"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	// GetReturns returns returns with their refunds, the oldest first
+	GetReturns(ctx context.Context, filter *Filter) ([]*Return, error)
+	// GetRefunds returns refunds, the oldest first
+	GetRefunds(ctx context.Context, filter *Filter) ([]*Refund, error)
+}
+
+type Filter struct {
+	IDs     option.Option[[]string]
+	OrderID option.Option[string]
+
+	// Published selects refunds by whether they were sent to the payment integration
+	Published     option.Option[bool]
+	CreatedBefore option.Option[time.Time]
+}
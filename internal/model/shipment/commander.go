@@ -0,0 +1,14 @@
+package shipment
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	// Create stores the shipment with its lines and events
+	Create(ctx context.Context, item *Shipment) error
+	// AddEvent moves the shipment to the event status and appends the event to its timeline
+	AddEvent(ctx context.Context, id string, event *Event) error
+}
@@ -0,0 +1,178 @@
+package shipment
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+)
+
+type Status int
+
+const (
+	StatusShipped   Status = 1
+	StatusInTransit Status = 2
+	StatusDelivered Status = 3
+	StatusCancelled Status = 4
+)
+
+var statusNames = map[Status]string{
+	StatusShipped:   "shipped",
+	StatusInTransit: "in_transit",
+	StatusDelivered: "delivered",
+	StatusCancelled: "cancelled",
+}
+
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+
+	return strconv.Itoa(int(s))
+}
+
+// ParseStatus is the reverse of Status.String
+func ParseStatus(name string) (Status, bool) {
+	for s, n := range statusNames {
+		if n == name {
+			return s, true
+		}
+	}
+
+	return 0, false
+}
+
+var transitions = map[Status][]Status{
+	StatusShipped:   {StatusInTransit, StatusDelivered, StatusCancelled},
+	StatusInTransit: {StatusDelivered, StatusCancelled},
+}
+
+func (s Status) CanTransition(to Status) bool {
+	for _, next := range transitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	maxCarrierLen  = 64
+	maxTrackingLen = 128
+)
+
+type Shipment struct {
+	ID       string
+	TSCreate time.Time
+	TSModify time.Time
+
+	OrderID        string
+	Carrier        string
+	TrackingNumber string
+	Status         Status
+
+	Lines []*Line
+	// Events is the status timeline, the oldest first
+	Events []*Event
+}
+
+// Line is a quantity of an order line packed into the shipment
+type Line struct {
+	LineID   string
+	Quantity int
+}
+
+type Event struct {
+	Status   Status
+	TSCreate time.Time
+}
+
+func New(orderID string, form *Form, now func() time.Time, newID func() uuid.UUID) *Shipment {
+	ts := now()
+
+	return &Shipment{
+		ID:             newID().String(),
+		TSCreate:       ts,
+		TSModify:       ts,
+		OrderID:        orderID,
+		Carrier:        form.Carrier,
+		TrackingNumber: form.TrackingNumber,
+		Status:         StatusShipped,
+		Lines:          form.Lines,
+		Events:         []*Event{{Status: StatusShipped, TSCreate: ts}},
+	}
+}
+
+// SetStatus moves the shipment along its timeline and returns the new event
+func (s *Shipment) SetStatus(to Status, now time.Time) (*Event, error) {
+	if !s.Status.CanTransition(to) {
+		return nil, fmt.Errorf("%w: shipment can not move from %s to %s", model.ErrConflict, s.Status, to)
+	}
+
+	event := &Event{Status: to, TSCreate: now}
+
+	s.Status = to
+	s.TSModify = now
+	s.Events = append(s.Events, event)
+
+	return event, nil
+}
+
+type Form struct {
+	Carrier        string
+	TrackingNumber string
+	Lines          []*Line
+}
+
+func (f *Form) Validate() error {
+	f.Carrier = strings.TrimSpace(f.Carrier)
+	f.TrackingNumber = strings.TrimSpace(f.TrackingNumber)
+
+	switch {
+	case f.Carrier == "":
+		return fmt.Errorf("%w: carrier is required", model.ErrInvalidArgument)
+	case utf8.RuneCountInString(f.Carrier) > maxCarrierLen:
+		return fmt.Errorf("%w: carrier is longer than %d characters", model.ErrInvalidArgument, maxCarrierLen)
+	case utf8.RuneCountInString(f.TrackingNumber) > maxTrackingLen:
+		return fmt.Errorf("%w: tracking number is longer than %d characters", model.ErrInvalidArgument, maxTrackingLen)
+	case len(f.Lines) == 0:
+		return fmt.Errorf("%w: shipment has no lines", model.ErrInvalidArgument)
+	}
+
+	seen := make(map[string]struct{}, len(f.Lines))
+	for _, l := range f.Lines {
+		if l.Quantity <= 0 {
+			return fmt.Errorf("%w: quantity of line %s must be positive", model.ErrInvalidArgument, l.LineID)
+		}
+
+		if _, ok := seen[l.LineID]; ok {
+			return fmt.Errorf("%w: line %s is listed twice", model.ErrInvalidArgument, l.LineID)
+		}
+
+		seen[l.LineID] = struct{}{}
+	}
+
+	return nil
+}
+
+// Shipped sums quantities per order line over the shipments that were not cancelled
+func Shipped(shipments []*Shipment) map[string]int {
+	res := make(map[string]int)
+
+	for _, s := range shipments {
+		if s.Status == StatusCancelled {
+			continue
+		}
+
+		for _, l := range s.Lines {
+			res[l.LineID] += l.Quantity
+		}
+	}
+
+	return res
+}
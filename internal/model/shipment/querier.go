@@ -0,0 +1,19 @@
+package shipment
+
+import (
+	"context"
+
+	"github.com/krivenkov/pkg/option"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	// GetList returns shipments with lines and events, the oldest first
+	GetList(ctx context.Context, filter *Filter) ([]*Shipment, error)
+}
+
+type Filter struct {
+	IDs     option.Option[[]string]
+	OrderID option.Option[string]
+}
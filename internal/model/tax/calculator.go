@@ -0,0 +1,43 @@
+package tax
+
+import (
+	"context"
+
+	"github.com/krivenkov/order/internal/model/line"
+)
+
+//go:generate mockgen -source=calculator.go -destination=mock/calculator.go
+
+// Calculator computes the taxes of an order. It sets the tax of every line and returns
+// the tax lines of the order, the default one uses the configured rates.
+type Calculator interface {
+	Calculate(ctx context.Context, req *Request) (*Result, error)
+}
+
+// Request is an order to tax in the jurisdiction of its address
+type Request struct {
+	Currency string
+	// Country is ISO 3166-1 alpha-2 code
+	Country string
+	Region  string
+	// Lines are taxed on their net amount
+	Lines []*line.Line
+}
+
+type Result struct {
+	// Inclusive is true when the prices include the taxes
+	Inclusive bool
+	Lines     []*Line
+}
+
+// Line is a tax charged on the order
+type Line struct {
+	Country  string
+	Region   string
+	Category string
+	// Rate in basis points, 1900 is 19%
+	Rate int64
+	// Taxable is the net amount of the lines taxed at the rate
+	Taxable int64
+	Amount  int64
+}
@@ -0,0 +1,16 @@
+package tenant
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=commander.go -destination=mock/commander.go
+
+type Commander interface {
+	Create(ctx context.Context, item *Tenant) error
+	// SetMember adds the member or changes its role
+	SetMember(ctx context.Context, item *Member) error
+	RemoveMember(ctx context.Context, tenantID, userID string) error
+	// RemoveUser removes the user from every tenant
+	RemoveUser(ctx context.Context, userID string) error
+}
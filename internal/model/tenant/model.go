@@ -0,0 +1,72 @@
+package tenant
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+)
+
+const maxNameLength = 256
+
+// Tenant is an organisation owning the orders of its members
+type Tenant struct {
+	ID       string
+	TSCreate time.Time
+	Name     string
+}
+
+func New(name string, now func() time.Time, newID func() uuid.UUID) (*Tenant, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxNameLength {
+		return nil, fmt.Errorf("%w: name must be 1 to %d characters", model.ErrInvalidArgument, maxNameLength)
+	}
+
+	return &Tenant{
+		ID:       newID().String(),
+		TSCreate: now(),
+		Name:     name,
+	}, nil
+}
+
+type Role string
+
+const (
+	// RoleViewer reads the orders of the tenant
+	RoleViewer Role = "viewer"
+	// RoleEditor also creates and changes them
+	RoleEditor Role = "editor"
+	// RoleApprover is an editor who approves orders as well
+	RoleApprover Role = "approver"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer:   1,
+	RoleEditor:   2,
+	RoleApprover: 3,
+}
+
+func (r Role) Validate() error {
+	if _, ok := roleRanks[r]; !ok {
+		return fmt.Errorf("%w: unknown role %q", model.ErrInvalidArgument, r)
+	}
+
+	return nil
+}
+
+// Allows tells whether the role grants what the given one does
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRanks[r]
+
+	return ok && rank >= roleRanks[required]
+}
+
+// Member is a user of a tenant with its role
+type Member struct {
+	TenantID string
+	UserID   string
+	Role     Role
+	TSCreate time.Time
+}
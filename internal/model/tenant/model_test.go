@@ -0,0 +1,30 @@
+package tenant_test
+
+import (
+	"testing"
+
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleAllows(t *testing.T) {
+	tests := []struct {
+		role     tenant.Role
+		required tenant.Role
+		expected bool
+	}{
+		{role: tenant.RoleViewer, required: tenant.RoleViewer, expected: true},
+		{role: tenant.RoleViewer, required: tenant.RoleEditor, expected: false},
+		{role: tenant.RoleEditor, required: tenant.RoleViewer, expected: true},
+		{role: tenant.RoleEditor, required: tenant.RoleApprover, expected: false},
+		{role: tenant.RoleApprover, required: tenant.RoleEditor, expected: true},
+		{role: "", required: tenant.RoleViewer, expected: false},
+		{role: "owner", required: tenant.RoleViewer, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+" "+string(tt.required), func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.role.Allows(tt.required))
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package tenant
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=querier.go -destination=mock/querier.go
+
+type Querier interface {
+	GetItem(ctx context.Context, id string) (*Tenant, error)
+	GetMember(ctx context.Context, tenantID, userID string) (*Member, error)
+	// GetMembers returns the members of the tenant with the role, the oldest first
+	GetMembers(ctx context.Context, tenantID string, role Role) ([]*Member, error)
+}
@@ -0,0 +1,24 @@
+package tenant
+
+import "context"
+
+type scopeCtxKey struct{}
+
+// Scope is the tenant a request acts in with the role of its user there
+type Scope struct {
+	TenantID string
+	UserID   string
+	Role     Role
+}
+
+func CtxWithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeCtxKey{}, scope)
+}
+
+// ScopeFromContext returns the scope stored in ctx, requests of the users acting for
+// themselves and the system ones have none
+func ScopeFromContext(ctx context.Context) (Scope, bool) {
+	scope, ok := ctx.Value(scopeCtxKey{}).(Scope)
+
+	return scope, ok
+}
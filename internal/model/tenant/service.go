@@ -0,0 +1,18 @@
+package tenant
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go
+
+// Service manages the tenants and their members
+type Service interface {
+	Create(ctx context.Context, name string) (*Tenant, error)
+	SetMember(ctx context.Context, tenantID, userID string, role Role) (*Member, error)
+	RemoveMember(ctx context.Context, tenantID, userID string) error
+
+	// Resolve returns the scope of the user in the tenant, model.ErrPermissionDenied
+	// when the user is not a member
+	Resolve(ctx context.Context, tenantID, userID string) (*Scope, error)
+}
@@ -2,7 +2,10 @@ package user
 
 import "github.com/krivenkov/pkg/busapi/topics"
 
-const UpdateUserTopic topics.Topic = "user.update.user.1"
+const (
+	UpdateUserTopic topics.Topic = "user.update.user.1"
+	DeleteUserTopic topics.Topic = "user.delete.user.1"
+)
 
 type User struct {
 	ID      string
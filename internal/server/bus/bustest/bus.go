@@ -0,0 +1,158 @@
+// Package bustest contains in-memory bus transport for tests
+package bustest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/busapi/topics"
+)
+
+type record struct {
+	key   string
+	value []byte
+}
+
+// Bus keeps published records in memory, records are encoded the same way as in franz transport
+type Bus struct {
+	mx      sync.Mutex
+	records map[topics.Topic][]record
+	changed chan struct{}
+}
+
+func New() *Bus {
+	return &Bus{
+		records: make(map[topics.Topic][]record),
+		changed: make(chan struct{}),
+	}
+}
+
+func (b *Bus) append(topic topics.Topic, records ...record) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	b.records[topic] = append(b.records[topic], records...)
+
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+func (b *Bus) read(topic topics.Topic, offset int) ([]record, <-chan struct{}) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if offset >= len(b.records[topic]) {
+		return nil, b.changed
+	}
+
+	return b.records[topic][offset:], b.changed
+}
+
+// Messages decodes all records published to the topic
+func Messages[T any](b *Bus, topic topics.Topic) ([]bus.Message[T], error) {
+	records, _ := b.read(topic, 0)
+
+	res := make([]bus.Message[T], 0, len(records))
+	for _, r := range records {
+		var value bus.MessageValue[T]
+		if err := json.Unmarshal(r.value, &value); err != nil {
+			return nil, fmt.Errorf("unmarshal record: %w", err)
+		}
+
+		res = append(res, bus.Message[T]{Key: r.key, Value: value})
+	}
+
+	return res, nil
+}
+
+type publisher[T any] struct {
+	b     *Bus
+	topic topics.Topic
+}
+
+func NewPublisher[T any](b *Bus, topic topics.Topic) bus.ClientPublisher[T] {
+	return &publisher[T]{b: b, topic: topic}
+}
+
+func (p *publisher[T]) Publish(_ context.Context, messages ...bus.Message[T]) error {
+	records := make([]record, 0, len(messages))
+	for _, message := range messages {
+		data, err := json.Marshal(message.Value)
+		if err != nil {
+			return fmt.Errorf("value marshal to json: %w", err)
+		}
+
+		records = append(records, record{key: message.Key, value: data})
+	}
+
+	p.b.append(p.topic, records...)
+
+	return nil
+}
+
+func (p *publisher[T]) Close(_ context.Context) error {
+	return nil
+}
+
+type consumer[T any] struct {
+	b      *Bus
+	topic  topics.Topic
+	sub    bus.Subscriber[T]
+	offset int
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewConsumer reads the topic from the beginning, every record is delivered once
+func NewConsumer[T any](b *Bus, topic topics.Topic, sub bus.Subscriber[T]) bus.ClientConsumer {
+	return &consumer[T]{
+		b:      b,
+		topic:  topic,
+		sub:    sub,
+		closed: make(chan struct{}),
+	}
+}
+
+func (c *consumer[T]) Consume(ctx context.Context) error {
+	for {
+		records, changed := c.b.read(c.topic, c.offset)
+
+		for _, r := range records {
+			select {
+			case <-c.closed:
+				return bus.ErrClientClosed
+			default:
+			}
+
+			var payload T
+
+			value := bus.MessageValue[T]{Payload: &payload}
+			if err := json.Unmarshal(r.value, &value); err != nil {
+				return fmt.Errorf("unmarshal record: %w", err)
+			}
+
+			c.sub.Handle(ctx, bus.Message[T]{Key: r.key, Value: value})
+			c.offset++
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.closed:
+			return bus.ErrClientClosed
+		case <-changed:
+		}
+	}
+}
+
+func (c *consumer[T]) Close(_ context.Context) error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+
+	return nil
+}
@@ -1,5 +1,9 @@
 package bus
 
+import "github.com/krivenkov/order/internal/server/bus/retry"
+
 type Config struct {
 	WorkerID string `json:"worker_id" yaml:"worker_id" env:"WORKER_ID"`
+
+	Retry retry.Config `json:"retry" yaml:"retry" envPrefix:"RETRY_"`
 }
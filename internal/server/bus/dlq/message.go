@@ -0,0 +1,26 @@
+package dlq
+
+import (
+	"time"
+
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/busapi/topics"
+)
+
+const topicSuffix = ".dlq"
+
+// Topic returns the dead-letter topic of the source topic
+func Topic(topic topics.Topic) topics.Topic {
+	return topic + topicSuffix
+}
+
+// Message is a message which could not be handled after all attempts
+type Message[T any] struct {
+	Topic    topics.Topic
+	Key      string
+	Error    string
+	Attempts int
+	FailedAt time.Time
+
+	Value bus.MessageValue[T]
+}
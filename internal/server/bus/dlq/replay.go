@@ -0,0 +1,137 @@
+package dlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/krivenkov/pkg/bus"
+)
+
+var errLimitReached = errors.New("replay limit reached")
+
+type ReplayConfig struct {
+	// Limit is a max number of replayed messages, 0 means all
+	Limit int
+	// Idle stops replay when the dead-letter topic has no new messages for this long
+	Idle time.Duration
+}
+
+type replayer[T any] struct {
+	limit int
+	pub   bus.Publisher[T]
+
+	mx       sync.Mutex
+	replayed int
+	activity chan struct{}
+}
+
+func (r *replayer[T]) Handle(ctx context.Context, message bus.Message[Message[T]]) *bus.HandleResult {
+	defer func() {
+		select {
+		case r.activity <- struct{}{}:
+		default:
+		}
+	}()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	// leave the rest uncommitted, they are replayed next time
+	if r.limit > 0 && r.replayed >= r.limit {
+		return &bus.HandleResult{
+			Code: bus.StatusError,
+			Err:  errLimitReached,
+		}
+	}
+
+	dl := message.Value.Payload
+	if dl == nil {
+		return &bus.HandleResult{
+			Err:  fmt.Errorf("empty record"),
+			Code: bus.StatusWarning,
+		}
+	}
+
+	if err := r.pub.Publish(ctx, bus.Message[T]{
+		Key:   dl.Key,
+		Value: dl.Value,
+	}); err != nil {
+		return &bus.HandleResult{
+			Code: bus.StatusError,
+			Err:  fmt.Errorf("republish: %w", err),
+		}
+	}
+
+	r.replayed++
+
+	return &bus.HandleResult{
+		Code: bus.StatusOk,
+	}
+}
+
+func (r *replayer[T]) done() (int, bool) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	return r.replayed, r.limit > 0 && r.replayed >= r.limit
+}
+
+// Replay consumes the dead-letter topic and publishes original messages with pub
+// until the topic is idle or the limit is reached. It returns the number of replayed messages.
+func Replay[T any](
+	ctx context.Context,
+	cfg ReplayConfig,
+	newConsumer func(sub bus.Subscriber[Message[T]]) (bus.ClientConsumer, error),
+	pub bus.Publisher[T],
+) (int, error) {
+	r := &replayer[T]{
+		limit:    cfg.Limit,
+		pub:      pub,
+		activity: make(chan struct{}, 1),
+	}
+
+	consumer, err := newConsumer(r)
+	if err != nil {
+		return 0, fmt.Errorf("create consumer: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	consumed := make(chan error, 1)
+	go func() {
+		consumed <- consumer.Consume(ctx)
+	}()
+
+	idle := time.NewTimer(cfg.Idle)
+	defer idle.Stop()
+
+	for {
+		select {
+		case <-r.activity:
+			if replayed, done := r.done(); done {
+				return replayed, consumer.Close(ctx)
+			}
+
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(cfg.Idle)
+		case <-idle.C:
+			replayed, _ := r.done()
+			return replayed, consumer.Close(ctx)
+		case err = <-consumed:
+			replayed, _ := r.done()
+			if errors.Is(err, bus.ErrClientClosed) {
+				return replayed, nil
+			}
+			return replayed, err
+		case <-ctx.Done():
+			replayed, _ := r.done()
+			return replayed, errors.Join(ctx.Err(), consumer.Close(context.Background()))
+		}
+	}
+}
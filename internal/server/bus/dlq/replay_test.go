@@ -0,0 +1,146 @@
+package dlq_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ledgerMock "github.com/krivenkov/order/internal/model/ledger/mock"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/user"
+	"github.com/krivenkov/order/internal/server/bus/bustest"
+	"github.com/krivenkov/order/internal/server/bus/dlq"
+	"github.com/krivenkov/order/internal/server/bus/retry"
+	"github.com/krivenkov/order/internal/server/bus/user_handler"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/busapi/topics"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const topic topics.Topic = "user.update.user.1"
+
+func TestReplay(t *testing.T) {
+	t.Run("Failed message is dead-lettered and replayed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			b    = bustest.New()
+			svc  = orderMock.NewMockService(ctrl)
+			ldg  = ledgerMock.NewMockCommander(ctrl)
+			tXer = txerMock.NewMockTXer(ctrl)
+
+			cfg = retry.Config{
+				MaxAttempts:   2,
+				BackoffMin:    time.Millisecond,
+				BackoffMax:    time.Millisecond,
+				BackoffFactor: 2,
+			}
+
+			handled atomic.Int32
+		)
+
+		tXer.EXPECT().WithTX(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).AnyTimes()
+
+		ldg.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		gomock.InOrder(
+			svc.EXPECT().Disable(gomock.Any(), "user_id").Return(errors.New("some error")).Times(2),
+			svc.EXPECT().Disable(gomock.Any(), "user_id").DoAndReturn(func(_ context.Context, _ string) error {
+				handled.Add(1)
+				return nil
+			}),
+		)
+
+		sub := retry.New[user.User](cfg, topic, user_handler.New(user_handler.Params{
+			Service: svc,
+			Ledger:  ldg,
+			TXer:    tXer,
+			Now:     now,
+		}), bustest.NewPublisher[dlq.Message[user.User]](b, dlq.Topic(topic)), now)
+		consumer := bustest.NewConsumer[user.User](b, topic, sub)
+
+		go func() {
+			_ = consumer.Consume(context.Background())
+		}()
+		defer consumer.Close(context.Background())
+
+		err := bustest.NewPublisher[user.User](b, topic).Publish(context.TODO(), bus.Message[user.User]{
+			Key: "user_id",
+			Value: bus.MessageValue[user.User]{
+				Payload: &user.User{ID: "user_id", Disable: true},
+			},
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			dead, _ := bustest.Messages[dlq.Message[user.User]](b, dlq.Topic(topic))
+			return len(dead) == 1
+		}, time.Second, time.Millisecond)
+
+		replayed, err := dlq.Replay[user.User](context.TODO(), dlq.ReplayConfig{Idle: 50 * time.Millisecond},
+			func(sub bus.Subscriber[dlq.Message[user.User]]) (bus.ClientConsumer, error) {
+				return bustest.NewConsumer[dlq.Message[user.User]](b, dlq.Topic(topic), sub), nil
+			},
+			bustest.NewPublisher[user.User](b, topic),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 1, replayed)
+
+		require.Eventually(t, func() bool {
+			return handled.Load() == 1
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		b := bustest.New()
+
+		dead := make([]bus.Message[dlq.Message[user.User]], 0, 3)
+		for _, id := range []string{"1", "2", "3"} {
+			dead = append(dead, bus.Message[dlq.Message[user.User]]{
+				Key: id,
+				Value: bus.MessageValue[dlq.Message[user.User]]{
+					Payload: &dlq.Message[user.User]{
+						Topic:    topic,
+						Key:      id,
+						Error:    "some error",
+						Attempts: 5,
+						FailedAt: now(),
+						Value: bus.MessageValue[user.User]{
+							Payload: &user.User{ID: id},
+						},
+					},
+				},
+			})
+		}
+
+		err := bustest.NewPublisher[dlq.Message[user.User]](b, dlq.Topic(topic)).Publish(context.TODO(), dead...)
+		require.NoError(t, err)
+
+		replayed, err := dlq.Replay[user.User](context.TODO(), dlq.ReplayConfig{Limit: 2, Idle: time.Second},
+			func(sub bus.Subscriber[dlq.Message[user.User]]) (bus.ClientConsumer, error) {
+				return bustest.NewConsumer[dlq.Message[user.User]](b, dlq.Topic(topic), sub), nil
+			},
+			bustest.NewPublisher[user.User](b, topic),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, replayed)
+
+		messages, err := bustest.Messages[user.User](b, topic)
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+		require.Equal(t, "1", messages[0].Key)
+		require.Equal(t, "1", messages[0].Value.Payload.ID)
+		require.Equal(t, "2", messages[1].Key)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
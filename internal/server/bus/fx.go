@@ -1,7 +1,13 @@
 package bus
 
 import (
+	"github.com/krivenkov/order/internal/model/approval"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/order/internal/model/erasure"
+	"github.com/krivenkov/order/internal/model/refund"
 	"github.com/krivenkov/order/internal/model/user"
+	"github.com/krivenkov/order/internal/server/bus/payment_handler"
+	"github.com/krivenkov/order/internal/server/bus/user_delete_handler"
 	"github.com/krivenkov/order/internal/server/bus/user_handler"
 	busBuilder "github.com/krivenkov/pkg/bus/builder"
 	"go.uber.org/fx"
@@ -11,10 +17,16 @@ var FXModule = fx.Options(
 	fx.Provide(
 		newRouter,
 		user_handler.New,
+		user_delete_handler.New,
+		payment_handler.New,
 	),
 
 	fx.Provide(
 		fx.Annotate(busBuilder.NewFXPublisher[user.User](user.UpdateUserTopic), fx.ResultTags(`name:"user_bus_update"`)),
+		fx.Annotate(busBuilder.NewFXPublisher[erasure.Erasure](erasure.ErasedTopic), fx.ResultTags(`name:"erasure_bus_erased"`)),
+		fx.Annotate(busBuilder.NewFXPublisher[refund.Refund](refund.RefundedTopic), fx.ResultTags(`name:"refund_bus_refunded"`)),
+		fx.Annotate(busBuilder.NewFXPublisher[approval.Notification](approval.NotifiedTopic), fx.ResultTags(`name:"approval_bus_notified"`)),
+		fx.Annotate(busBuilder.NewFXPublisher[comment.Comment](comment.AddedTopic), fx.ResultTags(`name:"comment_bus_added"`)),
 	),
 
 	fx.Invoke(registerRoutes),
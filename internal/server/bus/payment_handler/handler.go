@@ -0,0 +1,59 @@
+package payment_handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/mlog"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service order.Service
+}
+
+func New(service order.Service) *Handler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (p *Handler) Handle(ctx context.Context, record bus.Message[payment.Result]) *bus.HandleResult {
+	if record.Value.Payload == nil {
+		return &bus.HandleResult{
+			Err:  fmt.Errorf("empty record"),
+			Code: bus.StatusWarning,
+		}
+	}
+
+	_, err := p.service.ApplyPaymentResult(ctx, record.Value.Payload)
+
+	switch {
+	case errors.Is(err, payment.ErrDuplicate):
+		mlog.FromContext(ctx).Info("skip payment result",
+			zap.String("orderID", record.Value.Payload.OrderID),
+			zap.String("externalRef", record.Value.Payload.ExternalRef),
+			zap.Error(err),
+		)
+	// retries would not help
+	case errors.Is(err, model.ErrInvalidArgument), errors.Is(err, model.ErrNotFound):
+		return &bus.HandleResult{
+			Code: bus.StatusWarning,
+			Err:  err,
+		}
+	case err != nil:
+		return &bus.HandleResult{
+			Code: bus.StatusError,
+			Err:  err,
+		}
+	}
+
+	return &bus.HandleResult{
+		Code: bus.StatusOk,
+	}
+}
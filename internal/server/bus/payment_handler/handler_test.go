@@ -0,0 +1,77 @@
+package payment_handler_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/order/internal/server/bus/payment_handler"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		svc = orderMock.NewMockService(ctrl)
+
+		result = &payment.Result{
+			OrderID:     uuid.New().String(),
+			Provider:    "stripe",
+			ExternalRef: "pi_1",
+			Amount:      1999,
+			Currency:    "EUR",
+			Succeeded:   true,
+		}
+
+		msg = bus.Message[payment.Result]{
+			Value: bus.MessageValue[payment.Result]{
+				Payload: result,
+			},
+		}
+
+		someErr = fmt.Errorf("some error")
+	)
+
+	handler := payment_handler.New(svc)
+
+	t.Run("NoPayload", func(t *testing.T) {
+		res := handler.Handle(context.TODO(), bus.Message[payment.Result]{})
+		require.Equal(t, bus.StatusWarning, res.Code)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		svc.EXPECT().ApplyPaymentResult(context.TODO(), result).Return(&payment.Payment{}, nil)
+
+		res := handler.Handle(context.TODO(), msg)
+		require.Equal(t, bus.StatusOk, res.Code)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		svc.EXPECT().ApplyPaymentResult(context.TODO(), result).Return(nil, fmt.Errorf("payment create: %w", payment.ErrDuplicate))
+
+		res := handler.Handle(context.TODO(), msg)
+		require.Equal(t, bus.StatusOk, res.Code)
+	})
+
+	t.Run("Unknown order", func(t *testing.T) {
+		svc.EXPECT().ApplyPaymentResult(context.TODO(), result).Return(nil, fmt.Errorf("get item: %w", model.ErrNotFound))
+
+		res := handler.Handle(context.TODO(), msg)
+		require.Equal(t, bus.StatusWarning, res.Code)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		svc.EXPECT().ApplyPaymentResult(context.TODO(), result).Return(nil, someErr)
+
+		res := handler.Handle(context.TODO(), msg)
+		require.Equal(t, bus.StatusError, res.Code)
+	})
+}
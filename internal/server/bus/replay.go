@@ -0,0 +1,42 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/order/internal/model/user"
+	"github.com/krivenkov/order/internal/server/bus/dlq"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/bus/builder"
+	"github.com/krivenkov/pkg/busapi/topics"
+	"go.uber.org/zap"
+)
+
+const replayGroupSuffix = ".dlq-replay"
+
+// ReplayDLQ re-injects dead-lettered messages of the topic back to it
+func ReplayDLQ(ctx context.Context, logger *zap.Logger, cfg Config, busCfg builder.Config, topic topics.Topic, rc dlq.ReplayConfig) (int, error) {
+	switch topic {
+	case user.UpdateUserTopic, user.DeleteUserTopic:
+		return replay[user.User](ctx, logger, cfg, busCfg, topic, rc)
+	case payment.ResultTopic:
+		return replay[payment.Result](ctx, logger, cfg, busCfg, topic, rc)
+	}
+
+	return 0, fmt.Errorf("topic '%s' is not consumed by the service", topic)
+}
+
+func replay[T any](ctx context.Context, logger *zap.Logger, cfg Config, busCfg builder.Config, topic topics.Topic, rc dlq.ReplayConfig) (int, error) {
+	pub, err := builder.NewPublisher[T](busCfg, logger, topic)
+	if err != nil {
+		return 0, fmt.Errorf("create publisher: %w", err)
+	}
+	defer func() {
+		_ = pub.Close(ctx)
+	}()
+
+	return dlq.Replay[T](ctx, rc, func(sub bus.Subscriber[dlq.Message[T]]) (bus.ClientConsumer, error) {
+		return builder.NewConsumer[dlq.Message[T]](busCfg, logger, dlq.Topic(topic), cfg.WorkerID+replayGroupSuffix, sub)
+	}, pub)
+}
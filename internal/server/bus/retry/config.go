@@ -0,0 +1,25 @@
+package retry
+
+import "time"
+
+type Config struct {
+	// MaxAttempts is a number of handle attempts before message goes to the dead-letter topic
+	MaxAttempts int `json:"max_attempts" yaml:"max_attempts" env:"MAX_ATTEMPTS" default:"5"`
+
+	BackoffMin    time.Duration `json:"backoff_min" yaml:"backoff_min" env:"BACKOFF_MIN" default:"100ms"`
+	BackoffMax    time.Duration `json:"backoff_max" yaml:"backoff_max" env:"BACKOFF_MAX" default:"10s"`
+	BackoffFactor float64       `json:"backoff_factor" yaml:"backoff_factor" env:"BACKOFF_FACTOR" default:"2"`
+}
+
+// Backoff returns a delay before the next attempt, attempt starts from 1
+func (c Config) Backoff(attempt int) time.Duration {
+	d := float64(c.BackoffMin)
+	for i := 1; i < attempt; i++ {
+		d *= c.BackoffFactor
+		if d >= float64(c.BackoffMax) {
+			return c.BackoffMax
+		}
+	}
+
+	return time.Duration(d)
+}
@@ -0,0 +1,103 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/server/bus/dlq"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/busapi/topics"
+	"github.com/krivenkov/pkg/mlog"
+	"go.uber.org/zap"
+)
+
+type subscriber[T any] struct {
+	cfg   Config
+	topic topics.Topic
+	sub   bus.Subscriber[T]
+	dlq   bus.Publisher[dlq.Message[T]]
+	now   func() time.Time
+}
+
+// New wraps sub with retries, message is sent to the dead-letter topic when attempts are exhausted
+func New[T any](cfg Config, topic topics.Topic, sub bus.Subscriber[T], dlqPub bus.Publisher[dlq.Message[T]], now func() time.Time) bus.Subscriber[T] {
+	return &subscriber[T]{
+		cfg:   cfg,
+		topic: topic,
+		sub:   sub,
+		dlq:   dlqPub,
+		now:   now,
+	}
+}
+
+func (s *subscriber[T]) Handle(ctx context.Context, message bus.Message[T]) *bus.HandleResult {
+	logger := mlog.FromContext(ctx)
+
+	var res *bus.HandleResult
+
+	for attempt := 1; ; attempt++ {
+		res = s.sub.Handle(ctx, message)
+		if res.Code != bus.StatusError {
+			return res
+		}
+
+		if attempt >= s.cfg.MaxAttempts {
+			return s.deadLetter(ctx, message, res.Err, attempt)
+		}
+
+		backoff := s.cfg.Backoff(attempt)
+
+		logger.Warn("handle record failed, retry",
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff),
+			zap.Error(res.Err),
+		)
+
+		timer := time.NewTimer(backoff)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return &bus.HandleResult{
+				Code: bus.StatusError,
+				Err:  fmt.Errorf("retry interrupted: %w", ctx.Err()),
+			}
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *subscriber[T]) deadLetter(ctx context.Context, message bus.Message[T], err error, attempts int) *bus.HandleResult {
+	var errText string
+	if err != nil {
+		errText = err.Error()
+	}
+
+	if errPub := s.dlq.Publish(ctx, bus.Message[dlq.Message[T]]{
+		Key: message.Key,
+		Value: bus.MessageValue[dlq.Message[T]]{
+			CommandID: message.Value.CommandID,
+			UserID:    message.Value.UserID,
+			CreatedAt: s.now(),
+			Payload: &dlq.Message[T]{
+				Topic:    s.topic,
+				Key:      message.Key,
+				Error:    errText,
+				Attempts: attempts,
+				FailedAt: s.now(),
+				Value:    message.Value,
+			},
+		},
+	}); errPub != nil {
+		return &bus.HandleResult{
+			Code: bus.StatusError,
+			Err:  fmt.Errorf("publish to dead-letter topic: %w (handle error: %v)", errPub, err),
+		}
+	}
+
+	return &bus.HandleResult{
+		Code: bus.StatusWarning,
+		Err:  fmt.Errorf("sent to dead-letter topic after %d attempts: %w", attempts, err),
+	}
+}
@@ -0,0 +1,152 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/krivenkov/order/internal/model/user"
+	"github.com/krivenkov/order/internal/server/bus/bustest"
+	"github.com/krivenkov/order/internal/server/bus/dlq"
+	"github.com/krivenkov/order/internal/server/bus/retry"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/stretchr/testify/require"
+)
+
+const topic = "user.update.user.1"
+
+type subscriberStub struct {
+	results []*bus.HandleResult
+	calls   int
+}
+
+func (s *subscriberStub) Handle(_ context.Context, _ bus.Message[user.User]) *bus.HandleResult {
+	res := s.results[s.calls]
+	s.calls++
+
+	return res
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := retry.Config{
+		BackoffMin:    100 * time.Millisecond,
+		BackoffMax:    time.Second,
+		BackoffFactor: 2,
+	}
+
+	require.Equal(t, 100*time.Millisecond, cfg.Backoff(1))
+	require.Equal(t, 200*time.Millisecond, cfg.Backoff(2))
+	require.Equal(t, 800*time.Millisecond, cfg.Backoff(4))
+	require.Equal(t, time.Second, cfg.Backoff(5))
+	require.Equal(t, time.Second, cfg.Backoff(50))
+}
+
+func TestSubscriber(t *testing.T) {
+	var (
+		cfg = retry.Config{
+			MaxAttempts:   3,
+			BackoffMin:    time.Millisecond,
+			BackoffMax:    5 * time.Millisecond,
+			BackoffFactor: 2,
+		}
+
+		someErr = errors.New("some error")
+
+		msg = bus.Message[user.User]{
+			Key: "user_id",
+			Value: bus.MessageValue[user.User]{
+				CommandID: "command_id",
+				Payload:   &user.User{ID: "user_id", Disable: true},
+			},
+		}
+	)
+
+	t.Run("Success after retry", func(t *testing.T) {
+		b := bustest.New()
+		stub := &subscriberStub{results: []*bus.HandleResult{
+			{Code: bus.StatusError, Err: someErr},
+			{Code: bus.StatusOk},
+		}}
+
+		sub := retry.New[user.User](cfg, topic, stub, bustest.NewPublisher[dlq.Message[user.User]](b, dlq.Topic(topic)), now)
+
+		res := sub.Handle(context.TODO(), msg)
+
+		require.Equal(t, bus.StatusOk, res.Code)
+		require.Equal(t, 2, stub.calls)
+
+		dead, err := bustest.Messages[dlq.Message[user.User]](b, dlq.Topic(topic))
+		require.NoError(t, err)
+		require.Empty(t, dead)
+	})
+
+	t.Run("Warning is not retried", func(t *testing.T) {
+		b := bustest.New()
+		stub := &subscriberStub{results: []*bus.HandleResult{
+			{Code: bus.StatusWarning, Err: someErr},
+		}}
+
+		sub := retry.New[user.User](cfg, topic, stub, bustest.NewPublisher[dlq.Message[user.User]](b, dlq.Topic(topic)), now)
+
+		res := sub.Handle(context.TODO(), msg)
+
+		require.Equal(t, bus.StatusWarning, res.Code)
+		require.Equal(t, 1, stub.calls)
+	})
+
+	t.Run("Dead letter", func(t *testing.T) {
+		b := bustest.New()
+		stub := &subscriberStub{results: []*bus.HandleResult{
+			{Code: bus.StatusError, Err: someErr},
+			{Code: bus.StatusError, Err: someErr},
+			{Code: bus.StatusError, Err: someErr},
+		}}
+
+		sub := retry.New[user.User](cfg, topic, stub, bustest.NewPublisher[dlq.Message[user.User]](b, dlq.Topic(topic)), now)
+
+		res := sub.Handle(context.TODO(), msg)
+
+		require.Equal(t, bus.StatusWarning, res.Code)
+		require.ErrorIs(t, res.Err, someErr)
+		require.Equal(t, 3, stub.calls)
+
+		dead, err := bustest.Messages[dlq.Message[user.User]](b, "user.update.user.1.dlq")
+		require.NoError(t, err)
+		require.Len(t, dead, 1)
+		require.Equal(t, "user_id", dead[0].Key)
+		require.Equal(t, &dlq.Message[user.User]{
+			Topic:    topic,
+			Key:      "user_id",
+			Error:    "some error",
+			Attempts: 3,
+			FailedAt: now(),
+			Value:    msg.Value,
+		}, dead[0].Value.Payload)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		b := bustest.New()
+		stub := &subscriberStub{results: []*bus.HandleResult{
+			{Code: bus.StatusError, Err: someErr},
+		}}
+
+		slow := cfg
+		slow.BackoffMin = time.Hour
+		slow.BackoffMax = time.Hour
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		sub := retry.New[user.User](slow, topic, stub, bustest.NewPublisher[dlq.Message[user.User]](b, dlq.Topic(topic)), now)
+
+		res := sub.Handle(ctx, msg)
+
+		require.Equal(t, bus.StatusError, res.Code)
+		require.ErrorIs(t, res.Err, context.Canceled)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -2,62 +2,120 @@ package bus
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/payment"
 	"github.com/krivenkov/order/internal/model/user"
+	"github.com/krivenkov/order/internal/server/bus/dlq"
+	"github.com/krivenkov/order/internal/server/bus/payment_handler"
+	"github.com/krivenkov/order/internal/server/bus/retry"
+	"github.com/krivenkov/order/internal/server/bus/user_delete_handler"
 	"github.com/krivenkov/order/internal/server/bus/user_handler"
 	"github.com/krivenkov/pkg/bus"
 	"github.com/krivenkov/pkg/bus/builder"
+	"github.com/krivenkov/pkg/busapi/topics"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
 
+type closer interface {
+	Close(ctx context.Context) error
+}
+
 type router struct {
 	cfg    Config
 	busCfg builder.Config
 	cg     *bus.ConsumerGroup
+	now    func() time.Time
+
+	// dead-letter publishers
+	publishers []closer
 }
 
-func newRouter(cfg Config, busCfg builder.Config, lc fx.Lifecycle) *router {
-	cg := bus.NewConsumerGroup()
+func newRouter(cfg Config, busCfg builder.Config, now func() time.Time, lc fx.Lifecycle) *router {
+	r := &router{
+		cfg:    cfg,
+		busCfg: busCfg,
+		cg:     bus.NewConsumerGroup(),
+		now:    now,
+	}
 
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			go func() {
-				_ = cg.Consume(context.Background())
+				_ = r.cg.Consume(context.Background())
 			}()
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
-			return cg.Close(ctx)
+			errs := []error{r.cg.Close(ctx)}
+			for _, p := range r.publishers {
+				errs = append(errs, p.Close(ctx))
+			}
+
+			return errors.Join(errs...)
 		},
 	})
 
-	return &router{
-		cfg:    cfg,
-		busCfg: busCfg,
-		cg:     cg,
-	}
+	return r
 }
 
 func registerRoutes(
 	logger *zap.Logger,
 	r *router,
 	updateUserHandler *user_handler.Handler,
+	deleteUserHandler *user_delete_handler.Handler,
+	paymentResultHandler *payment_handler.Handler,
 ) error {
-	var (
-		consumer bus.ClientConsumer
-		err      error
-	)
+	if err := route[user.User](r, logger, user.UpdateUserTopic, updateUserHandler); err != nil {
+		return fmt.Errorf("create consumer UpdateUser: %w", err)
+	}
 
-	consumer, err = builder.NewConsumer[user.User](
-		r.busCfg, logger, user.UpdateUserTopic, r.cfg.WorkerID, updateUserHandler,
+	if err := route[user.User](r, logger, user.DeleteUserTopic, deleteUserHandler); err != nil {
+		return fmt.Errorf("create consumer DeleteUser: %w", err)
+	}
+
+	if err := route[payment.Result](r, logger, payment.ResultTopic, paymentResultHandler); err != nil {
+		return fmt.Errorf("create consumer PaymentResult: %w", err)
+	}
+
+	return nil
+}
+
+// route subscribes sub to the topic with retries and the dead-letter topic
+func route[T any](r *router, logger *zap.Logger, topic topics.Topic, sub bus.Subscriber[T]) error {
+	dlqPub, err := builder.NewPublisher[dlq.Message[T]](r.busCfg, logger, dlq.Topic(topic))
+	if err != nil {
+		return fmt.Errorf("create dead-letter publisher: %w", err)
+	}
+
+	r.publishers = append(r.publishers, dlqPub)
+
+	consumer, err := builder.NewConsumer[T](
+		r.busCfg, logger, topic, r.cfg.WorkerID, retry.New[T](r.cfg.Retry, topic, &origin[T]{sub: sub}, dlqPub, r.now),
 	)
 	if err != nil {
-		return fmt.Errorf("create consumer PublishAuthor: %w", err)
+		return err
 	}
 
 	r.cg.Add(consumer)
 
 	return nil
 }
+
+// origin marks changes made by bus handlers in the order history, the actor is the user who issued the command
+type origin[T any] struct {
+	sub bus.Subscriber[T]
+}
+
+func (o *origin[T]) Handle(ctx context.Context, message bus.Message[T]) *bus.HandleResult {
+	ctx = history.CtxWithOrigin(ctx, history.Origin{
+		Actor:  message.Value.UserID,
+		Source: history.SourceBus,
+	})
+
+	return o.sub.Handle(ctx, message)
+}
@@ -0,0 +1,67 @@
+package user_delete_handler_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model/erasure"
+	"github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/user"
+	"github.com/krivenkov/order/internal/server/bus/user_delete_handler"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		svc = orderMock.NewMockService(ctrl)
+
+		userID    = uuid.New().String()
+		initiator = uuid.New().String()
+
+		req = &order.EraseRequest{
+			UserID:      userID,
+			Source:      erasure.SourceBus,
+			RequestedBy: initiator,
+		}
+
+		msg = bus.Message[user.User]{
+			Value: bus.MessageValue[user.User]{
+				UserID: initiator,
+				Payload: &user.User{
+					ID: userID,
+				},
+			},
+		}
+
+		someErr = fmt.Errorf("some error")
+	)
+
+	handler := user_delete_handler.New(svc)
+
+	t.Run("NoPayload", func(t *testing.T) {
+		res := handler.Handle(context.TODO(), bus.Message[user.User]{})
+		require.Equal(t, bus.StatusWarning, res.Code)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		svc.EXPECT().Erase(context.TODO(), req).Return(&erasure.Erasure{UserID: userID}, nil)
+
+		res := handler.Handle(context.TODO(), msg)
+		require.Equal(t, bus.StatusOk, res.Code)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		svc.EXPECT().Erase(context.TODO(), req).Return(nil, someErr)
+
+		res := handler.Handle(context.TODO(), msg)
+		require.Equal(t, bus.StatusError, res.Code)
+	})
+}
@@ -2,20 +2,42 @@ package user_handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/krivenkov/order/internal/model/ledger"
 	"github.com/krivenkov/order/internal/model/order"
 	"github.com/krivenkov/order/internal/model/user"
 	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/txer"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
 type Handler struct {
 	service order.Service
+	ledger  ledger.Commander
+	tXer    txer.TXer
+	now     func() time.Time
 }
 
-func New(service order.Service) *Handler {
+type Params struct {
+	fx.In
+
+	Service order.Service
+	Ledger  ledger.Commander `name:"ledger_pg_cmd"`
+	TXer    txer.TXer
+	Now     func() time.Time
+}
+
+func New(params Params) *Handler {
 	return &Handler{
-		service: service,
+		service: params.Service,
+		ledger:  params.Ledger,
+		tXer:    params.TXer,
+		now:     params.Now,
 	}
 }
 
@@ -27,13 +49,36 @@ func (p *Handler) Handle(ctx context.Context, record bus.Message[user.User]) *bu
 		}
 	}
 
+	toggle := p.service.Disable
 	if !record.Value.Payload.Disable {
-		return &bus.HandleResult{
-			Code: bus.StatusOk,
-		}
+		toggle = p.service.Enable
 	}
 
-	if err := p.service.Disable(ctx, record.Value.Payload.ID); err != nil {
+	entry := &ledger.Entry{
+		Topic:       user.UpdateUserTopic,
+		MessageID:   record.Value.CommandID,
+		Key:         record.Key,
+		UserID:      record.Value.Payload.ID,
+		Version:     record.Value.CreatedAt,
+		TSProcessed: p.now(),
+	}
+
+	err := p.tXer.WithTX(ctx, func(ctx context.Context) error {
+		if err := p.ledger.Register(ctx, entry); err != nil {
+			return fmt.Errorf("register message: %w", err)
+		}
+
+		return toggle(ctx, record.Value.Payload.ID)
+	})
+
+	switch {
+	case errors.Is(err, ledger.ErrDuplicate), errors.Is(err, ledger.ErrOutdated):
+		mlog.FromContext(ctx).Info("skip user event",
+			zap.String("commandID", entry.MessageID),
+			zap.String("userID", entry.UserID),
+			zap.Error(err),
+		)
+	case err != nil:
 		return &bus.HandleResult{
 			Code: bus.StatusError,
 			Err:  err,
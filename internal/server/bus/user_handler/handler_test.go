@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model/ledger"
+	ledgerMock "github.com/krivenkov/order/internal/model/ledger/mock"
 	orderMock "github.com/krivenkov/order/internal/model/order/mock"
 	"github.com/krivenkov/order/internal/model/user"
 	"github.com/krivenkov/order/internal/server/bus/user_handler"
 	"github.com/krivenkov/pkg/bus"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,57 +23,110 @@ func TestHandler(t *testing.T) {
 	defer ctrl.Finish()
 
 	var (
-		svc = orderMock.NewMockService(ctrl)
+		svc  = orderMock.NewMockService(ctrl)
+		ldg  = ledgerMock.NewMockCommander(ctrl)
+		tXer = txerMock.NewMockTXer(ctrl)
 
-		userID = uuid.New().String()
+		userID    = uuid.New().String()
+		commandID = uuid.New().String()
+		createdAt = now().Add(-time.Minute)
 
 		someErr = fmt.Errorf("some error")
 	)
 
-	handler := user_handler.New(svc)
+	tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+		return cb(ctx)
+	}).AnyTimes()
 
-	t.Run("NoPayload", func(t *testing.T) {
-		res := handler.Handle(context.TODO(), bus.Message[user.User]{})
-		require.Equal(t, bus.StatusWarning, res.Code)
+	handler := user_handler.New(user_handler.Params{
+		Service: svc,
+		Ledger:  ldg,
+		TXer:    tXer,
+		Now:     now,
 	})
 
-	t.Run("ActiveUser", func(t *testing.T) {
-		res := handler.Handle(context.TODO(), bus.Message[user.User]{
+	message := func(disable bool) bus.Message[user.User] {
+		return bus.Message[user.User]{
+			Key: userID,
 			Value: bus.MessageValue[user.User]{
+				CommandID: commandID,
+				CreatedAt: createdAt,
 				Payload: &user.User{
 					ID:      userID,
-					Disable: false,
+					Disable: disable,
 				},
 			},
-		})
+		}
+	}
+
+	entry := &ledger.Entry{
+		Topic:       user.UpdateUserTopic,
+		MessageID:   commandID,
+		Key:         userID,
+		UserID:      userID,
+		Version:     createdAt,
+		TSProcessed: now(),
+	}
+
+	t.Run("NoPayload", func(t *testing.T) {
+		res := handler.Handle(context.TODO(), bus.Message[user.User]{})
+		require.Equal(t, bus.StatusWarning, res.Code)
+	})
+
+	t.Run("ActiveUser", func(t *testing.T) {
+		ldg.EXPECT().Register(context.TODO(), entry).Return(nil)
+		svc.EXPECT().Enable(context.TODO(), userID).Return(nil)
+
+		res := handler.Handle(context.TODO(), message(false))
 		require.Equal(t, bus.StatusOk, res.Code)
 	})
 
 	t.Run("Success", func(t *testing.T) {
+		ldg.EXPECT().Register(context.TODO(), entry).Return(nil)
 		svc.EXPECT().Disable(context.TODO(), userID).Return(nil)
 
-		res := handler.Handle(context.TODO(), bus.Message[user.User]{
-			Value: bus.MessageValue[user.User]{
-				Payload: &user.User{
-					ID:      userID,
-					Disable: true,
-				},
-			},
-		})
+		res := handler.Handle(context.TODO(), message(true))
 		require.Equal(t, bus.StatusOk, res.Code)
 	})
 
+	t.Run("Duplicate", func(t *testing.T) {
+		ldg.EXPECT().Register(context.TODO(), entry).Return(ledger.ErrDuplicate)
+
+		res := handler.Handle(context.TODO(), message(true))
+		require.Equal(t, bus.StatusOk, res.Code)
+	})
+
+	t.Run("Outdated", func(t *testing.T) {
+		ldg.EXPECT().Register(context.TODO(), entry).Return(ledger.ErrOutdated)
+
+		res := handler.Handle(context.TODO(), message(false))
+		require.Equal(t, bus.StatusOk, res.Code)
+	})
+
+	t.Run("BadLedger", func(t *testing.T) {
+		ldg.EXPECT().Register(context.TODO(), entry).Return(someErr)
+
+		res := handler.Handle(context.TODO(), message(true))
+		require.Equal(t, bus.StatusError, res.Code)
+	})
+
 	t.Run("Bad", func(t *testing.T) {
+		ldg.EXPECT().Register(context.TODO(), entry).Return(nil)
 		svc.EXPECT().Disable(context.TODO(), userID).Return(someErr)
 
-		res := handler.Handle(context.TODO(), bus.Message[user.User]{
-			Value: bus.MessageValue[user.User]{
-				Payload: &user.User{
-					ID:      userID,
-					Disable: true,
-				},
-			},
-		})
+		res := handler.Handle(context.TODO(), message(true))
+		require.Equal(t, bus.StatusError, res.Code)
+	})
+
+	t.Run("BadEnable", func(t *testing.T) {
+		ldg.EXPECT().Register(context.TODO(), entry).Return(nil)
+		svc.EXPECT().Enable(context.TODO(), userID).Return(someErr)
+
+		res := handler.Handle(context.TODO(), message(false))
 		require.Equal(t, bus.StatusError, res.Code)
 	})
 }
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/krivenkov/order/internal/server/bus"
 	"github.com/krivenkov/order/internal/server/grpc"
 	"github.com/krivenkov/order/internal/server/http"
+	"github.com/krivenkov/order/internal/server/jobs"
 	"go.uber.org/fx"
 )
 
@@ -13,4 +14,5 @@ type Config struct {
 	Bus  bus.Config  `json:"bus" yaml:"bus" envPrefix:"BUS_"`
 	HTTP http.Config `json:"http" yaml:"http" envPrefix:"HTTP_"`
 	GRPC grpc.Config `json:"grpc" yaml:"grpc" envPrefix:"GRPC_"`
+	Jobs jobs.Config `json:"jobs" yaml:"jobs" envPrefix:"JOBS_"`
 }
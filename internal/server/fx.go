@@ -4,6 +4,7 @@ import (
 	"github.com/krivenkov/order/internal/server/bus"
 	"github.com/krivenkov/order/internal/server/grpc"
 	"github.com/krivenkov/order/internal/server/http"
+	"github.com/krivenkov/order/internal/server/jobs"
 	"go.uber.org/fx"
 )
 
@@ -11,4 +12,5 @@ var FXModule = fx.Options(
 	bus.FXModule,
 	http.FXModule,
 	grpc.FXModule,
+	jobs.FXModule,
 )
@@ -4,7 +4,14 @@ import (
 	"errors"
 
 	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/line"
 	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/order/internal/model/tax"
 	"github.com/krivenkov/order/pkg/api"
 	"github.com/krivenkov/pkg/order"
 	"github.com/krivenkov/pkg/paginator"
@@ -26,6 +33,14 @@ func toError(err error) error {
 		return api.ErrMultiItems
 	}
 
+	if errors.Is(err, model.ErrInvalidArgument) {
+		return api.ErrInvalidArgument
+	}
+
+	if errors.Is(err, model.ErrPermissionDenied) {
+		return api.ErrPermissionDenied
+	}
+
 	return status.Error(codes.Unknown, err.Error())
 }
 
@@ -62,11 +77,91 @@ func toOrderItem(source *orderModel.Order) *api.OrderItem {
 	return &api.OrderItem{
 		Id:          source.ID,
 		Status:      api.OrderItemStatus(source.Status),
+		State:       api.OrderItemState(source.State),
 		TsCreate:    timestamppb.New(source.TSCreate),
 		TsModify:    timestamppb.New(source.TSModify),
+		Number:      source.Number,
 		UserId:      source.UserID,
+		TenantId:    source.TenantID,
+		Tags:        source.Tags,
+		Metadata:    source.Metadata,
 		Name:        source.Name,
 		Description: source.Description,
+
+		ShippingAddress: toOrderAddress(source.ShippingAddress),
+		BillingAddress:  toOrderAddress(source.BillingAddress),
+		Totals:          toOrderTotals(source.Totals),
+		Discounts:       toOrderDiscounts(source.Discounts),
+		Taxes:           toOrderTaxes(source.Taxes),
+	}
+}
+
+func toOrderTotals(source orderModel.Totals) *api.OrderTotals {
+	return &api.OrderTotals{
+		Currency: source.Currency,
+		Subtotal: source.Subtotal,
+		Total:    source.Total,
+		Paid:     source.Paid,
+		Refunded: source.Refunded,
+		Discount: source.Discount,
+		Tax:      source.Tax,
+
+		TaxInclusive: source.TaxInclusive,
+	}
+}
+
+func toOrderTaxes(source []*tax.Line) []*api.OrderTax {
+	if len(source) == 0 {
+		return nil
+	}
+
+	target := make([]*api.OrderTax, 0, len(source))
+
+	for _, s := range source {
+		target = append(target, &api.OrderTax{
+			Country:  s.Country,
+			Region:   s.Region,
+			Category: s.Category,
+			Rate:     s.Rate,
+			Taxable:  s.Taxable,
+			Amount:   s.Amount,
+		})
+	}
+
+	return target
+}
+
+func toOrderDiscounts(source []*promo.Discount) []*api.OrderDiscount {
+	if len(source) == 0 {
+		return nil
+	}
+
+	target := make([]*api.OrderDiscount, 0, len(source))
+
+	for _, s := range source {
+		target = append(target, &api.OrderDiscount{
+			Code:   s.Code,
+			Amount: s.Amount,
+		})
+	}
+
+	return target
+}
+
+func toOrderAddress(source *orderModel.Address) *api.OrderAddress {
+	if source == nil {
+		return nil
+	}
+
+	return &api.OrderAddress{
+		Name:       source.Name,
+		Line1:      source.Line1,
+		Line2:      source.Line2,
+		City:       source.City,
+		Region:     source.Region,
+		PostalCode: source.PostalCode,
+		Country:    source.Country,
+		Phone:      source.Phone,
 	}
 }
 
@@ -79,3 +174,183 @@ func toOrderItemList(source []*orderModel.Order) []*api.OrderItem {
 
 	return target
 }
+
+func fromFacetsInterval(source api.OrderFacetsInterval) orderModel.DateInterval {
+	switch source {
+	case api.OrderFacetsInterval_IntervalWeek:
+		return orderModel.DateIntervalWeek
+	case api.OrderFacetsInterval_IntervalMonth:
+		return orderModel.DateIntervalMonth
+	default:
+		return orderModel.DateIntervalDay
+	}
+}
+
+func toOrderFacets(source *orderModel.Facets) *api.OrderFacetsResponse {
+	target := &api.OrderFacetsResponse{
+		Statuses: make([]*api.OrderStatusFacet, 0, len(source.Statuses)),
+		Dates:    make([]*api.OrderDateFacet, 0, len(source.Dates)),
+		Tags:     make([]*api.OrderTagFacet, 0, len(source.Tags)),
+	}
+
+	for _, s := range source.Statuses {
+		target.Statuses = append(target.Statuses, &api.OrderStatusFacet{
+			Status: api.OrderItemStatus(s.Status),
+			Count:  int64(s.Count),
+		})
+	}
+
+	for _, d := range source.Dates {
+		target.Dates = append(target.Dates, &api.OrderDateFacet{
+			Date:  timestamppb.New(d.Date),
+			Count: int64(d.Count),
+		})
+	}
+
+	for _, t := range source.Tags {
+		target.Tags = append(target.Tags, &api.OrderTagFacet{
+			Tag:   t.Tag,
+			Count: int64(t.Count),
+		})
+	}
+
+	return target
+}
+
+func toOrderHistory(source []*history.Entry) []*api.OrderHistoryEntry {
+	target := make([]*api.OrderHistoryEntry, 0, len(source))
+
+	for _, s := range source {
+		changes := make([]*api.OrderHistoryChange, 0, len(s.Changes))
+		for _, c := range s.Changes {
+			changes = append(changes, &api.OrderHistoryChange{
+				Field: c.Field,
+				Old:   c.Old,
+				New:   c.New,
+			})
+		}
+
+		target = append(target, &api.OrderHistoryEntry{
+			Id:       s.ID,
+			TsCreate: timestamppb.New(s.TSCreate),
+			OrderId:  s.OrderID,
+			Actor:    s.Actor,
+			Source:   string(s.Source),
+			Action:   string(s.Action),
+			Changes:  changes,
+		})
+	}
+
+	return target
+}
+
+func toOrderLines(source []*line.Line) []*api.OrderLine {
+	target := make([]*api.OrderLine, 0, len(source))
+
+	for _, s := range source {
+		target = append(target, &api.OrderLine{
+			Id:        s.ID,
+			Sku:       s.SKU,
+			Name:      s.Name,
+			Quantity:  int64(s.Quantity),
+			UnitPrice: s.UnitPrice,
+			Discount:  s.Discount,
+
+			TaxCategory: s.TaxCategory,
+			Tax:         s.Tax,
+		})
+	}
+
+	return target
+}
+
+func toOrderShipments(source []*shipment.Shipment) []*api.OrderShipment {
+	target := make([]*api.OrderShipment, 0, len(source))
+
+	for _, s := range source {
+		lines := make([]*api.OrderShipmentLine, 0, len(s.Lines))
+		for _, l := range s.Lines {
+			lines = append(lines, &api.OrderShipmentLine{
+				LineId:   l.LineID,
+				Quantity: int64(l.Quantity),
+			})
+		}
+
+		events := make([]*api.OrderShipmentEvent, 0, len(s.Events))
+		for _, e := range s.Events {
+			events = append(events, &api.OrderShipmentEvent{
+				Status:   api.OrderShipmentStatus(e.Status),
+				TsCreate: timestamppb.New(e.TSCreate),
+			})
+		}
+
+		target = append(target, &api.OrderShipment{
+			Id:             s.ID,
+			TsCreate:       timestamppb.New(s.TSCreate),
+			Carrier:        s.Carrier,
+			TrackingNumber: s.TrackingNumber,
+			Status:         api.OrderShipmentStatus(s.Status),
+			Lines:          lines,
+			Events:         events,
+		})
+	}
+
+	return target
+}
+
+func toOrderReturns(source []*refund.Return) []*api.OrderReturn {
+	target := make([]*api.OrderReturn, 0, len(source))
+
+	for _, s := range source {
+		refunds := make([]*api.OrderRefund, 0, len(s.Refunds))
+		for _, r := range s.Refunds {
+			refunds = append(refunds, &api.OrderRefund{
+				Id:        r.ID,
+				TsCreate:  timestamppb.New(r.TSCreate),
+				PaymentId: r.PaymentID,
+				Amount:    r.Amount,
+				Currency:  r.Currency,
+			})
+		}
+
+		target = append(target, &api.OrderReturn{
+			Id:       s.ID,
+			TsCreate: timestamppb.New(s.TSCreate),
+			TsModify: timestamppb.New(s.TSModify),
+			LineId:   s.LineID,
+			Quantity: int64(s.Quantity),
+			Reason:   s.Reason,
+			Status:   api.OrderReturnStatus(s.Status),
+			Comment:  s.Comment,
+			Refunds:  refunds,
+		})
+	}
+
+	return target
+}
+
+func toOrderComments(source []*comment.Comment) []*api.OrderComment {
+	target := make([]*api.OrderComment, 0, len(source))
+	for _, s := range source {
+		target = append(target, toOrderComment(s))
+	}
+
+	return target
+}
+
+func toOrderComment(source *comment.Comment) *api.OrderComment {
+	target := &api.OrderComment{
+		Id:       source.ID,
+		TsCreate: timestamppb.New(source.TSCreate),
+		OrderId:  source.OrderID,
+		AuthorId: source.AuthorID,
+		Body:     source.Body,
+		Internal: source.Internal,
+	}
+
+	if source.TSEdit != nil {
+		target.TsEdit = timestamppb.New(*source.TSEdit)
+	}
+
+	return target
+}
@@ -3,9 +3,17 @@ package inner
 import (
 	"context"
 
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model/comment"
 	orderModel "github.com/krivenkov/order/internal/model/order"
 	"github.com/krivenkov/order/pkg/api"
 	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+const (
+	defaultHistoryLimit = 50
+	defaultCommentLimit = 50
 )
 
 type server struct {
@@ -29,6 +37,14 @@ func (s *server) GetOrderItem(ctx context.Context, request *api.OrderItemRequest
 		if request.Filter.UserId != nil {
 			filter.UserID = option.New(*request.Filter.UserId)
 		}
+
+		if request.Filter.TenantId != nil {
+			filter.TenantID = option.New(*request.Filter.TenantId)
+		}
+
+		if request.Filter.Number != nil {
+			filter.Number = option.New(*request.Filter.Number)
+		}
 	}
 
 	item, err := s.svc.InnerGetItem(ctx, filter)
@@ -57,6 +73,26 @@ func (s *server) GetOrderItemList(ctx context.Context, request *api.OrderItemLis
 			filter.UserID = option.New(*request.Filter.UserId)
 		}
 
+		if request.Filter.TenantId != nil {
+			filter.TenantID = option.New(*request.Filter.TenantId)
+		}
+
+		if request.Filter.Number != nil {
+			filter.Number = option.New(*request.Filter.Number)
+		}
+
+		if len(request.Filter.Tags) > 0 {
+			filter.Tags = option.New(request.Filter.Tags)
+		}
+
+		if len(request.Filter.TagsAny) > 0 {
+			filter.TagsAny = option.New(request.Filter.TagsAny)
+		}
+
+		if len(request.Filter.Metadata) > 0 {
+			filter.Metadata = option.New(request.Filter.Metadata)
+		}
+
 		if len(orders) > 0 {
 			filter.Orders = option.New(orders)
 		}
@@ -75,3 +111,151 @@ func (s *server) GetOrderItemList(ctx context.Context, request *api.OrderItemLis
 		Value: toOrderItemList(items),
 	}, nil
 }
+
+func (s *server) GetOrderFacets(ctx context.Context, request *api.OrderFacetsRequest) (*api.OrderFacetsResponse, error) {
+	filter := &orderModel.InnerGetFacetsRequest{
+		Interval: option.New(fromFacetsInterval(request.Interval)),
+	}
+
+	if request.Filter != nil {
+		if len(request.Filter.Ids) > 0 {
+			filter.IDs = option.New(request.Filter.Ids)
+		}
+
+		if request.Filter.UserId != nil {
+			filter.UserID = option.New(*request.Filter.UserId)
+		}
+
+		if request.Filter.TenantId != nil {
+			filter.TenantID = option.New(*request.Filter.TenantId)
+		}
+
+		if request.Filter.Number != nil {
+			filter.Number = option.New(*request.Filter.Number)
+		}
+
+		if len(request.Filter.Tags) > 0 {
+			filter.Tags = option.New(request.Filter.Tags)
+		}
+
+		if len(request.Filter.TagsAny) > 0 {
+			filter.TagsAny = option.New(request.Filter.TagsAny)
+		}
+
+		if len(request.Filter.Metadata) > 0 {
+			filter.Metadata = option.New(request.Filter.Metadata)
+		}
+	}
+
+	if request.Q != nil {
+		filter.Q = option.New(*request.Q)
+	}
+
+	facets, err := s.svc.InnerGetFacets(ctx, filter)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return toOrderFacets(facets), nil
+}
+
+func (s *server) GetOrderHistory(ctx context.Context, request *api.OrderHistoryRequest) (*api.OrderHistoryResponse, error) {
+	pagination := fromPagination(request.Pagination)
+	if pagination == nil {
+		pagination = &paginator.Pagination{Limit: defaultHistoryLimit}
+	}
+
+	entries, total, err := s.svc.InnerGetHistory(ctx, request.OrderId, *pagination)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return &api.OrderHistoryResponse{
+		Entries: toOrderHistory(entries),
+		Total:   int64(total),
+	}, nil
+}
+
+func (s *server) GetOrderShipments(ctx context.Context, request *api.OrderShipmentsRequest) (*api.OrderShipmentsResponse, error) {
+	shipments, err := s.svc.InnerGetShipments(ctx, request.OrderId)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	lines, err := s.svc.InnerGetLines(ctx, request.OrderId)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return &api.OrderShipmentsResponse{
+		Shipments: toOrderShipments(shipments),
+		Lines:     toOrderLines(lines),
+	}, nil
+}
+
+func (s *server) GetOrderReturns(ctx context.Context, request *api.OrderReturnsRequest) (*api.OrderReturnsResponse, error) {
+	returns, err := s.svc.InnerGetReturns(ctx, request.OrderId)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return &api.OrderReturnsResponse{
+		Returns: toOrderReturns(returns),
+	}, nil
+}
+
+// GetOrderComments lists the staff notes too: inner callers are back-office tools
+// and act as staff on the comments thread.
+func (s *server) GetOrderComments(ctx context.Context, request *api.OrderCommentsRequest) (*api.OrderCommentsResponse, error) {
+	pagination := fromPagination(request.Pagination)
+	if pagination == nil {
+		pagination = &paginator.Pagination{Limit: defaultCommentLimit}
+	}
+
+	comments, total, err := s.svc.GetComments(ctx, comment.Actor{Staff: true}, request.OrderId, *pagination)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return &api.OrderCommentsResponse{
+		Comments: toOrderComments(comments),
+		Total:    int64(total),
+	}, nil
+}
+
+func (s *server) CreateOrderComment(ctx context.Context, request *api.CreateOrderCommentRequest) (*api.OrderCommentResponse, error) {
+	if _, err := uuid.Parse(request.AuthorId); err != nil {
+		return nil, api.ErrInvalidArgument
+	}
+
+	res, err := s.svc.AddComment(ctx, comment.Actor{UserID: request.AuthorId, Staff: true}, request.OrderId, &comment.Form{
+		Body:     request.Body,
+		Internal: request.Internal,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return &api.OrderCommentResponse{
+		Comment: toOrderComment(res),
+	}, nil
+}
+
+func (s *server) UpdateOrderComment(ctx context.Context, request *api.UpdateOrderCommentRequest) (*api.OrderCommentResponse, error) {
+	res, err := s.svc.UpdateComment(ctx, comment.Actor{UserID: request.AuthorId, Staff: true}, request.OrderId, request.CommentId, request.Body)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return &api.OrderCommentResponse{
+		Comment: toOrderComment(res),
+	}, nil
+}
+
+func (s *server) DeleteOrderComment(ctx context.Context, request *api.DeleteOrderCommentRequest) (*api.DeleteOrderCommentResponse, error) {
+	if err := s.svc.DeleteComment(ctx, comment.Actor{Staff: true}, request.OrderId, request.CommentId); err != nil {
+		return nil, toError(err)
+	}
+
+	return &api.DeleteOrderCommentResponse{}, nil
+}
@@ -9,8 +9,13 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/line"
 	orderModel "github.com/krivenkov/order/internal/model/order"
 	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/model/shipment"
 	"github.com/krivenkov/order/internal/server/grpc/inner"
 	"github.com/krivenkov/order/pkg/api"
 	"github.com/krivenkov/pkg/option"
@@ -35,9 +40,11 @@ func TestGetOrderItem(t *testing.T) {
 				TSCreate:    now(),
 				TSModify:    now(),
 				Status:      orderModel.StatusCreated,
+				State:       orderModel.StateFulfilled,
 				UserID:      userID,
 				Name:        name,
 				Description: description,
+				Totals:      orderModel.Totals{Currency: "EUR", Subtotal: 500, Total: 500, Paid: 500},
 			}
 
 			svc = orderMock.NewMockService(ctrl)
@@ -62,15 +69,62 @@ func TestGetOrderItem(t *testing.T) {
 			Value: &api.OrderItem{
 				Id:          newID().String(),
 				Status:      api.OrderItemStatus_StatusCreated,
+				State:       api.OrderItemState_StateFulfilled,
 				TsCreate:    timestamppb.New(now()),
 				TsModify:    timestamppb.New(now()),
 				UserId:      userID,
 				Name:        name,
 				Description: description,
+				Totals:      &api.OrderTotals{Currency: "EUR", Subtotal: 500, Total: 500, Paid: 500},
 			},
 		}, res)
 	})
 
+	t.Run("By number", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			number = "ORD-2000-000001"
+
+			orderItem = &orderModel.Order{
+				ID:       newID().String(),
+				TSCreate: now(),
+				TSModify: now(),
+				Status:   orderModel.StatusCreated,
+				Number:   number,
+				UserID:   "user_id",
+				ShippingAddress: &orderModel.Address{
+					Name:       "John Smith",
+					Line1:      "221B Baker Street",
+					City:       "London",
+					PostalCode: "NW1 6XE",
+					Country:    "GB",
+					Phone:      "+442071234567",
+				},
+			}
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().InnerGetItem(context.TODO(), &orderModel.InnerGetItemRequest{
+			Number: option.New(number),
+		}).Return(orderItem, nil)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderItem(context.TODO(), &api.OrderItemRequest{
+			Filter: &api.OrderItemFilter{
+				Number: &number,
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, number, res.Value.Number)
+		require.Equal(t, "NW1 6XE", res.Value.ShippingAddress.PostalCode)
+		require.Nil(t, res.Value.BillingAddress)
+	})
+
 	t.Run("Bad", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -169,6 +223,7 @@ func TestGetOrderItemList(t *testing.T) {
 				UserId:      userID,
 				Name:        name,
 				Description: description,
+				Totals:      &api.OrderTotals{},
 			}},
 		}, res)
 	})
@@ -224,6 +279,505 @@ func TestGetOrderItemList(t *testing.T) {
 	})
 }
 
+func TestGetOrderFacets(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+			q      = "test"
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().InnerGetFacets(context.TODO(), &orderModel.InnerGetFacetsRequest{
+			UserID:   option.New(userID),
+			Q:        option.New(q),
+			Interval: option.New(orderModel.DateIntervalMonth),
+		}).Return(&orderModel.Facets{
+			Statuses: []*orderModel.StatusFacet{
+				{Status: orderModel.StatusCreated, Count: 2},
+			},
+			Dates: []*orderModel.DateFacet{
+				{Date: now(), Count: 2},
+			},
+			Tags: []*orderModel.TagFacet{
+				{Tag: "urgent", Count: 1},
+			},
+		}, nil)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderFacets(context.TODO(), &api.OrderFacetsRequest{
+			Filter: &api.OrderItemFilter{
+				UserId: &userID,
+			},
+			Q:        &q,
+			Interval: api.OrderFacetsInterval_IntervalMonth,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &api.OrderFacetsResponse{
+			Statuses: []*api.OrderStatusFacet{{
+				Status: api.OrderItemStatus_StatusCreated,
+				Count:  2,
+			}},
+			Dates: []*api.OrderDateFacet{{
+				Date:  timestamppb.New(now()),
+				Count: 2,
+			}},
+			Tags: []*api.OrderTagFacet{{
+				Tag:   "urgent",
+				Count: 1,
+			}},
+		}, res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			someErr = fmt.Errorf("some error")
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().InnerGetFacets(context.TODO(), &orderModel.InnerGetFacetsRequest{
+			Interval: option.New(orderModel.DateIntervalDay),
+		}).Return(nil, someErr)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderFacets(context.TODO(), &api.OrderFacetsRequest{})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID = newID().String()
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().InnerGetHistory(context.TODO(), orderID, paginator.Pagination{Limit: 10, Offset: 20}).Return([]*history.Entry{
+			{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  orderID,
+				Actor:    "user_id",
+				Source:   history.SourceBus,
+				Action:   history.ActionDisable,
+				Changes:  []*history.Change{{Field: "status", Old: "created", New: "disabled"}},
+			},
+		}, 21, nil)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderHistory(context.TODO(), &api.OrderHistoryRequest{
+			OrderId:    orderID,
+			Pagination: &api.Pagination{Limit: 10, Offset: 20},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &api.OrderHistoryResponse{
+			Entries: []*api.OrderHistoryEntry{{
+				Id:       newID().String(),
+				TsCreate: timestamppb.New(now()),
+				OrderId:  orderID,
+				Actor:    "user_id",
+				Source:   "bus",
+				Action:   "disable",
+				Changes:  []*api.OrderHistoryChange{{Field: "status", Old: "created", New: "disabled"}},
+			}},
+			Total: 21,
+		}, res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID = newID().String()
+			someErr = fmt.Errorf("some error")
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().InnerGetHistory(context.TODO(), orderID, paginator.Pagination{Limit: 50}).Return(nil, 0, someErr)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderHistory(context.TODO(), &api.OrderHistoryRequest{
+			OrderId: orderID,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestGetOrderShipments(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID = newID().String()
+			lineID  = "line_id"
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().InnerGetShipments(context.TODO(), orderID).Return([]*shipment.Shipment{
+			{
+				ID:             newID().String(),
+				TSCreate:       now(),
+				TSModify:       now(),
+				OrderID:        orderID,
+				Carrier:        "dhl",
+				TrackingNumber: "JD0001",
+				Status:         shipment.StatusInTransit,
+				Lines:          []*shipment.Line{{LineID: lineID, Quantity: 1}},
+				Events: []*shipment.Event{
+					{Status: shipment.StatusShipped, TSCreate: now()},
+					{Status: shipment.StatusInTransit, TSCreate: now()},
+				},
+			},
+		}, nil)
+		svc.EXPECT().InnerGetLines(context.TODO(), orderID).Return([]*line.Line{
+			{ID: lineID, TSCreate: now(), OrderID: orderID, SKU: "SKU-1", Name: "Widget", Quantity: 2, UnitPrice: 250},
+		}, nil)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderShipments(context.TODO(), &api.OrderShipmentsRequest{
+			OrderId: orderID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &api.OrderShipmentsResponse{
+			Shipments: []*api.OrderShipment{{
+				Id:             newID().String(),
+				TsCreate:       timestamppb.New(now()),
+				Carrier:        "dhl",
+				TrackingNumber: "JD0001",
+				Status:         api.OrderShipmentStatus_ShipmentInTransit,
+				Lines:          []*api.OrderShipmentLine{{LineId: lineID, Quantity: 1}},
+				Events: []*api.OrderShipmentEvent{
+					{Status: api.OrderShipmentStatus_ShipmentShipped, TsCreate: timestamppb.New(now())},
+					{Status: api.OrderShipmentStatus_ShipmentInTransit, TsCreate: timestamppb.New(now())},
+				},
+			}},
+			Lines: []*api.OrderLine{{Id: lineID, Sku: "SKU-1", Name: "Widget", Quantity: 2, UnitPrice: 250}},
+		}, res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID = newID().String()
+			someErr = fmt.Errorf("some error")
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().InnerGetShipments(context.TODO(), orderID).Return(nil, someErr)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderShipments(context.TODO(), &api.OrderShipmentsRequest{
+			OrderId: orderID,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestGetOrderReturns(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID = newID().String()
+			lineID  = "line_id"
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().InnerGetReturns(context.TODO(), orderID).Return([]*refund.Return{
+			{
+				ID:        newID().String(),
+				TSCreate:  now(),
+				TSModify:  now(),
+				OrderID:   orderID,
+				LineID:    lineID,
+				Quantity:  1,
+				Reason:    "damaged",
+				Status:    refund.StatusApproved,
+				DecidedBy: "admin_id",
+				Refunds: []*refund.Refund{{
+					ID:        newID().String(),
+					TSCreate:  now(),
+					OrderID:   orderID,
+					ReturnID:  newID().String(),
+					PaymentID: "payment_id",
+					Amount:    250,
+					Currency:  "EUR",
+				}},
+			},
+		}, nil)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderReturns(context.TODO(), &api.OrderReturnsRequest{
+			OrderId: orderID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &api.OrderReturnsResponse{
+			Returns: []*api.OrderReturn{{
+				Id:       newID().String(),
+				TsCreate: timestamppb.New(now()),
+				TsModify: timestamppb.New(now()),
+				LineId:   lineID,
+				Quantity: 1,
+				Reason:   "damaged",
+				Status:   api.OrderReturnStatus_ReturnApproved,
+				Refunds: []*api.OrderRefund{{
+					Id:        newID().String(),
+					TsCreate:  timestamppb.New(now()),
+					PaymentId: "payment_id",
+					Amount:    250,
+					Currency:  "EUR",
+				}},
+			}},
+		}, res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID = newID().String()
+			someErr = fmt.Errorf("some error")
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().InnerGetReturns(context.TODO(), orderID).Return(nil, someErr)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderReturns(context.TODO(), &api.OrderReturnsRequest{
+			OrderId: orderID,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestGetOrderComments(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID = newID().String()
+			edited  = now().Add(time.Hour)
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().GetComments(context.TODO(), comment.Actor{Staff: true}, orderID, paginator.Pagination{Limit: 50}).Return([]*comment.Comment{
+			{
+				ID:       newID().String(),
+				TSCreate: now(),
+				TSEdit:   &edited,
+				OrderID:  orderID,
+				AuthorID: "staff_id",
+				Body:     "Courier called twice",
+				Internal: true,
+			},
+		}, 1, nil)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderComments(context.TODO(), &api.OrderCommentsRequest{
+			OrderId: orderID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &api.OrderCommentsResponse{
+			Comments: []*api.OrderComment{{
+				Id:       newID().String(),
+				TsCreate: timestamppb.New(now()),
+				TsEdit:   timestamppb.New(edited),
+				OrderId:  orderID,
+				AuthorId: "staff_id",
+				Body:     "Courier called twice",
+				Internal: true,
+			}},
+			Total: 1,
+		}, res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID = newID().String()
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().GetComments(context.TODO(), comment.Actor{Staff: true}, orderID, paginator.Pagination{Limit: 10}).Return(nil, 0, model.ErrNotFound)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.GetOrderComments(context.TODO(), &api.OrderCommentsRequest{
+			OrderId:    orderID,
+			Pagination: &api.Pagination{Limit: 10},
+		})
+
+		require.ErrorIs(t, err, api.ErrNotFound)
+		require.Nil(t, res)
+	})
+}
+
+func TestCreateOrderComment(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID  = newID().String()
+			authorID = uuid.New().String()
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().AddComment(context.TODO(), comment.Actor{UserID: authorID, Staff: true}, orderID, &comment.Form{
+			Body:     "Refund approved",
+			Internal: true,
+		}).Return(&comment.Comment{
+			ID:       newID().String(),
+			TSCreate: now(),
+			OrderID:  orderID,
+			AuthorID: authorID,
+			Body:     "Refund approved",
+			Internal: true,
+		}, nil)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.CreateOrderComment(context.TODO(), &api.CreateOrderCommentRequest{
+			OrderId:  orderID,
+			AuthorId: authorID,
+			Body:     "Refund approved",
+			Internal: true,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &api.OrderCommentResponse{
+			Comment: &api.OrderComment{
+				Id:       newID().String(),
+				TsCreate: timestamppb.New(now()),
+				OrderId:  orderID,
+				AuthorId: authorID,
+				Body:     "Refund approved",
+				Internal: true,
+			},
+		}, res)
+	})
+
+	t.Run("Invalid author", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		srv := inner.NewServer(orderMock.NewMockService(ctrl))
+
+		res, err := srv.CreateOrderComment(context.TODO(), &api.CreateOrderCommentRequest{
+			OrderId: newID().String(),
+			Body:    "Refund approved",
+		})
+
+		require.ErrorIs(t, err, api.ErrInvalidArgument)
+		require.Nil(t, res)
+	})
+}
+
+func TestUpdateOrderComment(t *testing.T) {
+	t.Run("Not the author", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID   = newID().String()
+			commentID = uuid.New().String()
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().UpdateComment(context.TODO(), comment.Actor{UserID: "staff_id", Staff: true}, orderID, commentID, "Edited").
+			Return(nil, fmt.Errorf("edit comment: %w", model.ErrPermissionDenied))
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.UpdateOrderComment(context.TODO(), &api.UpdateOrderCommentRequest{
+			OrderId:   orderID,
+			CommentId: commentID,
+			AuthorId:  "staff_id",
+			Body:      "Edited",
+		})
+
+		require.ErrorIs(t, err, api.ErrPermissionDenied)
+		require.Nil(t, res)
+	})
+}
+
+func TestDeleteOrderComment(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderID   = newID().String()
+			commentID = uuid.New().String()
+
+			svc = orderMock.NewMockService(ctrl)
+		)
+
+		svc.EXPECT().DeleteComment(context.TODO(), comment.Actor{Staff: true}, orderID, commentID).Return(nil)
+
+		srv := inner.NewServer(svc)
+
+		res, err := srv.DeleteOrderComment(context.TODO(), &api.DeleteOrderCommentRequest{
+			OrderId:   orderID,
+			CommentId: commentID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &api.DeleteOrderCommentResponse{}, res)
+	})
+}
+
 func now() time.Time {
 	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
 }
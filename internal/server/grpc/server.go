@@ -7,6 +7,7 @@ import (
 
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcRecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"github.com/krivenkov/order/internal/model/history"
 	"github.com/krivenkov/pkg/mlog"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -28,6 +29,9 @@ func NewServer(lc fx.Lifecycle, p Params) (*grpc.Server, error) {
 				func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 					return handler(mlog.CtxWithLogger(ctx, p.Logger), req)
 				},
+				func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+					return handler(history.CtxWithOrigin(ctx, history.Origin{Source: history.SourceGRPC}), req)
+				},
 			),
 		),
 	)
@@ -3,7 +3,10 @@ package auth
 import (
 	"context"
 	"errors"
+	"net/http"
+	"slices"
 
+	openapiErrors "github.com/go-openapi/errors"
 	"github.com/krivenkov/pkg/auth"
 	"go.uber.org/zap"
 )
@@ -21,6 +24,31 @@ func NewJWT(logger *zap.Logger, authCli *auth.Client) *JWT {
 }
 
 func (j *JWT) Handle(tokenStr string) (interface{}, error) {
+	session, err := j.session(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.userID(session)
+}
+
+// Admin returns a handler accepting only members of the admin group
+func (j *JWT) Admin(group string) func(tokenStr string) (interface{}, error) {
+	return func(tokenStr string) (interface{}, error) {
+		session, err := j.session(tokenStr)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(session.Groups, group) {
+			return nil, openapiErrors.New(http.StatusForbidden, "access token: admin group required")
+		}
+
+		return j.userID(session)
+	}
+}
+
+func (j *JWT) session(tokenStr string) (*auth.Session, error) {
 	session, err := j.authCli.SessionFromToken(tokenStr)
 	if err != nil {
 		if errors.Is(err, auth.ErrNoUserFound) {
@@ -34,6 +62,10 @@ func (j *JWT) Handle(tokenStr string) (interface{}, error) {
 		return nil, errors.New("internal error")
 	}
 
+	return session, nil
+}
+
+func (j *JWT) userID(session *auth.Session) (interface{}, error) {
 	user, err := j.authCli.ExtractUserByUsername(context.Background(), session.PreferredUsername)
 	if err != nil {
 		return nil, ErrInvalidGrand{
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+
+	openapiErrors "github.com/go-openapi/errors"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"go.uber.org/zap"
+)
+
+// TenantHeader selects the tenant a request acts in, without it the user acts for itself
+const TenantHeader = "X-Tenant-ID"
+
+type Tenant struct {
+	svc    tenant.Service
+	logger *zap.Logger
+}
+
+func NewTenant(logger *zap.Logger, svc tenant.Service) *Tenant {
+	return &Tenant{
+		svc:    svc,
+		logger: logger,
+	}
+}
+
+// Authorize resolves the membership of the authenticated user in the tenant of the request
+// and keeps its scope in the request context, the order queriers read it from there
+func (t *Tenant) Authorize(r *http.Request, principal interface{}) error {
+	tenantID := r.Header.Get(TenantHeader)
+	if tenantID == "" {
+		return nil
+	}
+
+	if _, err := uuid.Parse(tenantID); err != nil {
+		return openapiErrors.New(http.StatusBadRequest, "tenant: invalid id")
+	}
+
+	userID, _ := principal.(string)
+
+	scope, err := t.svc.Resolve(r.Context(), tenantID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return openapiErrors.New(http.StatusForbidden, "tenant: not a member")
+		}
+
+		t.logger.Error("resolve tenant", zap.String("tenant_id", tenantID), zap.String("user_id", userID), zap.Error(err))
+
+		return openapiErrors.New(http.StatusInternalServerError, "internal error")
+	}
+
+	// the runtime hands this very request over to the handler
+	*r = *r.WithContext(tenant.CtxWithScope(r.Context(), *scope))
+
+	return nil
+}
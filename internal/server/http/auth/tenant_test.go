@@ -0,0 +1,98 @@
+package auth_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapiErrors "github.com/go-openapi/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	tenantMock "github.com/krivenkov/order/internal/model/tenant/mock"
+	"github.com/krivenkov/order/internal/server/http/auth"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTenantAuthorize(t *testing.T) {
+	var (
+		userID   = "user_id"
+		tenantID = "00000000-0000-0000-0000-000000000001"
+	)
+
+	newRequest := func(tenantID string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/orders", nil)
+		if tenantID != "" {
+			r.Header.Set(auth.TenantHeader, tenantID)
+		}
+
+		return r
+	}
+
+	t.Run("Without tenant", func(t *testing.T) {
+		r := newRequest("")
+
+		err := auth.NewTenant(zap.NewNop(), nil).Authorize(r, userID)
+
+		require.NoError(t, err)
+
+		_, ok := tenant.ScopeFromContext(r.Context())
+		require.False(t, ok)
+	})
+
+	t.Run("Member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			tenantService = tenantMock.NewMockService(ctrl)
+
+			r     = newRequest(tenantID)
+			scope = &tenant.Scope{TenantID: tenantID, UserID: userID, Role: tenant.RoleEditor}
+		)
+
+		tenantService.EXPECT().Resolve(gomock.Any(), tenantID, userID).Return(scope, nil)
+
+		err := auth.NewTenant(zap.NewNop(), tenantService).Authorize(r, userID)
+
+		require.NoError(t, err)
+
+		res, ok := tenant.ScopeFromContext(r.Context())
+		require.True(t, ok)
+		require.Equal(t, *scope, res)
+	})
+
+	t.Run("Invalid tenant", func(t *testing.T) {
+		err := auth.NewTenant(zap.NewNop(), nil).Authorize(newRequest("acme"), userID)
+
+		require.Equal(t, openapiErrors.New(http.StatusBadRequest, "tenant: invalid id"), err)
+	})
+
+	t.Run("Not a member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantService := tenantMock.NewMockService(ctrl)
+
+		tenantService.EXPECT().Resolve(gomock.Any(), tenantID, userID).Return(nil, model.ErrPermissionDenied)
+
+		err := auth.NewTenant(zap.NewNop(), tenantService).Authorize(newRequest(tenantID), userID)
+
+		require.Equal(t, openapiErrors.New(http.StatusForbidden, "tenant: not a member"), err)
+	})
+
+	t.Run("Bad resolve", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantService := tenantMock.NewMockService(ctrl)
+
+		tenantService.EXPECT().Resolve(gomock.Any(), tenantID, userID).Return(nil, fmt.Errorf("some error"))
+
+		err := auth.NewTenant(zap.NewNop(), tenantService).Authorize(newRequest(tenantID), userID)
+
+		require.Equal(t, openapiErrors.New(http.StatusInternalServerError, "internal error"), err)
+	})
+}
@@ -3,4 +3,6 @@ package http
 type Config struct {
 	Host string `json:"host" yaml:"host" env:"HOST"`
 	Port int    `json:"port" yaml:"port" env:"PORT"`
+
+	AdminGroup string `json:"admin_group" yaml:"admin_group" env:"ADMIN_GROUP" default:"/admin"`
 }
@@ -0,0 +1,42 @@
+package convertors
+
+import (
+	"github.com/go-openapi/swag"
+	"github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func AddressFromModel(a *order.Address) *models.Address {
+	if a == nil {
+		return nil
+	}
+
+	return &models.Address{
+		Name:       ptr.Pointer(a.Name),
+		Line1:      ptr.Pointer(a.Line1),
+		Line2:      a.Line2,
+		City:       ptr.Pointer(a.City),
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    ptr.Pointer(a.Country),
+		Phone:      ptr.Pointer(a.Phone),
+	}
+}
+
+func AddressToModel(a *models.Address) *order.Address {
+	if a == nil {
+		return nil
+	}
+
+	return &order.Address{
+		Name:       swag.StringValue(a.Name),
+		Line1:      swag.StringValue(a.Line1),
+		Line2:      a.Line2,
+		City:       swag.StringValue(a.City),
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    swag.StringValue(a.Country),
+		Phone:      swag.StringValue(a.Phone),
+	}
+}
@@ -0,0 +1,29 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/krivenkov/order/internal/model/approval"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func ApprovalFromModel(a *approval.Approval) *models.Approval {
+	approvers := make([]strfmt.UUID, 0, len(a.Approvers))
+	for _, id := range a.Approvers {
+		approvers = append(approvers, strfmt.UUID(id))
+	}
+
+	return &models.Approval{
+		ID:          ptr.Pointer(strfmt.UUID(a.ID)),
+		OrderID:     ptr.Pointer(strfmt.UUID(a.OrderID)),
+		RequestedBy: ptr.Pointer(strfmt.UUID(a.RequestedBy)),
+		Approvers:   approvers,
+		Status:      ptr.Pointer(a.Status.String()),
+		Level:       ptr.Pointer(int64(a.Level)),
+		DueAt:       ptr.Pointer(strfmt.DateTime(a.TSDue)),
+		Comment:     a.Comment,
+		DecidedBy:   a.DecidedBy,
+		CreatedAt:   ptr.Pointer(strfmt.DateTime(a.TSCreate)),
+		UpdatedAt:   ptr.Pointer(strfmt.DateTime(a.TSModify)),
+	}
+}
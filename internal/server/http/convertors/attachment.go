@@ -0,0 +1,29 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func AttachmentFromModel(a *attachment.Attachment) *models.Attachment {
+	return &models.Attachment{
+		ID:          ptr.Pointer(strfmt.UUID(a.ID)),
+		OrderID:     ptr.Pointer(strfmt.UUID(a.OrderID)),
+		UserID:      ptr.Pointer(strfmt.UUID(a.UserID)),
+		FileName:    ptr.Pointer(a.FileName),
+		ContentType: ptr.Pointer(a.ContentType),
+		Size:        ptr.Pointer(a.Size),
+		CreatedAt:   ptr.Pointer(strfmt.DateTime(a.TSCreate)),
+	}
+}
+
+func AttachmentsFromModel(attachments []*attachment.Attachment) []*models.Attachment {
+	res := make([]*models.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		res = append(res, AttachmentFromModel(a))
+	}
+
+	return res
+}
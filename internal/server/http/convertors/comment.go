@@ -0,0 +1,48 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func CommentFromModel(c *comment.Comment) *models.Comment {
+	res := &models.Comment{
+		ID:        ptr.Pointer(strfmt.UUID(c.ID)),
+		OrderID:   ptr.Pointer(strfmt.UUID(c.OrderID)),
+		AuthorID:  ptr.Pointer(strfmt.UUID(c.AuthorID)),
+		Body:      ptr.Pointer(c.Body),
+		Internal:  ptr.Pointer(c.Internal),
+		CreatedAt: ptr.Pointer(strfmt.DateTime(c.TSCreate)),
+	}
+
+	if c.TSEdit != nil {
+		res.EditedAt = ptr.Pointer(strfmt.DateTime(*c.TSEdit))
+	}
+
+	return res
+}
+
+func CommentsFromModel(comments []*comment.Comment) []*models.Comment {
+	res := make([]*models.Comment, 0, len(comments))
+	for _, c := range comments {
+		res = append(res, CommentFromModel(c))
+	}
+
+	return res
+}
+
+func CommentFormFromRequest(r *models.CommentRequest) *comment.Form {
+	return &comment.Form{
+		Body: swag.StringValue(r.Body),
+	}
+}
+
+func StaffCommentFormFromRequest(r *models.StaffCommentRequest) *comment.Form {
+	return &comment.Form{
+		Body:     swag.StringValue(r.Body),
+		Internal: r.Internal,
+	}
+}
@@ -0,0 +1,19 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/krivenkov/order/internal/model/erasure"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func ErasureFromModel(e *erasure.Erasure) *models.ErasureReceipt {
+	return &models.ErasureReceipt{
+		ID:          ptr.Pointer(strfmt.UUID(e.ID)),
+		UserID:      ptr.Pointer(strfmt.UUID(e.UserID)),
+		Source:      ptr.Pointer(string(e.Source)),
+		RequestedBy: ptr.Pointer(e.RequestedBy),
+		Orders:      ptr.Pointer(int64(e.Orders)),
+		CreatedAt:   ptr.Pointer(strfmt.DateTime(e.TSCreate)),
+	}
+}
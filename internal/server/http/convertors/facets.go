@@ -0,0 +1,62 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func StatusFromModel(s order.Status) string {
+	return s.String()
+}
+
+func FacetsFromModel(f *order.Facets) *models.GetFacetsResponse {
+	res := &models.GetFacetsResponse{
+		Statuses: make([]*models.StatusFacet, 0),
+		Dates:    make([]*models.DateFacet, 0),
+		Tags:     make([]*models.TagFacet, 0),
+	}
+
+	if f == nil {
+		return res
+	}
+
+	for _, s := range f.Statuses {
+		res.Statuses = append(res.Statuses, &models.StatusFacet{
+			Status: ptr.Pointer(StatusFromModel(s.Status)),
+			Count:  ptr.Pointer(int64(s.Count)),
+		})
+	}
+
+	for _, d := range f.Dates {
+		res.Dates = append(res.Dates, &models.DateFacet{
+			Date:  ptr.Pointer(strfmt.DateTime(d.Date)),
+			Count: ptr.Pointer(int64(d.Count)),
+		})
+	}
+
+	for _, t := range f.Tags {
+		res.Tags = append(res.Tags, &models.TagFacet{
+			Tag:   ptr.Pointer(t.Tag),
+			Count: ptr.Pointer(int64(t.Count)),
+		})
+	}
+
+	return res
+}
+
+func TagFacetsFromModel(tags []*order.TagFacet) *models.GetTagsResponse {
+	res := &models.GetTagsResponse{
+		Tags: make([]*models.TagFacet, 0, len(tags)),
+	}
+
+	for _, t := range tags {
+		res.Tags = append(res.Tags, &models.TagFacet{
+			Tag:   ptr.Pointer(t.Tag),
+			Count: ptr.Pointer(int64(t.Count)),
+		})
+	}
+
+	return res
+}
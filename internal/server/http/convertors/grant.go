@@ -0,0 +1,36 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/krivenkov/order/internal/model/grant"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func GrantFromModel(g *grant.Grant) *models.Grant {
+	return &models.Grant{
+		OrderID:    ptr.Pointer(strfmt.UUID(g.OrderID)),
+		UserID:     ptr.Pointer(strfmt.UUID(g.UserID)),
+		Permission: ptr.Pointer(string(g.Permission)),
+		GrantedBy:  ptr.Pointer(strfmt.UUID(g.GrantedBy)),
+		CreatedAt:  ptr.Pointer(strfmt.DateTime(g.TSCreate)),
+		ExpiresAt:  dateTimeFromModel(g.TSExpire),
+	}
+}
+
+func GrantsFromModel(grants []*grant.Grant) []*models.Grant {
+	res := make([]*models.Grant, 0, len(grants))
+	for _, g := range grants {
+		res = append(res, GrantFromModel(g))
+	}
+
+	return res
+}
+
+func GrantFormFromRequest(r *models.GrantRequest) *grant.Form {
+	return &grant.Form{
+		Permission: grant.Permission(swag.StringValue(r.Permission)),
+		TSExpire:   dateTimeToModel(r.ExpiresAt),
+	}
+}
@@ -0,0 +1,34 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func HistoryFromModel(entries []*history.Entry) []*models.HistoryEntry {
+	res := make([]*models.HistoryEntry, 0, len(entries))
+
+	for _, e := range entries {
+		changes := make([]*models.HistoryChange, 0, len(e.Changes))
+		for _, c := range e.Changes {
+			changes = append(changes, &models.HistoryChange{
+				Field: ptr.Pointer(c.Field),
+				Old:   ptr.Pointer(c.Old),
+				New:   ptr.Pointer(c.New),
+			})
+		}
+
+		res = append(res, &models.HistoryEntry{
+			ID:        ptr.Pointer(strfmt.UUID(e.ID)),
+			CreatedAt: ptr.Pointer(strfmt.DateTime(e.TSCreate)),
+			Actor:     ptr.Pointer(e.Actor),
+			Source:    ptr.Pointer(string(e.Source)),
+			Action:    ptr.Pointer(string(e.Action)),
+			Changes:   changes,
+		})
+	}
+
+	return res
+}
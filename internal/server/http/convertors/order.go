@@ -1,8 +1,14 @@
 package convertors
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/go-openapi/strfmt"
+	"github.com/krivenkov/order/internal/model"
 	"github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/model/tax"
 	"github.com/krivenkov/order/internal/server/http/models"
 	"github.com/krivenkov/pkg/ptr"
 )
@@ -14,11 +20,94 @@ func OrderFromModel(n *order.Order) *models.Order {
 
 	return &models.Order{
 		ID:          ptr.Pointer(strfmt.UUID(n.ID)),
+		Number:      ptr.Pointer(n.Number),
+		TenantID:    strfmt.UUID(n.TenantID),
+		State:       ptr.Pointer(n.State.String()),
 		Name:        ptr.Pointer(n.Name),
 		Description: ptr.Pointer(n.Description),
+		Tags:        TagsFromModel(n.Tags),
+		Metadata:    n.Metadata,
+
+		ShippingAddress: AddressFromModel(n.ShippingAddress),
+		BillingAddress:  AddressFromModel(n.BillingAddress),
+
+		Totals: &models.OrderTotals{
+			Currency:     n.Totals.Currency,
+			Subtotal:     ptr.Pointer(n.Totals.Subtotal),
+			Discount:     ptr.Pointer(n.Totals.Discount),
+			Tax:          ptr.Pointer(n.Totals.Tax),
+			TaxInclusive: ptr.Pointer(n.Totals.TaxInclusive),
+			Total:        ptr.Pointer(n.Totals.Total),
+			Paid:         ptr.Pointer(n.Totals.Paid),
+			Refunded:     ptr.Pointer(n.Totals.Refunded),
+		},
+		Discounts: DiscountsFromModel(n.Discounts),
+		Taxes:     TaxesFromModel(n.Taxes),
 	}
 }
 
+// TagsFromModel never returns nil, an order without tags has an empty list
+func TagsFromModel(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+
+	return tags
+}
+
+// MetadataFilterToModel parses the key:value pairs of a filter, a value may contain ':'
+func MetadataFilterToModel(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	res := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: metadata filter %q is not key:value", model.ErrInvalidArgument, pair)
+		}
+
+		if err := order.ValidateMetadataKey(key); err != nil {
+			return nil, err
+		}
+
+		res[key] = value
+	}
+
+	return res, nil
+}
+
+func TaxesFromModel(items []*tax.Line) []*models.OrderTax {
+	res := make([]*models.OrderTax, 0, len(items))
+
+	for _, t := range items {
+		res = append(res, &models.OrderTax{
+			Country:  ptr.Pointer(t.Country),
+			Region:   ptr.Pointer(t.Region),
+			Category: ptr.Pointer(t.Category),
+			Rate:     ptr.Pointer(t.Rate),
+			Taxable:  ptr.Pointer(t.Taxable),
+			Amount:   ptr.Pointer(t.Amount),
+		})
+	}
+
+	return res
+}
+
+func DiscountsFromModel(items []*promo.Discount) []*models.OrderDiscount {
+	res := make([]*models.OrderDiscount, 0, len(items))
+
+	for _, d := range items {
+		res = append(res, &models.OrderDiscount{
+			Code:   ptr.Pointer(d.Code),
+			Amount: ptr.Pointer(d.Amount),
+		})
+	}
+
+	return res
+}
+
 func OrdersFromModel(items []*order.Order) []*models.Order {
 	orders := make([]*models.Order, 0, len(items))
 
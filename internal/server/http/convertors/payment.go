@@ -0,0 +1,27 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func PaymentsFromModel(items []*payment.Payment) []*models.Payment {
+	res := make([]*models.Payment, 0, len(items))
+
+	for _, p := range items {
+		res = append(res, &models.Payment{
+			ID:          ptr.Pointer(strfmt.UUID(p.ID)),
+			Provider:    ptr.Pointer(p.Provider),
+			ExternalRef: ptr.Pointer(p.ExternalRef),
+			Amount:      ptr.Pointer(p.Amount),
+			Currency:    ptr.Pointer(p.Currency),
+			Status:      ptr.Pointer(p.Status.String()),
+			Reason:      p.Reason,
+			CreatedAt:   ptr.Pointer(strfmt.DateTime(p.TSCreate)),
+		})
+	}
+
+	return res
+}
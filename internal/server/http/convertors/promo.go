@@ -0,0 +1,82 @@
+package convertors
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func PromoFromModel(p *promo.Promo) *models.Promo {
+	skus := p.SKUs
+	if skus == nil {
+		skus = []string{}
+	}
+
+	return &models.Promo{
+		ID:             ptr.Pointer(strfmt.UUID(p.ID)),
+		Code:           ptr.Pointer(p.Code),
+		Kind:           ptr.Pointer(p.Kind.String()),
+		Scope:          ptr.Pointer(p.Scope.String()),
+		Value:          ptr.Pointer(p.Value),
+		Currency:       p.Currency,
+		Skus:           skus,
+		MinAmount:      ptr.Pointer(p.MinAmount),
+		ValidFrom:      dateTimeFromModel(p.ValidFrom),
+		ValidTo:        dateTimeFromModel(p.ValidTo),
+		MaxUses:        ptr.Pointer(int64(p.MaxUses)),
+		MaxUsesPerUser: ptr.Pointer(int64(p.MaxUsesPerUser)),
+		Uses:           ptr.Pointer(int64(p.Uses)),
+		CreatedAt:      ptr.Pointer(strfmt.DateTime(p.TSCreate)),
+		UpdatedAt:      ptr.Pointer(strfmt.DateTime(p.TSModify)),
+	}
+}
+
+func PromosFromModel(items []*promo.Promo) []*models.Promo {
+	res := make([]*models.Promo, 0, len(items))
+
+	for _, p := range items {
+		res = append(res, PromoFromModel(p))
+	}
+
+	return res
+}
+
+// PromoFormToModel leaves unknown kinds and scopes zero for the form validation to reject
+func PromoFormToModel(r *models.PromoRequest) *promo.Form {
+	kind, _ := promo.ParseKind(swag.StringValue(r.Kind))
+	scope, _ := promo.ParseScope(swag.StringValue(r.Scope))
+
+	return &promo.Form{
+		Code:           swag.StringValue(r.Code),
+		Kind:           kind,
+		Scope:          scope,
+		Value:          swag.Int64Value(r.Value),
+		Currency:       r.Currency,
+		SKUs:           r.Skus,
+		MinAmount:      r.MinAmount,
+		ValidFrom:      dateTimeToModel(r.ValidFrom),
+		ValidTo:        dateTimeToModel(r.ValidTo),
+		MaxUses:        int(r.MaxUses),
+		MaxUsesPerUser: int(r.MaxUsesPerUser),
+	}
+}
+
+func dateTimeFromModel(t *time.Time) *strfmt.DateTime {
+	if t == nil {
+		return nil
+	}
+
+	return ptr.Pointer(strfmt.DateTime(*t))
+}
+
+func dateTimeToModel(t *strfmt.DateTime) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	return ptr.Pointer(time.Time(*t))
+}
@@ -0,0 +1,129 @@
+package convertors
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/recurring"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func RecurringOrderFromModel(t *recurring.Template) *models.RecurringOrder {
+	return &models.RecurringOrder{
+		ID:              ptr.Pointer(strfmt.UUID(t.ID)),
+		Name:            ptr.Pointer(t.Name),
+		Cron:            t.Schedule.Cron,
+		IntervalSeconds: int64(t.Schedule.Interval / time.Second),
+		NextRunAt:       ptr.Pointer(strfmt.DateTime(t.NextRun)),
+		Paused:          ptr.Pointer(t.Paused),
+		Order:           OrderFormFromModel(t.Order),
+		CreatedAt:       ptr.Pointer(strfmt.DateTime(t.TSCreate)),
+		UpdatedAt:       ptr.Pointer(strfmt.DateTime(t.TSModify)),
+	}
+}
+
+func RecurringOrdersFromModel(items []*recurring.Template) []*models.RecurringOrder {
+	res := make([]*models.RecurringOrder, 0, len(items))
+
+	for _, t := range items {
+		res = append(res, RecurringOrderFromModel(t))
+	}
+
+	return res
+}
+
+func RecurringOrderFormToModel(r *models.RecurringOrderRequest) *recurring.Form {
+	form := &recurring.Form{
+		Name: swag.StringValue(r.Name),
+		Schedule: recurring.Schedule{
+			Cron:     r.Cron,
+			Interval: time.Duration(r.IntervalSeconds) * time.Second,
+		},
+	}
+
+	if r.Order != nil {
+		form.Order = OrderFormToModel(r.Order)
+	}
+
+	return form
+}
+
+func RecurringRunsFromModel(items []*recurring.Run) []*models.RecurringRun {
+	res := make([]*models.RecurringRun, 0, len(items))
+
+	for _, r := range items {
+		res = append(res, &models.RecurringRun{
+			ID:          ptr.Pointer(strfmt.UUID(r.ID)),
+			CreatedAt:   ptr.Pointer(strfmt.DateTime(r.TSCreate)),
+			ScheduledAt: ptr.Pointer(strfmt.DateTime(r.ScheduledAt)),
+			OrderID:     r.OrderID,
+			Error:       r.Error,
+		})
+	}
+
+	return res
+}
+
+func OrderFormToModel(r *models.CreateOrderRequest) *order.Form {
+	form := &order.Form{
+		Name:        r.Name,
+		Description: r.Description,
+
+		ShippingAddress: AddressToModel(r.ShippingAddress),
+		BillingAddress:  AddressToModel(r.BillingAddress),
+
+		Tags:     r.Tags,
+		Metadata: r.Metadata,
+
+		Lines:      LinesToModel(r.Lines),
+		PromoCodes: r.PromoCodes,
+		Draft:      r.Draft,
+	}
+
+	if r.Currency != "" {
+		form.Currency = &r.Currency
+	}
+
+	return form
+}
+
+func OrderFormFromModel(f *order.Form) *models.CreateOrderRequest {
+	if f == nil {
+		return nil
+	}
+
+	lines := make([]*models.CreateOrderLine, 0, len(f.Lines))
+	for _, l := range f.Lines {
+		lines = append(lines, lineFormFromModel(l))
+	}
+
+	return &models.CreateOrderRequest{
+		Name:        f.Name,
+		Description: f.Description,
+
+		ShippingAddress: AddressFromModel(f.ShippingAddress),
+		BillingAddress:  AddressFromModel(f.BillingAddress),
+
+		Tags:     f.Tags,
+		Metadata: f.Metadata,
+
+		Currency:   swag.StringValue(f.Currency),
+		Lines:      lines,
+		PromoCodes: f.PromoCodes,
+	}
+}
+
+func lineFormFromModel(l *line.Form) *models.CreateOrderLine {
+	return &models.CreateOrderLine{
+		Sku:       ptr.Pointer(l.SKU),
+		Name:      l.Name,
+		Quantity:  ptr.Pointer(int64(l.Quantity)),
+		UnitPrice: l.UnitPrice,
+
+		TaxCategory: l.TaxCategory,
+	}
+}
@@ -0,0 +1,52 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func ReturnFromModel(r *refund.Return) *models.Return {
+	refunds := make([]*models.Refund, 0, len(r.Refunds))
+	for _, f := range r.Refunds {
+		refunds = append(refunds, &models.Refund{
+			ID:        ptr.Pointer(strfmt.UUID(f.ID)),
+			PaymentID: ptr.Pointer(strfmt.UUID(f.PaymentID)),
+			Amount:    ptr.Pointer(f.Amount),
+			Currency:  ptr.Pointer(f.Currency),
+			CreatedAt: ptr.Pointer(strfmt.DateTime(f.TSCreate)),
+		})
+	}
+
+	return &models.Return{
+		ID:        ptr.Pointer(strfmt.UUID(r.ID)),
+		LineID:    ptr.Pointer(strfmt.UUID(r.LineID)),
+		Quantity:  ptr.Pointer(int64(r.Quantity)),
+		Reason:    ptr.Pointer(r.Reason),
+		Status:    ptr.Pointer(r.Status.String()),
+		Comment:   r.Comment,
+		CreatedAt: ptr.Pointer(strfmt.DateTime(r.TSCreate)),
+		UpdatedAt: ptr.Pointer(strfmt.DateTime(r.TSModify)),
+		Refunds:   refunds,
+	}
+}
+
+func ReturnsFromModel(items []*refund.Return) []*models.Return {
+	res := make([]*models.Return, 0, len(items))
+
+	for _, r := range items {
+		res = append(res, ReturnFromModel(r))
+	}
+
+	return res
+}
+
+func ReturnFormToModel(r *models.CreateReturnRequest) *refund.ReturnForm {
+	return &refund.ReturnForm{
+		LineID:   r.LineID.String(),
+		Quantity: int(swag.Int64Value(r.Quantity)),
+		Reason:   swag.StringValue(r.Reason),
+	}
+}
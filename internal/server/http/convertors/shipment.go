@@ -0,0 +1,113 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func LinesFromModel(items []*line.Line) []*models.OrderLine {
+	res := make([]*models.OrderLine, 0, len(items))
+
+	for _, l := range items {
+		res = append(res, LineFromModel(l))
+	}
+
+	return res
+}
+
+func LineFromModel(l *line.Line) *models.OrderLine {
+	return &models.OrderLine{
+		ID:        ptr.Pointer(strfmt.UUID(l.ID)),
+		Sku:       ptr.Pointer(l.SKU),
+		Name:      ptr.Pointer(l.Name),
+		Quantity:  ptr.Pointer(int64(l.Quantity)),
+		UnitPrice: ptr.Pointer(l.UnitPrice),
+		Discount:  ptr.Pointer(l.Discount),
+
+		TaxCategory: ptr.Pointer(l.TaxCategory),
+		Tax:         ptr.Pointer(l.Tax),
+	}
+}
+
+func LinesToModel(items []*models.CreateOrderLine) []*line.Form {
+	if len(items) == 0 {
+		return nil
+	}
+
+	res := make([]*line.Form, 0, len(items))
+
+	for _, l := range items {
+		res = append(res, LineToModel(l))
+	}
+
+	return res
+}
+
+func LineToModel(l *models.CreateOrderLine) *line.Form {
+	return &line.Form{
+		SKU:       swag.StringValue(l.Sku),
+		Name:      l.Name,
+		Quantity:  int(swag.Int64Value(l.Quantity)),
+		UnitPrice: l.UnitPrice,
+
+		TaxCategory: l.TaxCategory,
+	}
+}
+
+func ShipmentFromModel(s *shipment.Shipment) *models.Shipment {
+	lines := make([]*models.ShipmentLine, 0, len(s.Lines))
+	for _, l := range s.Lines {
+		lines = append(lines, &models.ShipmentLine{
+			LineID:   ptr.Pointer(strfmt.UUID(l.LineID)),
+			Quantity: ptr.Pointer(int64(l.Quantity)),
+		})
+	}
+
+	events := make([]*models.ShipmentEvent, 0, len(s.Events))
+	for _, e := range s.Events {
+		events = append(events, &models.ShipmentEvent{
+			Status:    ptr.Pointer(e.Status.String()),
+			CreatedAt: ptr.Pointer(strfmt.DateTime(e.TSCreate)),
+		})
+	}
+
+	return &models.Shipment{
+		ID:             ptr.Pointer(strfmt.UUID(s.ID)),
+		Carrier:        ptr.Pointer(s.Carrier),
+		TrackingNumber: ptr.Pointer(s.TrackingNumber),
+		Status:         ptr.Pointer(s.Status.String()),
+		CreatedAt:      ptr.Pointer(strfmt.DateTime(s.TSCreate)),
+		Lines:          lines,
+		Events:         events,
+	}
+}
+
+func ShipmentsFromModel(items []*shipment.Shipment) []*models.Shipment {
+	res := make([]*models.Shipment, 0, len(items))
+
+	for _, s := range items {
+		res = append(res, ShipmentFromModel(s))
+	}
+
+	return res
+}
+
+func ShipmentFormToModel(req *models.CreateShipmentRequest) *shipment.Form {
+	lines := make([]*shipment.Line, 0, len(req.Lines))
+	for _, l := range req.Lines {
+		lines = append(lines, &shipment.Line{
+			LineID:   l.LineID.String(),
+			Quantity: int(swag.Int64Value(l.Quantity)),
+		})
+	}
+
+	return &shipment.Form{
+		Carrier:        swag.StringValue(req.Carrier),
+		TrackingNumber: req.TrackingNumber,
+		Lines:          lines,
+	}
+}
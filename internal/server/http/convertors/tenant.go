@@ -0,0 +1,25 @@
+package convertors
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/pkg/ptr"
+)
+
+func TenantFromModel(t *tenant.Tenant) *models.Tenant {
+	return &models.Tenant{
+		ID:        ptr.Pointer(strfmt.UUID(t.ID)),
+		Name:      ptr.Pointer(t.Name),
+		CreatedAt: ptr.Pointer(strfmt.DateTime(t.TSCreate)),
+	}
+}
+
+func TenantMemberFromModel(m *tenant.Member) *models.TenantMember {
+	return &models.TenantMember{
+		TenantID:  ptr.Pointer(strfmt.UUID(m.TenantID)),
+		UserID:    ptr.Pointer(strfmt.UUID(m.UserID)),
+		Role:      ptr.Pointer(string(m.Role)),
+		CreatedAt: ptr.Pointer(strfmt.DateTime(m.TSCreate)),
+	}
+}
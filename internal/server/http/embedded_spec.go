@@ -84,6 +84,48 @@ func init() {
             "default": "asc",
             "name": "sortDirection",
             "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Drafts are left out unless true, then only drafts are returned.",
+            "name": "draft",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying every tag.",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying at least one of the tags.",
+            "name": "tagsAny",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders with the metadata key set to the value, as key:value.",
+            "name": "metadata",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Adds the orders shared with the user to its own ones, ignored in an organisation.",
+            "name": "includeShared",
+            "in": "query"
           }
         ],
         "responses": {
@@ -105,6 +147,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal Server Error",
             "schema": {
@@ -158,6 +206,18 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal Server Error",
             "schema": {
@@ -167,25 +227,34 @@ func init() {
         }
       }
     },
-    "/orders/count": {
+    "/admin/orders/{id}/comments": {
       "get": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
         ],
-        "summary": "Get a count of all orders",
-        "operationId": "get-orders-count",
+        "summary": "Get comments of an order, oldest first with the internal notes",
+        "operationId": "get-admin-order-comments",
         "parameters": [
           {
-            "type": "string",
-            "name": "q",
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "default": 0,
+            "name": "offset",
             "in": "query"
           }
         ],
@@ -193,7 +262,13 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/GetCountResponse"
+              "$ref": "#/definitions/GetCommentsResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -202,6 +277,18 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal Server Error",
             "schema": {
@@ -209,28 +296,44 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/orders/{id}": {
-      "get": {
+      },
+      "post": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
+        ],
+        "summary": "Comment on an order",
+        "operationId": "create-admin-order-comment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/StaffCommentRequest"
+            }
+          }
         ],
-        "summary": "Get order",
-        "operationId": "get-order",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/GetOrderResponse"
+              "$ref": "#/definitions/GetCommentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -259,10 +362,20 @@ func init() {
           }
         }
       },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/orders/{id}/comments/{commentId}": {
       "put": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
         "consumes": [
@@ -272,16 +385,16 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
         ],
-        "summary": "Update order",
-        "operationId": "update-order",
+        "summary": "Edit a comment of the user",
+        "operationId": "update-admin-order-comment",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "schema": {
-              "$ref": "#/definitions/UpdateOrderRequest"
+              "$ref": "#/definitions/CommentRequest"
             }
           }
         ],
@@ -289,7 +402,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/UpdateOrderResponse"
+              "$ref": "#/definitions/GetCommentResponse"
             }
           },
           "400": {
@@ -327,17 +440,17 @@ func init() {
       "delete": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
         ],
-        "summary": "Delete order",
-        "operationId": "delete-order",
+        "summary": "Delete a comment of any author",
+        "operationId": "delete-admin-order-comment",
         "responses": {
           "204": {
             "description": "OK"
@@ -374,272 +487,134 @@ func init() {
           "name": "id",
           "in": "path",
           "required": true
-        }
-      ]
-    }
-  },
-  "definitions": {
-    "CreateOrderRequest": {
-      "type": "object",
-      "required": [
-        "name",
-        "description"
-      ],
-      "properties": {
-        "description": {
-          "description": "The description of the order.",
-          "type": "string"
         },
-        "name": {
-          "description": "The name of the order.",
-          "type": "string"
+        {
+          "type": "string",
+          "name": "commentId",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "CreateOrderResponse": {
-      "type": "object",
-      "required": [
-        "order"
-      ],
-      "properties": {
-        "order": {
-          "$ref": "#/definitions/Order"
+    "/admin/orders/{id}/returns/{returnId}/approve": {
+      "post": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Approve a return and refund it from the order payments",
+        "operationId": "approve-return",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/DecideReturnRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetReturnResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
-      }
-    },
-    "Error": {
-      "type": "object",
-      "required": [
-        "error",
-        "errorDescription"
-      ],
-      "properties": {
-        "error": {
+      },
+      "parameters": [
+        {
           "type": "string",
-          "enum": [
-            "server_error",
-            "access_denied",
-            "invalid_grant",
-            "not_found",
-            "invalid_request"
-          ]
+          "name": "id",
+          "in": "path",
+          "required": true
         },
-        "errorDescription": {
+        {
           "type": "string",
-          "example": "Internal Server Error"
-        }
-      }
-    },
-    "GetCountResponse": {
-      "type": "object",
-      "required": [
-        "count"
-      ],
-      "properties": {
-        "count": {
-          "description": "Content version id of the order.",
-          "type": "integer"
+          "name": "returnId",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "GetOrderResponse": {
-      "type": "object",
-      "required": [
-        "order"
-      ],
-      "properties": {
-        "order": {
-          "$ref": "#/definitions/Order"
-        }
-      }
-    },
-    "GetOrdersResponse": {
-      "type": "object",
-      "required": [
-        "orders",
-        "pagination"
-      ],
-      "properties": {
-        "orders": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Order"
-          }
-        },
-        "pagination": {
-          "$ref": "#/definitions/Pagination"
-        }
-      }
-    },
-    "Order": {
-      "type": "object",
-      "required": [
-        "id",
-        "name",
-        "description"
-      ],
-      "properties": {
-        "description": {
-          "description": "The description of the order.",
-          "type": "string"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid",
-          "example": "123e4567-e89b-12d3-a456-426614174000"
-        },
-        "name": {
-          "description": "The name of the order.",
-          "type": "string"
-        }
-      }
-    },
-    "Pagination": {
-      "type": "object",
-      "title": "Pagination",
-      "required": [
-        "limit",
-        "offset",
-        "total"
-      ],
-      "properties": {
-        "limit": {
-          "description": "limit of orders",
-          "type": "number",
-          "default": 1,
-          "minimum": 1
-        },
-        "offset": {
-          "description": "offset",
-          "type": "number",
-          "default": 1,
-          "minimum": 1
-        },
-        "total": {
-          "description": "Total items",
-          "type": "number"
-        }
-      }
-    },
-    "UpdateOrderRequest": {
-      "type": "object",
-      "required": [
-        "name",
-        "description"
-      ],
-      "properties": {
-        "description": {
-          "description": "The description of the order.",
-          "type": "string"
-        },
-        "name": {
-          "description": "The name of the order.",
-          "type": "string"
-        }
-      }
-    },
-    "UpdateOrderResponse": {
-      "type": "object",
-      "required": [
-        "order"
-      ],
-      "properties": {
-        "order": {
-          "$ref": "#/definitions/Order"
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "JWT": {
-      "description": "JSON Web Token",
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "tags": [
-    {
-      "name": "order"
-    }
-  ],
-  "x-components": {}
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "API specification for the order-api service",
-    "title": "order-api",
-    "version": "1.0.1"
-  },
-  "host": "127.0.0.1",
-  "basePath": "/api/v1/order",
-  "paths": {
-    "/": {
-      "get": {
+    "/admin/orders/{id}/returns/{returnId}/reject": {
+      "post": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
         ],
-        "summary": "Get a list of all orders",
-        "operationId": "get-orders",
+        "summary": "Reject a return",
+        "operationId": "reject-return",
         "parameters": [
           {
-            "maximum": 200,
-            "minimum": 10,
-            "type": "number",
-            "default": 50,
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "minimum": 0,
-            "type": "number",
-            "default": 0,
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "q",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "id",
-              "name"
-            ],
-            "type": "string",
-            "default": "name",
-            "name": "sortBy",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "asc",
-              "desc"
-            ],
-            "type": "string",
-            "default": "asc",
-            "name": "sortDirection",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/DecideReturnRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/GetOrdersResponse"
+              "$ref": "#/definitions/GetReturnResponse"
             }
           },
           "400": {
@@ -654,6 +629,24 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal Server Error",
             "schema": {
@@ -662,10 +655,26 @@ func init() {
           }
         }
       },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "returnId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/orders/{id}/shipments": {
       "post": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
         "consumes": [
@@ -675,16 +684,16 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
         ],
-        "summary": "Create new order",
-        "operationId": "create-order",
+        "summary": "Record a shipment of order lines, the order is fulfilled once every line has shipped",
+        "operationId": "create-shipment",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "schema": {
-              "$ref": "#/definitions/CreateOrderRequest"
+              "$ref": "#/definitions/CreateShipmentRequest"
             }
           }
         ],
@@ -692,7 +701,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/CreateOrderResponse"
+              "$ref": "#/definitions/GetShipmentResponse"
             }
           },
           "400": {
@@ -707,6 +716,24 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal Server Error",
             "schema": {
@@ -714,27 +741,131 @@ func init() {
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/orders/count": {
-      "get": {
+    "/admin/orders/{id}/shipments/{shipmentId}/status": {
+      "put": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
         ],
-        "summary": "Get a count of all orders",
-        "operationId": "get-orders-count",
+        "summary": "Move a shipment along its status timeline",
+        "operationId": "update-shipment-status",
         "parameters": [
           {
-            "type": "string",
-            "name": "q",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/UpdateShipmentStatusRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetShipmentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "shipmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/promo-codes": {
+      "get": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Get promo codes ordered by code",
+        "operationId": "get-promo-codes",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "default": 0,
+            "name": "offset",
             "in": "query"
           }
         ],
@@ -742,7 +873,72 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/GetCountResponse"
+              "$ref": "#/definitions/GetPromosResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Create promo code",
+        "operationId": "create-promo-code",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/PromoRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetPromoResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -751,6 +947,18 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal Server Error",
             "schema": {
@@ -760,26 +968,26 @@ func init() {
         }
       }
     },
-    "/orders/{id}": {
+    "/admin/promo-codes/{id}": {
       "get": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
         ],
-        "summary": "Get order",
-        "operationId": "get-order",
+        "summary": "Get promo code",
+        "operationId": "get-promo-code",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/GetOrderResponse"
+              "$ref": "#/definitions/GetPromoResponse"
             }
           },
           "401": {
@@ -811,7 +1019,7 @@ func init() {
       "put": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
         "consumes": [
@@ -821,16 +1029,16 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
         ],
-        "summary": "Update order",
-        "operationId": "update-order",
+        "summary": "Update promo code",
+        "operationId": "update-promo-code",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "schema": {
-              "$ref": "#/definitions/UpdateOrderRequest"
+              "$ref": "#/definitions/PromoRequest"
             }
           }
         ],
@@ -838,7 +1046,7 @@ func init() {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/UpdateOrderResponse"
+              "$ref": "#/definitions/GetPromoResponse"
             }
           },
           "400": {
@@ -865,6 +1073,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal Server Error",
             "schema": {
@@ -876,17 +1090,17 @@ func init() {
       "delete": {
         "security": [
           {
-            "JWT": []
+            "AdminJWT": []
           }
         ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "order"
+          "admin"
         ],
-        "summary": "Delete order",
-        "operationId": "delete-order",
+        "summary": "Delete promo code",
+        "operationId": "delete-promo-code",
         "responses": {
           "204": {
             "description": "OK"
@@ -920,117 +1134,10851 @@ func init() {
       "parameters": [
         {
           "type": "string",
-          "name": "id",
-          "in": "path",
-          "required": true
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/tenants": {
+      "post": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Create organisation",
+        "operationId": "create-tenant",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/TenantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetTenantResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tenants/{id}/members/{userId}": {
+      "put": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Add organisation member or change its role",
+        "operationId": "set-tenant-member",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/TenantMemberRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetTenantMemberResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Remove organisation member",
+        "operationId": "remove-tenant-member",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/users/{userId}/orders": {
+      "delete": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Permanently delete all orders of the user",
+        "operationId": "erase-user-orders",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "userId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ErasureReceipt"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/by-number/{number}": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order by number",
+        "operationId": "get-order-by-number",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "number",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/count": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get a count of all orders",
+        "operationId": "get-orders-count",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Drafts are left out unless true, then only drafts are returned.",
+            "name": "draft",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying every tag.",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying at least one of the tags.",
+            "name": "tagsAny",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders with the metadata key set to the value, as key:value.",
+            "name": "metadata",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Adds the orders shared with the user to its own ones, ignored in an organisation.",
+            "name": "includeShared",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCountResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/facets": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get facet counts of orders",
+        "operationId": "get-orders-facets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "day",
+              "week",
+              "month"
+            ],
+            "type": "string",
+            "default": "day",
+            "name": "interval",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Drafts are left out unless true, then only drafts are returned.",
+            "name": "draft",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying every tag.",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying at least one of the tags.",
+            "name": "tagsAny",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders with the metadata key set to the value, as key:value.",
+            "name": "metadata",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Adds the orders shared with the user to its own ones, ignored in an organisation.",
+            "name": "includeShared",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetFacetsResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/tags": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get the tags of orders with their counts",
+        "operationId": "get-orders-tags",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetTagsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/trash": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get deleted orders, the most recently deleted first",
+        "operationId": "get-trash",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrdersResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/{id}": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order",
+        "operationId": "get-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Update order",
+        "operationId": "update-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/UpdateOrderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/UpdateOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Delete order",
+        "operationId": "delete-order",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/approval": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get the approval request of an order",
+        "operationId": "get-order-approval",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetApprovalResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/approve": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Approve an order waiting for approval, it is placed",
+        "operationId": "approve-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/DecideApprovalRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/attachments": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get the files attached to an order",
+        "operationId": "get-order-attachments",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetAttachmentsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Attach a file to an order",
+        "operationId": "create-order-attachment",
+        "parameters": [
+          {
+            "type": "file",
+            "description": "PDF, JPEG or PNG by default, up to 10 MB.",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetAttachmentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/attachments/{attachmentId}": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream",
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Download a file attached to an order",
+        "operationId": "get-order-attachment",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string"
+              },
+              "Content-Type": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Delete a file attached to an order",
+        "operationId": "delete-order-attachment",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "attachmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/checkout": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Place a draft order",
+        "operationId": "checkout-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/comments": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get comments of an order, oldest first",
+        "operationId": "get-order-comments",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCommentsResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Comment on an order",
+        "operationId": "create-order-comment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CommentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCommentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/comments/{commentId}": {
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Edit a comment of the user",
+        "operationId": "update-order-comment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CommentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCommentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Delete a comment of the user",
+        "operationId": "delete-order-comment",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "commentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/grants": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get the users an order is shared with",
+        "operationId": "get-order-grants",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetGrantsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/grants/{userId}": {
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Share an order with a user or change its access",
+        "operationId": "share-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/GrantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetGrantResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Stop sharing an order with a user",
+        "operationId": "unshare-order",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/history": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order change history, newest first",
+        "operationId": "get-order-history",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderHistoryResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/lines": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order line items",
+        "operationId": "get-order-lines",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderLinesResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Add a line item to a draft order",
+        "operationId": "add-order-line",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CreateOrderLine"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderLineResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/lines/{lineId}": {
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Remove a line item from a draft order",
+        "operationId": "remove-order-line",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Change the quantity of a draft order line",
+        "operationId": "update-order-line",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/UpdateOrderLineRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderLineResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "lineId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/payments": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order payment attempts, the oldest first",
+        "operationId": "get-payments",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetPaymentsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/reject": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Reject an order waiting for approval",
+        "operationId": "reject-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/DecideApprovalRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/restore": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Restore deleted order",
+        "operationId": "restore-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/returns": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order return requests with their refunds",
+        "operationId": "get-returns",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetReturnsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Request a return of units of an order line",
+        "operationId": "create-return",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CreateReturnRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetReturnResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/shipments": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order shipments with their status timelines",
+        "operationId": "get-shipments",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetShipmentsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/recurring-orders": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Get recurring orders ordered by the next run",
+        "operationId": "get-recurring-orders",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrdersResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Create recurring order",
+        "operationId": "create-recurring-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/RecurringOrderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/recurring-orders/{id}": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Get recurring order",
+        "operationId": "get-recurring-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Update recurring order, a changed schedule starts over from now",
+        "operationId": "update-recurring-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/RecurringOrderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Delete recurring order, the orders it created are kept",
+        "operationId": "delete-recurring-order",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/recurring-orders/{id}/pause": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Pause recurring order",
+        "operationId": "pause-recurring-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/recurring-orders/{id}/resume": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Resume recurring order, the runs missed while paused are skipped",
+        "operationId": "resume-recurring-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/recurring-orders/{id}/runs": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Get orders created from recurring order, the latest first",
+        "operationId": "get-recurring-order-runs",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringRunsResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "Address": {
+      "description": "Postal address, the rules for the postal code and the region depend on the country.",
+      "type": "object",
+      "required": [
+        "name",
+        "line1",
+        "city",
+        "country",
+        "phone"
+      ],
+      "properties": {
+        "city": {
+          "description": "City or locality.",
+          "type": "string",
+          "maxLength": 128
+        },
+        "country": {
+          "description": "ISO 3166-1 alpha-2 country code.",
+          "type": "string",
+          "maxLength": 2,
+          "minLength": 2,
+          "example": "GB"
+        },
+        "line1": {
+          "description": "Street address.",
+          "type": "string",
+          "maxLength": 256
+        },
+        "line2": {
+          "description": "Apartment, suite, building.",
+          "type": "string",
+          "maxLength": 256
+        },
+        "name": {
+          "description": "Recipient or payer name.",
+          "type": "string",
+          "maxLength": 128
+        },
+        "phone": {
+          "description": "Contact phone in international format.",
+          "type": "string",
+          "example": "+442071234567"
+        },
+        "postalCode": {
+          "description": "Postal code, omitted in countries without postal codes.",
+          "type": "string",
+          "example": "NW1 6XE"
+        },
+        "region": {
+          "description": "State, province or prefecture, required in some countries.",
+          "type": "string",
+          "maxLength": 128
+        }
+      }
+    },
+    "Approval": {
+      "description": "Request to approve an order of an organisation above the approval threshold.",
+      "type": "object",
+      "required": [
+        "id",
+        "orderId",
+        "requestedBy",
+        "approvers",
+        "status",
+        "level",
+        "dueAt",
+        "createdAt",
+        "updatedAt"
+      ],
+      "properties": {
+        "approvers": {
+          "description": "Approvers of the organisation who may decide, the requester is never one of them.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid",
+            "example": "123e4567-e89b-12d3-a456-426614174000"
+          }
+        },
+        "comment": {
+          "description": "Comment of the approver who approved or rejected the order.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "decidedBy": {
+          "type": "string"
+        },
+        "dueAt": {
+          "description": "When the request escalates unless decided.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "level": {
+          "description": "Number of escalations.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "orderId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "requestedBy": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "approved",
+            "rejected"
+          ]
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "Attachment": {
+      "description": "File attached to an order.",
+      "type": "object",
+      "required": [
+        "id",
+        "orderId",
+        "userId",
+        "fileName",
+        "contentType",
+        "size",
+        "createdAt"
+      ],
+      "properties": {
+        "contentType": {
+          "description": "Detected from the content of the file.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "fileName": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "orderId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "size": {
+          "description": "Size in bytes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "userId": {
+          "description": "Who uploaded the file.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "Comment": {
+      "description": "Message of the thread of an order.",
+      "type": "object",
+      "required": [
+        "id",
+        "orderId",
+        "authorId",
+        "body",
+        "internal",
+        "createdAt"
+      ],
+      "properties": {
+        "authorId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "body": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "editedAt": {
+          "description": "Missing for a comment never edited.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "internal": {
+          "description": "Note of the staff, customers never see it.",
+          "type": "boolean"
+        },
+        "orderId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CommentRequest": {
+      "type": "object",
+      "required": [
+        "body"
+      ],
+      "properties": {
+        "body": {
+          "type": "string",
+          "maxLength": 4000,
+          "minLength": 1
+        }
+      }
+    },
+    "CreateOrderLine": {
+      "type": "object",
+      "required": [
+        "sku",
+        "quantity"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 256
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "sku": {
+          "type": "string",
+          "maxLength": 64
+        },
+        "taxCategory": {
+          "description": "Tax category of the product, the standard rate applies when empty.",
+          "type": "string",
+          "maxLength": 32,
+          "example": "reduced"
+        },
+        "unitPrice": {
+          "description": "Price of one unit in minor units of the order currency.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "CreateOrderRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "description"
+      ],
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "currency": {
+          "description": "ISO 4217 code, required when lines have prices.",
+          "type": "string",
+          "example": "EUR"
+        },
+        "description": {
+          "description": "The description of the order.",
+          "type": "string"
+        },
+        "draft": {
+          "description": "Create a draft, its lines can change until the checkout places it.",
+          "type": "boolean"
+        },
+        "lines": {
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "$ref": "#/definitions/CreateOrderLine"
+          }
+        },
+        "metadata": {
+          "description": "Keys and values of integrators: up to 50 keys of letters, digits, '_' or '-' and at most 40 characters, values of at most 500 characters.",
+          "type": "object",
+          "maxProperties": 50,
+          "additionalProperties": {
+            "type": "string",
+            "maxLength": 500
+          }
+        },
+        "name": {
+          "description": "The name of the order.",
+          "type": "string"
+        },
+        "promoCodes": {
+          "description": "Promo codes to apply, line discounts go before order discounts.",
+          "type": "array",
+          "maxItems": 5,
+          "items": {
+            "type": "string"
+          }
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "tags": {
+          "description": "Free-form tags, matched regardless of case.",
+          "type": "array",
+          "maxItems": 20,
+          "items": {
+            "type": "string",
+            "maxLength": 50
+          }
+        }
+      }
+    },
+    "CreateOrderResponse": {
+      "type": "object",
+      "required": [
+        "order"
+      ],
+      "properties": {
+        "order": {
+          "$ref": "#/definitions/Order"
+        }
+      }
+    },
+    "CreateReturnRequest": {
+      "type": "object",
+      "required": [
+        "lineId",
+        "quantity",
+        "reason"
+      ],
+      "properties": {
+        "lineId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "reason": {
+          "type": "string",
+          "maxLength": 1024
+        }
+      }
+    },
+    "CreateShipmentRequest": {
+      "type": "object",
+      "required": [
+        "carrier",
+        "lines"
+      ],
+      "properties": {
+        "carrier": {
+          "type": "string",
+          "maxLength": 64
+        },
+        "lines": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/ShipmentLine"
+          }
+        },
+        "trackingNumber": {
+          "type": "string",
+          "maxLength": 128
+        }
+      }
+    },
+    "DateFacet": {
+      "type": "object",
+      "required": [
+        "date",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "Number of orders created within the bucket.",
+          "type": "integer"
+        },
+        "date": {
+          "description": "The start of the date bucket.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "DecideApprovalRequest": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "description": "Required to reject.",
+          "type": "string",
+          "maxLength": 1024
+        }
+      }
+    },
+    "DecideReturnRequest": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string",
+          "maxLength": 1024
+        }
+      }
+    },
+    "ErasureReceipt": {
+      "type": "object",
+      "required": [
+        "id",
+        "userId",
+        "source",
+        "requestedBy",
+        "orders",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "orders": {
+          "description": "Number of erased orders.",
+          "type": "integer"
+        },
+        "requestedBy": {
+          "description": "ID of the user who requested the erasure.",
+          "type": "string"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "bus",
+            "http"
+          ]
+        },
+        "userId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "required": [
+        "error",
+        "errorDescription"
+      ],
+      "properties": {
+        "error": {
+          "type": "string",
+          "enum": [
+            "server_error",
+            "access_denied",
+            "invalid_grant",
+            "not_found",
+            "invalid_request"
+          ]
+        },
+        "errorDescription": {
+          "type": "string",
+          "example": "Internal Server Error"
+        }
+      }
+    },
+    "GetApprovalResponse": {
+      "type": "object",
+      "required": [
+        "approval"
+      ],
+      "properties": {
+        "approval": {
+          "$ref": "#/definitions/Approval"
+        }
+      }
+    },
+    "GetAttachmentResponse": {
+      "type": "object",
+      "required": [
+        "attachment"
+      ],
+      "properties": {
+        "attachment": {
+          "$ref": "#/definitions/Attachment"
+        }
+      }
+    },
+    "GetAttachmentsResponse": {
+      "type": "object",
+      "required": [
+        "attachments"
+      ],
+      "properties": {
+        "attachments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Attachment"
+          }
+        }
+      }
+    },
+    "GetCommentResponse": {
+      "type": "object",
+      "required": [
+        "comment"
+      ],
+      "properties": {
+        "comment": {
+          "$ref": "#/definitions/Comment"
+        }
+      }
+    },
+    "GetCommentsResponse": {
+      "type": "object",
+      "required": [
+        "comments",
+        "pagination"
+      ],
+      "properties": {
+        "comments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Comment"
+          }
+        },
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        }
+      }
+    },
+    "GetCountResponse": {
+      "type": "object",
+      "required": [
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "Content version id of the order.",
+          "type": "integer"
+        }
+      }
+    },
+    "GetFacetsResponse": {
+      "type": "object",
+      "required": [
+        "statuses",
+        "dates",
+        "tags"
+      ],
+      "properties": {
+        "dates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DateFacet"
+          }
+        },
+        "statuses": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StatusFacet"
+          }
+        },
+        "tags": {
+          "description": "The most used first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TagFacet"
+          }
+        }
+      }
+    },
+    "GetGrantResponse": {
+      "type": "object",
+      "required": [
+        "grant"
+      ],
+      "properties": {
+        "grant": {
+          "$ref": "#/definitions/Grant"
+        }
+      }
+    },
+    "GetGrantsResponse": {
+      "type": "object",
+      "required": [
+        "grants"
+      ],
+      "properties": {
+        "grants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Grant"
+          }
+        }
+      }
+    },
+    "GetOrderHistoryResponse": {
+      "type": "object",
+      "required": [
+        "entries",
+        "pagination"
+      ],
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HistoryEntry"
+          }
+        },
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        }
+      }
+    },
+    "GetOrderLineResponse": {
+      "type": "object",
+      "required": [
+        "line",
+        "order"
+      ],
+      "properties": {
+        "line": {
+          "$ref": "#/definitions/OrderLine"
+        },
+        "order": {
+          "$ref": "#/definitions/Order"
+        }
+      }
+    },
+    "GetOrderLinesResponse": {
+      "type": "object",
+      "required": [
+        "lines"
+      ],
+      "properties": {
+        "lines": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/OrderLine"
+          }
+        }
+      }
+    },
+    "GetOrderResponse": {
+      "type": "object",
+      "required": [
+        "order"
+      ],
+      "properties": {
+        "order": {
+          "$ref": "#/definitions/Order"
+        }
+      }
+    },
+    "GetOrdersResponse": {
+      "type": "object",
+      "required": [
+        "orders",
+        "pagination"
+      ],
+      "properties": {
+        "orders": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Order"
+          }
+        },
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        }
+      }
+    },
+    "GetPaymentsResponse": {
+      "type": "object",
+      "required": [
+        "payments"
+      ],
+      "properties": {
+        "payments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Payment"
+          }
+        }
+      }
+    },
+    "GetPromoResponse": {
+      "type": "object",
+      "required": [
+        "promo"
+      ],
+      "properties": {
+        "promo": {
+          "$ref": "#/definitions/Promo"
+        }
+      }
+    },
+    "GetPromosResponse": {
+      "type": "object",
+      "required": [
+        "promos",
+        "pagination"
+      ],
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        },
+        "promos": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Promo"
+          }
+        }
+      }
+    },
+    "GetRecurringOrderResponse": {
+      "type": "object",
+      "required": [
+        "recurringOrder"
+      ],
+      "properties": {
+        "recurringOrder": {
+          "$ref": "#/definitions/RecurringOrder"
+        }
+      }
+    },
+    "GetRecurringOrdersResponse": {
+      "type": "object",
+      "required": [
+        "recurringOrders",
+        "pagination"
+      ],
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        },
+        "recurringOrders": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RecurringOrder"
+          }
+        }
+      }
+    },
+    "GetRecurringRunsResponse": {
+      "type": "object",
+      "required": [
+        "runs",
+        "pagination"
+      ],
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        },
+        "runs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RecurringRun"
+          }
+        }
+      }
+    },
+    "GetReturnResponse": {
+      "type": "object",
+      "required": [
+        "return"
+      ],
+      "properties": {
+        "return": {
+          "$ref": "#/definitions/Return"
+        }
+      }
+    },
+    "GetReturnsResponse": {
+      "type": "object",
+      "required": [
+        "returns"
+      ],
+      "properties": {
+        "returns": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Return"
+          }
+        }
+      }
+    },
+    "GetShipmentResponse": {
+      "type": "object",
+      "required": [
+        "shipment"
+      ],
+      "properties": {
+        "shipment": {
+          "$ref": "#/definitions/Shipment"
+        }
+      }
+    },
+    "GetShipmentsResponse": {
+      "type": "object",
+      "required": [
+        "shipments"
+      ],
+      "properties": {
+        "shipments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Shipment"
+          }
+        }
+      }
+    },
+    "GetTagsResponse": {
+      "type": "object",
+      "required": [
+        "tags"
+      ],
+      "properties": {
+        "tags": {
+          "description": "The most used first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TagFacet"
+          }
+        }
+      }
+    },
+    "GetTenantMemberResponse": {
+      "type": "object",
+      "required": [
+        "member"
+      ],
+      "properties": {
+        "member": {
+          "$ref": "#/definitions/TenantMember"
+        }
+      }
+    },
+    "GetTenantResponse": {
+      "type": "object",
+      "required": [
+        "tenant"
+      ],
+      "properties": {
+        "tenant": {
+          "$ref": "#/definitions/Tenant"
+        }
+      }
+    },
+    "Grant": {
+      "description": "Access of a user to a personal order shared with it.",
+      "type": "object",
+      "required": [
+        "orderId",
+        "userId",
+        "permission",
+        "grantedBy",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "expiresAt": {
+          "description": "The grant never expires when missing.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "grantedBy": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "orderId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "permission": {
+          "description": "Readers see the order, editors also change it but neither deletes nor shares it.",
+          "type": "string",
+          "enum": [
+            "read",
+            "edit"
+          ]
+        },
+        "userId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "GrantRequest": {
+      "type": "object",
+      "required": [
+        "permission"
+      ],
+      "properties": {
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "permission": {
+          "type": "string",
+          "enum": [
+            "read",
+            "edit"
+          ]
+        }
+      }
+    },
+    "HistoryChange": {
+      "type": "object",
+      "required": [
+        "field",
+        "old",
+        "new"
+      ],
+      "properties": {
+        "field": {
+          "type": "string",
+          "example": "name"
+        },
+        "new": {
+          "description": "Value after the change.",
+          "type": "string"
+        },
+        "old": {
+          "description": "Value before the change, empty for a new order.",
+          "type": "string"
+        }
+      }
+    },
+    "HistoryEntry": {
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "actor",
+        "source",
+        "action",
+        "changes"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "status",
+            "delete",
+            "disable",
+            "enable",
+            "restore",
+            "payment",
+            "refund",
+            "lines",
+            "checkout",
+            "approve",
+            "reject",
+            "escalate"
+          ]
+        },
+        "actor": {
+          "description": "ID of the user who made the change.",
+          "type": "string"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HistoryChange"
+          }
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "http",
+            "grpc",
+            "bus",
+            "scheduler"
+          ]
+        }
+      }
+    },
+    "Order": {
+      "type": "object",
+      "required": [
+        "id",
+        "number",
+        "name",
+        "description",
+        "state",
+        "totals",
+        "discounts",
+        "taxes",
+        "tags"
+      ],
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "description": {
+          "description": "The description of the order.",
+          "type": "string"
+        },
+        "discounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/OrderDiscount"
+          }
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "metadata": {
+          "description": "Keys and values attached by integrators.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the order.",
+          "type": "string"
+        },
+        "number": {
+          "description": "Human-readable order number.",
+          "type": "string",
+          "example": "ORD-2026-000123"
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "state": {
+          "description": "Lifecycle state of the order.",
+          "type": "string",
+          "enum": [
+            "draft",
+            "pending_approval",
+            "placed",
+            "paid",
+            "payment_failed",
+            "fulfilled",
+            "partially_refunded",
+            "refunded",
+            "rejected"
+          ]
+        },
+        "tags": {
+          "description": "Lower-cased free-form tags.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "taxes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/OrderTax"
+          }
+        },
+        "tenantId": {
+          "description": "Organisation owning the order, absent for personal orders.",
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "totals": {
+          "$ref": "#/definitions/OrderTotals"
+        }
+      }
+    },
+    "OrderDiscount": {
+      "description": "Promo code applied to the order.",
+      "type": "object",
+      "required": [
+        "code",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount taken off the order.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "code": {
+          "type": "string",
+          "example": "SPRING-10"
+        }
+      }
+    },
+    "OrderLine": {
+      "type": "object",
+      "required": [
+        "id",
+        "sku",
+        "name",
+        "quantity",
+        "unitPrice",
+        "discount",
+        "taxCategory",
+        "tax"
+      ],
+      "properties": {
+        "discount": {
+          "description": "Part of the promo code discounts taken off the line.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "name": {
+          "type": "string"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sku": {
+          "type": "string"
+        },
+        "tax": {
+          "description": "Tax of the line, included in its price when the order prices include taxes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "taxCategory": {
+          "type": "string"
+        },
+        "unitPrice": {
+          "description": "Price of one unit in minor units of the order currency.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "OrderTax": {
+      "description": "Tax charged on the order.",
+      "type": "object",
+      "required": [
+        "country",
+        "region",
+        "category",
+        "rate",
+        "taxable",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "category": {
+          "description": "Tax category, empty for the standard rate.",
+          "type": "string"
+        },
+        "country": {
+          "type": "string",
+          "example": "US"
+        },
+        "rate": {
+          "description": "Rate in basis points, 725 is 7.25%.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "region": {
+          "type": "string",
+          "example": "CA"
+        },
+        "taxable": {
+          "description": "Net amount of the lines taxed at the rate.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "OrderTotals": {
+      "description": "Amounts in minor units of the currency.",
+      "type": "object",
+      "required": [
+        "subtotal",
+        "discount",
+        "tax",
+        "taxInclusive",
+        "total",
+        "paid",
+        "refunded"
+      ],
+      "properties": {
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "discount": {
+          "description": "Sum of the promo code discounts.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "paid": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "refunded": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "subtotal": {
+          "description": "Sum of the line amounts.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tax": {
+          "description": "Sum of the line taxes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "taxInclusive": {
+          "description": "True when the prices include the tax, it is added to the total otherwise.",
+          "type": "boolean"
+        },
+        "total": {
+          "description": "Amount due.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Pagination": {
+      "type": "object",
+      "title": "Pagination",
+      "required": [
+        "limit",
+        "offset",
+        "total"
+      ],
+      "properties": {
+        "limit": {
+          "description": "limit of orders",
+          "type": "number",
+          "default": 1,
+          "minimum": 1
+        },
+        "offset": {
+          "description": "offset",
+          "type": "number",
+          "default": 1,
+          "minimum": 1
+        },
+        "total": {
+          "description": "Total items",
+          "type": "number"
+        }
+      }
+    },
+    "Payment": {
+      "type": "object",
+      "required": [
+        "id",
+        "provider",
+        "externalRef",
+        "amount",
+        "currency",
+        "status",
+        "createdAt"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in minor units of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "externalRef": {
+          "description": "Reference of the payment attempt at the provider.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "provider": {
+          "type": "string"
+        },
+        "reason": {
+          "description": "Failure reason reported by the provider.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed"
+          ]
+        }
+      }
+    },
+    "Promo": {
+      "type": "object",
+      "required": [
+        "id",
+        "code",
+        "kind",
+        "scope",
+        "value",
+        "skus",
+        "minAmount",
+        "maxUses",
+        "maxUsesPerUser",
+        "uses",
+        "createdAt",
+        "updatedAt"
+      ],
+      "properties": {
+        "code": {
+          "type": "string",
+          "example": "SPRING-10"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "percentage",
+            "fixed"
+          ]
+        },
+        "maxUses": {
+          "description": "Unlimited when 0.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxUsesPerUser": {
+          "description": "Unlimited when 0.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "minAmount": {
+          "description": "Minimal order subtotal.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "scope": {
+          "type": "string",
+          "enum": [
+            "order",
+            "line"
+          ]
+        },
+        "skus": {
+          "description": "Lines a line discount applies to, all lines when empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "uses": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "validFrom": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "validTo": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "value": {
+          "description": "Percentage, or amount in minor units of the currency for fixed discounts.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "PromoRequest": {
+      "type": "object",
+      "required": [
+        "code",
+        "kind",
+        "scope",
+        "value"
+      ],
+      "properties": {
+        "code": {
+          "type": "string",
+          "maxLength": 32,
+          "minLength": 3,
+          "example": "SPRING-10"
+        },
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "percentage",
+            "fixed"
+          ]
+        },
+        "maxUses": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxUsesPerUser": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "minAmount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "scope": {
+          "type": "string",
+          "enum": [
+            "order",
+            "line"
+          ]
+        },
+        "skus": {
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "type": "string"
+          }
+        },
+        "validFrom": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "validTo": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "value": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "RecurringOrder": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "nextRunAt",
+        "paused",
+        "order",
+        "createdAt",
+        "updatedAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "cron": {
+          "description": "Cron expression in UTC with minute, hour, day of month, month and day of week.",
+          "type": "string",
+          "example": "0 9 * * 1"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "intervalSeconds": {
+          "description": "Interval between orders when there is no cron expression.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string",
+          "example": "Weekly coffee"
+        },
+        "nextRunAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "order": {
+          "$ref": "#/definitions/CreateOrderRequest"
+        },
+        "paused": {
+          "type": "boolean"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "RecurringOrderRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "order"
+      ],
+      "properties": {
+        "cron": {
+          "description": "Cron expression in UTC, either it or intervalSeconds is required.",
+          "type": "string",
+          "example": "0 9 * * 1"
+        },
+        "intervalSeconds": {
+          "description": "Interval between orders, at least an hour.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 3600
+        },
+        "name": {
+          "type": "string",
+          "maxLength": 256,
+          "example": "Weekly coffee"
+        },
+        "order": {
+          "$ref": "#/definitions/CreateOrderRequest"
+        }
+      }
+    },
+    "RecurringRun": {
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "scheduledAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "Why the order was not created.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "orderId": {
+          "description": "Empty when the order was not created.",
+          "type": "string"
+        },
+        "scheduledAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "Refund": {
+      "type": "object",
+      "required": [
+        "id",
+        "paymentId",
+        "amount",
+        "currency",
+        "createdAt"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in minor units of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "paymentId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        }
+      }
+    },
+    "Return": {
+      "type": "object",
+      "required": [
+        "id",
+        "lineId",
+        "quantity",
+        "reason",
+        "status",
+        "createdAt",
+        "updatedAt",
+        "refunds"
+      ],
+      "properties": {
+        "comment": {
+          "description": "Comment of the staff member who approved or rejected the return.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "lineId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "refunds": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Refund"
+          }
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "requested",
+            "approved",
+            "rejected"
+          ]
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "Shipment": {
+      "type": "object",
+      "required": [
+        "id",
+        "carrier",
+        "trackingNumber",
+        "status",
+        "createdAt",
+        "lines",
+        "events"
+      ],
+      "properties": {
+        "carrier": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "events": {
+          "description": "Status timeline, the oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ShipmentEvent"
+          }
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "lines": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ShipmentLine"
+          }
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "shipped",
+            "in_transit",
+            "delivered",
+            "cancelled"
+          ]
+        },
+        "trackingNumber": {
+          "type": "string"
+        }
+      }
+    },
+    "ShipmentEvent": {
+      "type": "object",
+      "required": [
+        "status",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "shipped",
+            "in_transit",
+            "delivered",
+            "cancelled"
+          ]
+        }
+      }
+    },
+    "ShipmentLine": {
+      "type": "object",
+      "required": [
+        "lineId",
+        "quantity"
+      ],
+      "properties": {
+        "lineId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "StaffCommentRequest": {
+      "type": "object",
+      "required": [
+        "body"
+      ],
+      "properties": {
+        "body": {
+          "type": "string",
+          "maxLength": 4000,
+          "minLength": 1
+        },
+        "internal": {
+          "description": "Keeps the comment to the staff.",
+          "type": "boolean"
+        }
+      }
+    },
+    "StatusFacet": {
+      "type": "object",
+      "required": [
+        "status",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "Number of orders in the status.",
+          "type": "integer"
+        },
+        "status": {
+          "description": "The status of the orders.",
+          "type": "string",
+          "example": "created"
+        }
+      }
+    },
+    "TagFacet": {
+      "type": "object",
+      "required": [
+        "tag",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "Number of orders carrying the tag.",
+          "type": "integer"
+        },
+        "tag": {
+          "type": "string",
+          "example": "urgent"
+        }
+      }
+    },
+    "Tenant": {
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "name"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "name": {
+          "type": "string",
+          "example": "Acme"
+        }
+      }
+    },
+    "TenantMember": {
+      "type": "object",
+      "required": [
+        "tenantId",
+        "userId",
+        "role",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "role": {
+          "description": "Viewers read the orders of the organisation, editors change them and approvers approve them too.",
+          "type": "string",
+          "enum": [
+            "viewer",
+            "editor",
+            "approver"
+          ]
+        },
+        "tenantId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "userId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "TenantMemberRequest": {
+      "type": "object",
+      "required": [
+        "role"
+      ],
+      "properties": {
+        "role": {
+          "type": "string",
+          "enum": [
+            "viewer",
+            "editor",
+            "approver"
+          ]
+        }
+      }
+    },
+    "TenantRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 256,
+          "minLength": 1,
+          "example": "Acme"
+        }
+      }
+    },
+    "UpdateOrderLineRequest": {
+      "type": "object",
+      "required": [
+        "quantity"
+      ],
+      "properties": {
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "UpdateOrderRequest": {
+      "description": "Addresses that are not sent are left untouched.",
+      "type": "object",
+      "required": [
+        "name",
+        "description"
+      ],
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "description": {
+          "description": "The description of the order.",
+          "type": "string"
+        },
+        "metadata": {
+          "description": "Replaces the metadata of the order, an empty object removes it, missing keeps it.",
+          "type": "object",
+          "maxProperties": 50,
+          "additionalProperties": {
+            "type": "string",
+            "maxLength": 500
+          }
+        },
+        "name": {
+          "description": "The name of the order.",
+          "type": "string"
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "tags": {
+          "description": "Replace the tags of the order, an empty list removes them, missing keeps them.",
+          "type": "array",
+          "maxItems": 20,
+          "items": {
+            "type": "string",
+            "maxLength": 50
+          }
+        }
+      }
+    },
+    "UpdateOrderResponse": {
+      "type": "object",
+      "required": [
+        "order"
+      ],
+      "properties": {
+        "order": {
+          "$ref": "#/definitions/Order"
+        }
+      }
+    },
+    "UpdateShipmentStatusRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "in_transit",
+            "delivered",
+            "cancelled"
+          ]
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "AdminJWT": {
+      "description": "JSON Web Token of a member of the admin group",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    },
+    "JWT": {
+      "description": "JSON Web Token, the X-Tenant-ID header selects the organisation the request acts in",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "tags": [
+    {
+      "name": "admin"
+    },
+    {
+      "name": "order"
+    },
+    {
+      "name": "recurring"
+    }
+  ],
+  "x-components": {}
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "API specification for the order-api service",
+    "title": "order-api",
+    "version": "1.0.1"
+  },
+  "host": "127.0.0.1",
+  "basePath": "/api/v1/order",
+  "paths": {
+    "/": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get a list of all orders",
+        "operationId": "get-orders",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "id",
+              "name"
+            ],
+            "type": "string",
+            "default": "name",
+            "name": "sortBy",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "name": "sortDirection",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Drafts are left out unless true, then only drafts are returned.",
+            "name": "draft",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying every tag.",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying at least one of the tags.",
+            "name": "tagsAny",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders with the metadata key set to the value, as key:value.",
+            "name": "metadata",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Adds the orders shared with the user to its own ones, ignored in an organisation.",
+            "name": "includeShared",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrdersResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Create new order",
+        "operationId": "create-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CreateOrderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/CreateOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/orders/{id}/comments": {
+      "get": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Get comments of an order, oldest first with the internal notes",
+        "operationId": "get-admin-order-comments",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCommentsResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Comment on an order",
+        "operationId": "create-admin-order-comment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/StaffCommentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCommentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/orders/{id}/comments/{commentId}": {
+      "put": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Edit a comment of the user",
+        "operationId": "update-admin-order-comment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CommentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCommentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Delete a comment of any author",
+        "operationId": "delete-admin-order-comment",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "commentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/orders/{id}/returns/{returnId}/approve": {
+      "post": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Approve a return and refund it from the order payments",
+        "operationId": "approve-return",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/DecideReturnRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetReturnResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "returnId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/orders/{id}/returns/{returnId}/reject": {
+      "post": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Reject a return",
+        "operationId": "reject-return",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/DecideReturnRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetReturnResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "returnId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/orders/{id}/shipments": {
+      "post": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Record a shipment of order lines, the order is fulfilled once every line has shipped",
+        "operationId": "create-shipment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CreateShipmentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetShipmentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/orders/{id}/shipments/{shipmentId}/status": {
+      "put": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Move a shipment along its status timeline",
+        "operationId": "update-shipment-status",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/UpdateShipmentStatusRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetShipmentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "shipmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/promo-codes": {
+      "get": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Get promo codes ordered by code",
+        "operationId": "get-promo-codes",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetPromosResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Create promo code",
+        "operationId": "create-promo-code",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/PromoRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetPromoResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/promo-codes/{id}": {
+      "get": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Get promo code",
+        "operationId": "get-promo-code",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetPromoResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Update promo code",
+        "operationId": "update-promo-code",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/PromoRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetPromoResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Delete promo code",
+        "operationId": "delete-promo-code",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/tenants": {
+      "post": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Create organisation",
+        "operationId": "create-tenant",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/TenantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetTenantResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tenants/{id}/members/{userId}": {
+      "put": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Add organisation member or change its role",
+        "operationId": "set-tenant-member",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/TenantMemberRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetTenantMemberResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Remove organisation member",
+        "operationId": "remove-tenant-member",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/users/{userId}/orders": {
+      "delete": {
+        "security": [
+          {
+            "AdminJWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Permanently delete all orders of the user",
+        "operationId": "erase-user-orders",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "userId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ErasureReceipt"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/by-number/{number}": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order by number",
+        "operationId": "get-order-by-number",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "number",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/count": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get a count of all orders",
+        "operationId": "get-orders-count",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Drafts are left out unless true, then only drafts are returned.",
+            "name": "draft",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying every tag.",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying at least one of the tags.",
+            "name": "tagsAny",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders with the metadata key set to the value, as key:value.",
+            "name": "metadata",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Adds the orders shared with the user to its own ones, ignored in an organisation.",
+            "name": "includeShared",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCountResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/facets": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get facet counts of orders",
+        "operationId": "get-orders-facets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "day",
+              "week",
+              "month"
+            ],
+            "type": "string",
+            "default": "day",
+            "name": "interval",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Drafts are left out unless true, then only drafts are returned.",
+            "name": "draft",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying every tag.",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders carrying at least one of the tags.",
+            "name": "tagsAny",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Keeps the orders with the metadata key set to the value, as key:value.",
+            "name": "metadata",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Adds the orders shared with the user to its own ones, ignored in an organisation.",
+            "name": "includeShared",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetFacetsResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/tags": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get the tags of orders with their counts",
+        "operationId": "get-orders-tags",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetTagsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/trash": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get deleted orders, the most recently deleted first",
+        "operationId": "get-trash",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrdersResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/orders/{id}": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order",
+        "operationId": "get-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Update order",
+        "operationId": "update-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/UpdateOrderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/UpdateOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Delete order",
+        "operationId": "delete-order",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/approval": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get the approval request of an order",
+        "operationId": "get-order-approval",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetApprovalResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/approve": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Approve an order waiting for approval, it is placed",
+        "operationId": "approve-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/DecideApprovalRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/attachments": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get the files attached to an order",
+        "operationId": "get-order-attachments",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetAttachmentsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Attach a file to an order",
+        "operationId": "create-order-attachment",
+        "parameters": [
+          {
+            "type": "file",
+            "description": "PDF, JPEG or PNG by default, up to 10 MB.",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetAttachmentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/attachments/{attachmentId}": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/octet-stream",
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Download a file attached to an order",
+        "operationId": "get-order-attachment",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            },
+            "headers": {
+              "Content-Disposition": {
+                "type": "string"
+              },
+              "Content-Type": {
+                "type": "string"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Delete a file attached to an order",
+        "operationId": "delete-order-attachment",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "attachmentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/checkout": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Place a draft order",
+        "operationId": "checkout-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/comments": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get comments of an order, oldest first",
+        "operationId": "get-order-comments",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCommentsResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Comment on an order",
+        "operationId": "create-order-comment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CommentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCommentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/comments/{commentId}": {
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Edit a comment of the user",
+        "operationId": "update-order-comment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CommentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetCommentResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Delete a comment of the user",
+        "operationId": "delete-order-comment",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "commentId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/grants": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get the users an order is shared with",
+        "operationId": "get-order-grants",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetGrantsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/grants/{userId}": {
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Share an order with a user or change its access",
+        "operationId": "share-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/GrantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetGrantResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Stop sharing an order with a user",
+        "operationId": "unshare-order",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/history": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order change history, newest first",
+        "operationId": "get-order-history",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderHistoryResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/lines": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order line items",
+        "operationId": "get-order-lines",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderLinesResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Add a line item to a draft order",
+        "operationId": "add-order-line",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CreateOrderLine"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderLineResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/lines/{lineId}": {
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Remove a line item from a draft order",
+        "operationId": "remove-order-line",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Change the quantity of a draft order line",
+        "operationId": "update-order-line",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/UpdateOrderLineRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderLineResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "lineId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/payments": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order payment attempts, the oldest first",
+        "operationId": "get-payments",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetPaymentsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/reject": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Reject an order waiting for approval",
+        "operationId": "reject-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/DecideApprovalRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/restore": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Restore deleted order",
+        "operationId": "restore-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/returns": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order return requests with their refunds",
+        "operationId": "get-returns",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetReturnsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Request a return of units of an order line",
+        "operationId": "create-return",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/CreateReturnRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetReturnResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Conflict",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/orders/{id}/shipments": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "order"
+        ],
+        "summary": "Get order shipments with their status timelines",
+        "operationId": "get-shipments",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetShipmentsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/recurring-orders": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Get recurring orders ordered by the next run",
+        "operationId": "get-recurring-orders",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrdersResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Create recurring order",
+        "operationId": "create-recurring-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/RecurringOrderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/recurring-orders/{id}": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Get recurring order",
+        "operationId": "get-recurring-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Update recurring order, a changed schedule starts over from now",
+        "operationId": "update-recurring-order",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/RecurringOrderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Delete recurring order, the orders it created are kept",
+        "operationId": "delete-recurring-order",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/recurring-orders/{id}/pause": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Pause recurring order",
+        "operationId": "pause-recurring-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/recurring-orders/{id}/resume": {
+      "post": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Resume recurring order, the runs missed while paused are skipped",
+        "operationId": "resume-recurring-order",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringOrderResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/recurring-orders/{id}/runs": {
+      "get": {
+        "security": [
+          {
+            "JWT": []
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "recurring"
+        ],
+        "summary": "Get orders created from recurring order, the latest first",
+        "operationId": "get-recurring-order-runs",
+        "parameters": [
+          {
+            "maximum": 200,
+            "minimum": 10,
+            "type": "number",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetRecurringRunsResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "Address": {
+      "description": "Postal address, the rules for the postal code and the region depend on the country.",
+      "type": "object",
+      "required": [
+        "name",
+        "line1",
+        "city",
+        "country",
+        "phone"
+      ],
+      "properties": {
+        "city": {
+          "description": "City or locality.",
+          "type": "string",
+          "maxLength": 128
+        },
+        "country": {
+          "description": "ISO 3166-1 alpha-2 country code.",
+          "type": "string",
+          "maxLength": 2,
+          "minLength": 2,
+          "example": "GB"
+        },
+        "line1": {
+          "description": "Street address.",
+          "type": "string",
+          "maxLength": 256
+        },
+        "line2": {
+          "description": "Apartment, suite, building.",
+          "type": "string",
+          "maxLength": 256
+        },
+        "name": {
+          "description": "Recipient or payer name.",
+          "type": "string",
+          "maxLength": 128
+        },
+        "phone": {
+          "description": "Contact phone in international format.",
+          "type": "string",
+          "example": "+442071234567"
+        },
+        "postalCode": {
+          "description": "Postal code, omitted in countries without postal codes.",
+          "type": "string",
+          "example": "NW1 6XE"
+        },
+        "region": {
+          "description": "State, province or prefecture, required in some countries.",
+          "type": "string",
+          "maxLength": 128
+        }
+      }
+    },
+    "Approval": {
+      "description": "Request to approve an order of an organisation above the approval threshold.",
+      "type": "object",
+      "required": [
+        "id",
+        "orderId",
+        "requestedBy",
+        "approvers",
+        "status",
+        "level",
+        "dueAt",
+        "createdAt",
+        "updatedAt"
+      ],
+      "properties": {
+        "approvers": {
+          "description": "Approvers of the organisation who may decide, the requester is never one of them.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid",
+            "example": "123e4567-e89b-12d3-a456-426614174000"
+          }
+        },
+        "comment": {
+          "description": "Comment of the approver who approved or rejected the order.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "decidedBy": {
+          "type": "string"
+        },
+        "dueAt": {
+          "description": "When the request escalates unless decided.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "level": {
+          "description": "Number of escalations.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "orderId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "requestedBy": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "approved",
+            "rejected"
+          ]
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "Attachment": {
+      "description": "File attached to an order.",
+      "type": "object",
+      "required": [
+        "id",
+        "orderId",
+        "userId",
+        "fileName",
+        "contentType",
+        "size",
+        "createdAt"
+      ],
+      "properties": {
+        "contentType": {
+          "description": "Detected from the content of the file.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "fileName": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "orderId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "size": {
+          "description": "Size in bytes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "userId": {
+          "description": "Who uploaded the file.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "Comment": {
+      "description": "Message of the thread of an order.",
+      "type": "object",
+      "required": [
+        "id",
+        "orderId",
+        "authorId",
+        "body",
+        "internal",
+        "createdAt"
+      ],
+      "properties": {
+        "authorId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "body": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "editedAt": {
+          "description": "Missing for a comment never edited.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "internal": {
+          "description": "Note of the staff, customers never see it.",
+          "type": "boolean"
+        },
+        "orderId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CommentRequest": {
+      "type": "object",
+      "required": [
+        "body"
+      ],
+      "properties": {
+        "body": {
+          "type": "string",
+          "maxLength": 4000,
+          "minLength": 1
+        }
+      }
+    },
+    "CreateOrderLine": {
+      "type": "object",
+      "required": [
+        "sku",
+        "quantity"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 256
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "sku": {
+          "type": "string",
+          "maxLength": 64
+        },
+        "taxCategory": {
+          "description": "Tax category of the product, the standard rate applies when empty.",
+          "type": "string",
+          "maxLength": 32,
+          "example": "reduced"
+        },
+        "unitPrice": {
+          "description": "Price of one unit in minor units of the order currency.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        }
+      }
+    },
+    "CreateOrderRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "description"
+      ],
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "currency": {
+          "description": "ISO 4217 code, required when lines have prices.",
+          "type": "string",
+          "example": "EUR"
+        },
+        "description": {
+          "description": "The description of the order.",
+          "type": "string"
+        },
+        "draft": {
+          "description": "Create a draft, its lines can change until the checkout places it.",
+          "type": "boolean"
+        },
+        "lines": {
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "$ref": "#/definitions/CreateOrderLine"
+          }
+        },
+        "metadata": {
+          "description": "Keys and values of integrators: up to 50 keys of letters, digits, '_' or '-' and at most 40 characters, values of at most 500 characters.",
+          "type": "object",
+          "maxProperties": 50,
+          "additionalProperties": {
+            "type": "string",
+            "maxLength": 500
+          }
+        },
+        "name": {
+          "description": "The name of the order.",
+          "type": "string"
+        },
+        "promoCodes": {
+          "description": "Promo codes to apply, line discounts go before order discounts.",
+          "type": "array",
+          "maxItems": 5,
+          "items": {
+            "type": "string"
+          }
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "tags": {
+          "description": "Free-form tags, matched regardless of case.",
+          "type": "array",
+          "maxItems": 20,
+          "items": {
+            "type": "string",
+            "maxLength": 50
+          }
+        }
+      }
+    },
+    "CreateOrderResponse": {
+      "type": "object",
+      "required": [
+        "order"
+      ],
+      "properties": {
+        "order": {
+          "$ref": "#/definitions/Order"
+        }
+      }
+    },
+    "CreateReturnRequest": {
+      "type": "object",
+      "required": [
+        "lineId",
+        "quantity",
+        "reason"
+      ],
+      "properties": {
+        "lineId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "reason": {
+          "type": "string",
+          "maxLength": 1024
+        }
+      }
+    },
+    "CreateShipmentRequest": {
+      "type": "object",
+      "required": [
+        "carrier",
+        "lines"
+      ],
+      "properties": {
+        "carrier": {
+          "type": "string",
+          "maxLength": 64
+        },
+        "lines": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/ShipmentLine"
+          }
+        },
+        "trackingNumber": {
+          "type": "string",
+          "maxLength": 128
+        }
+      }
+    },
+    "DateFacet": {
+      "type": "object",
+      "required": [
+        "date",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "Number of orders created within the bucket.",
+          "type": "integer"
+        },
+        "date": {
+          "description": "The start of the date bucket.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "DecideApprovalRequest": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "description": "Required to reject.",
+          "type": "string",
+          "maxLength": 1024
+        }
+      }
+    },
+    "DecideReturnRequest": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string",
+          "maxLength": 1024
+        }
+      }
+    },
+    "ErasureReceipt": {
+      "type": "object",
+      "required": [
+        "id",
+        "userId",
+        "source",
+        "requestedBy",
+        "orders",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "orders": {
+          "description": "Number of erased orders.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "requestedBy": {
+          "description": "ID of the user who requested the erasure.",
+          "type": "string"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "bus",
+            "http"
+          ]
+        },
+        "userId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "required": [
+        "error",
+        "errorDescription"
+      ],
+      "properties": {
+        "error": {
+          "type": "string",
+          "enum": [
+            "server_error",
+            "access_denied",
+            "invalid_grant",
+            "not_found",
+            "invalid_request"
+          ]
+        },
+        "errorDescription": {
+          "type": "string",
+          "example": "Internal Server Error"
+        }
+      }
+    },
+    "GetApprovalResponse": {
+      "type": "object",
+      "required": [
+        "approval"
+      ],
+      "properties": {
+        "approval": {
+          "$ref": "#/definitions/Approval"
+        }
+      }
+    },
+    "GetAttachmentResponse": {
+      "type": "object",
+      "required": [
+        "attachment"
+      ],
+      "properties": {
+        "attachment": {
+          "$ref": "#/definitions/Attachment"
+        }
+      }
+    },
+    "GetAttachmentsResponse": {
+      "type": "object",
+      "required": [
+        "attachments"
+      ],
+      "properties": {
+        "attachments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Attachment"
+          }
+        }
+      }
+    },
+    "GetCommentResponse": {
+      "type": "object",
+      "required": [
+        "comment"
+      ],
+      "properties": {
+        "comment": {
+          "$ref": "#/definitions/Comment"
+        }
+      }
+    },
+    "GetCommentsResponse": {
+      "type": "object",
+      "required": [
+        "comments",
+        "pagination"
+      ],
+      "properties": {
+        "comments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Comment"
+          }
+        },
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        }
+      }
+    },
+    "GetCountResponse": {
+      "type": "object",
+      "required": [
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "Content version id of the order.",
+          "type": "integer"
+        }
+      }
+    },
+    "GetFacetsResponse": {
+      "type": "object",
+      "required": [
+        "statuses",
+        "dates",
+        "tags"
+      ],
+      "properties": {
+        "dates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DateFacet"
+          }
+        },
+        "statuses": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StatusFacet"
+          }
+        },
+        "tags": {
+          "description": "The most used first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TagFacet"
+          }
+        }
+      }
+    },
+    "GetGrantResponse": {
+      "type": "object",
+      "required": [
+        "grant"
+      ],
+      "properties": {
+        "grant": {
+          "$ref": "#/definitions/Grant"
+        }
+      }
+    },
+    "GetGrantsResponse": {
+      "type": "object",
+      "required": [
+        "grants"
+      ],
+      "properties": {
+        "grants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Grant"
+          }
+        }
+      }
+    },
+    "GetOrderHistoryResponse": {
+      "type": "object",
+      "required": [
+        "entries",
+        "pagination"
+      ],
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HistoryEntry"
+          }
+        },
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        }
+      }
+    },
+    "GetOrderLineResponse": {
+      "type": "object",
+      "required": [
+        "line",
+        "order"
+      ],
+      "properties": {
+        "line": {
+          "$ref": "#/definitions/OrderLine"
+        },
+        "order": {
+          "$ref": "#/definitions/Order"
+        }
+      }
+    },
+    "GetOrderLinesResponse": {
+      "type": "object",
+      "required": [
+        "lines"
+      ],
+      "properties": {
+        "lines": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/OrderLine"
+          }
+        }
+      }
+    },
+    "GetOrderResponse": {
+      "type": "object",
+      "required": [
+        "order"
+      ],
+      "properties": {
+        "order": {
+          "$ref": "#/definitions/Order"
+        }
+      }
+    },
+    "GetOrdersResponse": {
+      "type": "object",
+      "required": [
+        "orders",
+        "pagination"
+      ],
+      "properties": {
+        "orders": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Order"
+          }
+        },
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        }
+      }
+    },
+    "GetPaymentsResponse": {
+      "type": "object",
+      "required": [
+        "payments"
+      ],
+      "properties": {
+        "payments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Payment"
+          }
+        }
+      }
+    },
+    "GetPromoResponse": {
+      "type": "object",
+      "required": [
+        "promo"
+      ],
+      "properties": {
+        "promo": {
+          "$ref": "#/definitions/Promo"
+        }
+      }
+    },
+    "GetPromosResponse": {
+      "type": "object",
+      "required": [
+        "promos",
+        "pagination"
+      ],
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        },
+        "promos": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Promo"
+          }
+        }
+      }
+    },
+    "GetRecurringOrderResponse": {
+      "type": "object",
+      "required": [
+        "recurringOrder"
+      ],
+      "properties": {
+        "recurringOrder": {
+          "$ref": "#/definitions/RecurringOrder"
+        }
+      }
+    },
+    "GetRecurringOrdersResponse": {
+      "type": "object",
+      "required": [
+        "recurringOrders",
+        "pagination"
+      ],
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        },
+        "recurringOrders": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RecurringOrder"
+          }
+        }
+      }
+    },
+    "GetRecurringRunsResponse": {
+      "type": "object",
+      "required": [
+        "runs",
+        "pagination"
+      ],
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/Pagination"
+        },
+        "runs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RecurringRun"
+          }
+        }
+      }
+    },
+    "GetReturnResponse": {
+      "type": "object",
+      "required": [
+        "return"
+      ],
+      "properties": {
+        "return": {
+          "$ref": "#/definitions/Return"
+        }
+      }
+    },
+    "GetReturnsResponse": {
+      "type": "object",
+      "required": [
+        "returns"
+      ],
+      "properties": {
+        "returns": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Return"
+          }
+        }
+      }
+    },
+    "GetShipmentResponse": {
+      "type": "object",
+      "required": [
+        "shipment"
+      ],
+      "properties": {
+        "shipment": {
+          "$ref": "#/definitions/Shipment"
+        }
+      }
+    },
+    "GetShipmentsResponse": {
+      "type": "object",
+      "required": [
+        "shipments"
+      ],
+      "properties": {
+        "shipments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Shipment"
+          }
+        }
+      }
+    },
+    "GetTagsResponse": {
+      "type": "object",
+      "required": [
+        "tags"
+      ],
+      "properties": {
+        "tags": {
+          "description": "The most used first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TagFacet"
+          }
+        }
+      }
+    },
+    "GetTenantMemberResponse": {
+      "type": "object",
+      "required": [
+        "member"
+      ],
+      "properties": {
+        "member": {
+          "$ref": "#/definitions/TenantMember"
+        }
+      }
+    },
+    "GetTenantResponse": {
+      "type": "object",
+      "required": [
+        "tenant"
+      ],
+      "properties": {
+        "tenant": {
+          "$ref": "#/definitions/Tenant"
+        }
+      }
+    },
+    "Grant": {
+      "description": "Access of a user to a personal order shared with it.",
+      "type": "object",
+      "required": [
+        "orderId",
+        "userId",
+        "permission",
+        "grantedBy",
+        "createdAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "expiresAt": {
+          "description": "The grant never expires when missing.",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "grantedBy": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "orderId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "permission": {
+          "description": "Readers see the order, editors also change it but neither deletes nor shares it.",
+          "type": "string",
+          "enum": [
+            "read",
+            "edit"
+          ]
+        },
+        "userId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "GrantRequest": {
+      "type": "object",
+      "required": [
+        "permission"
+      ],
+      "properties": {
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "permission": {
+          "type": "string",
+          "enum": [
+            "read",
+            "edit"
+          ]
+        }
+      }
+    },
+    "HistoryChange": {
+      "type": "object",
+      "required": [
+        "field",
+        "old",
+        "new"
+      ],
+      "properties": {
+        "field": {
+          "type": "string",
+          "example": "name"
+        },
+        "new": {
+          "description": "Value after the change.",
+          "type": "string"
+        },
+        "old": {
+          "description": "Value before the change, empty for a new order.",
+          "type": "string"
+        }
+      }
+    },
+    "HistoryEntry": {
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "actor",
+        "source",
+        "action",
+        "changes"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "status",
+            "delete",
+            "disable",
+            "enable",
+            "restore",
+            "payment",
+            "refund",
+            "lines",
+            "checkout",
+            "approve",
+            "reject",
+            "escalate"
+          ]
+        },
+        "actor": {
+          "description": "ID of the user who made the change.",
+          "type": "string"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HistoryChange"
+          }
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "http",
+            "grpc",
+            "bus",
+            "scheduler"
+          ]
+        }
+      }
+    },
+    "Order": {
+      "type": "object",
+      "required": [
+        "id",
+        "number",
+        "name",
+        "description",
+        "state",
+        "totals",
+        "discounts",
+        "taxes",
+        "tags"
+      ],
+      "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "description": {
+          "description": "The description of the order.",
+          "type": "string"
+        },
+        "discounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/OrderDiscount"
+          }
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "metadata": {
+          "description": "Keys and values attached by integrators.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the order.",
+          "type": "string"
+        },
+        "number": {
+          "description": "Human-readable order number.",
+          "type": "string",
+          "example": "ORD-2026-000123"
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "state": {
+          "description": "Lifecycle state of the order.",
+          "type": "string",
+          "enum": [
+            "draft",
+            "pending_approval",
+            "placed",
+            "paid",
+            "payment_failed",
+            "fulfilled",
+            "partially_refunded",
+            "refunded",
+            "rejected"
+          ]
+        },
+        "tags": {
+          "description": "Lower-cased free-form tags.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "taxes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/OrderTax"
+          }
+        },
+        "tenantId": {
+          "description": "Organisation owning the order, absent for personal orders.",
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "totals": {
+          "$ref": "#/definitions/OrderTotals"
+        }
+      }
+    },
+    "OrderDiscount": {
+      "description": "Promo code applied to the order.",
+      "type": "object",
+      "required": [
+        "code",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount taken off the order.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "code": {
+          "type": "string",
+          "example": "SPRING-10"
+        }
+      }
+    },
+    "OrderLine": {
+      "type": "object",
+      "required": [
+        "id",
+        "sku",
+        "name",
+        "quantity",
+        "unitPrice",
+        "discount",
+        "taxCategory",
+        "tax"
+      ],
+      "properties": {
+        "discount": {
+          "description": "Part of the promo code discounts taken off the line.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "name": {
+          "type": "string"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sku": {
+          "type": "string"
+        },
+        "tax": {
+          "description": "Tax of the line, included in its price when the order prices include taxes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "taxCategory": {
+          "type": "string"
+        },
+        "unitPrice": {
+          "description": "Price of one unit in minor units of the order currency.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "OrderTax": {
+      "description": "Tax charged on the order.",
+      "type": "object",
+      "required": [
+        "country",
+        "region",
+        "category",
+        "rate",
+        "taxable",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "category": {
+          "description": "Tax category, empty for the standard rate.",
+          "type": "string"
+        },
+        "country": {
+          "type": "string",
+          "example": "US"
+        },
+        "rate": {
+          "description": "Rate in basis points, 725 is 7.25%.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "region": {
+          "type": "string",
+          "example": "CA"
+        },
+        "taxable": {
+          "description": "Net amount of the lines taxed at the rate.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "OrderTotals": {
+      "description": "Amounts in minor units of the currency.",
+      "type": "object",
+      "required": [
+        "subtotal",
+        "discount",
+        "tax",
+        "taxInclusive",
+        "total",
+        "paid",
+        "refunded"
+      ],
+      "properties": {
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "discount": {
+          "description": "Sum of the promo code discounts.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "paid": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "refunded": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "subtotal": {
+          "description": "Sum of the line amounts.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "tax": {
+          "description": "Sum of the line taxes.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "taxInclusive": {
+          "description": "True when the prices include the tax, it is added to the total otherwise.",
+          "type": "boolean"
+        },
+        "total": {
+          "description": "Amount due.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "Pagination": {
+      "type": "object",
+      "title": "Pagination",
+      "required": [
+        "limit",
+        "offset",
+        "total"
+      ],
+      "properties": {
+        "limit": {
+          "description": "limit of orders",
+          "type": "number",
+          "default": 1,
+          "minimum": 1
+        },
+        "offset": {
+          "description": "offset",
+          "type": "number",
+          "default": 1,
+          "minimum": 1
+        },
+        "total": {
+          "description": "Total items",
+          "type": "number",
+          "minimum": 0
+        }
+      }
+    },
+    "Payment": {
+      "type": "object",
+      "required": [
+        "id",
+        "provider",
+        "externalRef",
+        "amount",
+        "currency",
+        "status",
+        "createdAt"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in minor units of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "externalRef": {
+          "description": "Reference of the payment attempt at the provider.",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "provider": {
+          "type": "string"
+        },
+        "reason": {
+          "description": "Failure reason reported by the provider.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "succeeded",
+            "failed"
+          ]
+        }
+      }
+    },
+    "Promo": {
+      "type": "object",
+      "required": [
+        "id",
+        "code",
+        "kind",
+        "scope",
+        "value",
+        "skus",
+        "minAmount",
+        "maxUses",
+        "maxUsesPerUser",
+        "uses",
+        "createdAt",
+        "updatedAt"
+      ],
+      "properties": {
+        "code": {
+          "type": "string",
+          "example": "SPRING-10"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "percentage",
+            "fixed"
+          ]
+        },
+        "maxUses": {
+          "description": "Unlimited when 0.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxUsesPerUser": {
+          "description": "Unlimited when 0.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "minAmount": {
+          "description": "Minimal order subtotal.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "scope": {
+          "type": "string",
+          "enum": [
+            "order",
+            "line"
+          ]
+        },
+        "skus": {
+          "description": "Lines a line discount applies to, all lines when empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "uses": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "validFrom": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "validTo": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "value": {
+          "description": "Percentage, or amount in minor units of the currency for fixed discounts.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "PromoRequest": {
+      "type": "object",
+      "required": [
+        "code",
+        "kind",
+        "scope",
+        "value"
+      ],
+      "properties": {
+        "code": {
+          "type": "string",
+          "maxLength": 32,
+          "minLength": 3,
+          "example": "SPRING-10"
+        },
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "percentage",
+            "fixed"
+          ]
+        },
+        "maxUses": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxUsesPerUser": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "minAmount": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "scope": {
+          "type": "string",
+          "enum": [
+            "order",
+            "line"
+          ]
+        },
+        "skus": {
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "type": "string"
+          }
+        },
+        "validFrom": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "validTo": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "value": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "RecurringOrder": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "nextRunAt",
+        "paused",
+        "order",
+        "createdAt",
+        "updatedAt"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "cron": {
+          "description": "Cron expression in UTC with minute, hour, day of month, month and day of week.",
+          "type": "string",
+          "example": "0 9 * * 1"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "intervalSeconds": {
+          "description": "Interval between orders when there is no cron expression.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string",
+          "example": "Weekly coffee"
+        },
+        "nextRunAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "order": {
+          "$ref": "#/definitions/CreateOrderRequest"
+        },
+        "paused": {
+          "type": "boolean"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "RecurringOrderRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "order"
+      ],
+      "properties": {
+        "cron": {
+          "description": "Cron expression in UTC, either it or intervalSeconds is required.",
+          "type": "string",
+          "example": "0 9 * * 1"
+        },
+        "intervalSeconds": {
+          "description": "Interval between orders, at least an hour.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 3600
+        },
+        "name": {
+          "type": "string",
+          "maxLength": 256,
+          "example": "Weekly coffee"
+        },
+        "order": {
+          "$ref": "#/definitions/CreateOrderRequest"
         }
-      ]
-    }
-  },
-  "definitions": {
-    "CreateOrderRequest": {
+      }
+    },
+    "RecurringRun": {
       "type": "object",
       "required": [
-        "name",
-        "description"
+        "id",
+        "createdAt",
+        "scheduledAt"
       ],
       "properties": {
-        "description": {
-          "description": "The description of the order.",
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "Why the order was not created.",
           "type": "string"
         },
-        "name": {
-          "description": "The name of the order.",
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "orderId": {
+          "description": "Empty when the order was not created.",
           "type": "string"
+        },
+        "scheduledAt": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "CreateOrderResponse": {
+    "Refund": {
       "type": "object",
       "required": [
-        "order"
+        "id",
+        "paymentId",
+        "amount",
+        "currency",
+        "createdAt"
       ],
       "properties": {
-        "order": {
-          "$ref": "#/definitions/Order"
+        "amount": {
+          "description": "Amount in minor units of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string",
+          "example": "EUR"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "paymentId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
         }
       }
     },
-    "Error": {
+    "Return": {
       "type": "object",
       "required": [
-        "error",
-        "errorDescription"
+        "id",
+        "lineId",
+        "quantity",
+        "reason",
+        "status",
+        "createdAt",
+        "updatedAt",
+        "refunds"
       ],
       "properties": {
-        "error": {
+        "comment": {
+          "description": "Comment of the staff member who approved or rejected the return.",
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "lineId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "quantity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "refunds": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Refund"
+          }
+        },
+        "status": {
           "type": "string",
           "enum": [
-            "server_error",
-            "access_denied",
-            "invalid_grant",
-            "not_found",
-            "invalid_request"
+            "requested",
+            "approved",
+            "rejected"
           ]
         },
-        "errorDescription": {
+        "updatedAt": {
           "type": "string",
-          "example": "Internal Server Error"
+          "format": "date-time"
         }
       }
     },
-    "GetCountResponse": {
+    "Shipment": {
       "type": "object",
       "required": [
-        "count"
+        "id",
+        "carrier",
+        "trackingNumber",
+        "status",
+        "createdAt",
+        "lines",
+        "events"
       ],
       "properties": {
-        "count": {
-          "description": "Content version id of the order.",
-          "type": "integer"
+        "carrier": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "events": {
+          "description": "Status timeline, the oldest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ShipmentEvent"
+          }
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
+        },
+        "lines": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ShipmentLine"
+          }
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "shipped",
+            "in_transit",
+            "delivered",
+            "cancelled"
+          ]
+        },
+        "trackingNumber": {
+          "type": "string"
         }
       }
     },
-    "GetOrderResponse": {
+    "ShipmentEvent": {
       "type": "object",
       "required": [
-        "order"
+        "status",
+        "createdAt"
       ],
       "properties": {
-        "order": {
-          "$ref": "#/definitions/Order"
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "shipped",
+            "in_transit",
+            "delivered",
+            "cancelled"
+          ]
         }
       }
     },
-    "GetOrdersResponse": {
+    "ShipmentLine": {
       "type": "object",
       "required": [
-        "orders",
-        "pagination"
+        "lineId",
+        "quantity"
       ],
       "properties": {
-        "orders": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Order"
-          }
+        "lineId": {
+          "type": "string",
+          "format": "uuid",
+          "example": "123e4567-e89b-12d3-a456-426614174000"
         },
-        "pagination": {
-          "$ref": "#/definitions/Pagination"
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         }
       }
     },
-    "Order": {
+    "StaffCommentRequest": {
+      "type": "object",
+      "required": [
+        "body"
+      ],
+      "properties": {
+        "body": {
+          "type": "string",
+          "maxLength": 4000,
+          "minLength": 1
+        },
+        "internal": {
+          "description": "Keeps the comment to the staff.",
+          "type": "boolean"
+        }
+      }
+    },
+    "StatusFacet": {
+      "type": "object",
+      "required": [
+        "status",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "Number of orders in the status.",
+          "type": "integer"
+        },
+        "status": {
+          "description": "The status of the orders.",
+          "type": "string",
+          "example": "created"
+        }
+      }
+    },
+    "TagFacet": {
+      "type": "object",
+      "required": [
+        "tag",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "Number of orders carrying the tag.",
+          "type": "integer"
+        },
+        "tag": {
+          "type": "string",
+          "example": "urgent"
+        }
+      }
+    },
+    "Tenant": {
       "type": "object",
       "required": [
         "id",
-        "name",
-        "description"
+        "createdAt",
+        "name"
       ],
       "properties": {
-        "description": {
-          "description": "The description of the order.",
-          "type": "string"
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
         },
         "id": {
           "type": "string",
@@ -1038,53 +11986,125 @@ func init() {
           "example": "123e4567-e89b-12d3-a456-426614174000"
         },
         "name": {
-          "description": "The name of the order.",
-          "type": "string"
+          "type": "string",
+          "example": "Acme"
         }
       }
     },
-    "Pagination": {
+    "TenantMember": {
       "type": "object",
-      "title": "Pagination",
       "required": [
-        "limit",
-        "offset",
-        "total"
+        "tenantId",
+        "userId",
+        "role",
+        "createdAt"
       ],
       "properties": {
-        "limit": {
-          "description": "limit of orders",
-          "type": "number",
-          "default": 1,
-          "minimum": 1
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
         },
-        "offset": {
-          "description": "offset",
-          "type": "number",
-          "default": 1,
-          "minimum": 1
+        "role": {
+          "description": "Viewers read the orders of the organisation, editors change them and approvers approve them too.",
+          "type": "string",
+          "enum": [
+            "viewer",
+            "editor",
+            "approver"
+          ]
         },
-        "total": {
-          "description": "Total items",
-          "type": "number",
-          "minimum": 0
+        "tenantId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "userId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "TenantMemberRequest": {
+      "type": "object",
+      "required": [
+        "role"
+      ],
+      "properties": {
+        "role": {
+          "type": "string",
+          "enum": [
+            "viewer",
+            "editor",
+            "approver"
+          ]
+        }
+      }
+    },
+    "TenantRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 256,
+          "minLength": 1,
+          "example": "Acme"
+        }
+      }
+    },
+    "UpdateOrderLineRequest": {
+      "type": "object",
+      "required": [
+        "quantity"
+      ],
+      "properties": {
+        "quantity": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         }
       }
     },
     "UpdateOrderRequest": {
+      "description": "Addresses that are not sent are left untouched.",
       "type": "object",
       "required": [
         "name",
         "description"
       ],
       "properties": {
+        "billingAddress": {
+          "$ref": "#/definitions/Address"
+        },
         "description": {
           "description": "The description of the order.",
           "type": "string"
         },
+        "metadata": {
+          "description": "Replaces the metadata of the order, an empty object removes it, missing keeps it.",
+          "type": "object",
+          "maxProperties": 50,
+          "additionalProperties": {
+            "type": "string",
+            "maxLength": 500
+          }
+        },
         "name": {
           "description": "The name of the order.",
           "type": "string"
+        },
+        "shippingAddress": {
+          "$ref": "#/definitions/Address"
+        },
+        "tags": {
+          "description": "Replace the tags of the order, an empty list removes them, missing keeps them.",
+          "type": "array",
+          "maxItems": 20,
+          "items": {
+            "type": "string",
+            "maxLength": 50
+          }
         }
       }
     },
@@ -1098,19 +12118,47 @@ func init() {
           "$ref": "#/definitions/Order"
         }
       }
+    },
+    "UpdateShipmentStatusRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "in_transit",
+            "delivered",
+            "cancelled"
+          ]
+        }
+      }
     }
   },
   "securityDefinitions": {
+    "AdminJWT": {
+      "description": "JSON Web Token of a member of the admin group",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    },
     "JWT": {
-      "description": "JSON Web Token",
+      "description": "JSON Web Token, the X-Tenant-ID header selects the organisation the request acts in",
       "type": "apiKey",
       "name": "Authorization",
       "in": "header"
     }
   },
   "tags": [
+    {
+      "name": "admin"
+    },
     {
       "name": "order"
+    },
+    {
+      "name": "recurring"
     }
   ],
   "x-components": {}
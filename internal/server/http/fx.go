@@ -23,6 +23,7 @@ var FXModule = fx.Options(
 		newApi,
 		newServer,
 		auth.NewJWT,
+		auth.NewTenant,
 	),
 
 	fx.Invoke(
@@ -34,7 +35,7 @@ var FXModule = fx.Options(
 	),
 )
 
-func newApi(logger *zap.Logger, authJWT *auth.JWT) (*operations.OrderAPIAPI, error) {
+func newApi(cfg Config, logger *zap.Logger, authJWT *auth.JWT, authTenant *auth.Tenant) (*operations.OrderAPIAPI, error) {
 	swaggerSpec, err := loads.Embedded(SwaggerJSON, FlatSwaggerJSON)
 	if err != nil {
 		return nil, fmt.Errorf("load specs: %w", err)
@@ -48,6 +49,8 @@ func newApi(logger *zap.Logger, authJWT *auth.JWT) (*operations.OrderAPIAPI, err
 	api.JSONConsumer = runtime.JSONConsumer()
 	api.JSONProducer = runtime.JSONProducer()
 	api.JWTAuth = authJWT.Handle
+	api.AdminJWTAuth = authJWT.Admin(cfg.AdminGroup)
+	api.APIAuthorizer = runtime.AuthorizerFunc(authTenant.Authorize)
 
 	return api, nil
 }
@@ -85,9 +88,9 @@ func invokeApi(api *operations.OrderAPIAPI, server *Server) {
 
 func invokeMiddlewares(
 	api *operations.OrderAPIAPI,
-	server *Server, logger *middlewares.Logger) {
+	server *Server, logger *middlewares.Logger, origin *middlewares.Origin) {
 
-	log := logger.Provide(api.Serve(nil))
+	log := logger.Provide(origin.Provide(api.Serve(nil)))
 
 	mux := http.NewServeMux()
 
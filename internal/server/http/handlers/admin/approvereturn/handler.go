@@ -0,0 +1,87 @@
+package approvereturn
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) admin.ApproveReturnHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.ApproveReturnParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	for _, id := range []string{params.ID, params.ReturnID} {
+		if _, err := uuid.Parse(id); err != nil {
+			return admin.NewApproveReturnNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer("Not Found"),
+			})
+		}
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("orderID", params.ID),
+		zap.String("returnID", params.ReturnID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	var comment string
+	if params.Body != nil {
+		comment = params.Body.Comment
+	}
+
+	item, err := h.service.ApproveReturn(ctx, adminID, params.ID, params.ReturnID, comment)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return admin.NewApproveReturnBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewApproveReturnNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrConflict) {
+			return admin.NewApproveReturnConflict().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("approve return failed", zap.Error(err))
+
+		return admin.NewApproveReturnInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Approve return failed"),
+		})
+	}
+
+	return admin.NewApproveReturnOK().WithPayload(&models.GetReturnResponse{
+		Return: convertors.ReturnFromModel(item),
+	})
+}
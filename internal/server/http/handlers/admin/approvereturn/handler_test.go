@@ -0,0 +1,157 @@
+package approvereturn_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/approvereturn"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		path    = fmt.Sprintf("/api/v1/order/admin/orders/%s/returns/%s/approve", newID().String(), newID().String())
+
+		reqBody = &models.DecideReturnRequest{Comment: "ok"}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := approvereturn.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().ApproveReturn(gomock.Any(), adminID, newID().String(), newID().String(), "ok").Return(&refund.Return{
+			ID:        newID().String(),
+			TSCreate:  now(),
+			TSModify:  now(),
+			OrderID:   newID().String(),
+			LineID:    newID().String(),
+			Quantity:  1,
+			Reason:    "damaged",
+			Status:    refund.StatusApproved,
+			Comment:   "ok",
+			DecidedBy: adminID,
+			Refunds: []*refund.Refund{
+				{
+					ID:        newID().String(),
+					TSCreate:  now(),
+					OrderID:   newID().String(),
+					ReturnID:  newID().String(),
+					PaymentID: newID().String(),
+					Amount:    250,
+					Currency:  "EUR",
+				},
+			},
+		}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.ApproveReturnParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			ReturnID:    newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewApproveReturnOK().WithPayload(&models.GetReturnResponse{
+			Return: &models.Return{
+				ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+				LineID:    ptr.Pointer(strfmt.UUID(newID().String())),
+				Quantity:  ptr.Pointer(int64(1)),
+				Reason:    ptr.Pointer("damaged"),
+				Status:    ptr.Pointer("approved"),
+				Comment:   "ok",
+				CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+				UpdatedAt: ptr.Pointer(strfmt.DateTime(now())),
+				Refunds: []*models.Refund{
+					{
+						ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+						PaymentID: ptr.Pointer(strfmt.UUID(newID().String())),
+						Amount:    ptr.Pointer(int64(250)),
+						Currency:  ptr.Pointer("EUR"),
+						CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+					},
+				},
+			},
+		}), res)
+	})
+
+	t.Run("Exceeds the amount paid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := approvereturn.New(mock)
+
+		var (
+			i interface{} = adminID
+
+			errConflict = fmt.Errorf("%w: refund of 500 exceeds 250 left", model.ErrConflict)
+		)
+
+		mock.EXPECT().ApproveReturn(gomock.Any(), adminID, newID().String(), newID().String(), "").Return(nil, errConflict)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.ApproveReturnParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			ReturnID:    newID().String(),
+		}, i)
+
+		require.Equal(t, admin.NewApproveReturnConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Invalid return id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := approvereturn.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.ApproveReturnParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			ReturnID:    "123",
+		}, i)
+
+		require.Equal(t, admin.NewApproveReturnNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,17 @@
+package createordercomment
+
+import (
+	"github.com/krivenkov/order/internal/server/http/operations"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(New),
+
+	fx.Invoke(
+		func(handler admin.CreateAdminOrderCommentHandler, api *operations.OrderAPIAPI) {
+			api.AdminCreateAdminOrderCommentHandler = handler
+		},
+	),
+)
@@ -0,0 +1,88 @@
+package createordercomment
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) admin.CreateAdminOrderCommentHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.CreateAdminOrderCommentParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return admin.NewCreateAdminOrderCommentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("orderID", params.ID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if params.Body == nil {
+		l.Warn("request body is empty")
+		return admin.NewCreateAdminOrderCommentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		})
+	}
+
+	res, err := h.service.AddComment(ctx, comment.Actor{UserID: adminID, Staff: true}, params.ID, convertors.StaffCommentFormFromRequest(params.Body))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return admin.NewCreateAdminOrderCommentBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewCreateAdminOrderCommentNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return admin.NewCreateAdminOrderCommentForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("create order comment failed", zap.Error(err))
+
+		return admin.NewCreateAdminOrderCommentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create order comment failed"),
+		})
+	}
+
+	return admin.NewCreateAdminOrderCommentOK().WithPayload(&models.GetCommentResponse{
+		Comment: convertors.CommentFromModel(res),
+	})
+}
@@ -0,0 +1,172 @@
+package createordercomment_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/createordercomment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = newID().String()
+		actor   = comment.Actor{UserID: adminID, Staff: true}
+		path    = fmt.Sprintf("/api/v1/order/admin/orders/%s/comments", newID().String())
+		form    = &comment.Form{Body: "Courier called twice", Internal: true}
+		body    = &models.StaffCommentRequest{Body: ptr.Pointer("Courier called twice"), Internal: true}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().AddComment(gomock.Any(), actor, newID().String(), form).Return(&comment.Comment{
+			ID:       newID().String(),
+			TSCreate: now(),
+			OrderID:  newID().String(),
+			AuthorID: adminID,
+			Body:     "Courier called twice",
+			Internal: true,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreateAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, admin.NewCreateAdminOrderCommentOK().WithPayload(&models.GetCommentResponse{
+			Comment: &models.Comment{
+				ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+				OrderID:   ptr.Pointer(strfmt.UUID(newID().String())),
+				AuthorID:  ptr.Pointer(strfmt.UUID(adminID)),
+				Body:      ptr.Pointer("Courier called twice"),
+				Internal:  ptr.Pointer(true),
+				CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+			},
+		}), res)
+	})
+
+	t.Run("Invalid body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().AddComment(gomock.Any(), actor, newID().String(), form).Return(nil, model.ErrInvalidArgument)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreateAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, admin.NewCreateAdminOrderCommentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrInvalidArgument.Error()),
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().AddComment(gomock.Any(), actor, newID().String(), form).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreateAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, admin.NewCreateAdminOrderCommentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().AddComment(gomock.Any(), actor, newID().String(), form).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreateAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, admin.NewCreateAdminOrderCommentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create order comment failed"),
+		}), res)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreateAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, admin.NewCreateAdminOrderCommentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		}), res)
+	})
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,69 @@
+package createpromo
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service promo.Service
+}
+
+func New(service promo.Service) admin.CreatePromoCodeHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.CreatePromoCodeParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(zap.String("adminID", adminID))
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if params.Body == nil {
+		l.Warn("request body is empty")
+		return admin.NewCreatePromoCodeBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		})
+	}
+
+	item, err := h.service.Create(ctx, convertors.PromoFormToModel(params.Body))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return admin.NewCreatePromoCodeBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrConflict) {
+			return admin.NewCreatePromoCodeConflict().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("create promo code failed", zap.Error(err))
+
+		return admin.NewCreatePromoCodeInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create promo code failed"),
+		})
+	}
+
+	return admin.NewCreatePromoCodeOK().WithPayload(&models.GetPromoResponse{
+		Promo: convertors.PromoFromModel(item),
+	})
+}
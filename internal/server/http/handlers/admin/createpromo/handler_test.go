@@ -0,0 +1,204 @@
+package createpromo_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/promo"
+	promoMock "github.com/krivenkov/order/internal/model/promo/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/createpromo"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		path    = "/api/v1/order/admin/promo-codes"
+
+		reqBody = &models.PromoRequest{
+			Code:      ptr.Pointer("spring-10"),
+			Kind:      ptr.Pointer("fixed"),
+			Scope:     ptr.Pointer("line"),
+			Value:     ptr.Pointer(int64(100)),
+			Currency:  "EUR",
+			Skus:      []string{"SKU-1"},
+			ValidFrom: ptr.Pointer(strfmt.DateTime(now())),
+			MaxUses:   10,
+		}
+
+		form = &promo.Form{
+			Code:      "spring-10",
+			Kind:      promo.KindFixed,
+			Scope:     promo.ScopeLine,
+			Value:     100,
+			Currency:  "EUR",
+			SKUs:      []string{"SKU-1"},
+			ValidFrom: ptr.Pointer(now()),
+			MaxUses:   10,
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := createpromo.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Create(gomock.Any(), form).Return(&promo.Promo{
+			ID:        uuid.Nil.String(),
+			TSCreate:  now(),
+			TSModify:  now(),
+			Code:      "SPRING-10",
+			Kind:      promo.KindFixed,
+			Scope:     promo.ScopeLine,
+			Value:     100,
+			Currency:  "EUR",
+			SKUs:      []string{"SKU-1"},
+			ValidFrom: ptr.Pointer(now()),
+			MaxUses:   10,
+		}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.CreatePromoCodeParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreatePromoCodeOK().WithPayload(&models.GetPromoResponse{
+			Promo: &models.Promo{
+				ID:             ptr.Pointer(strfmt.UUID(uuid.Nil.String())),
+				Code:           ptr.Pointer("SPRING-10"),
+				Kind:           ptr.Pointer("fixed"),
+				Scope:          ptr.Pointer("line"),
+				Value:          ptr.Pointer(int64(100)),
+				Currency:       "EUR",
+				Skus:           []string{"SKU-1"},
+				MinAmount:      ptr.Pointer(int64(0)),
+				ValidFrom:      ptr.Pointer(strfmt.DateTime(now())),
+				MaxUses:        ptr.Pointer(int64(10)),
+				MaxUsesPerUser: ptr.Pointer(int64(0)),
+				Uses:           ptr.Pointer(int64(0)),
+				CreatedAt:      ptr.Pointer(strfmt.DateTime(now())),
+				UpdatedAt:      ptr.Pointer(strfmt.DateTime(now())),
+			},
+		}), res)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := createpromo.New(mock)
+
+		var (
+			i          interface{} = adminID
+			errInvalid             = fmt.Errorf("%w: amount must be positive", model.ErrInvalidArgument)
+		)
+
+		mock.EXPECT().Create(gomock.Any(), form).Return(nil, errInvalid)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreatePromoCodeParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreatePromoCodeBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errInvalid.Error()),
+		}), res)
+	})
+
+	t.Run("Code taken", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := createpromo.New(mock)
+
+		var (
+			i           interface{} = adminID
+			errConflict             = fmt.Errorf("%w: promo code already exists", model.ErrConflict)
+		)
+
+		mock.EXPECT().Create(gomock.Any(), form).Return(nil, errConflict)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreatePromoCodeParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreatePromoCodeConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := createpromo.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Create(gomock.Any(), form).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreatePromoCodeParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreatePromoCodeInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create promo code failed"),
+		}), res)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := createpromo.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreatePromoCodeParams{
+			HTTPRequest: req,
+		}, i)
+
+		require.Equal(t, admin.NewCreatePromoCodeBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,87 @@
+package createshipment
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) admin.CreateShipmentHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.CreateShipmentParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return admin.NewCreateShipmentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("orderID", params.ID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if params.Body == nil {
+		l.Warn("request body is empty")
+		return admin.NewCreateShipmentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		})
+	}
+
+	item, err := h.service.CreateShipment(ctx, adminID, params.ID, convertors.ShipmentFormToModel(params.Body))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewCreateShipmentNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return admin.NewCreateShipmentBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrConflict) {
+			return admin.NewCreateShipmentConflict().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("create shipment failed", zap.Error(err))
+
+		return admin.NewCreateShipmentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create shipment failed"),
+		})
+	}
+
+	return admin.NewCreateShipmentOK().WithPayload(&models.GetShipmentResponse{
+		Shipment: convertors.ShipmentFromModel(item),
+	})
+}
@@ -0,0 +1,176 @@
+package createshipment_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/createshipment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		path    = fmt.Sprintf("/api/v1/order/admin/orders/%s/shipments", newID().String())
+
+		reqBody = &models.CreateShipmentRequest{
+			Carrier:        ptr.Pointer("DHL"),
+			TrackingNumber: "JD0001",
+			Lines: []*models.ShipmentLine{
+				{LineID: ptr.Pointer(strfmt.UUID(newID().String())), Quantity: ptr.Pointer(int64(1))},
+			},
+		}
+
+		form = &shipment.Form{
+			Carrier:        "DHL",
+			TrackingNumber: "JD0001",
+			Lines:          []*shipment.Line{{LineID: newID().String(), Quantity: 1}},
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createshipment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().CreateShipment(gomock.Any(), adminID, newID().String(), form).Return(&shipment.Shipment{
+			ID:             newID().String(),
+			TSCreate:       now(),
+			OrderID:        newID().String(),
+			Carrier:        "DHL",
+			TrackingNumber: "JD0001",
+			Status:         shipment.StatusShipped,
+			Lines:          form.Lines,
+			Events:         []*shipment.Event{{Status: shipment.StatusShipped, TSCreate: now()}},
+		}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.CreateShipmentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreateShipmentOK().WithPayload(&models.GetShipmentResponse{
+			Shipment: &models.Shipment{
+				ID:             ptr.Pointer(strfmt.UUID(newID().String())),
+				Carrier:        ptr.Pointer("DHL"),
+				TrackingNumber: ptr.Pointer("JD0001"),
+				Status:         ptr.Pointer("shipped"),
+				CreatedAt:      ptr.Pointer(strfmt.DateTime(now())),
+				Lines:          reqBody.Lines,
+				Events: []*models.ShipmentEvent{
+					{Status: ptr.Pointer("shipped"), CreatedAt: ptr.Pointer(strfmt.DateTime(now()))},
+				},
+			},
+		}), res)
+	})
+
+	t.Run("Too many", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createshipment.New(mock)
+
+		var (
+			i interface{} = adminID
+
+			errInvalid = fmt.Errorf("%w: line has 0 left to ship", model.ErrInvalidArgument)
+		)
+
+		mock.EXPECT().CreateShipment(gomock.Any(), adminID, newID().String(), form).Return(nil, errInvalid)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.CreateShipmentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreateShipmentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errInvalid.Error()),
+		}), res)
+	})
+
+	t.Run("Already fulfilled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createshipment.New(mock)
+
+		var (
+			i interface{} = adminID
+
+			errConflict = fmt.Errorf("%w: order is fulfilled", model.ErrConflict)
+		)
+
+		mock.EXPECT().CreateShipment(gomock.Any(), adminID, newID().String(), form).Return(nil, errConflict)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.CreateShipmentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreateShipmentConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createshipment.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreateShipmentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, admin.NewCreateShipmentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,17 @@
+package createtenant
+
+import (
+	"github.com/krivenkov/order/internal/server/http/operations"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(New),
+
+	fx.Invoke(
+		func(handler admin.CreateTenantHandler, api *operations.OrderAPIAPI) {
+			api.AdminCreateTenantHandler = handler
+		},
+	),
+)
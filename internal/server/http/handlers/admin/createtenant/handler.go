@@ -0,0 +1,63 @@
+package createtenant
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service tenant.Service
+}
+
+func New(service tenant.Service) admin.CreateTenantHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.CreateTenantParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(zap.String("adminID", adminID))
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if params.Body == nil {
+		l.Warn("request body is empty")
+		return admin.NewCreateTenantBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		})
+	}
+
+	item, err := h.service.Create(ctx, swag.StringValue(params.Body.Name))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return admin.NewCreateTenantBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("create tenant failed", zap.Error(err))
+
+		return admin.NewCreateTenantInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create tenant failed"),
+		})
+	}
+
+	return admin.NewCreateTenantOK().WithPayload(&models.GetTenantResponse{
+		Tenant: convertors.TenantFromModel(item),
+	})
+}
@@ -0,0 +1,141 @@
+package createtenant_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	tenantMock "github.com/krivenkov/order/internal/model/tenant/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/createtenant"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		path    = "/api/v1/order/admin/tenants"
+
+		reqBody = &models.TenantRequest{
+			Name: ptr.Pointer("Acme"),
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := createtenant.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Create(gomock.Any(), "Acme").Return(&tenant.Tenant{
+			ID:       uuid.Nil.String(),
+			TSCreate: now(),
+			Name:     "Acme",
+		}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.CreateTenantParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreateTenantOK().WithPayload(&models.GetTenantResponse{
+			Tenant: &models.Tenant{
+				ID:        ptr.Pointer(strfmt.UUID(uuid.Nil.String())),
+				Name:      ptr.Pointer("Acme"),
+				CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+			},
+		}), res)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := createtenant.New(mock)
+
+		var (
+			i          interface{} = adminID
+			errInvalid             = fmt.Errorf("%w: name must be 1 to 256 characters", model.ErrInvalidArgument)
+		)
+
+		mock.EXPECT().Create(gomock.Any(), "Acme").Return(nil, errInvalid)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreateTenantParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreateTenantBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errInvalid.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := createtenant.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Create(gomock.Any(), "Acme").Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreateTenantParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewCreateTenantInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create tenant failed"),
+		}), res)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := createtenant.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(admin.CreateTenantParams{
+			HTTPRequest: req,
+		}, i)
+
+		require.Equal(t, admin.NewCreateTenantBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
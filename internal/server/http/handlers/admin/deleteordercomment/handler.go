@@ -0,0 +1,77 @@
+package deleteordercomment
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) admin.DeleteAdminOrderCommentHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.DeleteAdminOrderCommentParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return admin.NewDeleteAdminOrderCommentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	if _, err := uuid.Parse(params.CommentID); err != nil {
+		return admin.NewDeleteAdminOrderCommentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("orderID", params.ID),
+		zap.String("commentID", params.CommentID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if err := h.service.DeleteComment(ctx, comment.Actor{UserID: adminID, Staff: true}, params.ID, params.CommentID); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewDeleteAdminOrderCommentNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return admin.NewDeleteAdminOrderCommentForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("delete order comment failed", zap.Error(err))
+
+		return admin.NewDeleteAdminOrderCommentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Delete order comment failed"),
+		})
+	}
+
+	return admin.NewDeleteAdminOrderCommentNoContent()
+}
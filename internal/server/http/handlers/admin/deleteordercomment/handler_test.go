@@ -0,0 +1,101 @@
+package deleteordercomment_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/deleteordercomment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID   = "admin_id"
+		actor     = comment.Actor{UserID: adminID, Staff: true}
+		orderID   = uuid.New().String()
+		commentID = uuid.New().String()
+		path      = fmt.Sprintf("/api/v1/order/admin/orders/%s/comments/%s", orderID, commentID)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := deleteordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().DeleteComment(gomock.Any(), actor, orderID, commentID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.DeleteAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   commentID,
+		}, i)
+
+		require.Equal(t, admin.NewDeleteAdminOrderCommentNoContent(), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := deleteordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().DeleteComment(gomock.Any(), actor, orderID, commentID).Return(model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.DeleteAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   commentID,
+		}, i)
+
+		require.Equal(t, admin.NewDeleteAdminOrderCommentForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := deleteordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().DeleteComment(gomock.Any(), actor, orderID, commentID).Return(errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.DeleteAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   commentID,
+		}, i)
+
+		require.Equal(t, admin.NewDeleteAdminOrderCommentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Delete order comment failed"),
+		}), res)
+	})
+}
@@ -0,0 +1,58 @@
+package erase
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model/erasure"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) admin.EraseUserOrdersHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.EraseUserOrdersParams, i interface{}) middleware.Responder {
+	if _, err := uuid.Parse(params.UserID); err != nil {
+		return admin.NewEraseUserOrdersBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Invalid user id"),
+		})
+	}
+
+	adminID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("userID", params.UserID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	receipt, err := h.service.Erase(ctx, &orderModel.EraseRequest{
+		UserID:      params.UserID,
+		Source:      erasure.SourceHTTP,
+		RequestedBy: adminID,
+	})
+	if err != nil {
+		l.Error("erase user orders failed", zap.Error(err))
+
+		return admin.NewEraseUserOrdersInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Erase user orders failed"),
+		})
+	}
+
+	return admin.NewEraseUserOrdersOK().WithPayload(convertors.ErasureFromModel(receipt))
+}
@@ -0,0 +1,120 @@
+package erase_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model/erasure"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/erase"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		userID  = uuid.New().String()
+		path    = fmt.Sprintf("/api/v1/order/admin/users/%s/orders", userID)
+
+		eraseReq = &orderModel.EraseRequest{
+			UserID:      userID,
+			Source:      erasure.SourceHTTP,
+			RequestedBy: adminID,
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := erase.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Erase(gomock.Any(), eraseReq).Return(&erasure.Erasure{
+			ID:          uuid.Nil.String(),
+			TSCreate:    now(),
+			UserID:      userID,
+			Source:      erasure.SourceHTTP,
+			RequestedBy: adminID,
+			Orders:      3,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.EraseUserOrdersParams{
+			HTTPRequest: req,
+			UserID:      userID,
+		}, i)
+
+		require.Equal(t, admin.NewEraseUserOrdersOK().WithPayload(&models.ErasureReceipt{
+			ID:          ptr.Pointer(strfmt.UUID(uuid.Nil.String())),
+			UserID:      ptr.Pointer(strfmt.UUID(userID)),
+			Source:      ptr.Pointer("http"),
+			RequestedBy: ptr.Pointer(adminID),
+			Orders:      ptr.Pointer(int64(3)),
+			CreatedAt:   ptr.Pointer(strfmt.DateTime(now())),
+		}), res)
+	})
+
+	t.Run("Invalid user id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := erase.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/order/admin/users/bad/orders", nil)
+
+		res := serv.Handle(admin.EraseUserOrdersParams{
+			HTTPRequest: req,
+			UserID:      "bad",
+		}, i)
+
+		require.Equal(t, admin.NewEraseUserOrdersBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Invalid user id"),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := erase.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Erase(gomock.Any(), eraseReq).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.EraseUserOrdersParams{
+			HTTPRequest: req,
+			UserID:      userID,
+		}, i)
+
+		require.Equal(t, admin.NewEraseUserOrdersInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Erase user orders failed"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/approvereturn"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/createordercomment"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/createpromo"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/createshipment"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/createtenant"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/deleteordercomment"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/erase"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/ordercomments"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/promocode"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/promocodes"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/rejectreturn"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/removepromo"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/removetenantmember"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/settenantmember"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/shipmentstatus"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/updateordercomment"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/updatepromo"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	erase.FXModule,
+	promocodes.FXModule,
+	promocode.FXModule,
+	createpromo.FXModule,
+	updatepromo.FXModule,
+	removepromo.FXModule,
+	createtenant.FXModule,
+	settenantmember.FXModule,
+	removetenantmember.FXModule,
+	ordercomments.FXModule,
+	createordercomment.FXModule,
+	updateordercomment.FXModule,
+	deleteordercomment.FXModule,
+	createshipment.FXModule,
+	shipmentstatus.FXModule,
+	approvereturn.FXModule,
+	rejectreturn.FXModule,
+)
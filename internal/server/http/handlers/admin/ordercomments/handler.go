@@ -0,0 +1,83 @@
+package ordercomments
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) admin.GetAdminOrderCommentsHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.GetAdminOrderCommentsParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return admin.NewGetAdminOrderCommentsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("orderID", params.ID),
+		zap.Float64p("offset", params.Offset),
+		zap.Float64p("limit", params.Limit),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	pagination := convertors.Paginator(params.Limit, params.Offset)
+
+	comments, total, err := h.service.GetComments(ctx, comment.Actor{UserID: adminID, Staff: true}, params.ID, *pagination)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewGetAdminOrderCommentsNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return admin.NewGetAdminOrderCommentsForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("get order comments failed", zap.Error(err))
+
+		return admin.NewGetAdminOrderCommentsInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order comments failed"),
+		})
+	}
+
+	return admin.NewGetAdminOrderCommentsOK().WithPayload(&models.GetCommentsResponse{
+		Comments: convertors.CommentsFromModel(comments),
+		Pagination: convertors.Pagination(&paginator.PaginationResult{
+			Limit:  pagination.Limit,
+			Offset: pagination.Offset,
+			Total:  total,
+		}),
+	})
+}
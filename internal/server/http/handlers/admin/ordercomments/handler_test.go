@@ -0,0 +1,145 @@
+package ordercomments_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/ordercomments"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID    = newID().String()
+		actor      = comment.Actor{UserID: adminID, Staff: true}
+		limit      = float64(50)
+		offset     = float64(0)
+		pagination = paginator.Pagination{Limit: 50}
+		path       = fmt.Sprintf("/api/v1/order/admin/orders/%s/comments", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := ordercomments.New(mock)
+
+		var i interface{} = adminID
+
+		edited := now().Add(time.Minute)
+		items := []*comment.Comment{
+			{
+				ID:       newID().String(),
+				TSCreate: now(),
+				TSEdit:   &edited,
+				OrderID:  newID().String(),
+				AuthorID: adminID,
+				Body:     "Where is my parcel?",
+			},
+		}
+
+		mock.EXPECT().GetComments(gomock.Any(), actor, newID().String(), pagination).Return(items, 1, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(admin.GetAdminOrderCommentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, admin.NewGetAdminOrderCommentsOK().WithPayload(&models.GetCommentsResponse{
+			Comments: []*models.Comment{
+				{
+					ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+					OrderID:   ptr.Pointer(strfmt.UUID(newID().String())),
+					AuthorID:  ptr.Pointer(strfmt.UUID(adminID)),
+					Body:      ptr.Pointer("Where is my parcel?"),
+					Internal:  ptr.Pointer(false),
+					CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+					EditedAt:  ptr.Pointer(strfmt.DateTime(edited)),
+				},
+			},
+			Pagination: &models.Pagination{
+				Limit:  ptr.Pointer(limit),
+				Offset: ptr.Pointer(offset),
+				Total:  ptr.Pointer(float64(1)),
+			},
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := ordercomments.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().GetComments(gomock.Any(), actor, newID().String(), pagination).Return(nil, 0, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(admin.GetAdminOrderCommentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, admin.NewGetAdminOrderCommentsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := ordercomments.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().GetComments(gomock.Any(), actor, newID().String(), pagination).Return(nil, 0, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(admin.GetAdminOrderCommentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, admin.NewGetAdminOrderCommentsInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order comments failed"),
+		}), res)
+	})
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,114 @@
+package promocode_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/promo"
+	promoMock "github.com/krivenkov/order/internal/model/promo/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/promocode"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		id      = uuid.New().String()
+		path    = fmt.Sprintf("/api/v1/order/admin/promo-codes/%s", id)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := promocode.New(mock)
+
+		var i interface{} = adminID
+
+		obj := &promo.Promo{
+			ID:       id,
+			TSCreate: now(),
+			TSModify: now(),
+			Code:     "TEN",
+			Kind:     promo.KindPercentage,
+			Scope:    promo.ScopeOrder,
+			Value:    10,
+		}
+
+		mock.EXPECT().GetItem(gomock.Any(), id).Return(obj, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(admin.GetPromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, admin.NewGetPromoCodeOK().WithPayload(&models.GetPromoResponse{
+			Promo: convertors.PromoFromModel(obj),
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := promocode.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().GetItem(gomock.Any(), id).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(admin.GetPromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, admin.NewGetPromoCodeNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := promocode.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().GetItem(gomock.Any(), id).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(admin.GetPromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, admin.NewGetPromoCodeInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get promo code failed"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
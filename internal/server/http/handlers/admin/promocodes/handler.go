@@ -0,0 +1,62 @@
+package promocodes
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service promo.Service
+}
+
+func New(service promo.Service) admin.GetPromoCodesHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.GetPromoCodesParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.Float64p("offset", params.Offset),
+		zap.Float64p("limit", params.Limit),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	pagination := convertors.Paginator(params.Limit, params.Offset)
+	if pagination.Limit == 0 {
+		l.Error("empty pagination")
+		return admin.NewGetPromoCodesBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Get promo codes failed"),
+		})
+	}
+
+	list, total, err := h.service.GetList(ctx, *pagination)
+	if err != nil {
+		l.Error("promo codes get list failed", zap.Error(err))
+		return admin.NewGetPromoCodesInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get promo codes failed"),
+		})
+	}
+
+	return admin.NewGetPromoCodesOK().WithPayload(&models.GetPromosResponse{
+		Promos: convertors.PromosFromModel(list),
+		Pagination: convertors.Pagination(&paginator.PaginationResult{
+			Limit:  pagination.Limit,
+			Offset: pagination.Offset,
+			Total:  total,
+		}),
+	})
+}
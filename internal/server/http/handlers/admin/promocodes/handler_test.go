@@ -0,0 +1,113 @@
+package promocodes_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model/promo"
+	promoMock "github.com/krivenkov/order/internal/model/promo/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/promocodes"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		path    = "/api/v1/order/admin/promo-codes"
+		limit   = float64(10)
+		offset  = float64(20)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := promocodes.New(mock)
+
+		var i interface{} = adminID
+
+		list := []*promo.Promo{
+			{ID: uuid.NewString(), TSCreate: now(), TSModify: now(), Code: "A1", Kind: promo.KindPercentage, Scope: promo.ScopeOrder, Value: 5},
+			{ID: uuid.NewString(), TSCreate: now(), TSModify: now(), Code: "B2", Kind: promo.KindPercentage, Scope: promo.ScopeOrder, Value: 15},
+		}
+
+		mock.EXPECT().GetList(gomock.Any(), paginator.Pagination{Limit: 10, Offset: 20}).Return(list, 22, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(admin.GetPromoCodesParams{
+			HTTPRequest: req,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, admin.NewGetPromoCodesOK().WithPayload(&models.GetPromosResponse{
+			Promos: convertors.PromosFromModel(list),
+			Pagination: &models.Pagination{
+				Limit:  ptr.Pointer(float64(10)),
+				Offset: ptr.Pointer(float64(20)),
+				Total:  ptr.Pointer(float64(22)),
+			},
+		}), res)
+	})
+
+	t.Run("Empty pagination", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := promocodes.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(admin.GetPromoCodesParams{
+			HTTPRequest: req,
+		}, i)
+
+		require.Equal(t, admin.NewGetPromoCodesBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Get promo codes failed"),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := promocodes.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().GetList(gomock.Any(), paginator.Pagination{Limit: 10, Offset: 20}).Return(nil, 0, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(admin.GetPromoCodesParams{
+			HTTPRequest: req,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, admin.NewGetPromoCodesInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get promo codes failed"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,87 @@
+package rejectreturn
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) admin.RejectReturnHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.RejectReturnParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	for _, id := range []string{params.ID, params.ReturnID} {
+		if _, err := uuid.Parse(id); err != nil {
+			return admin.NewRejectReturnNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer("Not Found"),
+			})
+		}
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("orderID", params.ID),
+		zap.String("returnID", params.ReturnID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	var comment string
+	if params.Body != nil {
+		comment = params.Body.Comment
+	}
+
+	item, err := h.service.RejectReturn(ctx, adminID, params.ID, params.ReturnID, comment)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return admin.NewRejectReturnBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewRejectReturnNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrConflict) {
+			return admin.NewRejectReturnConflict().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("reject return failed", zap.Error(err))
+
+		return admin.NewRejectReturnInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Reject return failed"),
+		})
+	}
+
+	return admin.NewRejectReturnOK().WithPayload(&models.GetReturnResponse{
+		Return: convertors.ReturnFromModel(item),
+	})
+}
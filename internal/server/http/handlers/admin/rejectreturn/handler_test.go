@@ -0,0 +1,118 @@
+package rejectreturn_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/rejectreturn"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		path    = fmt.Sprintf("/api/v1/order/admin/orders/%s/returns/%s/reject", newID().String(), newID().String())
+
+		reqBody = &models.DecideReturnRequest{Comment: "used"}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := rejectreturn.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().RejectReturn(gomock.Any(), adminID, newID().String(), newID().String(), "used").Return(&refund.Return{
+			ID:        newID().String(),
+			TSCreate:  now(),
+			TSModify:  now(),
+			OrderID:   newID().String(),
+			LineID:    newID().String(),
+			Quantity:  1,
+			Reason:    "damaged",
+			Status:    refund.StatusRejected,
+			Comment:   "used",
+			DecidedBy: adminID,
+		}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.RejectReturnParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			ReturnID:    newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewRejectReturnOK().WithPayload(&models.GetReturnResponse{
+			Return: &models.Return{
+				ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+				LineID:    ptr.Pointer(strfmt.UUID(newID().String())),
+				Quantity:  ptr.Pointer(int64(1)),
+				Reason:    ptr.Pointer("damaged"),
+				Status:    ptr.Pointer("rejected"),
+				Comment:   "used",
+				CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+				UpdatedAt: ptr.Pointer(strfmt.DateTime(now())),
+				Refunds:   []*models.Refund{},
+			},
+		}), res)
+	})
+
+	t.Run("Already decided", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := rejectreturn.New(mock)
+
+		var (
+			i interface{} = adminID
+
+			errConflict = fmt.Errorf("%w: return is approved", model.ErrConflict)
+		)
+
+		mock.EXPECT().RejectReturn(gomock.Any(), adminID, newID().String(), newID().String(), "used").Return(nil, errConflict)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.RejectReturnParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			ReturnID:    newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewRejectReturnConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errConflict.Error()),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,61 @@
+package removepromo
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service promo.Service
+}
+
+func New(service promo.Service) admin.DeletePromoCodeHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.DeletePromoCodeParams, i interface{}) middleware.Responder {
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return admin.NewDeletePromoCodeNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	adminID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("promoID", params.ID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if err := h.service.Delete(ctx, params.ID); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewDeletePromoCodeNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("delete promo code failed", zap.Error(err))
+
+		return admin.NewDeletePromoCodeInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Delete promo code failed"),
+		})
+	}
+
+	return admin.NewDeletePromoCodeNoContent()
+}
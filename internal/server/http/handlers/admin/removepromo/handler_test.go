@@ -0,0 +1,95 @@
+package removepromo_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	promoMock "github.com/krivenkov/order/internal/model/promo/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/removepromo"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		id      = uuid.New().String()
+		path    = fmt.Sprintf("/api/v1/order/admin/promo-codes/%s", id)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := removepromo.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Delete(gomock.Any(), id).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.DeletePromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, admin.NewDeletePromoCodeNoContent(), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := removepromo.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Delete(gomock.Any(), id).Return(model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.DeletePromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, admin.NewDeletePromoCodeNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := removepromo.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Delete(gomock.Any(), id).Return(errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.DeletePromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, admin.NewDeletePromoCodeInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Delete promo code failed"),
+		}), res)
+	})
+}
@@ -0,0 +1,69 @@
+package removetenantmember
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service tenant.Service
+}
+
+func New(service tenant.Service) admin.RemoveTenantMemberHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.RemoveTenantMemberParams, i interface{}) middleware.Responder {
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return admin.NewRemoveTenantMemberNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	if _, err := uuid.Parse(params.UserID); err != nil {
+		return admin.NewRemoveTenantMemberNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	adminID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("tenantID", params.ID),
+		zap.String("userID", params.UserID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if err := h.service.RemoveMember(ctx, params.ID, params.UserID); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewRemoveTenantMemberNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("remove tenant member failed", zap.Error(err))
+
+		return admin.NewRemoveTenantMemberInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Remove tenant member failed"),
+		})
+	}
+
+	return admin.NewRemoveTenantMemberNoContent()
+}
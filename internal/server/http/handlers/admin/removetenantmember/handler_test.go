@@ -0,0 +1,121 @@
+package removetenantmember_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	tenantMock "github.com/krivenkov/order/internal/model/tenant/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/removetenantmember"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID  = "admin_id"
+		tenantID = uuid.New().String()
+		userID   = uuid.New().String()
+		path     = fmt.Sprintf("/api/v1/order/admin/tenants/%s/members/%s", tenantID, userID)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := removetenantmember.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().RemoveMember(gomock.Any(), tenantID, userID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.RemoveTenantMemberParams{
+			HTTPRequest: req,
+			ID:          tenantID,
+			UserID:      userID,
+		}, i)
+
+		require.Equal(t, admin.NewRemoveTenantMemberNoContent(), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := removetenantmember.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().RemoveMember(gomock.Any(), tenantID, userID).Return(model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.RemoveTenantMemberParams{
+			HTTPRequest: req,
+			ID:          tenantID,
+			UserID:      userID,
+		}, i)
+
+		require.Equal(t, admin.NewRemoveTenantMemberNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := removetenantmember.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().RemoveMember(gomock.Any(), tenantID, userID).Return(errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.RemoveTenantMemberParams{
+			HTTPRequest: req,
+			ID:          tenantID,
+			UserID:      userID,
+		}, i)
+
+		require.Equal(t, admin.NewRemoveTenantMemberInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Remove tenant member failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := removetenantmember.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(admin.RemoveTenantMemberParams{
+			HTTPRequest: req,
+			ID:          "tenant",
+			UserID:      userID,
+		}, i)
+
+		require.Equal(t, admin.NewRemoveTenantMemberNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
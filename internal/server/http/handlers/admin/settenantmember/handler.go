@@ -0,0 +1,89 @@
+package settenantmember
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service tenant.Service
+}
+
+func New(service tenant.Service) admin.SetTenantMemberHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.SetTenantMemberParams, i interface{}) middleware.Responder {
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return admin.NewSetTenantMemberNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	if _, err := uuid.Parse(params.UserID); err != nil {
+		return admin.NewSetTenantMemberBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("userId must be a uuid"),
+		})
+	}
+
+	adminID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("tenantID", params.ID),
+		zap.String("userID", params.UserID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if params.Body == nil {
+		l.Warn("request body is empty")
+		return admin.NewSetTenantMemberBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		})
+	}
+
+	member, err := h.service.SetMember(ctx, params.ID, params.UserID, tenant.Role(swag.StringValue(params.Body.Role)))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return admin.NewSetTenantMemberBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewSetTenantMemberNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("set tenant member failed", zap.Error(err))
+
+		return admin.NewSetTenantMemberInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Set tenant member failed"),
+		})
+	}
+
+	return admin.NewSetTenantMemberOK().WithPayload(&models.GetTenantMemberResponse{
+		Member: convertors.TenantMemberFromModel(member),
+	})
+}
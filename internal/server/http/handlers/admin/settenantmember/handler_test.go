@@ -0,0 +1,173 @@
+package settenantmember_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	tenantMock "github.com/krivenkov/order/internal/model/tenant/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/settenantmember"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID  = "admin_id"
+		tenantID = uuid.New().String()
+		userID   = uuid.New().String()
+		path     = fmt.Sprintf("/api/v1/order/admin/tenants/%s/members/%s", tenantID, userID)
+
+		reqBody = &models.TenantMemberRequest{
+			Role: ptr.Pointer("editor"),
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := settenantmember.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().SetMember(gomock.Any(), tenantID, userID, tenant.RoleEditor).Return(&tenant.Member{
+			TenantID: tenantID,
+			UserID:   userID,
+			Role:     tenant.RoleEditor,
+			TSCreate: now(),
+		}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.SetTenantMemberParams{
+			HTTPRequest: req,
+			ID:          tenantID,
+			UserID:      userID,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewSetTenantMemberOK().WithPayload(&models.GetTenantMemberResponse{
+			Member: &models.TenantMember{
+				TenantID:  ptr.Pointer(strfmt.UUID(tenantID)),
+				UserID:    ptr.Pointer(strfmt.UUID(userID)),
+				Role:      ptr.Pointer("editor"),
+				CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+			},
+		}), res)
+	})
+
+	t.Run("Unknown tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := settenantmember.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().SetMember(gomock.Any(), tenantID, userID, tenant.RoleEditor).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(admin.SetTenantMemberParams{
+			HTTPRequest: req,
+			ID:          tenantID,
+			UserID:      userID,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewSetTenantMemberNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Invalid user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := settenantmember.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(admin.SetTenantMemberParams{
+			HTTPRequest: req,
+			ID:          tenantID,
+			UserID:      "user",
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewSetTenantMemberBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("userId must be a uuid"),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := settenantmember.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().SetMember(gomock.Any(), tenantID, userID, tenant.RoleEditor).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(admin.SetTenantMemberParams{
+			HTTPRequest: req,
+			ID:          tenantID,
+			UserID:      userID,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewSetTenantMemberInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Set tenant member failed"),
+		}), res)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := tenantMock.NewMockService(ctrl)
+		serv := settenantmember.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(admin.SetTenantMemberParams{
+			HTTPRequest: req,
+			ID:          tenantID,
+			UserID:      userID,
+		}, i)
+
+		require.Equal(t, admin.NewSetTenantMemberBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,93 @@
+package shipmentstatus
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) admin.UpdateShipmentStatusHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.UpdateShipmentStatusParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	for _, id := range []string{params.ID, params.ShipmentID} {
+		if _, err := uuid.Parse(id); err != nil {
+			return admin.NewUpdateShipmentStatusNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer("Not Found"),
+			})
+		}
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("orderID", params.ID),
+		zap.String("shipmentID", params.ShipmentID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if params.Body == nil {
+		l.Warn("request body is empty")
+		return admin.NewUpdateShipmentStatusBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		})
+	}
+
+	status, ok := shipment.ParseStatus(swag.StringValue(params.Body.Status))
+	if !ok {
+		return admin.NewUpdateShipmentStatusBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("unknown status"),
+		})
+	}
+
+	item, err := h.service.UpdateShipmentStatus(ctx, adminID, params.ID, params.ShipmentID, status)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewUpdateShipmentStatusNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrConflict) {
+			return admin.NewUpdateShipmentStatusConflict().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("update shipment status failed", zap.Error(err))
+
+		return admin.NewUpdateShipmentStatusInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Update shipment status failed"),
+		})
+	}
+
+	return admin.NewUpdateShipmentStatusOK().WithPayload(&models.GetShipmentResponse{
+		Shipment: convertors.ShipmentFromModel(item),
+	})
+}
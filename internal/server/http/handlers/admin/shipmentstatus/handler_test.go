@@ -0,0 +1,172 @@
+package shipmentstatus_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/shipmentstatus"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		path    = fmt.Sprintf("/api/v1/order/admin/orders/%s/shipments/%s/status", newID().String(), newID().String())
+
+		reqBody = &models.UpdateShipmentStatusRequest{
+			Status: ptr.Pointer(models.UpdateShipmentStatusRequestStatusDelivered),
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := shipmentstatus.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().UpdateShipmentStatus(gomock.Any(), adminID, newID().String(), newID().String(), shipment.StatusDelivered).
+			Return(&shipment.Shipment{
+				ID:       newID().String(),
+				TSCreate: now(),
+				Carrier:  "DHL",
+				Status:   shipment.StatusDelivered,
+				Events: []*shipment.Event{
+					{Status: shipment.StatusShipped, TSCreate: now()},
+					{Status: shipment.StatusDelivered, TSCreate: now().Add(time.Hour)},
+				},
+			}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.UpdateShipmentStatusParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			ShipmentID:  newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewUpdateShipmentStatusOK().WithPayload(&models.GetShipmentResponse{
+			Shipment: &models.Shipment{
+				ID:             ptr.Pointer(strfmt.UUID(newID().String())),
+				Carrier:        ptr.Pointer("DHL"),
+				TrackingNumber: ptr.Pointer(""),
+				Status:         ptr.Pointer("delivered"),
+				CreatedAt:      ptr.Pointer(strfmt.DateTime(now())),
+				Lines:          []*models.ShipmentLine{},
+				Events: []*models.ShipmentEvent{
+					{Status: ptr.Pointer("shipped"), CreatedAt: ptr.Pointer(strfmt.DateTime(now()))},
+					{Status: ptr.Pointer("delivered"), CreatedAt: ptr.Pointer(strfmt.DateTime(now().Add(time.Hour)))},
+				},
+			},
+		}), res)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := shipmentstatus.New(mock)
+
+		var (
+			i interface{} = adminID
+
+			errConflict = fmt.Errorf("%w: shipment can not move from cancelled to delivered", model.ErrConflict)
+		)
+
+		mock.EXPECT().UpdateShipmentStatus(gomock.Any(), adminID, newID().String(), newID().String(), shipment.StatusDelivered).
+			Return(nil, errConflict)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.UpdateShipmentStatusParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			ShipmentID:  newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewUpdateShipmentStatusConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := shipmentstatus.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().UpdateShipmentStatus(gomock.Any(), adminID, newID().String(), newID().String(), shipment.StatusDelivered).
+			Return(nil, errors.New("some error"))
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewReader(body))
+
+		res := serv.Handle(admin.UpdateShipmentStatusParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			ShipmentID:  newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewUpdateShipmentStatusInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Update shipment status failed"),
+		}), res)
+	})
+
+	t.Run("Invalid shipment id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := shipmentstatus.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(admin.UpdateShipmentStatusParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			ShipmentID:  "123",
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewUpdateShipmentStatusNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,97 @@
+package updateordercomment
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) admin.UpdateAdminOrderCommentHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params admin.UpdateAdminOrderCommentParams, i interface{}) middleware.Responder {
+	adminID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return admin.NewUpdateAdminOrderCommentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	if _, err := uuid.Parse(params.CommentID); err != nil {
+		return admin.NewUpdateAdminOrderCommentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("adminID", adminID),
+		zap.String("orderID", params.ID),
+		zap.String("commentID", params.CommentID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if params.Body == nil {
+		l.Warn("request body is empty")
+		return admin.NewUpdateAdminOrderCommentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		})
+	}
+
+	res, err := h.service.UpdateComment(ctx, comment.Actor{UserID: adminID, Staff: true}, params.ID, params.CommentID, swag.StringValue(params.Body.Body))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return admin.NewUpdateAdminOrderCommentBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrNotFound) {
+			return admin.NewUpdateAdminOrderCommentNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return admin.NewUpdateAdminOrderCommentForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("update order comment failed", zap.Error(err))
+
+		return admin.NewUpdateAdminOrderCommentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Update order comment failed"),
+		})
+	}
+
+	return admin.NewUpdateAdminOrderCommentOK().WithPayload(&models.GetCommentResponse{
+		Comment: convertors.CommentFromModel(res),
+	})
+}
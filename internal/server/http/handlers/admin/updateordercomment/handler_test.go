@@ -0,0 +1,157 @@
+package updateordercomment_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/updateordercomment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID   = newID().String()
+		actor     = comment.Actor{UserID: adminID, Staff: true}
+		orderID   = uuid.New().String()
+		commentID = uuid.New().String()
+		path      = fmt.Sprintf("/api/v1/order/admin/orders/%s/comments/%s", orderID, commentID)
+		body      = &models.CommentRequest{Body: ptr.Pointer("Never mind, it arrived")}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := updateordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		edited := now().Add(time.Hour)
+
+		mock.EXPECT().UpdateComment(gomock.Any(), actor, orderID, commentID, "Never mind, it arrived").Return(&comment.Comment{
+			ID:       commentID,
+			TSCreate: now(),
+			TSEdit:   &edited,
+			OrderID:  orderID,
+			AuthorID: adminID,
+			Body:     "Never mind, it arrived",
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodPatch, path, nil)
+
+		res := serv.Handle(admin.UpdateAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   commentID,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, admin.NewUpdateAdminOrderCommentOK().WithPayload(&models.GetCommentResponse{
+			Comment: &models.Comment{
+				ID:        ptr.Pointer(strfmt.UUID(commentID)),
+				OrderID:   ptr.Pointer(strfmt.UUID(orderID)),
+				AuthorID:  ptr.Pointer(strfmt.UUID(adminID)),
+				Body:      ptr.Pointer("Never mind, it arrived"),
+				Internal:  ptr.Pointer(false),
+				CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+				EditedAt:  ptr.Pointer(strfmt.DateTime(edited)),
+			},
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := updateordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().UpdateComment(gomock.Any(), actor, orderID, commentID, "Never mind, it arrived").Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodPatch, path, nil)
+
+		res := serv.Handle(admin.UpdateAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   commentID,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, admin.NewUpdateAdminOrderCommentForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := updateordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().UpdateComment(gomock.Any(), actor, orderID, commentID, "Never mind, it arrived").Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPatch, path, nil)
+
+		res := serv.Handle(admin.UpdateAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   commentID,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, admin.NewUpdateAdminOrderCommentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Update order comment failed"),
+		}), res)
+	})
+
+	t.Run("Invalid comment id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := updateordercomment.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPatch, path, nil)
+
+		res := serv.Handle(admin.UpdateAdminOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   "comment",
+			Body:        body,
+		}, i)
+
+		require.Equal(t, admin.NewUpdateAdminOrderCommentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
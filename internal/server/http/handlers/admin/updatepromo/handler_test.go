@@ -0,0 +1,192 @@
+package updatepromo_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/promo"
+	promoMock "github.com/krivenkov/order/internal/model/promo/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/admin/updatepromo"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adminID = "admin_id"
+		id      = uuid.New().String()
+		path    = fmt.Sprintf("/api/v1/order/admin/promo-codes/%s", id)
+
+		reqBody = &models.PromoRequest{
+			Code:  ptr.Pointer("TEN"),
+			Kind:  ptr.Pointer("percentage"),
+			Scope: ptr.Pointer("order"),
+			Value: ptr.Pointer(int64(10)),
+		}
+
+		form = &promo.Form{
+			Code:  "TEN",
+			Kind:  promo.KindPercentage,
+			Scope: promo.ScopeOrder,
+			Value: 10,
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := updatepromo.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Update(gomock.Any(), id, form).Return(&promo.Promo{
+			ID:       id,
+			TSCreate: now(),
+			TSModify: now(),
+			Code:     "TEN",
+			Kind:     promo.KindPercentage,
+			Scope:    promo.ScopeOrder,
+			Value:    10,
+			Uses:     4,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(admin.UpdatePromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewUpdatePromoCodeOK().WithPayload(&models.GetPromoResponse{
+			Promo: &models.Promo{
+				ID:             ptr.Pointer(strfmt.UUID(id)),
+				Code:           ptr.Pointer("TEN"),
+				Kind:           ptr.Pointer("percentage"),
+				Scope:          ptr.Pointer("order"),
+				Value:          ptr.Pointer(int64(10)),
+				Skus:           []string{},
+				MinAmount:      ptr.Pointer(int64(0)),
+				MaxUses:        ptr.Pointer(int64(0)),
+				MaxUsesPerUser: ptr.Pointer(int64(0)),
+				Uses:           ptr.Pointer(int64(4)),
+				CreatedAt:      ptr.Pointer(strfmt.DateTime(now())),
+				UpdatedAt:      ptr.Pointer(strfmt.DateTime(now())),
+			},
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := updatepromo.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Update(gomock.Any(), id, form).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(admin.UpdatePromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewUpdatePromoCodeNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Code taken", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := updatepromo.New(mock)
+
+		var (
+			i           interface{} = adminID
+			errConflict             = fmt.Errorf("%w: promo code already exists", model.ErrConflict)
+		)
+
+		mock.EXPECT().Update(gomock.Any(), id, form).Return(nil, errConflict)
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(admin.UpdatePromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewUpdatePromoCodeConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := updatepromo.New(mock)
+
+		var i interface{} = adminID
+
+		mock.EXPECT().Update(gomock.Any(), id, form).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(admin.UpdatePromoCodeParams{
+			HTTPRequest: req,
+			ID:          id,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewUpdatePromoCodeInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Update promo code failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := promoMock.NewMockService(ctrl)
+		serv := updatepromo.New(mock)
+
+		var i interface{} = adminID
+
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/order/admin/promo-codes/bad", nil)
+
+		res := serv.Handle(admin.UpdatePromoCodeParams{
+			HTTPRequest: req,
+			ID:          "bad",
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, admin.NewUpdatePromoCodeNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -1,10 +1,14 @@
 package handlers
 
 import (
+	"github.com/krivenkov/order/internal/server/http/handlers/admin"
 	"github.com/krivenkov/order/internal/server/http/handlers/order"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring"
 	"go.uber.org/fx"
 )
 
 var FXModule = fx.Options(
 	order.FXModule,
+	admin.FXModule,
+	recurring.FXModule,
 )
@@ -0,0 +1,95 @@
+package addline
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) order.AddOrderLineHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params order.AddOrderLineParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return order.NewAddOrderLineNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+		zap.String("orderID", params.ID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if params.Body == nil {
+		l.Warn("request body is empty")
+		return order.NewAddOrderLineBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		})
+	}
+
+	res, item, err := h.service.AddLine(ctx, userID, params.ID, convertors.LineToModel(params.Body))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return order.NewAddOrderLineBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrNotFound) {
+			return order.NewAddOrderLineNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return order.NewAddOrderLineForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrConflict) {
+			return order.NewAddOrderLineConflict().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("add order line failed", zap.Error(err))
+
+		return order.NewAddOrderLineInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Add order line failed"),
+		})
+	}
+
+	return order.NewAddOrderLineOK().WithPayload(&models.GetOrderLineResponse{
+		Line:  convertors.LineFromModel(res),
+		Order: convertors.OrderFromModel(item),
+	})
+}
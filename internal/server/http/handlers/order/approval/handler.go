@@ -0,0 +1,72 @@
+package approval
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) order.GetOrderApprovalHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params order.GetOrderApprovalParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return order.NewGetOrderApprovalNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+		zap.String("orderID", params.ID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	item, err := h.service.GetApproval(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return order.NewGetOrderApprovalNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return order.NewGetOrderApprovalForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("get approval failed", zap.Error(err))
+
+		return order.NewGetOrderApprovalInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get approval failed"),
+		})
+	}
+
+	return order.NewGetOrderApprovalOK().WithPayload(&models.GetApprovalResponse{
+		Approval: convertors.ApprovalFromModel(item),
+	})
+}
@@ -0,0 +1,172 @@
+package approval_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	approvalModel "github.com/krivenkov/order/internal/model/approval"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/approval"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/approval", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := approval.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetApproval(gomock.Any(), userID, newID().String()).Return(&approvalModel.Approval{
+			ID:          newID().String(),
+			TSCreate:    now(),
+			TSModify:    now(),
+			OrderID:     newID().String(),
+			TenantID:    newID().String(),
+			RequestedBy: newID().String(),
+			Approvers:   []string{newID().String()},
+			Status:      approvalModel.StatusPending,
+			Level:       1,
+			TSDue:       now().Add(time.Hour),
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderApprovalParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderApprovalOK().WithPayload(&models.GetApprovalResponse{
+			Approval: &models.Approval{
+				ID:          ptr.Pointer(strfmt.UUID(newID().String())),
+				OrderID:     ptr.Pointer(strfmt.UUID(newID().String())),
+				RequestedBy: ptr.Pointer(strfmt.UUID(newID().String())),
+				Approvers:   []strfmt.UUID{strfmt.UUID(newID().String())},
+				Status:      ptr.Pointer("pending"),
+				Level:       ptr.Pointer(int64(1)),
+				DueAt:       ptr.Pointer(strfmt.DateTime(now().Add(time.Hour))),
+				CreatedAt:   ptr.Pointer(strfmt.DateTime(now())),
+				UpdatedAt:   ptr.Pointer(strfmt.DateTime(now())),
+			},
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := approval.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetApproval(gomock.Any(), userID, newID().String()).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderApprovalParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderApprovalNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := approval.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetApproval(gomock.Any(), userID, newID().String()).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderApprovalParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderApprovalForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := approval.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetApproval(gomock.Any(), userID, newID().String()).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderApprovalParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderApprovalInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get approval failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := approval.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/123/approval", nil)
+
+		res := serv.Handle(orderOperation.GetOrderApprovalParams{
+			HTTPRequest: req,
+			ID:          "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderApprovalNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,91 @@
+package approve
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) order.ApproveOrderHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params order.ApproveOrderParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return order.NewApproveOrderNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+		zap.String("orderID", params.ID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	var comment string
+	if params.Body != nil {
+		comment = params.Body.Comment
+	}
+
+	item, err := h.service.Approve(ctx, userID, params.ID, comment)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return order.NewApproveOrderBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrNotFound) {
+			return order.NewApproveOrderNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return order.NewApproveOrderForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrConflict) {
+			return order.NewApproveOrderConflict().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("approve order failed", zap.Error(err))
+
+		return order.NewApproveOrderInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Approve order failed"),
+		})
+	}
+
+	return order.NewApproveOrderOK().WithPayload(&models.GetOrderResponse{
+		Order: convertors.OrderFromModel(item),
+	})
+}
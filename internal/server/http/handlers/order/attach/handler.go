@@ -0,0 +1,103 @@
+package attach
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) order.CreateOrderAttachmentHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params order.CreateOrderAttachmentParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return order.NewCreateOrderAttachmentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	file, ok := params.File.(*runtime.File)
+	if !ok {
+		return order.NewCreateOrderAttachmentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("file is required"),
+		})
+	}
+	defer file.Close()
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+		zap.String("orderID", params.ID),
+		zap.String("fileName", file.Header.Filename),
+		zap.Int64("size", file.Header.Size),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	item, err := h.service.Attach(ctx, userID, params.ID, &attachment.Form{
+		FileName:    file.Header.Filename,
+		ContentType: file.Header.Header.Get("Content-Type"),
+		Size:        file.Header.Size,
+	}, file.Data)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return order.NewCreateOrderAttachmentBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrNotFound) {
+			return order.NewCreateOrderAttachmentNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return order.NewCreateOrderAttachmentForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrConflict) {
+			return order.NewCreateOrderAttachmentConflict().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("attach file failed", zap.Error(err))
+
+		return order.NewCreateOrderAttachmentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Attach file failed"),
+		})
+	}
+
+	return order.NewCreateOrderAttachmentOK().WithPayload(&models.GetAttachmentResponse{
+		Attachment: convertors.AttachmentFromModel(item),
+	})
+}
@@ -0,0 +1,201 @@
+package attach_test
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/attach"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/attachments", newID().String())
+		pdf    = "%PDF-1.7"
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attach.New(mock)
+
+		var i interface{} = userID
+
+		req, file := upload(t, path, "invoice.pdf", pdf)
+
+		mock.EXPECT().Attach(gomock.Any(), userID, newID().String(), &attachment.Form{
+			FileName:    "invoice.pdf",
+			ContentType: "application/octet-stream",
+			Size:        int64(len(pdf)),
+		}, file.Data).Return(&attachment.Attachment{
+			ID:          newID().String(),
+			TSCreate:    now(),
+			OrderID:     newID().String(),
+			UserID:      newID().String(),
+			FileName:    "invoice.pdf",
+			ContentType: "application/pdf",
+			Size:        int64(len(pdf)),
+		}, nil)
+
+		res := serv.Handle(orderOperation.CreateOrderAttachmentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			File:        file,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderAttachmentOK().WithPayload(&models.GetAttachmentResponse{
+			Attachment: &models.Attachment{
+				ID:          ptr.Pointer(strfmt.UUID(newID().String())),
+				OrderID:     ptr.Pointer(strfmt.UUID(newID().String())),
+				UserID:      ptr.Pointer(strfmt.UUID(newID().String())),
+				FileName:    ptr.Pointer("invoice.pdf"),
+				ContentType: ptr.Pointer("application/pdf"),
+				Size:        ptr.Pointer(int64(len(pdf))),
+				CreatedAt:   ptr.Pointer(strfmt.DateTime(now())),
+			},
+		}), res)
+	})
+
+	t.Run("Invalid file", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attach.New(mock)
+
+		var i interface{} = userID
+
+		req, file := upload(t, path, "run.sh", "#!/bin/sh")
+
+		mock.EXPECT().Attach(gomock.Any(), userID, newID().String(), gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("%w: content type %q is not allowed", model.ErrInvalidArgument, "text/plain"))
+
+		res := serv.Handle(orderOperation.CreateOrderAttachmentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			File:        file,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderAttachmentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(`invalid argument: content type "text/plain" is not allowed`),
+		}), res)
+	})
+
+	t.Run("Too many attachments", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attach.New(mock)
+
+		var i interface{} = userID
+
+		req, file := upload(t, path, "invoice.pdf", pdf)
+
+		mock.EXPECT().Attach(gomock.Any(), userID, newID().String(), gomock.Any(), gomock.Any()).Return(nil, model.ErrConflict)
+
+		res := serv.Handle(orderOperation.CreateOrderAttachmentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			File:        file,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderAttachmentConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attach.New(mock)
+
+		var i interface{} = userID
+
+		req, file := upload(t, path, "invoice.pdf", pdf)
+
+		mock.EXPECT().Attach(gomock.Any(), userID, newID().String(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("some error"))
+
+		res := serv.Handle(orderOperation.CreateOrderAttachmentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			File:        file,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderAttachmentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Attach file failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attach.New(mock)
+
+		var i interface{} = userID
+
+		req, file := upload(t, "/api/v1/order/orders/123/attachments", "invoice.pdf", pdf)
+
+		res := serv.Handle(orderOperation.CreateOrderAttachmentParams{
+			HTTPRequest: req,
+			ID:          "123",
+			File:        file,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderAttachmentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+// upload prepares a multipart request and the file bound from it as the generated parameters do
+func upload(t *testing.T, path, fileName, content string) (*http.Request, *runtime.File) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	part, err := w.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+
+	_, err = part.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	req := httptest.NewRequest(http.MethodPost, path, body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	data, header, err := req.FormFile("file")
+	require.NoError(t, err)
+
+	return req, &runtime.File{Data: data, Header: header}
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,17 @@
+package attachment
+
+import (
+	"github.com/krivenkov/order/internal/server/http/operations"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(New),
+
+	fx.Invoke(
+		func(handler order.GetOrderAttachmentHandler, api *operations.OrderAPIAPI) {
+			api.OrderGetOrderAttachmentHandler = handler
+		},
+	),
+)
@@ -0,0 +1,82 @@
+package attachment
+
+import (
+	"errors"
+	"mime"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) order.GetOrderAttachmentHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params order.GetOrderAttachmentParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	if _, err := uuid.Parse(params.ID); err != nil {
+		return order.NewGetOrderAttachmentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	if _, err := uuid.Parse(params.AttachmentID); err != nil {
+		return order.NewGetOrderAttachmentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+		zap.String("orderID", params.ID),
+		zap.String("attachmentID", params.AttachmentID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	item, content, err := h.service.GetAttachment(ctx, userID, params.ID, params.AttachmentID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return order.NewGetOrderAttachmentNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return order.NewGetOrderAttachmentForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("get attachment failed", zap.Error(err))
+
+		return order.NewGetOrderAttachmentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get attachment failed"),
+		})
+	}
+
+	// the producer closes the content once it is written
+	return order.NewGetOrderAttachmentOK().
+		WithContentType(item.ContentType).
+		WithContentDisposition(mime.FormatMediaType("attachment", map[string]string{"filename": item.FileName})).
+		WithPayload(content)
+}
@@ -0,0 +1,139 @@
+package attachment_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	attachmentModel "github.com/krivenkov/order/internal/model/attachment"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/attachment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/attachments/%s", newID().String(), newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attachment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetAttachment(gomock.Any(), userID, newID().String(), newID().String()).Return(&attachmentModel.Attachment{
+			ID:          newID().String(),
+			OrderID:     newID().String(),
+			FileName:    "счёт.pdf",
+			ContentType: "application/pdf",
+			Size:        8,
+		}, io.NopCloser(strings.NewReader("%PDF-1.7")), nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderAttachmentParams{
+			HTTPRequest:  req,
+			ID:           newID().String(),
+			AttachmentID: newID().String(),
+		}, i)
+
+		rec := httptest.NewRecorder()
+		res.WriteResponse(rec, runtime.ByteStreamProducer())
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/pdf", rec.Header().Get("Content-Type"))
+		require.Equal(t, "attachment; filename*=utf-8''%D1%81%D1%87%D1%91%D1%82.pdf", rec.Header().Get("Content-Disposition"))
+		require.Equal(t, "%PDF-1.7", rec.Body.String())
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attachment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetAttachment(gomock.Any(), userID, newID().String(), newID().String()).Return(nil, nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderAttachmentParams{
+			HTTPRequest:  req,
+			ID:           newID().String(),
+			AttachmentID: newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderAttachmentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attachment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetAttachment(gomock.Any(), userID, newID().String(), newID().String()).Return(nil, nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderAttachmentParams{
+			HTTPRequest:  req,
+			ID:           newID().String(),
+			AttachmentID: newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderAttachmentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get attachment failed"),
+		}), res)
+	})
+
+	t.Run("Invalid attachment id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attachment.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderAttachmentParams{
+			HTTPRequest:  req,
+			ID:           newID().String(),
+			AttachmentID: "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderAttachmentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,17 @@
+package attachments
+
+import (
+	"github.com/krivenkov/order/internal/server/http/operations"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(New),
+
+	fx.Invoke(
+		func(handler order.GetOrderAttachmentsHandler, api *operations.OrderAPIAPI) {
+			api.OrderGetOrderAttachmentsHandler = handler
+		},
+	),
+)
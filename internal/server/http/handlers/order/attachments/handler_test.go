@@ -0,0 +1,148 @@
+package attachments_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/attachments"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/attachments", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attachments.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetAttachments(gomock.Any(), userID, newID().String()).Return([]*attachment.Attachment{
+			{
+				ID:          newID().String(),
+				TSCreate:    now(),
+				OrderID:     newID().String(),
+				UserID:      newID().String(),
+				FileName:    "invoice.pdf",
+				ContentType: "application/pdf",
+				Size:        1024,
+			},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderAttachmentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderAttachmentsOK().WithPayload(&models.GetAttachmentsResponse{
+			Attachments: []*models.Attachment{
+				{
+					ID:          ptr.Pointer(strfmt.UUID(newID().String())),
+					OrderID:     ptr.Pointer(strfmt.UUID(newID().String())),
+					UserID:      ptr.Pointer(strfmt.UUID(newID().String())),
+					FileName:    ptr.Pointer("invoice.pdf"),
+					ContentType: ptr.Pointer("application/pdf"),
+					Size:        ptr.Pointer(int64(1024)),
+					CreatedAt:   ptr.Pointer(strfmt.DateTime(now())),
+				},
+			},
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attachments.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetAttachments(gomock.Any(), userID, newID().String()).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderAttachmentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderAttachmentsForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attachments.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetAttachments(gomock.Any(), userID, newID().String()).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderAttachmentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderAttachmentsInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get attachments failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := attachments.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/123/attachments", nil)
+
+		res := serv.Handle(orderOperation.GetOrderAttachmentsParams{
+			HTTPRequest: req,
+			ID:          "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderAttachmentsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
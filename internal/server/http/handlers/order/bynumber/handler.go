@@ -0,0 +1,72 @@
+package bynumber
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) order.GetOrderByNumberHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params order.GetOrderByNumberParams, i interface{}) middleware.Responder {
+	number, ok := orderModel.ParseNumber(params.Number)
+	if !ok {
+		return order.NewGetOrderByNumberNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		})
+	}
+
+	userID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+		zap.String("orderNumber", number),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	orderItem, err := h.service.GetItemByNumber(ctx, userID, number)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return order.NewGetOrderByNumberNotFound().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return order.NewGetOrderByNumberForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("get order by number failed", zap.Error(err))
+
+		return order.NewGetOrderByNumberInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order failed"),
+		})
+	}
+
+	return order.NewGetOrderByNumberOK().WithPayload(&models.GetOrderResponse{
+		Order: convertors.OrderFromModel(orderItem),
+	})
+}
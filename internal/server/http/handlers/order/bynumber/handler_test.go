@@ -0,0 +1,158 @@
+package bynumber_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/bynumber"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		number = "ORD-2000-000123"
+		path   = "/api/v1/order/orders/by-number/" + number
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := bynumber.New(mock)
+
+		var i interface{} = userID
+
+		obj := &orderModel.Order{
+			ID:          uuid.Nil.String(),
+			TSCreate:    now(),
+			TSModify:    now(),
+			Status:      orderModel.StatusCreated,
+			Number:      number,
+			UserID:      userID,
+			Name:        "name",
+			Description: "description",
+		}
+
+		mock.EXPECT().GetItemByNumber(gomock.Any(), userID, number).Return(obj, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderByNumberParams{
+			HTTPRequest: req,
+			Number:      "ord-2000-000123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderByNumberOK().WithPayload(&models.GetOrderResponse{
+			Order: convertors.OrderFromModel(obj),
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := bynumber.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetItemByNumber(gomock.Any(), userID, number).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderByNumberParams{
+			HTTPRequest: req,
+			Number:      number,
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderByNumberNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := bynumber.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetItemByNumber(gomock.Any(), userID, number).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderByNumberParams{
+			HTTPRequest: req,
+			Number:      number,
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderByNumberForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := bynumber.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetItemByNumber(gomock.Any(), userID, number).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderByNumberParams{
+			HTTPRequest: req,
+			Number:      number,
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderByNumberInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order failed"),
+		}), res)
+	})
+
+	t.Run("Invalid number", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := bynumber.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/by-number/123", nil)
+
+		res := serv.Handle(orderOperation.GetOrderByNumberParams{
+			HTTPRequest: req,
+			Number:      "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderByNumberNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,208 @@
+package checkout_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/inventory"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/checkout"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/checkout", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := checkout.New(mock)
+
+		var i interface{} = userID
+
+		obj := &orderModel.Order{
+			ID:       newID().String(),
+			TSCreate: now(),
+			TSModify: now(),
+			Status:   orderModel.StatusCreated,
+			State:    orderModel.StatePlaced,
+			UserID:   userID,
+			Name:     "name",
+		}
+
+		mock.EXPECT().Checkout(gomock.Any(), userID, newID().String()).Return(obj, nil)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CheckoutOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewCheckoutOrderOK().WithPayload(&models.GetOrderResponse{
+			Order: convertors.OrderFromModel(obj),
+		}), res)
+	})
+
+	t.Run("No lines", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := checkout.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Checkout(gomock.Any(), userID, newID().String()).Return(nil, model.ErrInvalidArgument)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CheckoutOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewCheckoutOrderBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrInvalidArgument.Error()),
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := checkout.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Checkout(gomock.Any(), userID, newID().String()).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CheckoutOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewCheckoutOrderNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := checkout.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Checkout(gomock.Any(), userID, newID().String()).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CheckoutOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewCheckoutOrderForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Out of stock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := checkout.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Checkout(gomock.Any(), userID, newID().String()).Return(nil, inventory.ErrOutOfStock)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CheckoutOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewCheckoutOrderConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(inventory.ErrOutOfStock.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := checkout.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Checkout(gomock.Any(), userID, newID().String()).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CheckoutOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewCheckoutOrderInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Checkout order failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := checkout.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/order/orders/123/checkout", nil)
+
+		res := serv.Handle(orderOperation.CheckoutOrderParams{
+			HTTPRequest: req,
+			ID:          "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewCheckoutOrderNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
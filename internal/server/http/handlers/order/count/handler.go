@@ -3,6 +3,7 @@ package count
 import (
 	"github.com/go-openapi/runtime/middleware"
 	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
 	"github.com/krivenkov/order/internal/server/http/models"
 	"github.com/krivenkov/order/internal/server/http/operations/order"
 	"github.com/krivenkov/pkg/mlog"
@@ -30,7 +31,15 @@ func (h *Handler) Handle(params order.GetOrdersCountParams, i interface{}) middl
 	)
 	ctx = mlog.CtxWithLogger(ctx, l)
 
-	count, err := h.service.Count(ctx, userID, h.prepareCountCondition(params))
+	metadata, err := convertors.MetadataFilterToModel(params.Metadata)
+	if err != nil {
+		return order.NewGetOrdersCountBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(err.Error()),
+		})
+	}
+
+	count, err := h.service.Count(ctx, userID, h.prepareCountCondition(params, metadata))
 	if err != nil {
 		l.Error("get order count failed", zap.Error(err))
 
@@ -45,12 +54,32 @@ func (h *Handler) Handle(params order.GetOrdersCountParams, i interface{}) middl
 	})
 }
 
-func (h *Handler) prepareCountCondition(params order.GetOrdersCountParams) *orderModel.GetCountRequest {
+func (h *Handler) prepareCountCondition(params order.GetOrdersCountParams, metadata map[string]string) *orderModel.GetCountRequest {
 	req := &orderModel.GetCountRequest{}
 
 	if params.Q != nil {
 		req.Q = option.New(*params.Q)
 	}
 
+	if params.Draft != nil {
+		req.Draft = option.New(*params.Draft)
+	}
+
+	if len(params.Tags) > 0 {
+		req.Tags = option.New(params.Tags)
+	}
+
+	if len(params.TagsAny) > 0 {
+		req.TagsAny = option.New(params.TagsAny)
+	}
+
+	if len(metadata) > 0 {
+		req.Metadata = option.New(metadata)
+	}
+
+	if params.IncludeShared != nil {
+		req.IncludeShared = option.New(*params.IncludeShared)
+	}
+
 	return req
 }
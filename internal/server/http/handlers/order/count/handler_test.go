@@ -86,4 +86,54 @@ func TestHandler(t *testing.T) {
 			ErrorDescription: ptr.Pointer("Get order count failed"),
 		}), res)
 	})
+
+	t.Run("Metadata filter", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := count.New(mock)
+
+		var (
+			userID = "user_id"
+			i      interface{}
+		)
+
+		filter := &orderModel.GetCountRequest{
+			Metadata: option.New(map[string]string{"crm-id": "42", "source": "web:mobile"}),
+		}
+
+		mock.EXPECT().Count(gomock.Any(), userID, filter).Return(1, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/count", nil)
+		i = userID
+
+		res := serv.Handle(order.GetOrdersCountParams{
+			Metadata:    []string{"crm-id:42", "source:web:mobile"},
+			HTTPRequest: req,
+		}, i)
+
+		require.IsType(t, &order.GetOrdersCountOK{}, res)
+	})
+
+	t.Run("Bad metadata filter", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := count.New(mock)
+
+		var (
+			userID = "user_id"
+			i      interface{}
+		)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/count", nil)
+		i = userID
+
+		res := serv.Handle(order.GetOrdersCountParams{
+			Metadata:    []string{"crm-id"},
+			HTTPRequest: req,
+		}, i)
+
+		require.IsType(t, &order.GetOrdersCountBadRequest{}, res)
+	})
 }
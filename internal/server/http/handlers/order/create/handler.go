@@ -1,7 +1,10 @@
 package create
 
 import (
+	"errors"
+
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/krivenkov/order/internal/model"
 	orderModel "github.com/krivenkov/order/internal/model/order"
 	"github.com/krivenkov/order/internal/server/http/convertors"
 	"github.com/krivenkov/order/internal/server/http/models"
@@ -38,13 +41,29 @@ func (h *Handler) Handle(params order.CreateOrderParams, i interface{}) middlewa
 		})
 	}
 
-	form := &orderModel.Form{
-		Name:        params.Body.Name,
-		Description: params.Body.Description,
-	}
-
-	item, err := h.service.Create(ctx, userID, form)
+	item, err := h.service.Create(ctx, userID, convertors.OrderFormToModel(params.Body))
 	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return order.NewCreateOrderBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrPermissionDenied) {
+			return order.NewCreateOrderForbidden().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		if errors.Is(err, model.ErrConflict) {
+			return order.NewCreateOrderConflict().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
 		l.Error("create order failed", zap.Error(err))
 
 		return order.NewCreateOrderInternalServerError().WithPayload(&models.Error{
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,8 +12,10 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
 	orderModel "github.com/krivenkov/order/internal/model/order"
 	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/promo"
 	"github.com/krivenkov/order/internal/server/http/convertors"
 	"github.com/krivenkov/order/internal/server/http/handlers/order/create"
 	"github.com/krivenkov/order/internal/server/http/models"
@@ -118,6 +121,123 @@ func TestHandler(t *testing.T) {
 		}), res)
 	})
 
+	t.Run("Invalid address", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := create.New(mock)
+
+		var (
+			userID      = "user_id"
+			i           interface{}
+			name        = "name"
+			description = "description"
+
+			address = &models.Address{
+				Name:    ptr.Pointer("John Smith"),
+				Line1:   ptr.Pointer("1 Main St"),
+				City:    ptr.Pointer("Springfield"),
+				Region:  "IL",
+				Country: ptr.Pointer("US"),
+				Phone:   ptr.Pointer("+12175550100"),
+			}
+
+			errInvalid = fmt.Errorf("shipping address: %w", model.ErrInvalidArgument)
+		)
+
+		mock.EXPECT().Create(gomock.Any(), userID, &orderModel.Form{
+			Name:            &name,
+			Description:     &description,
+			ShippingAddress: convertors.AddressToModel(address),
+		}).Return(nil, errInvalid)
+
+		reqBody := &models.CreateOrderRequest{
+			Name:            &name,
+			Description:     &description,
+			ShippingAddress: address,
+		}
+		body, _ := json.Marshal(reqBody)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/order", bytes.NewReader(body))
+		i = userID
+
+		res := serv.Handle(order.CreateOrderParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, order.NewCreateOrderBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errInvalid.Error()),
+		}), res)
+	})
+
+	t.Run("Promo code used up", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := create.New(mock)
+
+		var (
+			userID = "user_id"
+			i      interface{}
+
+			errConflict = fmt.Errorf("TEN: %w", promo.ErrLimitReached)
+		)
+
+		mock.EXPECT().Create(gomock.Any(), userID, &orderModel.Form{
+			PromoCodes: []string{"TEN"},
+		}).Return(nil, errConflict)
+
+		reqBody := &models.CreateOrderRequest{
+			PromoCodes: []string{"TEN"},
+		}
+		body, _ := json.Marshal(reqBody)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/order", bytes.NewReader(body))
+		i = userID
+
+		res := serv.Handle(order.CreateOrderParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, order.NewCreateOrderConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Viewer of the tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := create.New(mock)
+
+		var (
+			userID = "user_id"
+			i      interface{}
+		)
+
+		mock.EXPECT().Create(gomock.Any(), userID, &orderModel.Form{}).Return(nil, model.ErrPermissionDenied)
+
+		reqBody := &models.CreateOrderRequest{}
+		body, _ := json.Marshal(reqBody)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/order", bytes.NewReader(body))
+		i = userID
+
+		res := serv.Handle(order.CreateOrderParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, order.NewCreateOrderForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
 	t.Run("Empty body", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		t.Cleanup(ctrl.Finish)
@@ -0,0 +1,171 @@
+package createcomment_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/createcomment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = newID().String()
+		actor  = comment.Actor{UserID: userID}
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/comments", newID().String())
+		form   = &comment.Form{Body: "Where is my parcel?"}
+		body   = &models.CommentRequest{Body: ptr.Pointer("Where is my parcel?")}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createcomment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().AddComment(gomock.Any(), actor, newID().String(), form).Return(&comment.Comment{
+			ID:       newID().String(),
+			TSCreate: now(),
+			OrderID:  newID().String(),
+			AuthorID: userID,
+			Body:     "Where is my parcel?",
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CreateOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderCommentOK().WithPayload(&models.GetCommentResponse{
+			Comment: &models.Comment{
+				ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+				OrderID:   ptr.Pointer(strfmt.UUID(newID().String())),
+				AuthorID:  ptr.Pointer(strfmt.UUID(userID)),
+				Body:      ptr.Pointer("Where is my parcel?"),
+				Internal:  ptr.Pointer(false),
+				CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+			},
+		}), res)
+	})
+
+	t.Run("Invalid body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createcomment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().AddComment(gomock.Any(), actor, newID().String(), form).Return(nil, model.ErrInvalidArgument)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CreateOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderCommentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrInvalidArgument.Error()),
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createcomment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().AddComment(gomock.Any(), actor, newID().String(), form).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CreateOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderCommentNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createcomment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().AddComment(gomock.Any(), actor, newID().String(), form).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CreateOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderCommentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create order comment failed"),
+		}), res)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createcomment.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CreateOrderCommentParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateOrderCommentBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		}), res)
+	})
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,144 @@
+package createreturn_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/createreturn"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/returns", newID().String())
+
+		reqBody = &models.CreateReturnRequest{
+			LineID:   ptr.Pointer(strfmt.UUID(newID().String())),
+			Quantity: ptr.Pointer(int64(1)),
+			Reason:   ptr.Pointer("damaged"),
+		}
+
+		form = &refund.ReturnForm{
+			LineID:   newID().String(),
+			Quantity: 1,
+			Reason:   "damaged",
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createreturn.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().CreateReturn(gomock.Any(), userID, newID().String(), form).Return(&refund.Return{
+			ID:       newID().String(),
+			TSCreate: now(),
+			TSModify: now(),
+			OrderID:  newID().String(),
+			LineID:   newID().String(),
+			Quantity: 1,
+			Reason:   "damaged",
+			Status:   refund.StatusRequested,
+		}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(orderOperation.CreateReturnParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateReturnOK().WithPayload(&models.GetReturnResponse{
+			Return: &models.Return{
+				ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+				LineID:    ptr.Pointer(strfmt.UUID(newID().String())),
+				Quantity:  ptr.Pointer(int64(1)),
+				Reason:    ptr.Pointer("damaged"),
+				Status:    ptr.Pointer("requested"),
+				CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+				UpdatedAt: ptr.Pointer(strfmt.DateTime(now())),
+				Refunds:   []*models.Refund{},
+			},
+		}), res)
+	})
+
+	t.Run("Not fulfilled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createreturn.New(mock)
+
+		var (
+			i interface{} = userID
+
+			errConflict = fmt.Errorf("%w: order is paid", model.ErrConflict)
+		)
+
+		mock.EXPECT().CreateReturn(gomock.Any(), userID, newID().String(), form).Return(nil, errConflict)
+
+		body, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+
+		res := serv.Handle(orderOperation.CreateReturnParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        reqBody,
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateReturnConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := createreturn.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(orderOperation.CreateReturnParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewCreateReturnBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
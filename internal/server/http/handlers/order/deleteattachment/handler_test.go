@@ -0,0 +1,99 @@
+package deleteattachment_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/deleteattachment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID       = "user_id"
+		orderID      = uuid.New().String()
+		attachmentID = uuid.New().String()
+		path         = fmt.Sprintf("/api/v1/order/orders/%s/attachments/%s", orderID, attachmentID)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := deleteattachment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().DeleteAttachment(gomock.Any(), userID, orderID, attachmentID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.DeleteOrderAttachmentParams{
+			HTTPRequest:  req,
+			ID:           orderID,
+			AttachmentID: attachmentID,
+		}, i)
+
+		require.Equal(t, orderOperation.NewDeleteOrderAttachmentNoContent(), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := deleteattachment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().DeleteAttachment(gomock.Any(), userID, orderID, attachmentID).Return(model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.DeleteOrderAttachmentParams{
+			HTTPRequest:  req,
+			ID:           orderID,
+			AttachmentID: attachmentID,
+		}, i)
+
+		require.Equal(t, orderOperation.NewDeleteOrderAttachmentForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := deleteattachment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().DeleteAttachment(gomock.Any(), userID, orderID, attachmentID).Return(errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.DeleteOrderAttachmentParams{
+			HTTPRequest:  req,
+			ID:           orderID,
+			AttachmentID: attachmentID,
+		}, i)
+
+		require.Equal(t, orderOperation.NewDeleteOrderAttachmentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Delete attachment failed"),
+		}), res)
+	})
+}
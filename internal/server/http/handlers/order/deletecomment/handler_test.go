@@ -0,0 +1,101 @@
+package deletecomment_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/deletecomment"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = "user_id"
+		actor     = comment.Actor{UserID: userID}
+		orderID   = uuid.New().String()
+		commentID = uuid.New().String()
+		path      = fmt.Sprintf("/api/v1/order/orders/%s/comments/%s", orderID, commentID)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := deletecomment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().DeleteComment(gomock.Any(), actor, orderID, commentID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.DeleteOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   commentID,
+		}, i)
+
+		require.Equal(t, orderOperation.NewDeleteOrderCommentNoContent(), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := deletecomment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().DeleteComment(gomock.Any(), actor, orderID, commentID).Return(model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.DeleteOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   commentID,
+		}, i)
+
+		require.Equal(t, orderOperation.NewDeleteOrderCommentForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := deletecomment.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().DeleteComment(gomock.Any(), actor, orderID, commentID).Return(errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.DeleteOrderCommentParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			CommentID:   commentID,
+		}, i)
+
+		require.Equal(t, orderOperation.NewDeleteOrderCommentInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Delete order comment failed"),
+		}), res)
+	})
+}
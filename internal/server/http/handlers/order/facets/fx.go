@@ -0,0 +1,17 @@
+package facets
+
+import (
+	"github.com/krivenkov/order/internal/server/http/operations"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(New),
+
+	fx.Invoke(
+		func(handler order.GetOrdersFacetsHandler, api *operations.OrderAPIAPI) {
+			api.OrderGetOrdersFacetsHandler = handler
+		},
+	),
+)
@@ -0,0 +1,89 @@
+package facets
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) order.GetOrdersFacetsHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params order.GetOrdersFacetsParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+		zap.Stringp("q", params.Q),
+		zap.Stringp("interval", params.Interval),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	metadata, err := convertors.MetadataFilterToModel(params.Metadata)
+	if err != nil {
+		return order.NewGetOrdersFacetsBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(err.Error()),
+		})
+	}
+
+	facets, err := h.service.GetFacets(ctx, userID, h.prepareFacetsCondition(params, metadata))
+	if err != nil {
+		l.Error("get order facets failed", zap.Error(err))
+
+		return order.NewGetOrdersFacetsInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order facets failed"),
+		})
+	}
+
+	return order.NewGetOrdersFacetsOK().WithPayload(convertors.FacetsFromModel(facets))
+}
+
+func (h *Handler) prepareFacetsCondition(params order.GetOrdersFacetsParams, metadata map[string]string) *orderModel.GetFacetsRequest {
+	req := &orderModel.GetFacetsRequest{}
+
+	if params.Q != nil {
+		req.Q = option.New(*params.Q)
+	}
+
+	if params.Draft != nil {
+		req.Draft = option.New(*params.Draft)
+	}
+
+	if len(params.Tags) > 0 {
+		req.Tags = option.New(params.Tags)
+	}
+
+	if len(params.TagsAny) > 0 {
+		req.TagsAny = option.New(params.TagsAny)
+	}
+
+	if len(metadata) > 0 {
+		req.Metadata = option.New(metadata)
+	}
+
+	if params.IncludeShared != nil {
+		req.IncludeShared = option.New(*params.IncludeShared)
+	}
+
+	if params.Interval != nil {
+		req.Interval = option.New(orderModel.DateInterval(*params.Interval))
+	}
+
+	return req
+}
@@ -0,0 +1,144 @@
+package facets_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/facets"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := facets.New(mock)
+
+		var (
+			userID   = "user_id"
+			interval = "month"
+			i        interface{}
+		)
+
+		filter := &orderModel.GetFacetsRequest{
+			Tags:     option.New([]string{"urgent"}),
+			Metadata: option.New(map[string]string{"crm_id": "42"}),
+			Interval: option.New(orderModel.DateIntervalMonth),
+		}
+
+		mock.EXPECT().GetFacets(gomock.Any(), userID, filter).Return(&orderModel.Facets{
+			Statuses: []*orderModel.StatusFacet{
+				{Status: orderModel.StatusCreated, Count: 5},
+				{Status: orderModel.StatusDeleted, Count: 2},
+			},
+			Dates: []*orderModel.DateFacet{
+				{Date: now(), Count: 7},
+			},
+			Tags: []*orderModel.TagFacet{
+				{Tag: "urgent", Count: 7},
+			},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/facets", nil)
+		i = userID
+
+		res := serv.Handle(order.GetOrdersFacetsParams{
+			Interval:    &interval,
+			Tags:        []string{"urgent"},
+			Metadata:    []string{"crm_id:42"},
+			HTTPRequest: req,
+		}, i)
+
+		data := &models.GetFacetsResponse{
+			Statuses: []*models.StatusFacet{
+				{Status: ptr.Pointer("created"), Count: ptr.Pointer(int64(5))},
+				{Status: ptr.Pointer("deleted"), Count: ptr.Pointer(int64(2))},
+			},
+			Dates: []*models.DateFacet{
+				{Date: ptr.Pointer(strfmt.DateTime(now())), Count: ptr.Pointer(int64(7))},
+			},
+			Tags: []*models.TagFacet{
+				{Tag: ptr.Pointer("urgent"), Count: ptr.Pointer(int64(7))},
+			},
+		}
+
+		if respOk, ok := res.(*order.GetOrdersFacetsOK); ok {
+			require.Equal(t, data, respOk.Payload)
+		} else {
+			require.FailNow(t, "resp is not GetOrdersFacetsOK")
+		}
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := facets.New(mock)
+
+		var (
+			q       = "test"
+			userID  = "user_id"
+			i       interface{}
+			someErr = errors.New("some error")
+		)
+
+		filter := &orderModel.GetFacetsRequest{
+			Q: option.New(q),
+		}
+
+		mock.EXPECT().GetFacets(gomock.Any(), userID, filter).Return(nil, someErr)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/facets", nil)
+		i = userID
+
+		res := serv.Handle(order.GetOrdersFacetsParams{
+			Q:           &q,
+			HTTPRequest: req,
+		}, i)
+
+		require.Equal(t, order.NewGetOrdersFacetsInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order facets failed"),
+		}), res)
+	})
+
+	t.Run("Bad metadata filter", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := facets.New(mock)
+
+		var (
+			userID = "user_id"
+			i      interface{}
+		)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/facets", nil)
+		i = userID
+
+		res := serv.Handle(order.GetOrdersFacetsParams{
+			Metadata:    []string{"crm-id"},
+			HTTPRequest: req,
+		}, i)
+
+		require.IsType(t, &order.GetOrdersFacetsBadRequest{}, res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
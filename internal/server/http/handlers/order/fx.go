@@ -1,12 +1,41 @@
 package order
 
 import (
+	"github.com/krivenkov/order/internal/server/http/handlers/order/addline"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/approval"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/approve"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/attach"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/attachment"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/attachments"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/bynumber"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/checkout"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/comments"
 	"github.com/krivenkov/order/internal/server/http/handlers/order/count"
 	"github.com/krivenkov/order/internal/server/http/handlers/order/create"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/createcomment"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/createreturn"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/deleteattachment"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/deletecomment"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/facets"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/grants"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/history"
 	"github.com/krivenkov/order/internal/server/http/handlers/order/item"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/lines"
 	"github.com/krivenkov/order/internal/server/http/handlers/order/list"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/payments"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/reject"
 	"github.com/krivenkov/order/internal/server/http/handlers/order/remove"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/removeline"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/restore"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/returns"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/share"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/shipments"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/tags"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/trash"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/unshare"
 	"github.com/krivenkov/order/internal/server/http/handlers/order/update"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/updatecomment"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/updateline"
 	"go.uber.org/fx"
 )
 
@@ -16,5 +45,34 @@ var FXModule = fx.Options(
 	remove.FXModule,
 	list.FXModule,
 	item.FXModule,
+	bynumber.FXModule,
 	count.FXModule,
+	facets.FXModule,
+	tags.FXModule,
+	history.FXModule,
+	trash.FXModule,
+	restore.FXModule,
+	lines.FXModule,
+	addline.FXModule,
+	updateline.FXModule,
+	removeline.FXModule,
+	checkout.FXModule,
+	approval.FXModule,
+	approve.FXModule,
+	reject.FXModule,
+	grants.FXModule,
+	share.FXModule,
+	unshare.FXModule,
+	attachments.FXModule,
+	attach.FXModule,
+	attachment.FXModule,
+	deleteattachment.FXModule,
+	shipments.FXModule,
+	payments.FXModule,
+	returns.FXModule,
+	createreturn.FXModule,
+	comments.FXModule,
+	createcomment.FXModule,
+	updatecomment.FXModule,
+	deletecomment.FXModule,
 )
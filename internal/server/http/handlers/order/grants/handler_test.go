@@ -0,0 +1,146 @@
+package grants_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/grant"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/grants"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/grants", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := grants.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetGrants(gomock.Any(), userID, newID().String()).Return([]*grant.Grant{
+			{
+				OrderID:    newID().String(),
+				UserID:     newID().String(),
+				Permission: grant.PermissionRead,
+				GrantedBy:  newID().String(),
+				TSCreate:   now(),
+				TSExpire:   ptr.Pointer(now().Add(time.Hour)),
+			},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderGrantsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderGrantsOK().WithPayload(&models.GetGrantsResponse{
+			Grants: []*models.Grant{
+				{
+					OrderID:    ptr.Pointer(strfmt.UUID(newID().String())),
+					UserID:     ptr.Pointer(strfmt.UUID(newID().String())),
+					Permission: ptr.Pointer("read"),
+					GrantedBy:  ptr.Pointer(strfmt.UUID(newID().String())),
+					CreatedAt:  ptr.Pointer(strfmt.DateTime(now())),
+					ExpiresAt:  ptr.Pointer(strfmt.DateTime(now().Add(time.Hour))),
+				},
+			},
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := grants.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetGrants(gomock.Any(), userID, newID().String()).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderGrantsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderGrantsForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := grants.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetGrants(gomock.Any(), userID, newID().String()).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderGrantsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderGrantsInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get grants failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := grants.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/123/grants", nil)
+
+		res := serv.Handle(orderOperation.GetOrderGrantsParams{
+			HTTPRequest: req,
+			ID:          "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderGrantsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,191 @@
+package history_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	historyModel "github.com/krivenkov/order/internal/model/history"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/history"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID     = "user_id"
+		limit      = float64(50)
+		offset     = float64(0)
+		pagination = paginator.Pagination{Limit: 50}
+		path       = fmt.Sprintf("/api/v1/order/orders/%s/history", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := history.New(mock)
+
+		var i interface{} = userID
+
+		entries := []*historyModel.Entry{
+			{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    userID,
+				Source:   historyModel.SourceHTTP,
+				Action:   historyModel.ActionUpdate,
+				Changes:  []*historyModel.Change{{Field: "name", Old: "old", New: "new"}},
+			},
+		}
+
+		mock.EXPECT().GetHistory(gomock.Any(), userID, newID().String(), pagination).Return(entries, 11, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderHistoryParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderHistoryOK().WithPayload(&models.GetOrderHistoryResponse{
+			Entries: []*models.HistoryEntry{
+				{
+					ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+					CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+					Actor:     ptr.Pointer(userID),
+					Source:    ptr.Pointer("http"),
+					Action:    ptr.Pointer("update"),
+					Changes: []*models.HistoryChange{
+						{Field: ptr.Pointer("name"), Old: ptr.Pointer("old"), New: ptr.Pointer("new")},
+					},
+				},
+			},
+			Pagination: &models.Pagination{
+				Limit:  ptr.Pointer(limit),
+				Offset: ptr.Pointer(offset),
+				Total:  ptr.Pointer(float64(11)),
+			},
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := history.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetHistory(gomock.Any(), userID, newID().String(), pagination).Return(nil, 0, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderHistoryParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderHistoryNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := history.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetHistory(gomock.Any(), userID, newID().String(), pagination).Return(nil, 0, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderHistoryParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderHistoryForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := history.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetHistory(gomock.Any(), userID, newID().String(), pagination).Return(nil, 0, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderHistoryParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderHistoryInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order history failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := history.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/123/history", nil)
+
+		res := serv.Handle(orderOperation.GetOrderHistoryParams{
+			HTTPRequest: req,
+			ID:          "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderHistoryNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,116 @@
+package lines_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/lines"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/lines", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := lines.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetLines(gomock.Any(), userID, newID().String()).Return([]*line.Line{
+			{ID: newID().String(), OrderID: newID().String(), SKU: "SKU-1", Name: "Widget", Quantity: 2, UnitPrice: 250, Discount: 50, TaxCategory: "reduced", Tax: 14},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderLinesParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderLinesOK().WithPayload(&models.GetOrderLinesResponse{
+			Lines: []*models.OrderLine{
+				{
+					ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+					Sku:       ptr.Pointer("SKU-1"),
+					Name:      ptr.Pointer("Widget"),
+					Quantity:  ptr.Pointer(int64(2)),
+					UnitPrice: ptr.Pointer(int64(250)),
+					Discount:  ptr.Pointer(int64(50)),
+
+					TaxCategory: ptr.Pointer("reduced"),
+					Tax:         ptr.Pointer(int64(14)),
+				},
+			},
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := lines.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetLines(gomock.Any(), userID, newID().String()).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderLinesParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderLinesForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := lines.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetLines(gomock.Any(), userID, newID().String()).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetOrderLinesParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetOrderLinesInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order lines failed"),
+		}), res)
+	})
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
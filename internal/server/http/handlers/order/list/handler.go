@@ -38,7 +38,15 @@ func (h *Handler) Handle(params orderOperation.GetOrdersParams, i interface{}) m
 	)
 	ctx = mlog.CtxWithLogger(ctx, l)
 
-	listReq := h.prepareListCondition(params)
+	metadata, err := convertors.MetadataFilterToModel(params.Metadata)
+	if err != nil {
+		return orderOperation.NewGetOrdersBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(err.Error()),
+		})
+	}
+
+	listReq := h.prepareListCondition(params, metadata)
 	if !listReq.Pagination.IsSet() {
 		l.Error("empty pagination")
 		return orderOperation.NewGetOrdersBadRequest().WithPayload(&models.Error{
@@ -56,7 +64,7 @@ func (h *Handler) Handle(params orderOperation.GetOrdersParams, i interface{}) m
 		})
 	}
 
-	total, err := h.service.Count(ctx, userID, h.prepareCountCondition(params))
+	total, err := h.service.Count(ctx, userID, h.prepareCountCondition(params, metadata))
 	if err != nil {
 		l.Error("get order count failed", zap.Error(err))
 		return orderOperation.NewGetOrdersInternalServerError().WithPayload(&models.Error{
@@ -75,7 +83,7 @@ func (h *Handler) Handle(params orderOperation.GetOrdersParams, i interface{}) m
 	})
 }
 
-func (h *Handler) prepareListCondition(params orderOperation.GetOrdersParams) *orderModel.GetListRequest {
+func (h *Handler) prepareListCondition(params orderOperation.GetOrdersParams, metadata map[string]string) *orderModel.GetListRequest {
 	req := &orderModel.GetListRequest{}
 
 	ordering := convertors.Order(params.SortBy, params.SortDirection)
@@ -93,15 +101,55 @@ func (h *Handler) prepareListCondition(params orderOperation.GetOrdersParams) *o
 		req.Q = option.New(*params.Q)
 	}
 
+	if params.Draft != nil {
+		req.Draft = option.New(*params.Draft)
+	}
+
+	if len(params.Tags) > 0 {
+		req.Tags = option.New(params.Tags)
+	}
+
+	if len(params.TagsAny) > 0 {
+		req.TagsAny = option.New(params.TagsAny)
+	}
+
+	if len(metadata) > 0 {
+		req.Metadata = option.New(metadata)
+	}
+
+	if params.IncludeShared != nil {
+		req.IncludeShared = option.New(*params.IncludeShared)
+	}
+
 	return req
 }
 
-func (h *Handler) prepareCountCondition(params orderOperation.GetOrdersParams) *orderModel.GetCountRequest {
+func (h *Handler) prepareCountCondition(params orderOperation.GetOrdersParams, metadata map[string]string) *orderModel.GetCountRequest {
 	req := &orderModel.GetCountRequest{}
 
 	if params.Q != nil {
 		req.Q = option.New(*params.Q)
 	}
 
+	if params.Draft != nil {
+		req.Draft = option.New(*params.Draft)
+	}
+
+	if len(params.Tags) > 0 {
+		req.Tags = option.New(params.Tags)
+	}
+
+	if len(params.TagsAny) > 0 {
+		req.TagsAny = option.New(params.TagsAny)
+	}
+
+	if len(metadata) > 0 {
+		req.Metadata = option.New(metadata)
+	}
+
+	if params.IncludeShared != nil {
+		req.IncludeShared = option.New(*params.IncludeShared)
+	}
+
 	return req
 }
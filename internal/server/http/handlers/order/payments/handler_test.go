@@ -0,0 +1,127 @@
+package payments_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/payments"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/payments", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := payments.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetPayments(gomock.Any(), userID, newID().String()).Return([]*payment.Payment{
+			{
+				ID:          newID().String(),
+				TSCreate:    now(),
+				OrderID:     newID().String(),
+				Provider:    "stripe",
+				ExternalRef: "pi_1",
+				Amount:      1999,
+				Currency:    "EUR",
+				Status:      payment.StatusFailed,
+				Reason:      "card_declined",
+			},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetPaymentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetPaymentsOK().WithPayload(&models.GetPaymentsResponse{
+			Payments: []*models.Payment{
+				{
+					ID:          ptr.Pointer(strfmt.UUID(newID().String())),
+					Provider:    ptr.Pointer("stripe"),
+					ExternalRef: ptr.Pointer("pi_1"),
+					Amount:      ptr.Pointer(int64(1999)),
+					Currency:    ptr.Pointer("EUR"),
+					Status:      ptr.Pointer("failed"),
+					Reason:      "card_declined",
+					CreatedAt:   ptr.Pointer(strfmt.DateTime(now())),
+				},
+			},
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := payments.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetPayments(gomock.Any(), userID, newID().String()).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetPaymentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetPaymentsForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := payments.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/123/payments", nil)
+
+		res := serv.Handle(orderOperation.GetPaymentsParams{
+			HTTPRequest: req,
+			ID:          "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetPaymentsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,214 @@
+package reject_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/reject"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/reject", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := reject.New(mock)
+
+		var i interface{} = userID
+
+		obj := &orderModel.Order{
+			ID:       newID().String(),
+			TSCreate: now(),
+			TSModify: now(),
+			Status:   orderModel.StatusCreated,
+			State:    orderModel.StateRejected,
+			UserID:   userID,
+			Name:     "name",
+		}
+
+		mock.EXPECT().Reject(gomock.Any(), userID, newID().String(), "over budget").Return(obj, nil)
+
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"comment":"over budget"}`))
+
+		res := serv.Handle(orderOperation.RejectOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        &models.DecideApprovalRequest{Comment: "over budget"},
+		}, i)
+
+		require.Equal(t, orderOperation.NewRejectOrderOK().WithPayload(&models.GetOrderResponse{
+			Order: convertors.OrderFromModel(obj),
+		}), res)
+	})
+
+	t.Run("Invalid comment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := reject.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Reject(gomock.Any(), userID, newID().String(), "over budget").Return(nil, model.ErrInvalidArgument)
+
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"comment":"over budget"}`))
+
+		res := serv.Handle(orderOperation.RejectOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        &models.DecideApprovalRequest{Comment: "over budget"},
+		}, i)
+
+		require.Equal(t, orderOperation.NewRejectOrderBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrInvalidArgument.Error()),
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := reject.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Reject(gomock.Any(), userID, newID().String(), "over budget").Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"comment":"over budget"}`))
+
+		res := serv.Handle(orderOperation.RejectOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        &models.DecideApprovalRequest{Comment: "over budget"},
+		}, i)
+
+		require.Equal(t, orderOperation.NewRejectOrderNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Not an approver", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := reject.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Reject(gomock.Any(), userID, newID().String(), "over budget").Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"comment":"over budget"}`))
+
+		res := serv.Handle(orderOperation.RejectOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        &models.DecideApprovalRequest{Comment: "over budget"},
+		}, i)
+
+		require.Equal(t, orderOperation.NewRejectOrderForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Not pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := reject.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Reject(gomock.Any(), userID, newID().String(), "over budget").Return(nil, model.ErrConflict)
+
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"comment":"over budget"}`))
+
+		res := serv.Handle(orderOperation.RejectOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        &models.DecideApprovalRequest{Comment: "over budget"},
+		}, i)
+
+		require.Equal(t, orderOperation.NewRejectOrderConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := reject.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Reject(gomock.Any(), userID, newID().String(), "over budget").Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"comment":"over budget"}`))
+
+		res := serv.Handle(orderOperation.RejectOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			Body:        &models.DecideApprovalRequest{Comment: "over budget"},
+		}, i)
+
+		require.Equal(t, orderOperation.NewRejectOrderInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Reject order failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := reject.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/order/orders/123/reject", nil)
+
+		res := serv.Handle(orderOperation.RejectOrderParams{
+			HTTPRequest: req,
+			ID:          "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewRejectOrderNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,166 @@
+package removeline_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/removeline"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/lines/%s", newID().String(), newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := removeline.New(mock)
+
+		var i interface{} = userID
+
+		obj := &orderModel.Order{
+			ID:       newID().String(),
+			TSCreate: now(),
+			TSModify: now(),
+			Status:   orderModel.StatusCreated,
+			State:    orderModel.StateDraft,
+			UserID:   userID,
+			Name:     "name",
+		}
+
+		mock.EXPECT().RemoveLine(gomock.Any(), userID, newID().String(), newID().String()).Return(obj, nil)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.RemoveOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewRemoveOrderLineOK().WithPayload(&models.GetOrderResponse{
+			Order: convertors.OrderFromModel(obj),
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := removeline.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().RemoveLine(gomock.Any(), userID, newID().String(), newID().String()).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.RemoveOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewRemoveOrderLineNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Not a draft", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := removeline.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().RemoveLine(gomock.Any(), userID, newID().String(), newID().String()).Return(nil, model.ErrConflict)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.RemoveOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewRemoveOrderLineConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := removeline.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().RemoveLine(gomock.Any(), userID, newID().String(), newID().String()).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.RemoveOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewRemoveOrderLineInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Remove order line failed"),
+		}), res)
+	})
+
+	t.Run("Invalid line id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := removeline.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/order/orders/%s/lines/123", newID().String()), nil)
+
+		res := serv.Handle(orderOperation.RemoveOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewRemoveOrderLineNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
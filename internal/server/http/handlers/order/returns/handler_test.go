@@ -0,0 +1,148 @@
+package returns_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/returns"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/returns", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := returns.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetReturns(gomock.Any(), userID, newID().String()).Return([]*refund.Return{
+			{
+				ID:        newID().String(),
+				TSCreate:  now(),
+				TSModify:  now(),
+				OrderID:   newID().String(),
+				LineID:    newID().String(),
+				Quantity:  1,
+				Reason:    "damaged",
+				Status:    refund.StatusApproved,
+				Comment:   "ok",
+				DecidedBy: "admin_id",
+				Refunds: []*refund.Refund{
+					{
+						ID:        newID().String(),
+						TSCreate:  now(),
+						OrderID:   newID().String(),
+						ReturnID:  newID().String(),
+						PaymentID: newID().String(),
+						Amount:    250,
+						Currency:  "EUR",
+					},
+				},
+			},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetReturnsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetReturnsOK().WithPayload(&models.GetReturnsResponse{
+			Returns: []*models.Return{
+				{
+					ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+					LineID:    ptr.Pointer(strfmt.UUID(newID().String())),
+					Quantity:  ptr.Pointer(int64(1)),
+					Reason:    ptr.Pointer("damaged"),
+					Status:    ptr.Pointer("approved"),
+					Comment:   "ok",
+					CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+					UpdatedAt: ptr.Pointer(strfmt.DateTime(now())),
+					Refunds: []*models.Refund{
+						{
+							ID:        ptr.Pointer(strfmt.UUID(newID().String())),
+							PaymentID: ptr.Pointer(strfmt.UUID(newID().String())),
+							Amount:    ptr.Pointer(int64(250)),
+							Currency:  ptr.Pointer("EUR"),
+							CreatedAt: ptr.Pointer(strfmt.DateTime(now())),
+						},
+					},
+				},
+			},
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := returns.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetReturns(gomock.Any(), userID, newID().String()).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetReturnsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetReturnsForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := returns.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/123/returns", nil)
+
+		res := serv.Handle(orderOperation.GetReturnsParams{
+			HTTPRequest: req,
+			ID:          "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetReturnsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,177 @@
+package share_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/grant"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/share"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = "user_id"
+		granteeID = uuid.New().String()
+		path      = fmt.Sprintf("/api/v1/order/orders/%s/grants/%s", newID().String(), granteeID)
+
+		body = &models.GrantRequest{Permission: ptr.Pointer("edit")}
+		form = &grant.Form{Permission: grant.PermissionEdit}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := share.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Share(gomock.Any(), userID, newID().String(), granteeID, form).Return(&grant.Grant{
+			OrderID:    newID().String(),
+			UserID:     granteeID,
+			Permission: grant.PermissionEdit,
+			GrantedBy:  userID,
+			TSCreate:   now(),
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(orderOperation.ShareOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			UserID:      granteeID,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewShareOrderOK().WithPayload(&models.GetGrantResponse{
+			Grant: &models.Grant{
+				OrderID:    ptr.Pointer(strfmt.UUID(newID().String())),
+				UserID:     ptr.Pointer(strfmt.UUID(granteeID)),
+				Permission: ptr.Pointer("edit"),
+				GrantedBy:  ptr.Pointer(strfmt.UUID(userID)),
+				CreatedAt:  ptr.Pointer(strfmt.DateTime(now())),
+			},
+		}), res)
+	})
+
+	t.Run("Too many grants", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := share.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Share(gomock.Any(), userID, newID().String(), granteeID, form).Return(nil, model.ErrConflict)
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(orderOperation.ShareOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			UserID:      granteeID,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewShareOrderConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := share.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Share(gomock.Any(), userID, newID().String(), granteeID, form).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(orderOperation.ShareOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			UserID:      granteeID,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewShareOrderForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := share.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Share(gomock.Any(), userID, newID().String(), granteeID, form).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPut, path, nil)
+
+		res := serv.Handle(orderOperation.ShareOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			UserID:      granteeID,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewShareOrderInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Share order failed"),
+		}), res)
+	})
+
+	t.Run("Invalid user id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := share.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/order/orders/"+newID().String()+"/grants/123", nil)
+
+		res := serv.Handle(orderOperation.ShareOrderParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			UserID:      "123",
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewShareOrderBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("userId must be a uuid"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
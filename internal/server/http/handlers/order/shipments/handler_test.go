@@ -0,0 +1,133 @@
+package shipments_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/shipments"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/shipments", newID().String())
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := shipments.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetShipments(gomock.Any(), userID, newID().String()).Return([]*shipment.Shipment{
+			{
+				ID:             newID().String(),
+				TSCreate:       now(),
+				OrderID:        newID().String(),
+				Carrier:        "DHL",
+				TrackingNumber: "JD0001",
+				Status:         shipment.StatusInTransit,
+				Lines:          []*shipment.Line{{LineID: newID().String(), Quantity: 1}},
+				Events: []*shipment.Event{
+					{Status: shipment.StatusShipped, TSCreate: now()},
+					{Status: shipment.StatusInTransit, TSCreate: now().Add(time.Hour)},
+				},
+			},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetShipmentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetShipmentsOK().WithPayload(&models.GetShipmentsResponse{
+			Shipments: []*models.Shipment{
+				{
+					ID:             ptr.Pointer(strfmt.UUID(newID().String())),
+					Carrier:        ptr.Pointer("DHL"),
+					TrackingNumber: ptr.Pointer("JD0001"),
+					Status:         ptr.Pointer("in_transit"),
+					CreatedAt:      ptr.Pointer(strfmt.DateTime(now())),
+					Lines: []*models.ShipmentLine{
+						{LineID: ptr.Pointer(strfmt.UUID(newID().String())), Quantity: ptr.Pointer(int64(1))},
+					},
+					Events: []*models.ShipmentEvent{
+						{Status: ptr.Pointer("shipped"), CreatedAt: ptr.Pointer(strfmt.DateTime(now()))},
+						{Status: ptr.Pointer("in_transit"), CreatedAt: ptr.Pointer(strfmt.DateTime(now().Add(time.Hour)))},
+					},
+				},
+			},
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := shipments.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetShipments(gomock.Any(), userID, newID().String()).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(orderOperation.GetShipmentsParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetShipmentsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := shipments.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/123/shipments", nil)
+
+		res := serv.Handle(orderOperation.GetShipmentsParams{
+			HTTPRequest: req,
+			ID:          "123",
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetShipmentsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
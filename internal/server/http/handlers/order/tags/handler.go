@@ -0,0 +1,44 @@
+package tags
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) order.GetOrdersTagsHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params order.GetOrdersTagsParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	tags, err := h.service.GetTags(ctx, userID)
+	if err != nil {
+		l.Error("get order tags failed", zap.Error(err))
+
+		return order.NewGetOrdersTagsInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order tags failed"),
+		})
+	}
+
+	return order.NewGetOrdersTagsOK().WithPayload(convertors.TagFacetsFromModel(tags))
+}
@@ -0,0 +1,93 @@
+package tags_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/tags"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var userID = "user_id"
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := tags.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetTags(gomock.Any(), userID).Return([]*orderModel.TagFacet{
+			{Tag: "urgent", Count: 3},
+			{Tag: "gifts", Count: 1},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/tags", nil)
+
+		res := serv.Handle(order.GetOrdersTagsParams{
+			HTTPRequest: req,
+		}, i)
+
+		require.Equal(t, order.NewGetOrdersTagsOK().WithPayload(&models.GetTagsResponse{
+			Tags: []*models.TagFacet{
+				{Tag: ptr.Pointer("urgent"), Count: ptr.Pointer(int64(3))},
+				{Tag: ptr.Pointer("gifts"), Count: ptr.Pointer(int64(1))},
+			},
+		}), res)
+	})
+
+	t.Run("No tags", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := tags.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetTags(gomock.Any(), userID).Return(nil, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/tags", nil)
+
+		res := serv.Handle(order.GetOrdersTagsParams{
+			HTTPRequest: req,
+		}, i)
+
+		require.Equal(t, order.NewGetOrdersTagsOK().WithPayload(&models.GetTagsResponse{
+			Tags: []*models.TagFacet{},
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := tags.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetTags(gomock.Any(), userID).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/tags", nil)
+
+		res := serv.Handle(order.GetOrdersTagsParams{
+			HTTPRequest: req,
+		}, i)
+
+		require.Equal(t, order.NewGetOrdersTagsInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get order tags failed"),
+		}), res)
+	})
+}
@@ -0,0 +1,56 @@
+package trash
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service orderModel.Service
+}
+
+func New(service orderModel.Service) order.GetTrashHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params order.GetTrashParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+		zap.Float64p("offset", params.Offset),
+		zap.Float64p("limit", params.Limit),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	pagination := convertors.Paginator(params.Limit, params.Offset)
+
+	list, total, err := h.service.GetTrash(ctx, userID, *pagination)
+	if err != nil {
+		l.Error("get trash failed", zap.Error(err))
+
+		return order.NewGetTrashInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get trash failed"),
+		})
+	}
+
+	return order.NewGetTrashOK().WithPayload(&models.GetOrdersResponse{
+		Orders: convertors.OrdersFromModel(list),
+		Pagination: convertors.Pagination(&paginator.PaginationResult{
+			Limit:  pagination.Limit,
+			Offset: pagination.Offset,
+			Total:  total,
+		}),
+	})
+}
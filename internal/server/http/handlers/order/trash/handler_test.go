@@ -0,0 +1,104 @@
+package trash_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/trash"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID     = "user_id"
+		limit      = float64(10)
+		offset     = float64(20)
+		pagination = paginator.Pagination{Limit: 10, Offset: 20}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := trash.New(mock)
+
+		var i interface{} = userID
+
+		list := []*orderModel.Order{
+			{
+				ID:          newID().String(),
+				TSCreate:    now(),
+				TSModify:    now(),
+				Status:      orderModel.StatusDeleted,
+				UserID:      userID,
+				Name:        "name",
+				Description: "description",
+			},
+		}
+
+		mock.EXPECT().GetTrash(gomock.Any(), userID, pagination).Return(list, 21, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/trash", nil)
+
+		res := serv.Handle(orderOperation.GetTrashParams{
+			HTTPRequest: req,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetTrashOK().WithPayload(&models.GetOrdersResponse{
+			Orders: convertors.OrdersFromModel(list),
+			Pagination: &models.Pagination{
+				Limit:  ptr.Pointer(limit),
+				Offset: ptr.Pointer(offset),
+				Total:  ptr.Pointer(float64(21)),
+			},
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := trash.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetTrash(gomock.Any(), userID, pagination).Return(nil, 0, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/order/orders/trash", nil)
+
+		res := serv.Handle(orderOperation.GetTrashParams{
+			HTTPRequest: req,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, orderOperation.NewGetTrashInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get trash failed"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,99 @@
+package unshare_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/unshare"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID    = "user_id"
+		orderID   = uuid.New().String()
+		granteeID = uuid.New().String()
+		path      = fmt.Sprintf("/api/v1/order/orders/%s/grants/%s", orderID, granteeID)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := unshare.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Unshare(gomock.Any(), userID, orderID, granteeID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.UnshareOrderParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			UserID:      granteeID,
+		}, i)
+
+		require.Equal(t, orderOperation.NewUnshareOrderNoContent(), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := unshare.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Unshare(gomock.Any(), userID, orderID, granteeID).Return(model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.UnshareOrderParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			UserID:      granteeID,
+		}, i)
+
+		require.Equal(t, orderOperation.NewUnshareOrderNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := unshare.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Unshare(gomock.Any(), userID, orderID, granteeID).Return(errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodDelete, path, nil)
+
+		res := serv.Handle(orderOperation.UnshareOrderParams{
+			HTTPRequest: req,
+			ID:          orderID,
+			UserID:      granteeID,
+		}, i)
+
+		require.Equal(t, orderOperation.NewUnshareOrderInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Unshare order failed"),
+		}), res)
+	})
+}
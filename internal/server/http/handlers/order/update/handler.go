@@ -52,6 +52,12 @@ func (h *Handler) Handle(params order.UpdateOrderParams, i interface{}) middlewa
 	item, err := h.service.Update(ctx, userID, params.ID, &orderModel.Form{
 		Name:        params.Body.Name,
 		Description: params.Body.Description,
+
+		ShippingAddress: convertors.AddressToModel(params.Body.ShippingAddress),
+		BillingAddress:  convertors.AddressToModel(params.Body.BillingAddress),
+
+		Tags:     params.Body.Tags,
+		Metadata: params.Body.Metadata,
 	})
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
@@ -61,6 +67,13 @@ func (h *Handler) Handle(params order.UpdateOrderParams, i interface{}) middlewa
 			})
 		}
 
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return order.NewUpdateOrderBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
 		if errors.Is(err, model.ErrPermissionDenied) {
 			return order.NewUpdateOrderForbidden().WithPayload(&models.Error{
 				Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
@@ -159,6 +159,57 @@ func TestHandler(t *testing.T) {
 		}), res)
 	})
 
+	t.Run("Invalid address", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := update.New(mock)
+
+		var (
+			name        = "name"
+			description = "description"
+			userID      = "user_id"
+			i           interface{}
+
+			address = &models.Address{
+				Name:    ptr.Pointer("John Smith"),
+				Line1:   ptr.Pointer("1 Main St"),
+				City:    ptr.Pointer("Springfield"),
+				Country: ptr.Pointer("US"),
+				Phone:   ptr.Pointer("+12175550100"),
+			}
+
+			errInvalid = fmt.Errorf("billing address: %w", model.ErrInvalidArgument)
+		)
+
+		mock.EXPECT().Update(gomock.Any(), userID, newID().String(), &orderModel.Form{
+			Name:           &name,
+			Description:    &description,
+			BillingAddress: convertors.AddressToModel(address),
+		}).Return(nil, errInvalid)
+
+		reqBody := &models.UpdateOrderRequest{
+			Name:           &name,
+			Description:    &description,
+			BillingAddress: address,
+		}
+		body, _ := json.Marshal(reqBody)
+
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/order/orders/%s", newID().String()), bytes.NewReader(body))
+		i = userID
+
+		res := serv.Handle(orderOperation.UpdateOrderParams{
+			HTTPRequest: req,
+			Body:        reqBody,
+			ID:          newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewUpdateOrderBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(errInvalid.Error()),
+		}), res)
+	})
+
 	t.Run("Some error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		t.Cleanup(ctrl.Finish)
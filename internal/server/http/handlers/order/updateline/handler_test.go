@@ -0,0 +1,193 @@
+package updateline_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/order/updateline"
+	"github.com/krivenkov/order/internal/server/http/models"
+	orderOperation "github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = fmt.Sprintf("/api/v1/order/orders/%s/lines/%s", newID().String(), newID().String())
+
+		body = &models.UpdateOrderLineRequest{
+			Quantity: ptr.Pointer(int64(3)),
+		}
+		form = &line.UpdateForm{
+			Quantity: 3,
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := updateline.New(mock)
+
+		var i interface{} = userID
+
+		obj := &line.Line{
+			ID:        newID().String(),
+			TSCreate:  now(),
+			OrderID:   newID().String(),
+			SKU:       "sku-1",
+			Name:      "name",
+			Quantity:  3,
+			UnitPrice: 1000,
+		}
+		item := &orderModel.Order{
+			ID:       newID().String(),
+			TSCreate: now(),
+			TSModify: now(),
+			Status:   orderModel.StatusCreated,
+			State:    orderModel.StateDraft,
+			UserID:   userID,
+			Name:     "name",
+			Totals: orderModel.Totals{
+				Currency: "EUR",
+				Subtotal: 3000,
+				Total:    3000,
+			},
+		}
+
+		mock.EXPECT().UpdateLine(gomock.Any(), userID, newID().String(), newID().String(), form).Return(obj, item, nil)
+
+		req := httptest.NewRequest(http.MethodPatch, path, nil)
+
+		res := serv.Handle(orderOperation.UpdateOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewUpdateOrderLineOK().WithPayload(&models.GetOrderLineResponse{
+			Line:  convertors.LineFromModel(obj),
+			Order: convertors.OrderFromModel(item),
+		}), res)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := updateline.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodPatch, path, nil)
+
+		res := serv.Handle(orderOperation.UpdateOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      newID().String(),
+		}, i)
+
+		require.Equal(t, orderOperation.NewUpdateOrderLineBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := updateline.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().UpdateLine(gomock.Any(), userID, newID().String(), newID().String(), form).Return(nil, nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodPatch, path, nil)
+
+		res := serv.Handle(orderOperation.UpdateOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewUpdateOrderLineNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Not a draft", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := updateline.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().UpdateLine(gomock.Any(), userID, newID().String(), newID().String(), form).Return(nil, nil, model.ErrConflict)
+
+		req := httptest.NewRequest(http.MethodPatch, path, nil)
+
+		res := serv.Handle(orderOperation.UpdateOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewUpdateOrderLineConflict().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrConflict.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := orderMock.NewMockService(ctrl)
+		serv := updateline.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().UpdateLine(gomock.Any(), userID, newID().String(), newID().String(), form).Return(nil, nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPatch, path, nil)
+
+		res := serv.Handle(orderOperation.UpdateOrderLineParams{
+			HTTPRequest: req,
+			ID:          newID().String(),
+			LineID:      newID().String(),
+			Body:        body,
+		}, i)
+
+		require.Equal(t, orderOperation.NewUpdateOrderLineInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Update order line failed"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,17 @@
+package createrecurring
+
+import (
+	"github.com/krivenkov/order/internal/server/http/operations"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(New),
+
+	fx.Invoke(
+		func(handler recurring.CreateRecurringOrderHandler, api *operations.OrderAPIAPI) {
+			api.RecurringCreateRecurringOrderHandler = handler
+		},
+	),
+)
@@ -0,0 +1,64 @@
+package createrecurring
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/krivenkov/order/internal/model"
+	recurringModel "github.com/krivenkov/order/internal/model/recurring"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service recurringModel.Service
+}
+
+func New(service recurringModel.Service) recurring.CreateRecurringOrderHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params recurring.CreateRecurringOrderParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	if params.Body == nil {
+		l.Warn("request body is empty")
+		return recurring.NewCreateRecurringOrderBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		})
+	}
+
+	item, err := h.service.Create(ctx, userID, convertors.RecurringOrderFormToModel(params.Body))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidArgument) {
+			return recurring.NewCreateRecurringOrderBadRequest().WithPayload(&models.Error{
+				Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+				ErrorDescription: ptr.Pointer(err.Error()),
+			})
+		}
+
+		l.Error("create recurring order failed", zap.Error(err))
+
+		return recurring.NewCreateRecurringOrderInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create recurring order failed"),
+		})
+	}
+
+	return recurring.NewCreateRecurringOrderOK().WithPayload(&models.GetRecurringOrderResponse{
+		RecurringOrder: convertors.RecurringOrderFromModel(item),
+	})
+}
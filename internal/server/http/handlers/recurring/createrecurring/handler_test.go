@@ -0,0 +1,149 @@
+package createrecurring_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	recurringModel "github.com/krivenkov/order/internal/model/recurring"
+	recurringMock "github.com/krivenkov/order/internal/model/recurring/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/createrecurring"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = "/api/v1/order/recurring-orders"
+
+		body = &models.RecurringOrderRequest{
+			Name:            ptr.Pointer("weekly coffee"),
+			IntervalSeconds: 604800,
+			Order: &models.CreateOrderRequest{
+				Currency: "EUR",
+				Lines:    []*models.CreateOrderLine{{Sku: ptr.Pointer("SKU-1"), Quantity: ptr.Pointer(int64(2)), UnitPrice: 500}},
+			},
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := createrecurring.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Create(gomock.Any(), userID, convertors.RecurringOrderFormToModel(body)).Return(item(), nil)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(recurring.CreateRecurringOrderParams{
+			HTTPRequest: req,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, recurring.NewCreateRecurringOrderOK().WithPayload(&models.GetRecurringOrderResponse{
+			RecurringOrder: convertors.RecurringOrderFromModel(item()),
+		}), res)
+	})
+
+	t.Run("Invalid form", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := createrecurring.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Create(gomock.Any(), userID, convertors.RecurringOrderFormToModel(body)).Return(nil, model.ErrInvalidArgument)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(recurring.CreateRecurringOrderParams{
+			HTTPRequest: req,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, recurring.NewCreateRecurringOrderBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrInvalidArgument.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := createrecurring.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().Create(gomock.Any(), userID, convertors.RecurringOrderFormToModel(body)).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(recurring.CreateRecurringOrderParams{
+			HTTPRequest: req,
+			Body:        body,
+		}, i)
+
+		require.Equal(t, recurring.NewCreateRecurringOrderInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Create recurring order failed"),
+		}), res)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := createrecurring.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+
+		res := serv.Handle(recurring.CreateRecurringOrderParams{
+			HTTPRequest: req,
+		}, i)
+
+		require.Equal(t, recurring.NewCreateRecurringOrderBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("request body is empty"),
+		}), res)
+	})
+}
+
+func item() *recurringModel.Template {
+	return &recurringModel.Template{
+		ID:       uuid.Nil.String(),
+		TSCreate: now(),
+		TSModify: now(),
+		UserID:   "user_id",
+		Name:     "weekly coffee",
+		Schedule: recurringModel.Schedule{Interval: 7 * 24 * time.Hour},
+		NextRun:  now().Add(7 * 24 * time.Hour),
+		Order: &orderModel.Form{
+			Currency: ptr.Pointer("EUR"),
+			Lines:    []*line.Form{{SKU: "SKU-1", Quantity: 2, UnitPrice: 500}},
+		},
+	}
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
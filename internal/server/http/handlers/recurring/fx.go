@@ -0,0 +1,24 @@
+package recurring
+
+import (
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/createrecurring"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/pauserecurring"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/recurringorder"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/recurringorders"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/recurringruns"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/removerecurring"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/resumerecurring"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/updaterecurring"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	recurringorders.FXModule,
+	createrecurring.FXModule,
+	recurringorder.FXModule,
+	updaterecurring.FXModule,
+	removerecurring.FXModule,
+	pauserecurring.FXModule,
+	resumerecurring.FXModule,
+	recurringruns.FXModule,
+)
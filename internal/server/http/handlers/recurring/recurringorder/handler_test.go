@@ -0,0 +1,165 @@
+package recurringorder_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	recurringModel "github.com/krivenkov/order/internal/model/recurring"
+	recurringMock "github.com/krivenkov/order/internal/model/recurring/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/recurringorder"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		id     = uuid.NewString()
+		path   = "/api/v1/order/recurring-orders/id"
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringorder.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetItem(gomock.Any(), userID, id).Return(item(), nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderOK().WithPayload(&models.GetRecurringOrderResponse{
+			RecurringOrder: convertors.RecurringOrderFromModel(item()),
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringorder.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetItem(gomock.Any(), userID, id).Return(nil, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringorder.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetItem(gomock.Any(), userID, id).Return(nil, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringorder.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetItem(gomock.Any(), userID, id).Return(nil, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get recurring order failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringorder.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderParams{
+			HTTPRequest: req,
+			ID:          "not-uuid",
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func item() *recurringModel.Template {
+	return &recurringModel.Template{
+		ID:       uuid.Nil.String(),
+		TSCreate: now(),
+		TSModify: now(),
+		UserID:   "user_id",
+		Name:     "weekly coffee",
+		Schedule: recurringModel.Schedule{Interval: 7 * 24 * time.Hour},
+		NextRun:  now().Add(7 * 24 * time.Hour),
+		Order: &orderModel.Form{
+			Currency: ptr.Pointer("EUR"),
+			Lines:    []*line.Form{{SKU: "SKU-1", Quantity: 2, UnitPrice: 500}},
+		},
+	}
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,63 @@
+package recurringorders
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	recurringModel "github.com/krivenkov/order/internal/model/recurring"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	service recurringModel.Service
+}
+
+func New(service recurringModel.Service) recurring.GetRecurringOrdersHandler {
+	return &Handler{
+		service: service,
+	}
+}
+
+func (h *Handler) Handle(params recurring.GetRecurringOrdersParams, i interface{}) middleware.Responder {
+	userID := i.(string)
+
+	ctx := params.HTTPRequest.Context()
+	l := mlog.FromContext(ctx).With(
+		zap.String("userID", userID),
+		zap.Float64p("offset", params.Offset),
+		zap.Float64p("limit", params.Limit),
+	)
+	ctx = mlog.CtxWithLogger(ctx, l)
+
+	pagination := convertors.Paginator(params.Limit, params.Offset)
+	if pagination.Limit == 0 {
+		l.Error("empty pagination")
+		return recurring.NewGetRecurringOrdersBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Get recurring orders failed"),
+		})
+	}
+
+	list, total, err := h.service.GetList(ctx, userID, *pagination)
+	if err != nil {
+		l.Error("get recurring orders failed", zap.Error(err))
+
+		return recurring.NewGetRecurringOrdersInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get recurring orders failed"),
+		})
+	}
+
+	return recurring.NewGetRecurringOrdersOK().WithPayload(&models.GetRecurringOrdersResponse{
+		RecurringOrders: convertors.RecurringOrdersFromModel(list),
+		Pagination: convertors.Pagination(&paginator.PaginationResult{
+			Limit:  pagination.Limit,
+			Offset: pagination.Offset,
+			Total:  total,
+		}),
+	})
+}
@@ -0,0 +1,126 @@
+package recurringorders_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	recurringModel "github.com/krivenkov/order/internal/model/recurring"
+	recurringMock "github.com/krivenkov/order/internal/model/recurring/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/recurringorders"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		path   = "/api/v1/order/recurring-orders"
+		limit  = float64(10)
+		offset = float64(20)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringorders.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetList(gomock.Any(), userID, paginator.Pagination{Limit: 10, Offset: 20}).Return([]*recurringModel.Template{item()}, 21, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrdersParams{
+			HTTPRequest: req,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrdersOK().WithPayload(&models.GetRecurringOrdersResponse{
+			RecurringOrders: convertors.RecurringOrdersFromModel([]*recurringModel.Template{item()}),
+			Pagination: &models.Pagination{
+				Limit:  ptr.Pointer(float64(10)),
+				Offset: ptr.Pointer(float64(20)),
+				Total:  ptr.Pointer(float64(21)),
+			},
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringorders.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetList(gomock.Any(), userID, paginator.Pagination{Limit: 10, Offset: 20}).Return(nil, 0, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrdersParams{
+			HTTPRequest: req,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrdersInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get recurring orders failed"),
+		}), res)
+	})
+
+	t.Run("Empty pagination", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringorders.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrdersParams{
+			HTTPRequest: req,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrdersBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Get recurring orders failed"),
+		}), res)
+	})
+}
+
+func item() *recurringModel.Template {
+	return &recurringModel.Template{
+		ID:       uuid.Nil.String(),
+		TSCreate: now(),
+		TSModify: now(),
+		UserID:   "user_id",
+		Name:     "weekly coffee",
+		Schedule: recurringModel.Schedule{Interval: 7 * 24 * time.Hour},
+		NextRun:  now().Add(7 * 24 * time.Hour),
+		Order: &orderModel.Form{
+			Currency: ptr.Pointer("EUR"),
+			Lines:    []*line.Form{{SKU: "SKU-1", Quantity: 2, UnitPrice: 500}},
+		},
+	}
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,17 @@
+package recurringruns
+
+import (
+	"github.com/krivenkov/order/internal/server/http/operations"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(New),
+
+	fx.Invoke(
+		func(handler recurring.GetRecurringOrderRunsHandler, api *operations.OrderAPIAPI) {
+			api.RecurringGetRecurringOrderRunsHandler = handler
+		},
+	),
+)
@@ -0,0 +1,191 @@
+package recurringruns_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	recurringModel "github.com/krivenkov/order/internal/model/recurring"
+	recurringMock "github.com/krivenkov/order/internal/model/recurring/mock"
+	"github.com/krivenkov/order/internal/server/http/convertors"
+	"github.com/krivenkov/order/internal/server/http/handlers/recurring/recurringruns"
+	"github.com/krivenkov/order/internal/server/http/models"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var (
+		userID = "user_id"
+		id     = uuid.NewString()
+		path   = "/api/v1/order/recurring-orders/id/runs"
+		limit  = float64(10)
+		offset = float64(20)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringruns.New(mock)
+
+		var i interface{} = userID
+
+		runs := []*recurringModel.Run{
+			{ID: uuid.NewString(), TSCreate: now(), TemplateID: id, ScheduledAt: now(), OrderID: uuid.NewString()},
+			{ID: uuid.NewString(), TSCreate: now(), TemplateID: id, ScheduledAt: now(), Error: "out of stock"},
+		}
+
+		mock.EXPECT().GetRuns(gomock.Any(), userID, id, paginator.Pagination{Limit: 10, Offset: 20}).Return(runs, 1, nil)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderRunsParams{
+			HTTPRequest: req,
+			ID:          id,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderRunsOK().WithPayload(&models.GetRecurringRunsResponse{
+			Runs: convertors.RecurringRunsFromModel(runs),
+			Pagination: &models.Pagination{
+				Limit:  ptr.Pointer(float64(10)),
+				Offset: ptr.Pointer(float64(20)),
+				Total:  ptr.Pointer(float64(1)),
+			},
+		}), res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringruns.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetRuns(gomock.Any(), userID, id, paginator.Pagination{Limit: 10, Offset: 20}).Return(nil, 0, model.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderRunsParams{
+			HTTPRequest: req,
+			ID:          id,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderRunsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer(model.ErrNotFound.Error()),
+		}), res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringruns.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetRuns(gomock.Any(), userID, id, paginator.Pagination{Limit: 10, Offset: 20}).Return(nil, 0, model.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderRunsParams{
+			HTTPRequest: req,
+			ID:          id,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderRunsForbidden().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorAccessDenied),
+			ErrorDescription: ptr.Pointer(model.ErrPermissionDenied.Error()),
+		}), res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringruns.New(mock)
+
+		var i interface{} = userID
+
+		mock.EXPECT().GetRuns(gomock.Any(), userID, id, paginator.Pagination{Limit: 10, Offset: 20}).Return(nil, 0, errors.New("some error"))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderRunsParams{
+			HTTPRequest: req,
+			ID:          id,
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderRunsInternalServerError().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorServerError),
+			ErrorDescription: ptr.Pointer("Get recurring order runs failed"),
+		}), res)
+	})
+
+	t.Run("Empty pagination", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringruns.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderRunsParams{
+			HTTPRequest: req,
+			ID:          id,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderRunsBadRequest().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Get recurring order runs failed"),
+		}), res)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mock := recurringMock.NewMockService(ctrl)
+		serv := recurringruns.New(mock)
+
+		var i interface{} = userID
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+
+		res := serv.Handle(recurring.GetRecurringOrderRunsParams{
+			HTTPRequest: req,
+			ID:          "not-uuid",
+			Limit:       &limit,
+			Offset:      &offset,
+		}, i)
+
+		require.Equal(t, recurring.NewGetRecurringOrderRunsNotFound().WithPayload(&models.Error{
+			Error:            ptr.Pointer(models.ErrorErrorInvalidRequest),
+			ErrorDescription: ptr.Pointer("Not Found"),
+		}), res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
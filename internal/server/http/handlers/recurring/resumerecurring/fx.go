@@ -0,0 +1,17 @@
+package resumerecurring
+
+import (
+	"github.com/krivenkov/order/internal/server/http/operations"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(New),
+
+	fx.Invoke(
+		func(handler recurring.ResumeRecurringOrderHandler, api *operations.OrderAPIAPI) {
+			api.RecurringResumeRecurringOrderHandler = handler
+		},
+	),
+)
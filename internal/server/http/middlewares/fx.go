@@ -5,5 +5,6 @@ import "go.uber.org/fx"
 var FXModule = fx.Options(
 	fx.Provide(
 		NewLogger,
+		NewOrigin,
 	),
 )
@@ -0,0 +1,27 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/krivenkov/order/internal/model/history"
+)
+
+// Origin marks changes made through the http api in the order history
+type Origin struct {
+	next http.Handler
+}
+
+func NewOrigin() *Origin {
+	return &Origin{}
+}
+
+func (o *Origin) Provide(next http.Handler) http.Handler {
+	o.next = next
+	return o
+}
+
+func (o *Origin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := history.CtxWithOrigin(r.Context(), history.Origin{Source: history.SourceHTTP})
+
+	o.next.ServeHTTP(w, r.WithContext(ctx))
+}
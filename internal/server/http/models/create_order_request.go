@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,33 +20,101 @@ import (
 // swagger:model CreateOrderRequest
 type CreateOrderRequest struct {
 
+	// billing address
+	BillingAddress *Address `json:"billingAddress,omitempty"`
+
+	// ISO 4217 code, required when lines have prices.
+	// Example: EUR
+	Currency string `json:"currency,omitempty"`
+
 	// The description of the order.
 	// Required: true
 	Description *string `json:"description"`
 
+	// Create a draft, its lines can change until the checkout places it.
+	Draft bool `json:"draft,omitempty"`
+
+	// lines
+	// Max Items: 100
+	Lines []*CreateOrderLine `json:"lines,omitempty"`
+
+	// Keys and values of integrators: up to 50 keys of letters, digits, '_' or '-' and at most 40 characters, values of at most 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
 	// The name of the order.
 	// Required: true
 	Name *string `json:"name"`
+
+	// Promo codes to apply, line discounts go before order discounts.
+	// Max Items: 5
+	PromoCodes []string `json:"promoCodes,omitempty"`
+
+	// shipping address
+	ShippingAddress *Address `json:"shippingAddress,omitempty"`
+
+	// Free-form tags, matched regardless of case.
+	// Max Items: 20
+	Tags []string `json:"tags,omitempty"`
 }
 
 // Validate validates this create order request
 func (m *CreateOrderRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBillingAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateLines(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validatePromoCodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShippingAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTags(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *CreateOrderRequest) validateBillingAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BillingAddress) { // not required
+		return nil
+	}
+
+	if m.BillingAddress != nil {
+		if err := m.BillingAddress.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("billingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("billingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *CreateOrderRequest) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -55,6 +124,39 @@ func (m *CreateOrderRequest) validateDescription(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *CreateOrderRequest) validateLines(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Lines) { // not required
+		return nil
+	}
+
+	linesSize := int64(len(m.Lines))
+
+	if err := validate.MaxItems("lines", "body", linesSize, 100); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Lines); i++ {
+		if swag.IsZero(m.Lines[i]) { // not required
+			continue
+		}
+
+		if m.Lines[i] != nil {
+			if err := m.Lines[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lines" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("lines" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *CreateOrderRequest) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -64,8 +166,127 @@ func (m *CreateOrderRequest) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this create order request based on context it is used
+func (m *CreateOrderRequest) validatePromoCodes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PromoCodes) { // not required
+		return nil
+	}
+
+	promoCodesSize := int64(len(m.PromoCodes))
+
+	if err := validate.MaxItems("promoCodes", "body", promoCodesSize, 5); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateOrderRequest) validateShippingAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ShippingAddress) { // not required
+		return nil
+	}
+
+	if m.ShippingAddress != nil {
+		if err := m.ShippingAddress.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateOrderRequest) validateTags(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Tags) { // not required
+		return nil
+	}
+
+	tagsSize := int64(len(m.Tags))
+
+	if err := validate.MaxItems("tags", "body", tagsSize, 20); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this create order request based on the context it is used
 func (m *CreateOrderRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBillingAddress(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateLines(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateShippingAddress(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateOrderRequest) contextValidateBillingAddress(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BillingAddress != nil {
+		if err := m.BillingAddress.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("billingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("billingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateOrderRequest) contextValidateLines(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Lines); i++ {
+
+		if m.Lines[i] != nil {
+			if err := m.Lines[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lines" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("lines" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CreateOrderRequest) contextValidateShippingAddress(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ShippingAddress != nil {
+		if err := m.ShippingAddress.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingAddress")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -7,6 +7,8 @@ package models
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,29 +21,77 @@ import (
 // swagger:model Order
 type Order struct {
 
+	// billing address
+	BillingAddress *Address `json:"billingAddress,omitempty"`
+
 	// The description of the order.
 	// Required: true
 	Description *string `json:"description"`
 
+	// discounts
+	// Required: true
+	Discounts []*OrderDiscount `json:"discounts"`
+
 	// id
 	// Example: 123e4567-e89b-12d3-a456-426614174000
 	// Required: true
 	// Format: uuid
 	ID *strfmt.UUID `json:"id"`
 
+	// Keys and values attached by integrators.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
 	// The name of the order.
 	// Required: true
 	Name *string `json:"name"`
+
+	// Human-readable order number.
+	// Example: ORD-2026-000123
+	// Required: true
+	Number *string `json:"number"`
+
+	// shipping address
+	ShippingAddress *Address `json:"shippingAddress,omitempty"`
+
+	// Lifecycle state of the order.
+	// Required: true
+	// Enum: [draft pending_approval placed paid payment_failed fulfilled partially_refunded refunded rejected]
+	State *string `json:"state"`
+
+	// Lower-cased free-form tags.
+	// Required: true
+	Tags []string `json:"tags"`
+
+	// taxes
+	// Required: true
+	Taxes []*OrderTax `json:"taxes"`
+
+	// Organisation owning the order, absent for personal orders.
+	// Example: 123e4567-e89b-12d3-a456-426614174000
+	// Format: uuid
+	TenantID strfmt.UUID `json:"tenantId,omitempty"`
+
+	// totals
+	// Required: true
+	Totals *OrderTotals `json:"totals"`
 }
 
 // Validate validates this order
 func (m *Order) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBillingAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateDiscounts(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -50,12 +100,60 @@ func (m *Order) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateNumber(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShippingAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTags(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTaxes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTenantID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTotals(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Order) validateBillingAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BillingAddress) { // not required
+		return nil
+	}
+
+	if m.BillingAddress != nil {
+		if err := m.BillingAddress.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("billingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("billingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Order) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -65,6 +163,33 @@ func (m *Order) validateDescription(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Order) validateDiscounts(formats strfmt.Registry) error {
+
+	if err := validate.Required("discounts", "body", m.Discounts); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Discounts); i++ {
+		if swag.IsZero(m.Discounts[i]) { // not required
+			continue
+		}
+
+		if m.Discounts[i] != nil {
+			if err := m.Discounts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("discounts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("discounts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Order) validateID(formats strfmt.Registry) error {
 
 	if err := validate.Required("id", "body", m.ID); err != nil {
@@ -87,8 +212,283 @@ func (m *Order) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this order based on context it is used
+func (m *Order) validateNumber(formats strfmt.Registry) error {
+
+	if err := validate.Required("number", "body", m.Number); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) validateShippingAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ShippingAddress) { // not required
+		return nil
+	}
+
+	if m.ShippingAddress != nil {
+		if err := m.ShippingAddress.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var orderTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["draft","pending_approval","placed","paid","payment_failed","fulfilled","partially_refunded","refunded","rejected"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		orderTypeStatePropEnum = append(orderTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// OrderStateDraft captures enum value "draft"
+	OrderStateDraft string = "draft"
+
+	// OrderStatePendingApproval captures enum value "pending_approval"
+	OrderStatePendingApproval string = "pending_approval"
+
+	// OrderStatePlaced captures enum value "placed"
+	OrderStatePlaced string = "placed"
+
+	// OrderStatePaid captures enum value "paid"
+	OrderStatePaid string = "paid"
+
+	// OrderStatePaymentFailed captures enum value "payment_failed"
+	OrderStatePaymentFailed string = "payment_failed"
+
+	// OrderStateFulfilled captures enum value "fulfilled"
+	OrderStateFulfilled string = "fulfilled"
+
+	// OrderStatePartiallyRefunded captures enum value "partially_refunded"
+	OrderStatePartiallyRefunded string = "partially_refunded"
+
+	// OrderStateRefunded captures enum value "refunded"
+	OrderStateRefunded string = "refunded"
+
+	// OrderStateRejected captures enum value "rejected"
+	OrderStateRejected string = "rejected"
+)
+
+// prop value enum
+func (m *Order) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, orderTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Order) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Required("state", "body", m.State); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", *m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) validateTags(formats strfmt.Registry) error {
+
+	if err := validate.Required("tags", "body", m.Tags); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) validateTaxes(formats strfmt.Registry) error {
+
+	if err := validate.Required("taxes", "body", m.Taxes); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Taxes); i++ {
+		if swag.IsZero(m.Taxes[i]) { // not required
+			continue
+		}
+
+		if m.Taxes[i] != nil {
+			if err := m.Taxes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("taxes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("taxes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Order) validateTenantID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TenantID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("tenantId", "body", "uuid", m.TenantID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Order) validateTotals(formats strfmt.Registry) error {
+
+	if err := validate.Required("totals", "body", m.Totals); err != nil {
+		return err
+	}
+
+	if m.Totals != nil {
+		if err := m.Totals.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("totals")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("totals")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this order based on the context it is used
 func (m *Order) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBillingAddress(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDiscounts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateShippingAddress(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTaxes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTotals(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Order) contextValidateBillingAddress(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BillingAddress != nil {
+		if err := m.BillingAddress.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("billingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("billingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateDiscounts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Discounts); i++ {
+
+		if m.Discounts[i] != nil {
+			if err := m.Discounts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("discounts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("discounts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateShippingAddress(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ShippingAddress != nil {
+		if err := m.ShippingAddress.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateTaxes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Taxes); i++ {
+
+		if m.Taxes[i] != nil {
+			if err := m.Taxes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("taxes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("taxes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Order) contextValidateTotals(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Totals != nil {
+		if err := m.Totals.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("totals")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("totals")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
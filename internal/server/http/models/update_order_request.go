@@ -14,24 +14,41 @@ import (
 	"github.com/go-openapi/validate"
 )
 
-// UpdateOrderRequest update order request
+// UpdateOrderRequest Addresses that are not sent are left untouched.
 //
 // swagger:model UpdateOrderRequest
 type UpdateOrderRequest struct {
 
+	// billing address
+	BillingAddress *Address `json:"billingAddress,omitempty"`
+
 	// The description of the order.
 	// Required: true
 	Description *string `json:"description"`
 
+	// Replaces the metadata of the order, an empty object removes it, missing keeps it.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
 	// The name of the order.
 	// Required: true
 	Name *string `json:"name"`
+
+	// shipping address
+	ShippingAddress *Address `json:"shippingAddress,omitempty"`
+
+	// Replace the tags of the order, an empty list removes them, missing keeps them.
+	// Max Items: 20
+	Tags []string `json:"tags,omitempty"`
 }
 
 // Validate validates this update order request
 func (m *UpdateOrderRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBillingAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
@@ -40,12 +57,40 @@ func (m *UpdateOrderRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateShippingAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTags(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *UpdateOrderRequest) validateBillingAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BillingAddress) { // not required
+		return nil
+	}
+
+	if m.BillingAddress != nil {
+		if err := m.BillingAddress.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("billingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("billingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *UpdateOrderRequest) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -64,8 +109,88 @@ func (m *UpdateOrderRequest) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this update order request based on context it is used
+func (m *UpdateOrderRequest) validateShippingAddress(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ShippingAddress) { // not required
+		return nil
+	}
+
+	if m.ShippingAddress != nil {
+		if err := m.ShippingAddress.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateOrderRequest) validateTags(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Tags) { // not required
+		return nil
+	}
+
+	tagsSize := int64(len(m.Tags))
+
+	if err := validate.MaxItems("tags", "body", tagsSize, 20); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this update order request based on the context it is used
 func (m *UpdateOrderRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBillingAddress(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateShippingAddress(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UpdateOrderRequest) contextValidateBillingAddress(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BillingAddress != nil {
+		if err := m.BillingAddress.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("billingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("billingAddress")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateOrderRequest) contextValidateShippingAddress(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ShippingAddress != nil {
+		if err := m.ShippingAddress.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("shippingAddress")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("shippingAddress")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
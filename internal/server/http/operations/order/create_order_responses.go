@@ -148,6 +148,96 @@ func (o *CreateOrderUnauthorized) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// CreateOrderForbiddenCode is the HTTP code returned for type CreateOrderForbidden
+const CreateOrderForbiddenCode int = 403
+
+/*
+CreateOrderForbidden Forbidden
+
+swagger:response createOrderForbidden
+*/
+type CreateOrderForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateOrderForbidden creates CreateOrderForbidden with default headers values
+func NewCreateOrderForbidden() *CreateOrderForbidden {
+
+	return &CreateOrderForbidden{}
+}
+
+// WithPayload adds the payload to the create order forbidden response
+func (o *CreateOrderForbidden) WithPayload(payload *models.Error) *CreateOrderForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create order forbidden response
+func (o *CreateOrderForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrderForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateOrderConflictCode is the HTTP code returned for type CreateOrderConflict
+const CreateOrderConflictCode int = 409
+
+/*
+CreateOrderConflict Conflict
+
+swagger:response createOrderConflict
+*/
+type CreateOrderConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateOrderConflict creates CreateOrderConflict with default headers values
+func NewCreateOrderConflict() *CreateOrderConflict {
+
+	return &CreateOrderConflict{}
+}
+
+// WithPayload adds the payload to the create order conflict response
+func (o *CreateOrderConflict) WithPayload(payload *models.Error) *CreateOrderConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create order conflict response
+func (o *CreateOrderConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrderConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // CreateOrderInternalServerErrorCode is the HTTP code returned for type CreateOrderInternalServerError
 const CreateOrderInternalServerErrorCode int = 500
 
@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetOrdersCountParams creates a new GetOrdersCountParams object
@@ -31,10 +32,38 @@ type GetOrdersCountParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*
+	  Drafts are left out unless true, then only drafts are returned.
+	  In: query
+	*/
+	Draft *bool
+	/*
+	  Adds the orders shared with the user to its own ones, ignored in an organisation.
+	  In: query
+	*/
+	IncludeShared *bool
+	/*
+	  Keeps the orders with the metadata key set to the value, as key:value.
+	  In: query
+	  Collection Format: multi
+	*/
+	Metadata []string
 	/*
 	  In: query
 	*/
 	Q *string
+	/*
+	  Keeps the orders carrying every tag.
+	  In: query
+	  Collection Format: multi
+	*/
+	Tags []string
+	/*
+	  Keeps the orders carrying at least one of the tags.
+	  In: query
+	  Collection Format: multi
+	*/
+	TagsAny []string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -48,16 +77,109 @@ func (o *GetOrdersCountParams) BindRequest(r *http.Request, route *middleware.Ma
 
 	qs := runtime.Values(r.URL.Query())
 
+	qDraft, qhkDraft, _ := qs.GetOK("draft")
+	if err := o.bindDraft(qDraft, qhkDraft, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qIncludeShared, qhkIncludeShared, _ := qs.GetOK("includeShared")
+	if err := o.bindIncludeShared(qIncludeShared, qhkIncludeShared, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMetadata, qhkMetadata, _ := qs.GetOK("metadata")
+	if err := o.bindMetadata(qMetadata, qhkMetadata, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qQ, qhkQ, _ := qs.GetOK("q")
 	if err := o.bindQ(qQ, qhkQ, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qTags, qhkTags, _ := qs.GetOK("tags")
+	if err := o.bindTags(qTags, qhkTags, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTagsAny, qhkTagsAny, _ := qs.GetOK("tagsAny")
+	if err := o.bindTagsAny(qTagsAny, qhkTagsAny, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindDraft binds and validates parameter Draft from query.
+func (o *GetOrdersCountParams) bindDraft(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("draft", "query", "bool", raw)
+	}
+	o.Draft = &value
+
+	return nil
+}
+
+// bindIncludeShared binds and validates parameter IncludeShared from query.
+func (o *GetOrdersCountParams) bindIncludeShared(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("includeShared", "query", "bool", raw)
+	}
+	o.IncludeShared = &value
+
+	return nil
+}
+
+// bindMetadata binds and validates array parameter Metadata from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetOrdersCountParams) bindMetadata(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	metadataIC := rawData
+	if len(metadataIC) == 0 {
+		return nil
+	}
+
+	var metadataIR []string
+	for _, metadataIV := range metadataIC {
+		metadataI := metadataIV
+
+		metadataIR = append(metadataIR, metadataI)
+	}
+
+	o.Metadata = metadataIR
+
+	return nil
+}
+
 // bindQ binds and validates parameter Q from query.
 func (o *GetOrdersCountParams) bindQ(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -75,3 +197,47 @@ func (o *GetOrdersCountParams) bindQ(rawData []string, hasKey bool, formats strf
 
 	return nil
 }
+
+// bindTags binds and validates array parameter Tags from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetOrdersCountParams) bindTags(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	tagsIC := rawData
+	if len(tagsIC) == 0 {
+		return nil
+	}
+
+	var tagsIR []string
+	for _, tagsIV := range tagsIC {
+		tagsI := tagsIV
+
+		tagsIR = append(tagsIR, tagsI)
+	}
+
+	o.Tags = tagsIR
+
+	return nil
+}
+
+// bindTagsAny binds and validates array parameter TagsAny from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetOrdersCountParams) bindTagsAny(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	tagsAnyIC := rawData
+	if len(tagsAnyIC) == 0 {
+		return nil
+	}
+
+	var tagsAnyIR []string
+	for _, tagsAnyIV := range tagsAnyIC {
+		tagsAnyI := tagsAnyIV
+
+		tagsAnyIR = append(tagsAnyIR, tagsAnyI)
+	}
+
+	o.TagsAny = tagsAnyIR
+
+	return nil
+}
@@ -58,6 +58,51 @@ func (o *GetOrdersCountOK) WriteResponse(rw http.ResponseWriter, producer runtim
 	}
 }
 
+// GetOrdersCountBadRequestCode is the HTTP code returned for type GetOrdersCountBadRequest
+const GetOrdersCountBadRequestCode int = 400
+
+/*
+GetOrdersCountBadRequest Bad Request
+
+swagger:response getOrdersCountBadRequest
+*/
+type GetOrdersCountBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetOrdersCountBadRequest creates GetOrdersCountBadRequest with default headers values
+func NewGetOrdersCountBadRequest() *GetOrdersCountBadRequest {
+
+	return &GetOrdersCountBadRequest{}
+}
+
+// WithPayload adds the payload to the get orders count bad request response
+func (o *GetOrdersCountBadRequest) WithPayload(payload *models.Error) *GetOrdersCountBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get orders count bad request response
+func (o *GetOrdersCountBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetOrdersCountBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetOrdersCountUnauthorizedCode is the HTTP code returned for type GetOrdersCountUnauthorized
 const GetOrdersCountUnauthorizedCode int = 401
 
@@ -103,6 +148,51 @@ func (o *GetOrdersCountUnauthorized) WriteResponse(rw http.ResponseWriter, produ
 	}
 }
 
+// GetOrdersCountForbiddenCode is the HTTP code returned for type GetOrdersCountForbidden
+const GetOrdersCountForbiddenCode int = 403
+
+/*
+GetOrdersCountForbidden Forbidden
+
+swagger:response getOrdersCountForbidden
+*/
+type GetOrdersCountForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetOrdersCountForbidden creates GetOrdersCountForbidden with default headers values
+func NewGetOrdersCountForbidden() *GetOrdersCountForbidden {
+
+	return &GetOrdersCountForbidden{}
+}
+
+// WithPayload adds the payload to the get orders count forbidden response
+func (o *GetOrdersCountForbidden) WithPayload(payload *models.Error) *GetOrdersCountForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get orders count forbidden response
+func (o *GetOrdersCountForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetOrdersCountForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetOrdersCountInternalServerErrorCode is the HTTP code returned for type GetOrdersCountInternalServerError
 const GetOrdersCountInternalServerErrorCode int = 500
 
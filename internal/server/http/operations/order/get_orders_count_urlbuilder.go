@@ -9,11 +9,18 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // GetOrdersCountURL generates an URL for the get orders count operation
 type GetOrdersCountURL struct {
-	Q *string
+	Draft         *bool
+	IncludeShared *bool
+	Metadata      []string
+	Q             *string
+	Tags          []string
+	TagsAny       []string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -49,6 +56,34 @@ func (o *GetOrdersCountURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var draftQ string
+	if o.Draft != nil {
+		draftQ = swag.FormatBool(*o.Draft)
+	}
+	if draftQ != "" {
+		qs.Set("draft", draftQ)
+	}
+
+	var includeSharedQ string
+	if o.IncludeShared != nil {
+		includeSharedQ = swag.FormatBool(*o.IncludeShared)
+	}
+	if includeSharedQ != "" {
+		qs.Set("includeShared", includeSharedQ)
+	}
+
+	var metadataIR []string
+	for _, metadataI := range o.Metadata {
+		metadataIS := metadataI
+		if metadataIS != "" {
+			metadataIR = append(metadataIR, metadataIS)
+		}
+	}
+
+	for _, qsv := range metadataIR {
+		qs.Add("metadata", qsv)
+	}
+
 	var qQ string
 	if o.Q != nil {
 		qQ = *o.Q
@@ -57,6 +92,30 @@ func (o *GetOrdersCountURL) Build() (*url.URL, error) {
 		qs.Set("q", qQ)
 	}
 
+	var tagsIR []string
+	for _, tagsI := range o.Tags {
+		tagsIS := tagsI
+		if tagsIS != "" {
+			tagsIR = append(tagsIR, tagsIS)
+		}
+	}
+
+	for _, qsv := range tagsIR {
+		qs.Add("tags", qsv)
+	}
+
+	var tagsAnyIR []string
+	for _, tagsAnyI := range o.TagsAny {
+		tagsAnyIS := tagsAnyI
+		if tagsAnyIS != "" {
+			tagsAnyIR = append(tagsAnyIR, tagsAnyIS)
+		}
+	}
+
+	for _, qsv := range tagsAnyIR {
+		qs.Add("tagsAny", qsv)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
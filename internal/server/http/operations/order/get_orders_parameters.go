@@ -23,7 +23,8 @@ func NewGetOrdersParams() GetOrdersParams {
 	var (
 		// initialize parameters with default values
 
-		limitDefault  = float64(50)
+		limitDefault = float64(50)
+
 		offsetDefault = float64(0)
 
 		sortByDefault        = string("name")
@@ -50,6 +51,16 @@ type GetOrdersParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*
+	  Drafts are left out unless true, then only drafts are returned.
+	  In: query
+	*/
+	Draft *bool
+	/*
+	  Adds the orders shared with the user to its own ones, ignored in an organisation.
+	  In: query
+	*/
+	IncludeShared *bool
 	/*
 	  Maximum: 200
 	  Minimum: 10
@@ -57,6 +68,12 @@ type GetOrdersParams struct {
 	  Default: 50
 	*/
 	Limit *float64
+	/*
+	  Keeps the orders with the metadata key set to the value, as key:value.
+	  In: query
+	  Collection Format: multi
+	*/
+	Metadata []string
 	/*
 	  Minimum: 0
 	  In: query
@@ -77,6 +94,18 @@ type GetOrdersParams struct {
 	  Default: "asc"
 	*/
 	SortDirection *string
+	/*
+	  Keeps the orders carrying every tag.
+	  In: query
+	  Collection Format: multi
+	*/
+	Tags []string
+	/*
+	  Keeps the orders carrying at least one of the tags.
+	  In: query
+	  Collection Format: multi
+	*/
+	TagsAny []string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -90,11 +119,26 @@ func (o *GetOrdersParams) BindRequest(r *http.Request, route *middleware.Matched
 
 	qs := runtime.Values(r.URL.Query())
 
+	qDraft, qhkDraft, _ := qs.GetOK("draft")
+	if err := o.bindDraft(qDraft, qhkDraft, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qIncludeShared, qhkIncludeShared, _ := qs.GetOK("includeShared")
+	if err := o.bindIncludeShared(qIncludeShared, qhkIncludeShared, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qMetadata, qhkMetadata, _ := qs.GetOK("metadata")
+	if err := o.bindMetadata(qMetadata, qhkMetadata, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qOffset, qhkOffset, _ := qs.GetOK("offset")
 	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
 		res = append(res, err)
@@ -114,12 +158,68 @@ func (o *GetOrdersParams) BindRequest(r *http.Request, route *middleware.Matched
 	if err := o.bindSortDirection(qSortDirection, qhkSortDirection, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qTags, qhkTags, _ := qs.GetOK("tags")
+	if err := o.bindTags(qTags, qhkTags, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTagsAny, qhkTagsAny, _ := qs.GetOK("tagsAny")
+	if err := o.bindTagsAny(qTagsAny, qhkTagsAny, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindDraft binds and validates parameter Draft from query.
+func (o *GetOrdersParams) bindDraft(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("draft", "query", "bool", raw)
+	}
+	o.Draft = &value
+
+	return nil
+}
+
+// bindIncludeShared binds and validates parameter IncludeShared from query.
+func (o *GetOrdersParams) bindIncludeShared(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("includeShared", "query", "bool", raw)
+	}
+	o.IncludeShared = &value
+
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *GetOrdersParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -162,6 +262,28 @@ func (o *GetOrdersParams) validateLimit(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindMetadata binds and validates array parameter Metadata from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetOrdersParams) bindMetadata(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	metadataIC := rawData
+	if len(metadataIC) == 0 {
+		return nil
+	}
+
+	var metadataIR []string
+	for _, metadataIV := range metadataIC {
+		metadataI := metadataIV
+
+		metadataIR = append(metadataIR, metadataI)
+	}
+
+	o.Metadata = metadataIR
+
+	return nil
+}
+
 // bindOffset binds and validates parameter Offset from query.
 func (o *GetOrdersParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -283,3 +405,47 @@ func (o *GetOrdersParams) validateSortDirection(formats strfmt.Registry) error {
 
 	return nil
 }
+
+// bindTags binds and validates array parameter Tags from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetOrdersParams) bindTags(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	tagsIC := rawData
+	if len(tagsIC) == 0 {
+		return nil
+	}
+
+	var tagsIR []string
+	for _, tagsIV := range tagsIC {
+		tagsI := tagsIV
+
+		tagsIR = append(tagsIR, tagsI)
+	}
+
+	o.Tags = tagsIR
+
+	return nil
+}
+
+// bindTagsAny binds and validates array parameter TagsAny from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *GetOrdersParams) bindTagsAny(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	tagsAnyIC := rawData
+	if len(tagsAnyIC) == 0 {
+		return nil
+	}
+
+	var tagsAnyIR []string
+	for _, tagsAnyIV := range tagsAnyIC {
+		tagsAnyI := tagsAnyIV
+
+		tagsAnyIR = append(tagsAnyIR, tagsAnyI)
+	}
+
+	o.TagsAny = tagsAnyIR
+
+	return nil
+}
@@ -148,6 +148,51 @@ func (o *GetOrdersUnauthorized) WriteResponse(rw http.ResponseWriter, producer r
 	}
 }
 
+// GetOrdersForbiddenCode is the HTTP code returned for type GetOrdersForbidden
+const GetOrdersForbiddenCode int = 403
+
+/*
+GetOrdersForbidden Forbidden
+
+swagger:response getOrdersForbidden
+*/
+type GetOrdersForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetOrdersForbidden creates GetOrdersForbidden with default headers values
+func NewGetOrdersForbidden() *GetOrdersForbidden {
+
+	return &GetOrdersForbidden{}
+}
+
+// WithPayload adds the payload to the get orders forbidden response
+func (o *GetOrdersForbidden) WithPayload(payload *models.Error) *GetOrdersForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get orders forbidden response
+func (o *GetOrdersForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetOrdersForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetOrdersInternalServerErrorCode is the HTTP code returned for type GetOrdersInternalServerError
 const GetOrdersInternalServerErrorCode int = 500
 
@@ -15,11 +15,16 @@ import (
 
 // GetOrdersURL generates an URL for the get orders operation
 type GetOrdersURL struct {
+	Draft         *bool
+	IncludeShared *bool
 	Limit         *float64
+	Metadata      []string
 	Offset        *float64
 	Q             *string
 	SortBy        *string
 	SortDirection *string
+	Tags          []string
+	TagsAny       []string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -55,6 +60,22 @@ func (o *GetOrdersURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var draftQ string
+	if o.Draft != nil {
+		draftQ = swag.FormatBool(*o.Draft)
+	}
+	if draftQ != "" {
+		qs.Set("draft", draftQ)
+	}
+
+	var includeSharedQ string
+	if o.IncludeShared != nil {
+		includeSharedQ = swag.FormatBool(*o.IncludeShared)
+	}
+	if includeSharedQ != "" {
+		qs.Set("includeShared", includeSharedQ)
+	}
+
 	var limitQ string
 	if o.Limit != nil {
 		limitQ = swag.FormatFloat64(*o.Limit)
@@ -63,6 +84,18 @@ func (o *GetOrdersURL) Build() (*url.URL, error) {
 		qs.Set("limit", limitQ)
 	}
 
+	var metadataIR []string
+	for _, metadataI := range o.Metadata {
+		metadataIS := metadataI
+		if metadataIS != "" {
+			metadataIR = append(metadataIR, metadataIS)
+		}
+	}
+
+	for _, qsv := range metadataIR {
+		qs.Add("metadata", qsv)
+	}
+
 	var offsetQ string
 	if o.Offset != nil {
 		offsetQ = swag.FormatFloat64(*o.Offset)
@@ -95,6 +128,30 @@ func (o *GetOrdersURL) Build() (*url.URL, error) {
 		qs.Set("sortDirection", sortDirectionQ)
 	}
 
+	var tagsIR []string
+	for _, tagsI := range o.Tags {
+		tagsIS := tagsI
+		if tagsIS != "" {
+			tagsIR = append(tagsIR, tagsIS)
+		}
+	}
+
+	for _, qsv := range tagsIR {
+		qs.Add("tags", qsv)
+	}
+
+	var tagsAnyIR []string
+	for _, tagsAnyI := range o.TagsAny {
+		tagsAnyIS := tagsAnyI
+		if tagsAnyIS != "" {
+			tagsAnyIR = append(tagsAnyIR, tagsAnyIS)
+		}
+	}
+
+	for _, qsv := range tagsAnyIR {
+		qs.Add("tagsAny", qsv)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -19,7 +19,9 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/krivenkov/order/internal/server/http/operations/admin"
 	"github.com/krivenkov/order/internal/server/http/operations/order"
+	"github.com/krivenkov/order/internal/server/http/operations/recurring"
 )
 
 // NewOrderAPIAPI creates a new OrderAPI instance
@@ -42,27 +44,197 @@ func NewOrderAPIAPI(spec *loads.Document) *OrderAPIAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
+		MultipartformConsumer: runtime.DiscardConsumer,
+
 		JSONProducer: runtime.JSONProducer(),
 
+		BinProducer: runtime.ByteStreamProducer(),
+
+		AdminApproveReturnHandler: admin.ApproveReturnHandlerFunc(func(params admin.ApproveReturnParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.ApproveReturn has not yet been implemented")
+		}),
+		AdminCreateAdminOrderCommentHandler: admin.CreateAdminOrderCommentHandlerFunc(func(params admin.CreateAdminOrderCommentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.CreateAdminOrderComment has not yet been implemented")
+		}),
+		AdminCreatePromoCodeHandler: admin.CreatePromoCodeHandlerFunc(func(params admin.CreatePromoCodeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.CreatePromoCode has not yet been implemented")
+		}),
+		AdminCreateShipmentHandler: admin.CreateShipmentHandlerFunc(func(params admin.CreateShipmentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.CreateShipment has not yet been implemented")
+		}),
+		AdminCreateTenantHandler: admin.CreateTenantHandlerFunc(func(params admin.CreateTenantParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.CreateTenant has not yet been implemented")
+		}),
+		AdminDeleteAdminOrderCommentHandler: admin.DeleteAdminOrderCommentHandlerFunc(func(params admin.DeleteAdminOrderCommentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.DeleteAdminOrderComment has not yet been implemented")
+		}),
+		AdminDeletePromoCodeHandler: admin.DeletePromoCodeHandlerFunc(func(params admin.DeletePromoCodeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.DeletePromoCode has not yet been implemented")
+		}),
+		AdminEraseUserOrdersHandler: admin.EraseUserOrdersHandlerFunc(func(params admin.EraseUserOrdersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.EraseUserOrders has not yet been implemented")
+		}),
+		AdminGetAdminOrderCommentsHandler: admin.GetAdminOrderCommentsHandlerFunc(func(params admin.GetAdminOrderCommentsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetAdminOrderComments has not yet been implemented")
+		}),
+		AdminGetPromoCodeHandler: admin.GetPromoCodeHandlerFunc(func(params admin.GetPromoCodeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetPromoCode has not yet been implemented")
+		}),
+		AdminGetPromoCodesHandler: admin.GetPromoCodesHandlerFunc(func(params admin.GetPromoCodesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetPromoCodes has not yet been implemented")
+		}),
+		AdminRejectReturnHandler: admin.RejectReturnHandlerFunc(func(params admin.RejectReturnParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.RejectReturn has not yet been implemented")
+		}),
+		AdminRemoveTenantMemberHandler: admin.RemoveTenantMemberHandlerFunc(func(params admin.RemoveTenantMemberParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.RemoveTenantMember has not yet been implemented")
+		}),
+		AdminSetTenantMemberHandler: admin.SetTenantMemberHandlerFunc(func(params admin.SetTenantMemberParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.SetTenantMember has not yet been implemented")
+		}),
+		AdminUpdateAdminOrderCommentHandler: admin.UpdateAdminOrderCommentHandlerFunc(func(params admin.UpdateAdminOrderCommentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.UpdateAdminOrderComment has not yet been implemented")
+		}),
+		AdminUpdatePromoCodeHandler: admin.UpdatePromoCodeHandlerFunc(func(params admin.UpdatePromoCodeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.UpdatePromoCode has not yet been implemented")
+		}),
+		AdminUpdateShipmentStatusHandler: admin.UpdateShipmentStatusHandlerFunc(func(params admin.UpdateShipmentStatusParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.UpdateShipmentStatus has not yet been implemented")
+		}),
+		OrderAddOrderLineHandler: order.AddOrderLineHandlerFunc(func(params order.AddOrderLineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.AddOrderLine has not yet been implemented")
+		}),
+		OrderApproveOrderHandler: order.ApproveOrderHandlerFunc(func(params order.ApproveOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.ApproveOrder has not yet been implemented")
+		}),
+		OrderCheckoutOrderHandler: order.CheckoutOrderHandlerFunc(func(params order.CheckoutOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.CheckoutOrder has not yet been implemented")
+		}),
 		OrderCreateOrderHandler: order.CreateOrderHandlerFunc(func(params order.CreateOrderParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation order.CreateOrder has not yet been implemented")
 		}),
+		OrderCreateOrderAttachmentHandler: order.CreateOrderAttachmentHandlerFunc(func(params order.CreateOrderAttachmentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.CreateOrderAttachment has not yet been implemented")
+		}),
+		OrderCreateOrderCommentHandler: order.CreateOrderCommentHandlerFunc(func(params order.CreateOrderCommentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.CreateOrderComment has not yet been implemented")
+		}),
+		OrderCreateReturnHandler: order.CreateReturnHandlerFunc(func(params order.CreateReturnParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.CreateReturn has not yet been implemented")
+		}),
 		OrderDeleteOrderHandler: order.DeleteOrderHandlerFunc(func(params order.DeleteOrderParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation order.DeleteOrder has not yet been implemented")
 		}),
+		OrderDeleteOrderAttachmentHandler: order.DeleteOrderAttachmentHandlerFunc(func(params order.DeleteOrderAttachmentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.DeleteOrderAttachment has not yet been implemented")
+		}),
+		OrderDeleteOrderCommentHandler: order.DeleteOrderCommentHandlerFunc(func(params order.DeleteOrderCommentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.DeleteOrderComment has not yet been implemented")
+		}),
 		OrderGetOrderHandler: order.GetOrderHandlerFunc(func(params order.GetOrderParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation order.GetOrder has not yet been implemented")
 		}),
+		OrderGetOrderApprovalHandler: order.GetOrderApprovalHandlerFunc(func(params order.GetOrderApprovalParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrderApproval has not yet been implemented")
+		}),
+		OrderGetOrderAttachmentHandler: order.GetOrderAttachmentHandlerFunc(func(params order.GetOrderAttachmentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrderAttachment has not yet been implemented")
+		}),
+		OrderGetOrderAttachmentsHandler: order.GetOrderAttachmentsHandlerFunc(func(params order.GetOrderAttachmentsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrderAttachments has not yet been implemented")
+		}),
+		OrderGetOrderByNumberHandler: order.GetOrderByNumberHandlerFunc(func(params order.GetOrderByNumberParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrderByNumber has not yet been implemented")
+		}),
+		OrderGetOrderCommentsHandler: order.GetOrderCommentsHandlerFunc(func(params order.GetOrderCommentsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrderComments has not yet been implemented")
+		}),
+		OrderGetOrderGrantsHandler: order.GetOrderGrantsHandlerFunc(func(params order.GetOrderGrantsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrderGrants has not yet been implemented")
+		}),
+		OrderGetOrderHistoryHandler: order.GetOrderHistoryHandlerFunc(func(params order.GetOrderHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrderHistory has not yet been implemented")
+		}),
+		OrderGetOrderLinesHandler: order.GetOrderLinesHandlerFunc(func(params order.GetOrderLinesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrderLines has not yet been implemented")
+		}),
 		OrderGetOrdersHandler: order.GetOrdersHandlerFunc(func(params order.GetOrdersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation order.GetOrders has not yet been implemented")
 		}),
 		OrderGetOrdersCountHandler: order.GetOrdersCountHandlerFunc(func(params order.GetOrdersCountParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation order.GetOrdersCount has not yet been implemented")
 		}),
+		OrderGetOrdersFacetsHandler: order.GetOrdersFacetsHandlerFunc(func(params order.GetOrdersFacetsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrdersFacets has not yet been implemented")
+		}),
+		OrderGetOrdersTagsHandler: order.GetOrdersTagsHandlerFunc(func(params order.GetOrdersTagsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetOrdersTags has not yet been implemented")
+		}),
+		OrderGetPaymentsHandler: order.GetPaymentsHandlerFunc(func(params order.GetPaymentsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetPayments has not yet been implemented")
+		}),
+		OrderGetReturnsHandler: order.GetReturnsHandlerFunc(func(params order.GetReturnsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetReturns has not yet been implemented")
+		}),
+		OrderGetShipmentsHandler: order.GetShipmentsHandlerFunc(func(params order.GetShipmentsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetShipments has not yet been implemented")
+		}),
+		OrderGetTrashHandler: order.GetTrashHandlerFunc(func(params order.GetTrashParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.GetTrash has not yet been implemented")
+		}),
+		OrderRejectOrderHandler: order.RejectOrderHandlerFunc(func(params order.RejectOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.RejectOrder has not yet been implemented")
+		}),
+		OrderRemoveOrderLineHandler: order.RemoveOrderLineHandlerFunc(func(params order.RemoveOrderLineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.RemoveOrderLine has not yet been implemented")
+		}),
+		OrderRestoreOrderHandler: order.RestoreOrderHandlerFunc(func(params order.RestoreOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.RestoreOrder has not yet been implemented")
+		}),
+		OrderShareOrderHandler: order.ShareOrderHandlerFunc(func(params order.ShareOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.ShareOrder has not yet been implemented")
+		}),
+		OrderUnshareOrderHandler: order.UnshareOrderHandlerFunc(func(params order.UnshareOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.UnshareOrder has not yet been implemented")
+		}),
 		OrderUpdateOrderHandler: order.UpdateOrderHandlerFunc(func(params order.UpdateOrderParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation order.UpdateOrder has not yet been implemented")
 		}),
+		OrderUpdateOrderCommentHandler: order.UpdateOrderCommentHandlerFunc(func(params order.UpdateOrderCommentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.UpdateOrderComment has not yet been implemented")
+		}),
+		OrderUpdateOrderLineHandler: order.UpdateOrderLineHandlerFunc(func(params order.UpdateOrderLineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation order.UpdateOrderLine has not yet been implemented")
+		}),
+		RecurringCreateRecurringOrderHandler: recurring.CreateRecurringOrderHandlerFunc(func(params recurring.CreateRecurringOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation recurring.CreateRecurringOrder has not yet been implemented")
+		}),
+		RecurringDeleteRecurringOrderHandler: recurring.DeleteRecurringOrderHandlerFunc(func(params recurring.DeleteRecurringOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation recurring.DeleteRecurringOrder has not yet been implemented")
+		}),
+		RecurringGetRecurringOrderHandler: recurring.GetRecurringOrderHandlerFunc(func(params recurring.GetRecurringOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation recurring.GetRecurringOrder has not yet been implemented")
+		}),
+		RecurringGetRecurringOrderRunsHandler: recurring.GetRecurringOrderRunsHandlerFunc(func(params recurring.GetRecurringOrderRunsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation recurring.GetRecurringOrderRuns has not yet been implemented")
+		}),
+		RecurringGetRecurringOrdersHandler: recurring.GetRecurringOrdersHandlerFunc(func(params recurring.GetRecurringOrdersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation recurring.GetRecurringOrders has not yet been implemented")
+		}),
+		RecurringPauseRecurringOrderHandler: recurring.PauseRecurringOrderHandlerFunc(func(params recurring.PauseRecurringOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation recurring.PauseRecurringOrder has not yet been implemented")
+		}),
+		RecurringResumeRecurringOrderHandler: recurring.ResumeRecurringOrderHandlerFunc(func(params recurring.ResumeRecurringOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation recurring.ResumeRecurringOrder has not yet been implemented")
+		}),
+		RecurringUpdateRecurringOrderHandler: recurring.UpdateRecurringOrderHandlerFunc(func(params recurring.UpdateRecurringOrderParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation recurring.UpdateRecurringOrder has not yet been implemented")
+		}),
 
+		// Applies when the "Authorization" header is set
+		AdminJWTAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (AdminJWT) Authorization from header param [Authorization] has not yet been implemented")
+		},
 		// Applies when the "Authorization" header is set
 		JWTAuth: func(token string) (interface{}, error) {
 			return nil, errors.NotImplemented("api key auth (JWT) Authorization from header param [Authorization] has not yet been implemented")
@@ -101,10 +273,22 @@ type OrderAPIAPI struct {
 	//   - application/json
 	JSONConsumer runtime.Consumer
 
+	// MultipartformConsumer registers a consumer for the following mime types:
+	//   - multipart/form-data
+	MultipartformConsumer runtime.Consumer
+
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	BinProducer runtime.Producer
+
+	// AdminJWTAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key Authorization provided in the header
+	AdminJWTAuth func(string) (interface{}, error)
+
 	// JWTAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key Authorization provided in the header
 	JWTAuth func(string) (interface{}, error)
@@ -112,18 +296,126 @@ type OrderAPIAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// AdminApproveReturnHandler sets the operation handler for the approve return operation
+	AdminApproveReturnHandler admin.ApproveReturnHandler
+	// AdminCreateAdminOrderCommentHandler sets the operation handler for the create admin order comment operation
+	AdminCreateAdminOrderCommentHandler admin.CreateAdminOrderCommentHandler
+	// AdminCreatePromoCodeHandler sets the operation handler for the create promo code operation
+	AdminCreatePromoCodeHandler admin.CreatePromoCodeHandler
+	// AdminCreateShipmentHandler sets the operation handler for the create shipment operation
+	AdminCreateShipmentHandler admin.CreateShipmentHandler
+	// AdminCreateTenantHandler sets the operation handler for the create tenant operation
+	AdminCreateTenantHandler admin.CreateTenantHandler
+	// AdminDeleteAdminOrderCommentHandler sets the operation handler for the delete admin order comment operation
+	AdminDeleteAdminOrderCommentHandler admin.DeleteAdminOrderCommentHandler
+	// AdminDeletePromoCodeHandler sets the operation handler for the delete promo code operation
+	AdminDeletePromoCodeHandler admin.DeletePromoCodeHandler
+	// AdminEraseUserOrdersHandler sets the operation handler for the erase user orders operation
+	AdminEraseUserOrdersHandler admin.EraseUserOrdersHandler
+	// AdminGetAdminOrderCommentsHandler sets the operation handler for the get admin order comments operation
+	AdminGetAdminOrderCommentsHandler admin.GetAdminOrderCommentsHandler
+	// AdminGetPromoCodeHandler sets the operation handler for the get promo code operation
+	AdminGetPromoCodeHandler admin.GetPromoCodeHandler
+	// AdminGetPromoCodesHandler sets the operation handler for the get promo codes operation
+	AdminGetPromoCodesHandler admin.GetPromoCodesHandler
+	// AdminRejectReturnHandler sets the operation handler for the reject return operation
+	AdminRejectReturnHandler admin.RejectReturnHandler
+	// AdminRemoveTenantMemberHandler sets the operation handler for the remove tenant member operation
+	AdminRemoveTenantMemberHandler admin.RemoveTenantMemberHandler
+	// AdminSetTenantMemberHandler sets the operation handler for the set tenant member operation
+	AdminSetTenantMemberHandler admin.SetTenantMemberHandler
+	// AdminUpdateAdminOrderCommentHandler sets the operation handler for the update admin order comment operation
+	AdminUpdateAdminOrderCommentHandler admin.UpdateAdminOrderCommentHandler
+	// AdminUpdatePromoCodeHandler sets the operation handler for the update promo code operation
+	AdminUpdatePromoCodeHandler admin.UpdatePromoCodeHandler
+	// AdminUpdateShipmentStatusHandler sets the operation handler for the update shipment status operation
+	AdminUpdateShipmentStatusHandler admin.UpdateShipmentStatusHandler
+	// OrderAddOrderLineHandler sets the operation handler for the add order line operation
+	OrderAddOrderLineHandler order.AddOrderLineHandler
+	// OrderApproveOrderHandler sets the operation handler for the approve order operation
+	OrderApproveOrderHandler order.ApproveOrderHandler
+	// OrderCheckoutOrderHandler sets the operation handler for the checkout order operation
+	OrderCheckoutOrderHandler order.CheckoutOrderHandler
 	// OrderCreateOrderHandler sets the operation handler for the create order operation
 	OrderCreateOrderHandler order.CreateOrderHandler
+	// OrderCreateOrderAttachmentHandler sets the operation handler for the create order attachment operation
+	OrderCreateOrderAttachmentHandler order.CreateOrderAttachmentHandler
+	// OrderCreateOrderCommentHandler sets the operation handler for the create order comment operation
+	OrderCreateOrderCommentHandler order.CreateOrderCommentHandler
+	// OrderCreateReturnHandler sets the operation handler for the create return operation
+	OrderCreateReturnHandler order.CreateReturnHandler
 	// OrderDeleteOrderHandler sets the operation handler for the delete order operation
 	OrderDeleteOrderHandler order.DeleteOrderHandler
+	// OrderDeleteOrderAttachmentHandler sets the operation handler for the delete order attachment operation
+	OrderDeleteOrderAttachmentHandler order.DeleteOrderAttachmentHandler
+	// OrderDeleteOrderCommentHandler sets the operation handler for the delete order comment operation
+	OrderDeleteOrderCommentHandler order.DeleteOrderCommentHandler
 	// OrderGetOrderHandler sets the operation handler for the get order operation
 	OrderGetOrderHandler order.GetOrderHandler
+	// OrderGetOrderApprovalHandler sets the operation handler for the get order approval operation
+	OrderGetOrderApprovalHandler order.GetOrderApprovalHandler
+	// OrderGetOrderAttachmentHandler sets the operation handler for the get order attachment operation
+	OrderGetOrderAttachmentHandler order.GetOrderAttachmentHandler
+	// OrderGetOrderAttachmentsHandler sets the operation handler for the get order attachments operation
+	OrderGetOrderAttachmentsHandler order.GetOrderAttachmentsHandler
+	// OrderGetOrderByNumberHandler sets the operation handler for the get order by number operation
+	OrderGetOrderByNumberHandler order.GetOrderByNumberHandler
+	// OrderGetOrderCommentsHandler sets the operation handler for the get order comments operation
+	OrderGetOrderCommentsHandler order.GetOrderCommentsHandler
+	// OrderGetOrderGrantsHandler sets the operation handler for the get order grants operation
+	OrderGetOrderGrantsHandler order.GetOrderGrantsHandler
+	// OrderGetOrderHistoryHandler sets the operation handler for the get order history operation
+	OrderGetOrderHistoryHandler order.GetOrderHistoryHandler
+	// OrderGetOrderLinesHandler sets the operation handler for the get order lines operation
+	OrderGetOrderLinesHandler order.GetOrderLinesHandler
 	// OrderGetOrdersHandler sets the operation handler for the get orders operation
 	OrderGetOrdersHandler order.GetOrdersHandler
 	// OrderGetOrdersCountHandler sets the operation handler for the get orders count operation
 	OrderGetOrdersCountHandler order.GetOrdersCountHandler
+	// OrderGetOrdersFacetsHandler sets the operation handler for the get orders facets operation
+	OrderGetOrdersFacetsHandler order.GetOrdersFacetsHandler
+	// OrderGetOrdersTagsHandler sets the operation handler for the get orders tags operation
+	OrderGetOrdersTagsHandler order.GetOrdersTagsHandler
+	// OrderGetPaymentsHandler sets the operation handler for the get payments operation
+	OrderGetPaymentsHandler order.GetPaymentsHandler
+	// OrderGetReturnsHandler sets the operation handler for the get returns operation
+	OrderGetReturnsHandler order.GetReturnsHandler
+	// OrderGetShipmentsHandler sets the operation handler for the get shipments operation
+	OrderGetShipmentsHandler order.GetShipmentsHandler
+	// OrderGetTrashHandler sets the operation handler for the get trash operation
+	OrderGetTrashHandler order.GetTrashHandler
+	// OrderRejectOrderHandler sets the operation handler for the reject order operation
+	OrderRejectOrderHandler order.RejectOrderHandler
+	// OrderRemoveOrderLineHandler sets the operation handler for the remove order line operation
+	OrderRemoveOrderLineHandler order.RemoveOrderLineHandler
+	// OrderRestoreOrderHandler sets the operation handler for the restore order operation
+	OrderRestoreOrderHandler order.RestoreOrderHandler
+	// OrderShareOrderHandler sets the operation handler for the share order operation
+	OrderShareOrderHandler order.ShareOrderHandler
+	// OrderUnshareOrderHandler sets the operation handler for the unshare order operation
+	OrderUnshareOrderHandler order.UnshareOrderHandler
 	// OrderUpdateOrderHandler sets the operation handler for the update order operation
 	OrderUpdateOrderHandler order.UpdateOrderHandler
+	// OrderUpdateOrderCommentHandler sets the operation handler for the update order comment operation
+	OrderUpdateOrderCommentHandler order.UpdateOrderCommentHandler
+	// OrderUpdateOrderLineHandler sets the operation handler for the update order line operation
+	OrderUpdateOrderLineHandler order.UpdateOrderLineHandler
+	// RecurringCreateRecurringOrderHandler sets the operation handler for the create recurring order operation
+	RecurringCreateRecurringOrderHandler recurring.CreateRecurringOrderHandler
+	// RecurringDeleteRecurringOrderHandler sets the operation handler for the delete recurring order operation
+	RecurringDeleteRecurringOrderHandler recurring.DeleteRecurringOrderHandler
+	// RecurringGetRecurringOrderHandler sets the operation handler for the get recurring order operation
+	RecurringGetRecurringOrderHandler recurring.GetRecurringOrderHandler
+	// RecurringGetRecurringOrderRunsHandler sets the operation handler for the get recurring order runs operation
+	RecurringGetRecurringOrderRunsHandler recurring.GetRecurringOrderRunsHandler
+	// RecurringGetRecurringOrdersHandler sets the operation handler for the get recurring orders operation
+	RecurringGetRecurringOrdersHandler recurring.GetRecurringOrdersHandler
+	// RecurringPauseRecurringOrderHandler sets the operation handler for the pause recurring order operation
+	RecurringPauseRecurringOrderHandler recurring.PauseRecurringOrderHandler
+	// RecurringResumeRecurringOrderHandler sets the operation handler for the resume recurring order operation
+	RecurringResumeRecurringOrderHandler recurring.ResumeRecurringOrderHandler
+	// RecurringUpdateRecurringOrderHandler sets the operation handler for the update recurring order operation
+	RecurringUpdateRecurringOrderHandler recurring.UpdateRecurringOrderHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -197,32 +489,206 @@ func (o *OrderAPIAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.MultipartformConsumer == nil {
+		unregistered = append(unregistered, "MultipartformConsumer")
+	}
+
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
+
+	if o.AdminJWTAuth == nil {
+		unregistered = append(unregistered, "AuthorizationAuth")
+	}
+
 	if o.JWTAuth == nil {
 		unregistered = append(unregistered, "AuthorizationAuth")
 	}
 
+	if o.AdminApproveReturnHandler == nil {
+		unregistered = append(unregistered, "admin.ApproveReturnHandler")
+	}
+	if o.AdminCreateAdminOrderCommentHandler == nil {
+		unregistered = append(unregistered, "admin.CreateAdminOrderCommentHandler")
+	}
+	if o.AdminCreatePromoCodeHandler == nil {
+		unregistered = append(unregistered, "admin.CreatePromoCodeHandler")
+	}
+	if o.AdminCreateShipmentHandler == nil {
+		unregistered = append(unregistered, "admin.CreateShipmentHandler")
+	}
+	if o.AdminCreateTenantHandler == nil {
+		unregistered = append(unregistered, "admin.CreateTenantHandler")
+	}
+	if o.AdminDeleteAdminOrderCommentHandler == nil {
+		unregistered = append(unregistered, "admin.DeleteAdminOrderCommentHandler")
+	}
+	if o.AdminDeletePromoCodeHandler == nil {
+		unregistered = append(unregistered, "admin.DeletePromoCodeHandler")
+	}
+	if o.AdminEraseUserOrdersHandler == nil {
+		unregistered = append(unregistered, "admin.EraseUserOrdersHandler")
+	}
+	if o.AdminGetAdminOrderCommentsHandler == nil {
+		unregistered = append(unregistered, "admin.GetAdminOrderCommentsHandler")
+	}
+	if o.AdminGetPromoCodeHandler == nil {
+		unregistered = append(unregistered, "admin.GetPromoCodeHandler")
+	}
+	if o.AdminGetPromoCodesHandler == nil {
+		unregistered = append(unregistered, "admin.GetPromoCodesHandler")
+	}
+	if o.AdminRejectReturnHandler == nil {
+		unregistered = append(unregistered, "admin.RejectReturnHandler")
+	}
+	if o.AdminRemoveTenantMemberHandler == nil {
+		unregistered = append(unregistered, "admin.RemoveTenantMemberHandler")
+	}
+	if o.AdminSetTenantMemberHandler == nil {
+		unregistered = append(unregistered, "admin.SetTenantMemberHandler")
+	}
+	if o.AdminUpdateAdminOrderCommentHandler == nil {
+		unregistered = append(unregistered, "admin.UpdateAdminOrderCommentHandler")
+	}
+	if o.AdminUpdatePromoCodeHandler == nil {
+		unregistered = append(unregistered, "admin.UpdatePromoCodeHandler")
+	}
+	if o.AdminUpdateShipmentStatusHandler == nil {
+		unregistered = append(unregistered, "admin.UpdateShipmentStatusHandler")
+	}
+	if o.OrderAddOrderLineHandler == nil {
+		unregistered = append(unregistered, "order.AddOrderLineHandler")
+	}
+	if o.OrderApproveOrderHandler == nil {
+		unregistered = append(unregistered, "order.ApproveOrderHandler")
+	}
+	if o.OrderCheckoutOrderHandler == nil {
+		unregistered = append(unregistered, "order.CheckoutOrderHandler")
+	}
 	if o.OrderCreateOrderHandler == nil {
 		unregistered = append(unregistered, "order.CreateOrderHandler")
 	}
+	if o.OrderCreateOrderAttachmentHandler == nil {
+		unregistered = append(unregistered, "order.CreateOrderAttachmentHandler")
+	}
+	if o.OrderCreateOrderCommentHandler == nil {
+		unregistered = append(unregistered, "order.CreateOrderCommentHandler")
+	}
+	if o.OrderCreateReturnHandler == nil {
+		unregistered = append(unregistered, "order.CreateReturnHandler")
+	}
 	if o.OrderDeleteOrderHandler == nil {
 		unregistered = append(unregistered, "order.DeleteOrderHandler")
 	}
+	if o.OrderDeleteOrderAttachmentHandler == nil {
+		unregistered = append(unregistered, "order.DeleteOrderAttachmentHandler")
+	}
+	if o.OrderDeleteOrderCommentHandler == nil {
+		unregistered = append(unregistered, "order.DeleteOrderCommentHandler")
+	}
 	if o.OrderGetOrderHandler == nil {
 		unregistered = append(unregistered, "order.GetOrderHandler")
 	}
+	if o.OrderGetOrderApprovalHandler == nil {
+		unregistered = append(unregistered, "order.GetOrderApprovalHandler")
+	}
+	if o.OrderGetOrderAttachmentHandler == nil {
+		unregistered = append(unregistered, "order.GetOrderAttachmentHandler")
+	}
+	if o.OrderGetOrderAttachmentsHandler == nil {
+		unregistered = append(unregistered, "order.GetOrderAttachmentsHandler")
+	}
+	if o.OrderGetOrderByNumberHandler == nil {
+		unregistered = append(unregistered, "order.GetOrderByNumberHandler")
+	}
+	if o.OrderGetOrderCommentsHandler == nil {
+		unregistered = append(unregistered, "order.GetOrderCommentsHandler")
+	}
+	if o.OrderGetOrderGrantsHandler == nil {
+		unregistered = append(unregistered, "order.GetOrderGrantsHandler")
+	}
+	if o.OrderGetOrderHistoryHandler == nil {
+		unregistered = append(unregistered, "order.GetOrderHistoryHandler")
+	}
+	if o.OrderGetOrderLinesHandler == nil {
+		unregistered = append(unregistered, "order.GetOrderLinesHandler")
+	}
 	if o.OrderGetOrdersHandler == nil {
 		unregistered = append(unregistered, "order.GetOrdersHandler")
 	}
 	if o.OrderGetOrdersCountHandler == nil {
 		unregistered = append(unregistered, "order.GetOrdersCountHandler")
 	}
+	if o.OrderGetOrdersFacetsHandler == nil {
+		unregistered = append(unregistered, "order.GetOrdersFacetsHandler")
+	}
+	if o.OrderGetOrdersTagsHandler == nil {
+		unregistered = append(unregistered, "order.GetOrdersTagsHandler")
+	}
+	if o.OrderGetPaymentsHandler == nil {
+		unregistered = append(unregistered, "order.GetPaymentsHandler")
+	}
+	if o.OrderGetReturnsHandler == nil {
+		unregistered = append(unregistered, "order.GetReturnsHandler")
+	}
+	if o.OrderGetShipmentsHandler == nil {
+		unregistered = append(unregistered, "order.GetShipmentsHandler")
+	}
+	if o.OrderGetTrashHandler == nil {
+		unregistered = append(unregistered, "order.GetTrashHandler")
+	}
+	if o.OrderRejectOrderHandler == nil {
+		unregistered = append(unregistered, "order.RejectOrderHandler")
+	}
+	if o.OrderRemoveOrderLineHandler == nil {
+		unregistered = append(unregistered, "order.RemoveOrderLineHandler")
+	}
+	if o.OrderRestoreOrderHandler == nil {
+		unregistered = append(unregistered, "order.RestoreOrderHandler")
+	}
+	if o.OrderShareOrderHandler == nil {
+		unregistered = append(unregistered, "order.ShareOrderHandler")
+	}
+	if o.OrderUnshareOrderHandler == nil {
+		unregistered = append(unregistered, "order.UnshareOrderHandler")
+	}
 	if o.OrderUpdateOrderHandler == nil {
 		unregistered = append(unregistered, "order.UpdateOrderHandler")
 	}
+	if o.OrderUpdateOrderCommentHandler == nil {
+		unregistered = append(unregistered, "order.UpdateOrderCommentHandler")
+	}
+	if o.OrderUpdateOrderLineHandler == nil {
+		unregistered = append(unregistered, "order.UpdateOrderLineHandler")
+	}
+	if o.RecurringCreateRecurringOrderHandler == nil {
+		unregistered = append(unregistered, "recurring.CreateRecurringOrderHandler")
+	}
+	if o.RecurringDeleteRecurringOrderHandler == nil {
+		unregistered = append(unregistered, "recurring.DeleteRecurringOrderHandler")
+	}
+	if o.RecurringGetRecurringOrderHandler == nil {
+		unregistered = append(unregistered, "recurring.GetRecurringOrderHandler")
+	}
+	if o.RecurringGetRecurringOrderRunsHandler == nil {
+		unregistered = append(unregistered, "recurring.GetRecurringOrderRunsHandler")
+	}
+	if o.RecurringGetRecurringOrdersHandler == nil {
+		unregistered = append(unregistered, "recurring.GetRecurringOrdersHandler")
+	}
+	if o.RecurringPauseRecurringOrderHandler == nil {
+		unregistered = append(unregistered, "recurring.PauseRecurringOrderHandler")
+	}
+	if o.RecurringResumeRecurringOrderHandler == nil {
+		unregistered = append(unregistered, "recurring.ResumeRecurringOrderHandler")
+	}
+	if o.RecurringUpdateRecurringOrderHandler == nil {
+		unregistered = append(unregistered, "recurring.UpdateRecurringOrderHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -241,6 +707,10 @@ func (o *OrderAPIAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme)
 	result := make(map[string]runtime.Authenticator)
 	for name := range schemes {
 		switch name {
+		case "AdminJWT":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.AdminJWTAuth)
+
 		case "JWT":
 			scheme := schemes[name]
 			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.JWTAuth)
@@ -263,6 +733,8 @@ func (o *OrderAPIAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consu
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "multipart/form-data":
+			result["multipart/form-data"] = o.MultipartformConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -280,6 +752,8 @@ func (o *OrderAPIAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produ
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -320,14 +794,114 @@ func (o *OrderAPIAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/orders/{id}/returns/{returnId}/approve"] = admin.NewApproveReturn(o.context, o.AdminApproveReturnHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/orders/{id}/comments"] = admin.NewCreateAdminOrderComment(o.context, o.AdminCreateAdminOrderCommentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/promo-codes"] = admin.NewCreatePromoCode(o.context, o.AdminCreatePromoCodeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/orders/{id}/shipments"] = admin.NewCreateShipment(o.context, o.AdminCreateShipmentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/tenants"] = admin.NewCreateTenant(o.context, o.AdminCreateTenantHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/orders/{id}/comments/{commentId}"] = admin.NewDeleteAdminOrderComment(o.context, o.AdminDeleteAdminOrderCommentHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/promo-codes/{id}"] = admin.NewDeletePromoCode(o.context, o.AdminDeletePromoCodeHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/users/{userId}/orders"] = admin.NewEraseUserOrders(o.context, o.AdminEraseUserOrdersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/orders/{id}/comments"] = admin.NewGetAdminOrderComments(o.context, o.AdminGetAdminOrderCommentsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/promo-codes/{id}"] = admin.NewGetPromoCode(o.context, o.AdminGetPromoCodeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/promo-codes"] = admin.NewGetPromoCodes(o.context, o.AdminGetPromoCodesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/orders/{id}/returns/{returnId}/reject"] = admin.NewRejectReturn(o.context, o.AdminRejectReturnHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/tenants/{id}/members/{userId}"] = admin.NewRemoveTenantMember(o.context, o.AdminRemoveTenantMemberHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/tenants/{id}/members/{userId}"] = admin.NewSetTenantMember(o.context, o.AdminSetTenantMemberHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/orders/{id}/comments/{commentId}"] = admin.NewUpdateAdminOrderComment(o.context, o.AdminUpdateAdminOrderCommentHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/promo-codes/{id}"] = admin.NewUpdatePromoCode(o.context, o.AdminUpdatePromoCodeHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/orders/{id}/shipments/{shipmentId}/status"] = admin.NewUpdateShipmentStatus(o.context, o.AdminUpdateShipmentStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/lines"] = order.NewAddOrderLine(o.context, o.OrderAddOrderLineHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/approve"] = order.NewApproveOrder(o.context, o.OrderApproveOrderHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/checkout"] = order.NewCheckoutOrder(o.context, o.OrderCheckoutOrderHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"][""] = order.NewCreateOrder(o.context, o.OrderCreateOrderHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/attachments"] = order.NewCreateOrderAttachment(o.context, o.OrderCreateOrderAttachmentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/comments"] = order.NewCreateOrderComment(o.context, o.OrderCreateOrderCommentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/returns"] = order.NewCreateReturn(o.context, o.OrderCreateReturnHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/orders/{id}"] = order.NewDeleteOrder(o.context, o.OrderDeleteOrderHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/orders/{id}/attachments/{attachmentId}"] = order.NewDeleteOrderAttachment(o.context, o.OrderDeleteOrderAttachmentHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/orders/{id}/comments/{commentId}"] = order.NewDeleteOrderComment(o.context, o.OrderDeleteOrderCommentHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -335,15 +909,131 @@ func (o *OrderAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/orders/{id}/approval"] = order.NewGetOrderApproval(o.context, o.OrderGetOrderApprovalHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/attachments/{attachmentId}"] = order.NewGetOrderAttachment(o.context, o.OrderGetOrderAttachmentHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/attachments"] = order.NewGetOrderAttachments(o.context, o.OrderGetOrderAttachmentsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/by-number/{number}"] = order.NewGetOrderByNumber(o.context, o.OrderGetOrderByNumberHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/comments"] = order.NewGetOrderComments(o.context, o.OrderGetOrderCommentsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/grants"] = order.NewGetOrderGrants(o.context, o.OrderGetOrderGrantsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/history"] = order.NewGetOrderHistory(o.context, o.OrderGetOrderHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/lines"] = order.NewGetOrderLines(o.context, o.OrderGetOrderLinesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"][""] = order.NewGetOrders(o.context, o.OrderGetOrdersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/orders/count"] = order.NewGetOrdersCount(o.context, o.OrderGetOrdersCountHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/facets"] = order.NewGetOrdersFacets(o.context, o.OrderGetOrdersFacetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/tags"] = order.NewGetOrdersTags(o.context, o.OrderGetOrdersTagsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/payments"] = order.NewGetPayments(o.context, o.OrderGetPaymentsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/returns"] = order.NewGetReturns(o.context, o.OrderGetReturnsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/{id}/shipments"] = order.NewGetShipments(o.context, o.OrderGetShipmentsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orders/trash"] = order.NewGetTrash(o.context, o.OrderGetTrashHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/reject"] = order.NewRejectOrder(o.context, o.OrderRejectOrderHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/orders/{id}/lines/{lineId}"] = order.NewRemoveOrderLine(o.context, o.OrderRemoveOrderLineHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orders/{id}/restore"] = order.NewRestoreOrder(o.context, o.OrderRestoreOrderHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/orders/{id}/grants/{userId}"] = order.NewShareOrder(o.context, o.OrderShareOrderHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/orders/{id}/grants/{userId}"] = order.NewUnshareOrder(o.context, o.OrderUnshareOrderHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/orders/{id}"] = order.NewUpdateOrder(o.context, o.OrderUpdateOrderHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/orders/{id}/comments/{commentId}"] = order.NewUpdateOrderComment(o.context, o.OrderUpdateOrderCommentHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/orders/{id}/lines/{lineId}"] = order.NewUpdateOrderLine(o.context, o.OrderUpdateOrderLineHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/recurring-orders"] = recurring.NewCreateRecurringOrder(o.context, o.RecurringCreateRecurringOrderHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/recurring-orders/{id}"] = recurring.NewDeleteRecurringOrder(o.context, o.RecurringDeleteRecurringOrderHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/recurring-orders/{id}"] = recurring.NewGetRecurringOrder(o.context, o.RecurringGetRecurringOrderHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/recurring-orders/{id}/runs"] = recurring.NewGetRecurringOrderRuns(o.context, o.RecurringGetRecurringOrderRunsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/recurring-orders"] = recurring.NewGetRecurringOrders(o.context, o.RecurringGetRecurringOrdersHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/recurring-orders/{id}/pause"] = recurring.NewPauseRecurringOrder(o.context, o.RecurringPauseRecurringOrderHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/recurring-orders/{id}/resume"] = recurring.NewResumeRecurringOrder(o.context, o.RecurringResumeRecurringOrderHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/recurring-orders/{id}"] = recurring.NewUpdateRecurringOrder(o.context, o.RecurringUpdateRecurringOrderHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,9 @@
+package approvals
+
+import "time"
+
+type Config struct {
+	Disabled bool `json:"disabled" yaml:"disabled" env:"DISABLED"`
+	// Interval between escalation runs
+	Interval time.Duration `json:"interval" yaml:"interval" env:"INTERVAL" default:"5m"`
+}
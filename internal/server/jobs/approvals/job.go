@@ -0,0 +1,36 @@
+package approvals
+
+import (
+	"context"
+	"time"
+
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"go.uber.org/zap"
+)
+
+// Job escalates the approvals of tenant orders nobody decided before their timeout
+type Job struct {
+	svc    orderModel.Service
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+func New(svc orderModel.Service, logger *zap.Logger, now func() time.Time) *Job {
+	return &Job{
+		svc:    svc,
+		logger: logger,
+		now:    now,
+	}
+}
+
+func (j *Job) Escalate(ctx context.Context) {
+	before := j.now()
+
+	n, err := j.svc.EscalateApprovals(ctx, before)
+	if err != nil {
+		j.logger.Error("escalate approvals failed", zap.Error(err))
+		return
+	}
+
+	j.logger.Info("approvals escalated", zap.Int("escalated", n), zap.Time("before", before))
+}
@@ -0,0 +1,39 @@
+package approvals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/jobs/approvals"
+	"go.uber.org/zap"
+)
+
+func TestJob(t *testing.T) {
+	t.Run("Escalate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().EscalateApprovals(context.TODO(), now()).Return(3, nil)
+
+		approvals.New(svc, zap.NewNop(), now).Escalate(context.TODO())
+	})
+
+	t.Run("Escalate failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().EscalateApprovals(context.TODO(), now()).Return(0, fmt.Errorf("some error"))
+
+		approvals.New(svc, zap.NewNop(), now).Escalate(context.TODO())
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
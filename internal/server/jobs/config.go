@@ -0,0 +1,19 @@
+package jobs
+
+import (
+	"github.com/krivenkov/order/internal/server/jobs/approvals"
+	"github.com/krivenkov/order/internal/server/jobs/drafts"
+	"github.com/krivenkov/order/internal/server/jobs/purge"
+	"github.com/krivenkov/order/internal/server/jobs/recurring"
+	"github.com/krivenkov/order/internal/server/jobs/refunds"
+	"github.com/krivenkov/order/internal/server/jobs/reservations"
+)
+
+type Config struct {
+	Purge        purge.Config        `json:"purge" yaml:"purge" envPrefix:"PURGE_"`
+	Reservations reservations.Config `json:"reservations" yaml:"reservations" envPrefix:"RESERVATIONS_"`
+	Drafts       drafts.Config       `json:"drafts" yaml:"drafts" envPrefix:"DRAFTS_"`
+	Recurring    recurring.Config    `json:"recurring" yaml:"recurring" envPrefix:"RECURRING_"`
+	Approvals    approvals.Config    `json:"approvals" yaml:"approvals" envPrefix:"APPROVALS_"`
+	Refunds      refunds.Config      `json:"refunds" yaml:"refunds" envPrefix:"REFUNDS_"`
+}
@@ -0,0 +1,11 @@
+package drafts
+
+import "time"
+
+type Config struct {
+	Disabled bool `json:"disabled" yaml:"disabled" env:"DISABLED"`
+	// TTL is how long a draft lives after its last change
+	TTL time.Duration `json:"ttl" yaml:"ttl" env:"TTL" default:"168h"`
+	// Interval between expiry runs
+	Interval time.Duration `json:"interval" yaml:"interval" env:"INTERVAL" default:"1h"`
+}
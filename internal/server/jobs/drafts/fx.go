@@ -0,0 +1,15 @@
+package drafts
+
+import (
+	"github.com/krivenkov/order/internal/server/jobs/ticker"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(New),
+	fx.Invoke(func(lc fx.Lifecycle, cfg Config, job *Job) {
+		if !cfg.Disabled {
+			ticker.Append(lc, cfg.Interval, job.Expire)
+		}
+	}),
+)
@@ -0,0 +1,38 @@
+package drafts
+
+import (
+	"context"
+	"time"
+
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"go.uber.org/zap"
+)
+
+// Job deletes the drafts left untouched for longer than their TTL
+type Job struct {
+	cfg    Config
+	svc    orderModel.Service
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+func New(cfg Config, svc orderModel.Service, logger *zap.Logger, now func() time.Time) *Job {
+	return &Job{
+		cfg:    cfg,
+		svc:    svc,
+		logger: logger,
+		now:    now,
+	}
+}
+
+func (j *Job) Expire(ctx context.Context) {
+	modifiedBefore := j.now().Add(-j.cfg.TTL)
+
+	n, err := j.svc.ExpireDrafts(ctx, modifiedBefore)
+	if err != nil {
+		j.logger.Error("expire drafts failed", zap.Int("expired", n), zap.Error(err))
+		return
+	}
+
+	j.logger.Info("drafts expired", zap.Int("expired", n), zap.Time("modifiedBefore", modifiedBefore))
+}
@@ -0,0 +1,44 @@
+package drafts_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/jobs/drafts"
+	"go.uber.org/zap"
+)
+
+func TestJob(t *testing.T) {
+	cfg := drafts.Config{
+		TTL: time.Hour,
+	}
+	modifiedBefore := now().Add(-time.Hour)
+
+	t.Run("Expire", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().ExpireDrafts(context.TODO(), modifiedBefore).Return(3, nil)
+
+		drafts.New(cfg, svc, zap.NewNop(), now).Expire(context.TODO())
+	})
+
+	t.Run("Expire failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().ExpireDrafts(context.TODO(), modifiedBefore).Return(0, fmt.Errorf("some error"))
+
+		drafts.New(cfg, svc, zap.NewNop(), now).Expire(context.TODO())
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,29 @@
+package jobs
+
+import (
+	"github.com/krivenkov/order/internal/server/jobs/approvals"
+	"github.com/krivenkov/order/internal/server/jobs/drafts"
+	"github.com/krivenkov/order/internal/server/jobs/purge"
+	"github.com/krivenkov/order/internal/server/jobs/recurring"
+	"github.com/krivenkov/order/internal/server/jobs/refunds"
+	"github.com/krivenkov/order/internal/server/jobs/reservations"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(
+		func(cfg Config) purge.Config { return cfg.Purge },
+		func(cfg Config) reservations.Config { return cfg.Reservations },
+		func(cfg Config) drafts.Config { return cfg.Drafts },
+		func(cfg Config) recurring.Config { return cfg.Recurring },
+		func(cfg Config) approvals.Config { return cfg.Approvals },
+		func(cfg Config) refunds.Config { return cfg.Refunds },
+	),
+
+	purge.FXModule,
+	reservations.FXModule,
+	drafts.FXModule,
+	recurring.FXModule,
+	approvals.FXModule,
+	refunds.FXModule,
+)
@@ -0,0 +1,11 @@
+package purge
+
+import "time"
+
+type Config struct {
+	Disabled bool `json:"disabled" yaml:"disabled" env:"DISABLED"`
+	// Retention is how long soft-deleted orders stay in the trash
+	Retention time.Duration `json:"retention" yaml:"retention" env:"RETENTION" default:"720h"`
+	// Interval between purge runs
+	Interval time.Duration `json:"interval" yaml:"interval" env:"INTERVAL" default:"1h"`
+}
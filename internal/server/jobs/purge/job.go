@@ -0,0 +1,38 @@
+package purge
+
+import (
+	"context"
+	"time"
+
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"go.uber.org/zap"
+)
+
+// Job hard-deletes orders that stayed in the trash longer than the retention period
+type Job struct {
+	cfg    Config
+	svc    orderModel.Service
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+func New(cfg Config, svc orderModel.Service, logger *zap.Logger, now func() time.Time) *Job {
+	return &Job{
+		cfg:    cfg,
+		svc:    svc,
+		logger: logger,
+		now:    now,
+	}
+}
+
+func (j *Job) Purge(ctx context.Context) {
+	deletedBefore := j.now().Add(-j.cfg.Retention)
+
+	n, err := j.svc.Purge(ctx, deletedBefore)
+	if err != nil {
+		j.logger.Error("purge trash failed", zap.Int("purged", n), zap.Error(err))
+		return
+	}
+
+	j.logger.Info("trash purged", zap.Int("purged", n), zap.Time("deletedBefore", deletedBefore))
+}
@@ -0,0 +1,43 @@
+package purge_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/jobs/purge"
+	"go.uber.org/zap"
+)
+
+func TestJob(t *testing.T) {
+	cfg := purge.Config{
+		Retention: 24 * time.Hour,
+	}
+
+	t.Run("Purge", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().Purge(context.TODO(), now().Add(-24*time.Hour)).Return(3, nil)
+
+		purge.New(cfg, svc, zap.NewNop(), now).Purge(context.TODO())
+	})
+
+	t.Run("Purge failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().Purge(context.TODO(), now().Add(-24*time.Hour)).Return(0, fmt.Errorf("some error"))
+
+		purge.New(cfg, svc, zap.NewNop(), now).Purge(context.TODO())
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,27 @@
+package recurring
+
+import (
+	"context"
+
+	"github.com/krivenkov/order/internal/server/jobs/ticker"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(fx.Annotate(New, fx.ParamTags(``, `name:"recurring_pg_elector"`))),
+	fx.Invoke(func(lc fx.Lifecycle, cfg Config, job *Job) {
+		if cfg.Disabled {
+			return
+		}
+
+		// hooks stop in reverse order, the leadership is given up once the ticker is stopped
+		lc.Append(fx.Hook{
+			OnStop: func(ctx context.Context) error {
+				job.Resign(ctx)
+
+				return nil
+			},
+		})
+		ticker.Append(lc, cfg.Interval, job.Schedule)
+	}),
+)
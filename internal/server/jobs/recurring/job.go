@@ -0,0 +1,54 @@
+package recurring
+
+import (
+	"context"
+
+	"github.com/krivenkov/order/internal/model/leader"
+	"github.com/krivenkov/order/internal/model/recurring"
+	"go.uber.org/zap"
+)
+
+// Job creates the orders of the due recurring templates, only the instance
+// holding the leadership runs them
+type Job struct {
+	svc     recurring.Service
+	elector leader.Elector
+	logger  *zap.Logger
+}
+
+func New(svc recurring.Service, elector leader.Elector, logger *zap.Logger) *Job {
+	return &Job{
+		svc:     svc,
+		elector: elector,
+		logger:  logger,
+	}
+}
+
+func (j *Job) Schedule(ctx context.Context) {
+	leading, err := j.elector.Lead(ctx)
+	if err != nil {
+		j.logger.Error("leader election failed", zap.Error(err))
+		return
+	}
+
+	if !leading {
+		return
+	}
+
+	n, err := j.svc.RunDue(ctx)
+	if err != nil {
+		j.logger.Error("recurring orders failed", zap.Int("runs", n), zap.Error(err))
+		return
+	}
+
+	if n > 0 {
+		j.logger.Info("recurring orders created", zap.Int("runs", n))
+	}
+}
+
+// Resign gives up the leadership, another instance takes the schedule over on its next check
+func (j *Job) Resign(ctx context.Context) {
+	if err := j.elector.Resign(ctx); err != nil {
+		j.logger.Error("resign leadership failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,71 @@
+package recurring_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	leaderMock "github.com/krivenkov/order/internal/model/leader/mock"
+	recurringMock "github.com/krivenkov/order/internal/model/recurring/mock"
+	"github.com/krivenkov/order/internal/server/jobs/recurring"
+	"go.uber.org/zap"
+)
+
+func TestJob(t *testing.T) {
+	t.Run("Leader runs due templates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			svc     = recurringMock.NewMockService(ctrl)
+			elector = leaderMock.NewMockElector(ctrl)
+		)
+
+		elector.EXPECT().Lead(context.TODO()).Return(true, nil)
+		svc.EXPECT().RunDue(context.TODO()).Return(2, nil)
+
+		recurring.New(svc, elector, zap.NewNop()).Schedule(context.TODO())
+	})
+
+	t.Run("Follower waits", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			svc     = recurringMock.NewMockService(ctrl)
+			elector = leaderMock.NewMockElector(ctrl)
+		)
+
+		elector.EXPECT().Lead(context.TODO()).Return(false, nil)
+
+		recurring.New(svc, elector, zap.NewNop()).Schedule(context.TODO())
+	})
+
+	t.Run("Election failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			svc     = recurringMock.NewMockService(ctrl)
+			elector = leaderMock.NewMockElector(ctrl)
+		)
+
+		elector.EXPECT().Lead(context.TODO()).Return(false, fmt.Errorf("some error"))
+
+		recurring.New(svc, elector, zap.NewNop()).Schedule(context.TODO())
+	})
+	t.Run("Resign failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			svc     = recurringMock.NewMockService(ctrl)
+			elector = leaderMock.NewMockElector(ctrl)
+		)
+
+		elector.EXPECT().Resign(context.TODO()).Return(fmt.Errorf("some error"))
+
+		recurring.New(svc, elector, zap.NewNop()).Resign(context.TODO())
+	})
+}
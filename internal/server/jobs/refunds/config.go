@@ -0,0 +1,11 @@
+package refunds
+
+import "time"
+
+type Config struct {
+	Disabled bool `json:"disabled" yaml:"disabled" env:"DISABLED"`
+	// Delay leaves the refunds just approved to the publish right after their commit
+	Delay time.Duration `json:"delay" yaml:"delay" env:"DELAY" default:"1m"`
+	// Interval between publish runs
+	Interval time.Duration `json:"interval" yaml:"interval" env:"INTERVAL" default:"1m"`
+}
@@ -0,0 +1,38 @@
+package refunds
+
+import (
+	"context"
+	"time"
+
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"go.uber.org/zap"
+)
+
+// Job publishes the refunds whose publish after the approval of their return failed
+type Job struct {
+	cfg    Config
+	svc    orderModel.Service
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+func New(cfg Config, svc orderModel.Service, logger *zap.Logger, now func() time.Time) *Job {
+	return &Job{
+		cfg:    cfg,
+		svc:    svc,
+		logger: logger,
+		now:    now,
+	}
+}
+
+func (j *Job) Publish(ctx context.Context) {
+	createdBefore := j.now().Add(-j.cfg.Delay)
+
+	n, err := j.svc.PublishRefunds(ctx, createdBefore)
+	if err != nil {
+		j.logger.Error("publish refunds failed", zap.Error(err))
+		return
+	}
+
+	j.logger.Info("refunds published", zap.Int("published", n), zap.Time("createdBefore", createdBefore))
+}
@@ -0,0 +1,43 @@
+package refunds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/jobs/refunds"
+	"go.uber.org/zap"
+)
+
+func TestJob(t *testing.T) {
+	cfg := refunds.Config{
+		Delay: time.Minute,
+	}
+
+	t.Run("Publish", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().PublishRefunds(context.TODO(), now().Add(-time.Minute)).Return(2, nil)
+
+		refunds.New(cfg, svc, zap.NewNop(), now).Publish(context.TODO())
+	})
+
+	t.Run("Publish failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().PublishRefunds(context.TODO(), now().Add(-time.Minute)).Return(0, fmt.Errorf("some error"))
+
+		refunds.New(cfg, svc, zap.NewNop(), now).Publish(context.TODO())
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,9 @@
+package reservations
+
+import "time"
+
+type Config struct {
+	Disabled bool `json:"disabled" yaml:"disabled" env:"DISABLED"`
+	// Interval between expiry runs
+	Interval time.Duration `json:"interval" yaml:"interval" env:"INTERVAL" default:"1m"`
+}
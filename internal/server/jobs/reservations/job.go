@@ -0,0 +1,36 @@
+package reservations
+
+import (
+	"context"
+	"time"
+
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"go.uber.org/zap"
+)
+
+// Job puts back to stock the reservations of unpaid orders once their TTL is over
+type Job struct {
+	svc    orderModel.Service
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+func New(svc orderModel.Service, logger *zap.Logger, now func() time.Time) *Job {
+	return &Job{
+		svc:    svc,
+		logger: logger,
+		now:    now,
+	}
+}
+
+func (j *Job) Expire(ctx context.Context) {
+	before := j.now()
+
+	n, err := j.svc.ExpireReservations(ctx, before)
+	if err != nil {
+		j.logger.Error("expire reservations failed", zap.Error(err))
+		return
+	}
+
+	j.logger.Info("reservations expired", zap.Int("expired", n), zap.Time("before", before))
+}
@@ -0,0 +1,40 @@
+package reservations_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/server/jobs/reservations"
+	"go.uber.org/zap"
+)
+
+func TestJob(t *testing.T) {
+
+	t.Run("Expire", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().ExpireReservations(context.TODO(), now()).Return(3, nil)
+
+		reservations.New(svc, zap.NewNop(), now).Expire(context.TODO())
+	})
+
+	t.Run("Expire failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := orderMock.NewMockService(ctrl)
+		svc.EXPECT().ExpireReservations(context.TODO(), now()).Return(0, fmt.Errorf("some error"))
+
+		reservations.New(svc, zap.NewNop(), now).Expire(context.TODO())
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
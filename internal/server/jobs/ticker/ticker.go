@@ -0,0 +1,60 @@
+package ticker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+)
+
+// Run calls the action right away and then on every interval until ctx is done
+func Run(ctx context.Context, interval time.Duration, action func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		action(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}
+}
+
+// Append runs the action on every interval from the start of the app, the stop of the app
+// cancels the context of the action and waits for the running one to return
+func Append(lc fx.Lifecycle, interval time.Duration, action func(ctx context.Context)) {
+	var (
+		cancel context.CancelFunc
+		done   = make(chan struct{})
+	)
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+
+			go func() {
+				defer close(done)
+				Run(ctx, interval, action)
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			cancel()
+
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package ticker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/krivenkov/order/internal/server/jobs/ticker"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+)
+
+func TestRun(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		calls       int
+	)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker.Run(ctx, time.Millisecond, func(context.Context) {
+			calls++
+			if calls == 3 {
+				cancel()
+			}
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "ticker did not stop")
+	}
+
+	require.Equal(t, 3, calls)
+}
+
+func TestAppend(t *testing.T) {
+	var (
+		lc      = fxtest.NewLifecycle(t)
+		started = make(chan struct{})
+		stopped bool
+	)
+
+	ticker.Append(lc, time.Hour, func(ctx context.Context) {
+		close(started)
+
+		// the stop waits for the running action
+		<-ctx.Done()
+		stopped = true
+	})
+
+	lc.RequireStart()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "action did not run")
+	}
+
+	lc.RequireStop()
+
+	require.True(t, stopped)
+}
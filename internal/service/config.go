@@ -0,0 +1,14 @@
+package service
+
+import (
+	"github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/order/internal/service/tax"
+	"go.uber.org/fx"
+)
+
+type Config struct {
+	fx.Out
+
+	Order order.Config `json:"order" yaml:"order" envPrefix:"ORDER_"`
+	Tax   tax.Config   `json:"tax" yaml:"tax" envPrefix:"TAX_"`
+}
@@ -2,11 +2,20 @@ package service
 
 import (
 	"github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/order/internal/service/promo"
+	"github.com/krivenkov/order/internal/service/recurring"
+	"github.com/krivenkov/order/internal/service/tax"
+	"github.com/krivenkov/order/internal/service/tenant"
 	"go.uber.org/fx"
 )
 
 var FXModule = fx.Options(
 	fx.Provide(
 		order.New,
+		promo.New,
+		recurring.New,
+		tenant.New,
+		// tax.New can be replaced with fx.Decorate to use an external provider
+		tax.New,
 	),
 )
@@ -0,0 +1,245 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/approval"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/option"
+	"go.uber.org/zap"
+)
+
+// needsApproval tells whether the tenant order is above the threshold of its currency.
+// Once any threshold is set a currency without one always needs an approval, so no order
+// skips it by being placed in another currency.
+func (s *service) needsApproval(item *orderModel.Order) bool {
+	if item.TenantID == "" || len(s.approval.Thresholds) == 0 {
+		return false
+	}
+
+	threshold, ok := s.approval.Thresholds[item.Totals.Currency]
+
+	return !ok || item.Totals.Total > threshold
+}
+
+// requestApproval makes the order wait for the approvers of its tenant, the request is
+// returned to be stored along with the order
+func (s *service) requestApproval(ctx context.Context, userID string, item *orderModel.Order) (*approval.Approval, error) {
+	approvers, err := s.approvers(ctx, item.TenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(approvers) == 0 {
+		return nil, fmt.Errorf("%w: the organisation has no approver for the order", model.ErrConflict)
+	}
+
+	item.State = orderModel.StatePendingApproval
+
+	return approval.New(item.ID, item.TenantID, userID, approvers, s.approval.Timeout, s.now, s.newID), nil
+}
+
+// approvers returns the approvers of the tenant but the requester, nobody approves their own order
+func (s *service) approvers(ctx context.Context, tenantID, requestedBy string) ([]string, error) {
+	members, err := s.qrTenant.GetMembers(ctx, tenantID, tenant.RoleApprover)
+	if err != nil {
+		return nil, fmt.Errorf("get approvers: %w", err)
+	}
+
+	res := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.UserID != requestedBy {
+			res = append(res, m.UserID)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *service) GetApproval(ctx context.Context, userID, id string) (*approval.Approval, error) {
+	if _, err := s.getOwned(ctx, userID, id, tenant.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	item, err := s.qrApproval.GetItem(ctx, &approval.Filter{OrderID: option.New(id)})
+	if err != nil {
+		return nil, fmt.Errorf("get approval: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *service) Approve(ctx context.Context, userID, id, comment string) (*orderModel.Order, error) {
+	return s.decide(ctx, userID, id, approval.StatusApproved, comment)
+}
+
+func (s *service) Reject(ctx context.Context, userID, id, comment string) (*orderModel.Order, error) {
+	return s.decide(ctx, userID, id, approval.StatusRejected, comment)
+}
+
+// decide places or rejects the order waiting for the approval of the user
+func (s *service) decide(ctx context.Context, userID, id string, status approval.Status, comment string) (*orderModel.Order, error) {
+	var (
+		item  *orderModel.Order
+		req   *approval.Approval
+		event = approval.EventRejected
+	)
+
+	// the whole row is written back, the lock keeps a concurrent change of the order
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		var err error
+		if item, err = s.getLocked(ctx, userID, id, tenant.RoleApprover); err != nil {
+			return err
+		}
+
+		if item.State != orderModel.StatePendingApproval {
+			return fmt.Errorf("%w: order is %s", model.ErrConflict, item.State)
+		}
+
+		if req, err = s.qrApproval.GetItem(ctx, &approval.Filter{
+			OrderID: option.New(id),
+			Status:  option.New(approval.StatusPending),
+		}); err != nil {
+			return fmt.Errorf("get approval: %w", err)
+		}
+
+		if !req.IsApprover(userID) {
+			return model.ErrPermissionDenied
+		}
+
+		prev := *req
+		if err = req.Decide(status, userID, comment, s.now()); err != nil {
+			return err
+		}
+
+		before := *item
+
+		var (
+			lines  []*line.Line
+			action = history.ActionReject
+		)
+
+		if status == approval.StatusApproved {
+			if lines, err = s.qrLine.GetList(ctx, &line.Filter{OrderID: option.New(id)}); err != nil {
+				return fmt.Errorf("get lines: %w", err)
+			}
+
+			item.State = orderModel.StatePlaced
+			action, event = history.ActionApprove, approval.EventApproved
+		} else {
+			item.State = orderModel.StateRejected
+		}
+
+		if err = s.cmdApproval.Decide(ctx, req); err != nil {
+			return fmt.Errorf("approval decide: %w", err)
+		}
+
+		// the promo codes and the stock are taken on behalf of the requester
+		if item.State == orderModel.StatePlaced {
+			if err = s.place(ctx, item.UserID, item, lines); err != nil {
+				return err
+			}
+		}
+
+		return s.save(ctx, userID, action, &before, item, approval.Diff(&prev, req)...)
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	s.notifyApproval(ctx, userID, event, req)
+
+	return item, nil
+}
+
+func (s *service) EscalateApprovals(ctx context.Context, before time.Time) (int, error) {
+	due, err := s.qrApproval.GetList(ctx, &approval.Filter{
+		Status:    option.New(approval.StatusPending),
+		DueBefore: option.New(before),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get due approvals: %w", err)
+	}
+
+	ctx = history.CtxWithOrigin(ctx, history.Origin{Source: history.SourceScheduler})
+
+	var n int
+
+	for _, req := range due {
+		escalated, err := s.escalate(ctx, req, before)
+		if err != nil {
+			mlog.FromContext(ctx).Error("escalate approval failed", zap.String("approvalID", req.ID), zap.Error(err))
+			continue
+		}
+
+		if escalated {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+// escalate hands the request to the current approvers of the tenant with a raised level,
+// it is skipped while its order is deleted or when another instance escalated it first
+func (s *service) escalate(ctx context.Context, req *approval.Approval, before time.Time) (bool, error) {
+	item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusCreated)),
+		IDs:    option.New([]string{req.OrderID}),
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("get item: %w", err)
+	}
+
+	approvers, err := s.approvers(ctx, req.TenantID, req.RequestedBy)
+	if err != nil {
+		return false, err
+	}
+
+	prev := *req
+	req.Escalate(approvers, s.approval.Timeout, s.now())
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		if err = s.cmdApproval.Escalate(ctx, req, before); err != nil {
+			return fmt.Errorf("approval escalate: %w", err)
+		}
+
+		return s.record(ctx, req.RequestedBy, history.ActionEscalate, item, item, approval.Diff(&prev, req)...)
+	}); errTx != nil {
+		if errors.Is(errTx, model.ErrConflict) {
+			return false, nil
+		}
+
+		return false, errTx
+	}
+
+	s.notifyApproval(ctx, req.RequestedBy, approval.EventEscalated, req)
+
+	return true, nil
+}
+
+func (s *service) notifyApproval(ctx context.Context, actorID string, event approval.Event, req *approval.Approval) {
+	if err := s.approvalNotified.Publish(ctx, bus.Message[approval.Notification]{
+		Key: req.OrderID,
+		Value: bus.MessageValue[approval.Notification]{
+			CommandID: s.newID().String(),
+			UserID:    actorID,
+			CreatedAt: s.now(),
+			Payload:   &approval.Notification{Event: event, Approval: req},
+		},
+	}); err != nil {
+		mlog.FromContext(ctx).Error("publish approval failed", zap.String("orderID", req.OrderID), zap.String("event", string(event)), zap.Error(err))
+	}
+}
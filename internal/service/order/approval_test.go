@@ -0,0 +1,567 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/approval"
+	approvalMock "github.com/krivenkov/order/internal/model/approval/mock"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	"github.com/krivenkov/order/internal/model/line"
+	lineMock "github.com/krivenkov/order/internal/model/line/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/tenant"
+	tenantMock "github.com/krivenkov/order/internal/model/tenant/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/ptr"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestApproval(t *testing.T) {
+	var (
+		requesterID = "requester_id"
+		approverID  = "approver_id"
+		tenantID    = "tenant_id"
+
+		cfg = svc.Config{Approval: svc.ApprovalConfig{
+			Thresholds: map[string]int64{"EUR": 100000},
+			Timeout:    time.Hour,
+		}}
+
+		ctx = tenant.CtxWithScope(context.TODO(), tenant.Scope{TenantID: tenantID, UserID: requesterID, Role: tenant.RoleApprover})
+
+		form = func() *orderModel.Form {
+			return &orderModel.Form{
+				Name:     ptr.Pointer("test"),
+				Currency: ptr.Pointer("EUR"),
+				Lines:    []*line.Form{{SKU: "SKU-1", Name: "Widget", Quantity: 2, UnitPrice: 60000}},
+			}
+		}
+	)
+
+	t.Run("Order above the threshold waits for the approvers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGCommander  = orderMock.NewMockCommander(ctrl)
+			orderESCommander  = orderMock.NewMockCommander(ctrl)
+			historyCommander  = historyMock.NewMockCommander(ctrl)
+			lineCommander     = lineMock.NewMockCommander(ctrl)
+			approvalCommander = approvalMock.NewMockCommander(ctrl)
+			tenantQuerier     = tenantMock.NewMockQuerier(ctrl)
+			numberer          = orderMock.NewMockNumberer(ctrl)
+			tXer              = txerMock.NewMockTXer(ctrl)
+			notified          = &publisherStub[approval.Notification]{}
+			expectedApproval  = &approval.Approval{
+				ID:          newID().String(),
+				TSCreate:    now(),
+				TSModify:    now(),
+				OrderID:     newID().String(),
+				TenantID:    tenantID,
+				RequestedBy: requesterID,
+				Approvers:   []string{approverID},
+				Status:      approval.StatusPending,
+				TSDue:       now().Add(time.Hour),
+			}
+		)
+
+		tenantQuerier.EXPECT().GetMembers(ctx, tenantID, tenant.RoleApprover).Return([]*tenant.Member{
+			{TenantID: tenantID, UserID: requesterID, Role: tenant.RoleApprover},
+			{TenantID: tenantID, UserID: approverID, Role: tenant.RoleApprover},
+		}, nil)
+
+		tXer.EXPECT().WithTX(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		numberer.EXPECT().Next(ctx, tenantID, now()).Return("ORD-2000-000001", nil)
+		orderPGCommander.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		lineCommander.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		approvalCommander.EXPECT().Create(ctx, expectedApproval).Return(nil)
+		historyCommander.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, entries ...*history.Entry) error {
+			require.Len(t, entries, 1)
+			require.Equal(t, history.ActionCreate, entries[0].Action)
+			require.Contains(t, entries[0].Changes, &history.Change{Field: "state", New: "pending_approval"})
+			require.Contains(t, entries[0].Changes, &history.Change{Field: "approvers", New: approverID})
+
+			return nil
+		})
+		orderESCommander.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:            orderPGCommander,
+			CmdEs:            orderESCommander,
+			Numberer:         numberer,
+			CmdHistory:       historyCommander,
+			CmdLine:          lineCommander,
+			QrTenant:         tenantQuerier,
+			CmdApproval:      approvalCommander,
+			ApprovalNotified: notified,
+			Cfg:              cfg,
+			TXer:             tXer,
+			Now:              now,
+			NewID:            newID,
+		})
+
+		res, err := service.Create(ctx, requesterID, form())
+
+		require.NoError(t, err)
+		require.Equal(t, orderModel.StatePendingApproval, res.State)
+		require.Len(t, notified.messages, 1)
+		require.Equal(t, res.ID, notified.messages[0].Key)
+		require.Equal(t, &approval.Notification{Event: approval.EventRequested, Approval: expectedApproval}, notified.messages[0].Value.Payload)
+	})
+
+	t.Run("Nobody to approve", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantQuerier := tenantMock.NewMockQuerier(ctrl)
+		tenantQuerier.EXPECT().GetMembers(ctx, tenantID, tenant.RoleApprover).Return([]*tenant.Member{
+			{TenantID: tenantID, UserID: requesterID, Role: tenant.RoleApprover},
+		}, nil)
+
+		service := svc.New(svc.Params{
+			QrTenant: tenantQuerier,
+			Cfg:      cfg,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		_, err := service.Create(ctx, requesterID, form())
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Currency without a threshold", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// the approval is requested however small the total, the requester approves nothing
+		tenantQuerier := tenantMock.NewMockQuerier(ctrl)
+		tenantQuerier.EXPECT().GetMembers(ctx, tenantID, tenant.RoleApprover).Return([]*tenant.Member{
+			{TenantID: tenantID, UserID: requesterID, Role: tenant.RoleApprover},
+		}, nil)
+
+		service := svc.New(svc.Params{
+			QrTenant: tenantQuerier,
+			Cfg:      cfg,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		usd := form()
+		usd.Currency = ptr.Pointer("USD")
+		usd.Lines[0].UnitPrice = 100
+
+		_, err := service.Create(ctx, requesterID, usd)
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+}
+
+func TestDecideApproval(t *testing.T) {
+	var (
+		requesterID = "requester_id"
+		approverID  = "approver_id"
+		tenantID    = "tenant_id"
+		orderID     = newID().String()
+
+		ctx = tenant.CtxWithScope(context.TODO(), tenant.Scope{TenantID: tenantID, UserID: approverID, Role: tenant.RoleApprover})
+
+		filter = &orderModel.Filter{
+			Status:    option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{orderID}),
+			ForUpdate: option.New(true),
+		}
+		approvalFilter = &approval.Filter{
+			OrderID: option.New(orderID),
+			Status:  option.New(approval.StatusPending),
+		}
+
+		pending = func() *orderModel.Order {
+			return &orderModel.Order{
+				ID:       orderID,
+				UserID:   requesterID,
+				TenantID: tenantID,
+				Status:   orderModel.StatusCreated,
+				State:    orderModel.StatePendingApproval,
+			}
+		}
+		request = func(approvers ...string) *approval.Approval {
+			return &approval.Approval{
+				ID:          newID().String(),
+				OrderID:     orderID,
+				TenantID:    tenantID,
+				RequestedBy: requesterID,
+				Approvers:   approvers,
+				Status:      approval.StatusPending,
+			}
+		}
+	)
+
+	t.Run("Approve places the order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier    = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander  = orderMock.NewMockCommander(ctrl)
+			orderESCommander  = orderMock.NewMockCommander(ctrl)
+			historyCommander  = historyMock.NewMockCommander(ctrl)
+			lineQuerier       = lineMock.NewMockQuerier(ctrl)
+			approvalQuerier   = approvalMock.NewMockQuerier(ctrl)
+			approvalCommander = approvalMock.NewMockCommander(ctrl)
+			reserver          = inventoryMock.NewMockReserver(ctrl)
+			tXer              = txerMock.NewMockTXer(ctrl)
+			notified          = &publisherStub[approval.Notification]{}
+
+			lines = []*line.Line{{ID: "line_id", OrderID: orderID, SKU: "SKU-1", Quantity: 2}}
+		)
+
+		tXer.EXPECT().WithTX(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(ctx, filter).Return(pending(), nil)
+		approvalQuerier.EXPECT().GetItem(ctx, approvalFilter).Return(request(approverID), nil)
+		lineQuerier.EXPECT().GetList(ctx, &line.Filter{OrderID: option.New(orderID)}).Return(lines, nil)
+		approvalCommander.EXPECT().Decide(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, item *approval.Approval) error {
+			require.Equal(t, approval.StatusApproved, item.Status)
+			require.Equal(t, approverID, item.DecidedBy)
+			require.Equal(t, "fine", item.Comment)
+
+			return nil
+		})
+		reserver.EXPECT().Reserve(ctx, gomock.Any()).Return(nil)
+		orderPGCommander.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		historyCommander.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, entries ...*history.Entry) error {
+			require.Equal(t, history.ActionApprove, entries[0].Action)
+			require.Equal(t, approverID, entries[0].Actor)
+			require.Equal(t, []*history.Change{
+				{Field: "state", Old: "pending_approval", New: "placed"},
+				{Field: "approval_status", Old: "pending", New: "approved"},
+				{Field: "approval_comment", New: "fine"},
+			}, entries[0].Changes)
+
+			return nil
+		})
+		orderESCommander.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:            orderPGCommander,
+			CmdEs:            orderESCommander,
+			QrPg:             orderPGQuerier,
+			CmdHistory:       historyCommander,
+			QrLine:           lineQuerier,
+			QrApproval:       approvalQuerier,
+			CmdApproval:      approvalCommander,
+			ApprovalNotified: notified,
+			Reserver:         reserver,
+			TXer:             tXer,
+			Now:              now,
+			NewID:            newID,
+		})
+
+		res, err := service.Approve(ctx, approverID, orderID, " fine ")
+
+		require.NoError(t, err)
+		require.Equal(t, orderModel.StatePlaced, res.State)
+		require.Len(t, notified.messages, 1)
+		require.Equal(t, approval.EventApproved, notified.messages[0].Value.Payload.Event)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier    = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander  = orderMock.NewMockCommander(ctrl)
+			orderESCommander  = orderMock.NewMockCommander(ctrl)
+			historyCommander  = historyMock.NewMockCommander(ctrl)
+			approvalQuerier   = approvalMock.NewMockQuerier(ctrl)
+			approvalCommander = approvalMock.NewMockCommander(ctrl)
+			tXer              = txerMock.NewMockTXer(ctrl)
+			notified          = &publisherStub[approval.Notification]{}
+		)
+
+		tXer.EXPECT().WithTX(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(ctx, filter).Return(pending(), nil)
+		approvalQuerier.EXPECT().GetItem(ctx, approvalFilter).Return(request(approverID), nil)
+		approvalCommander.EXPECT().Decide(ctx, gomock.Any()).Return(nil)
+		orderPGCommander.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		historyCommander.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, entries ...*history.Entry) error {
+			require.Equal(t, history.ActionReject, entries[0].Action)
+
+			return nil
+		})
+		orderESCommander.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:            orderPGCommander,
+			CmdEs:            orderESCommander,
+			QrPg:             orderPGQuerier,
+			CmdHistory:       historyCommander,
+			QrApproval:       approvalQuerier,
+			CmdApproval:      approvalCommander,
+			ApprovalNotified: notified,
+			TXer:             tXer,
+			Now:              now,
+			NewID:            newID,
+		})
+
+		res, err := service.Reject(ctx, approverID, orderID, "over budget")
+
+		require.NoError(t, err)
+		require.Equal(t, orderModel.StateRejected, res.State)
+		require.Len(t, notified.messages, 1)
+		require.Equal(t, approval.EventRejected, notified.messages[0].Value.Payload.Event)
+	})
+
+	t.Run("Reject without comment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier  = orderMock.NewMockQuerier(ctrl)
+			approvalQuerier = approvalMock.NewMockQuerier(ctrl)
+			tXer            = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(ctx, filter).Return(pending(), nil)
+		approvalQuerier.EXPECT().GetItem(ctx, approvalFilter).Return(request(approverID), nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrApproval: approvalQuerier, TXer: tXer, Now: now})
+
+		_, err := service.Reject(ctx, approverID, orderID, " ")
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("Not assigned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier  = orderMock.NewMockQuerier(ctrl)
+			approvalQuerier = approvalMock.NewMockQuerier(ctrl)
+			tXer            = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(ctx, filter).Return(pending(), nil)
+		approvalQuerier.EXPECT().GetItem(ctx, approvalFilter).Return(request("other_id"), nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrApproval: approvalQuerier, TXer: tXer, Now: now})
+
+		_, err := service.Approve(ctx, approverID, orderID, "")
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Editor cannot approve", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			ctx = tenant.CtxWithScope(context.TODO(), tenant.Scope{TenantID: tenantID, UserID: approverID, Role: tenant.RoleEditor})
+		)
+
+		tXer.EXPECT().WithTX(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(ctx, filter).Return(pending(), nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, TXer: tXer})
+
+		_, err := service.Approve(ctx, approverID, orderID, "")
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Order not pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			item = pending()
+		)
+
+		tXer.EXPECT().WithTX(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		item.State = orderModel.StatePlaced
+		orderPGQuerier.EXPECT().GetItem(ctx, filter).Return(item, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, TXer: tXer})
+
+		_, err := service.Approve(ctx, approverID, orderID, "")
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+}
+
+func TestEscalateApprovals(t *testing.T) {
+	var (
+		requesterID = "requester_id"
+		tenantID    = "tenant_id"
+		before      = now()
+
+		listFilter = &approval.Filter{
+			Status:    option.New(approval.StatusPending),
+			DueBefore: option.New(before),
+		}
+
+		due = func() []*approval.Approval {
+			return []*approval.Approval{
+				{ID: "1", OrderID: "deleted_id", TenantID: tenantID, RequestedBy: requesterID, Status: approval.StatusPending},
+				{ID: "2", OrderID: "order_id", TenantID: tenantID, RequestedBy: requesterID, Approvers: []string{"a"}, Status: approval.StatusPending},
+			}
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier    = orderMock.NewMockQuerier(ctrl)
+			historyCommander  = historyMock.NewMockCommander(ctrl)
+			approvalQuerier   = approvalMock.NewMockQuerier(ctrl)
+			approvalCommander = approvalMock.NewMockCommander(ctrl)
+			tenantQuerier     = tenantMock.NewMockQuerier(ctrl)
+			tXer              = txerMock.NewMockTXer(ctrl)
+			notified          = &publisherStub[approval.Notification]{}
+
+			item = &orderModel.Order{ID: "order_id", UserID: requesterID, TenantID: tenantID, State: orderModel.StatePendingApproval}
+		)
+
+		tXer.EXPECT().WithTX(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		approvalQuerier.EXPECT().GetList(context.TODO(), listFilter).Return(due(), nil)
+		orderPGQuerier.EXPECT().GetItem(gomock.Any(), &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:    option.New([]string{"deleted_id"}),
+		}).Return(nil, model.ErrNotFound)
+		orderPGQuerier.EXPECT().GetItem(gomock.Any(), &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:    option.New([]string{"order_id"}),
+		}).Return(item, nil)
+		tenantQuerier.EXPECT().GetMembers(gomock.Any(), tenantID, tenant.RoleApprover).Return([]*tenant.Member{
+			{TenantID: tenantID, UserID: "a", Role: tenant.RoleApprover},
+			{TenantID: tenantID, UserID: "b", Role: tenant.RoleApprover},
+		}, nil)
+		approvalCommander.EXPECT().Escalate(gomock.Any(), &approval.Approval{
+			ID:          "2",
+			TSModify:    now(),
+			OrderID:     "order_id",
+			TenantID:    tenantID,
+			RequestedBy: requesterID,
+			Approvers:   []string{"a", "b"},
+			Status:      approval.StatusPending,
+			Level:       1,
+			TSDue:       now().Add(time.Hour),
+		}, before).Return(nil)
+		historyCommander.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entries ...*history.Entry) error {
+			require.Equal(t, history.ActionEscalate, entries[0].Action)
+			require.Equal(t, history.SourceScheduler, entries[0].Source)
+			require.Equal(t, requesterID, entries[0].Actor)
+			require.Equal(t, []*history.Change{
+				{Field: "approval_level", Old: "0", New: "1"},
+				{Field: "approvers", Old: "a", New: "a,b"},
+			}, entries[0].Changes)
+
+			return nil
+		})
+
+		service := svc.New(svc.Params{
+			QrPg:             orderPGQuerier,
+			CmdHistory:       historyCommander,
+			QrTenant:         tenantQuerier,
+			QrApproval:       approvalQuerier,
+			CmdApproval:      approvalCommander,
+			ApprovalNotified: notified,
+			Cfg:              svc.Config{Approval: svc.ApprovalConfig{Timeout: time.Hour}},
+			TXer:             tXer,
+			Now:              now,
+			NewID:            newID,
+		})
+
+		n, err := service.EscalateApprovals(context.TODO(), before)
+
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		require.Len(t, notified.messages, 1)
+		require.Equal(t, approval.EventEscalated, notified.messages[0].Value.Payload.Event)
+	})
+
+	t.Run("Escalated by another instance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier    = orderMock.NewMockQuerier(ctrl)
+			approvalQuerier   = approvalMock.NewMockQuerier(ctrl)
+			approvalCommander = approvalMock.NewMockCommander(ctrl)
+			tenantQuerier     = tenantMock.NewMockQuerier(ctrl)
+			tXer              = txerMock.NewMockTXer(ctrl)
+			notified          = &publisherStub[approval.Notification]{}
+		)
+
+		tXer.EXPECT().WithTX(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		approvalQuerier.EXPECT().GetList(context.TODO(), listFilter).Return(due()[1:], nil)
+		orderPGQuerier.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&orderModel.Order{ID: "order_id"}, nil)
+		tenantQuerier.EXPECT().GetMembers(gomock.Any(), tenantID, tenant.RoleApprover).Return(nil, nil)
+		approvalCommander.EXPECT().Escalate(gomock.Any(), gomock.Any(), before).Return(model.ErrConflict)
+
+		service := svc.New(svc.Params{
+			QrPg:             orderPGQuerier,
+			QrTenant:         tenantQuerier,
+			QrApproval:       approvalQuerier,
+			CmdApproval:      approvalCommander,
+			ApprovalNotified: notified,
+			TXer:             tXer,
+			Now:              now,
+			NewID:            newID,
+		})
+
+		n, err := service.EscalateApprovals(context.TODO(), before)
+
+		require.NoError(t, err)
+		require.Zero(t, n)
+		require.Empty(t, notified.messages)
+	})
+}
@@ -0,0 +1,170 @@
+package order
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/option"
+	"go.uber.org/zap"
+)
+
+// sniffLen is as much of the content as the detection looks at
+const sniffLen = 512
+
+func (s *service) GetAttachments(ctx context.Context, userID, id string) ([]*attachment.Attachment, error) {
+	if _, err := s.getOwned(ctx, userID, id, tenant.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.qrAttachment.GetList(ctx, &attachment.Filter{OrderIDs: option.New([]string{id})})
+	if err != nil {
+		return nil, fmt.Errorf("get attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+func (s *service) GetAttachment(ctx context.Context, userID, id, attachmentID string) (*attachment.Attachment, io.ReadCloser, error) {
+	if _, err := s.getOwned(ctx, userID, id, tenant.RoleViewer); err != nil {
+		return nil, nil, err
+	}
+
+	item, err := s.getAttachment(ctx, id, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobs.Get(ctx, item.Key())
+	if err != nil {
+		return nil, nil, fmt.Errorf("get attachment content: %w", err)
+	}
+
+	return item, content, nil
+}
+
+func (s *service) Attach(ctx context.Context, userID, id string, form *attachment.Form, content io.Reader) (*attachment.Attachment, error) {
+	contentType, content, err := sniffContentType(content)
+	if err != nil {
+		return nil, fmt.Errorf("read content: %w", err)
+	}
+
+	form.ContentType = contentType
+
+	if err = form.Validate(s.attachmentLimits); err != nil {
+		return nil, err
+	}
+
+	if _, err = s.getOwned(ctx, userID, id, tenant.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.qrAttachment.GetList(ctx, &attachment.Filter{OrderIDs: option.New([]string{id})})
+	if err != nil {
+		return nil, fmt.Errorf("get attachments: %w", err)
+	}
+
+	if len(attachments) >= attachment.MaxAttachments {
+		return nil, fmt.Errorf("%w: order has %d attachments already", model.ErrConflict, attachment.MaxAttachments)
+	}
+
+	res := attachment.New(id, userID, form, s.now, s.newID)
+
+	if err = s.blobs.Put(ctx, res.Key(), content, res.Size, res.ContentType); err != nil {
+		return nil, fmt.Errorf("store attachment content: %w", err)
+	}
+
+	if err = s.cmdAttachment.Create(ctx, res); err != nil {
+		// nothing would ever find the content without its record
+		if errDelete := s.blobs.Delete(ctx, res.Key()); errDelete != nil {
+			mlog.FromContext(ctx).Error("delete orphaned attachment content failed", zap.String("key", res.Key()), zap.Error(errDelete))
+		}
+
+		return nil, fmt.Errorf("attachment create: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *service) DeleteAttachment(ctx context.Context, userID, id, attachmentID string) error {
+	if _, err := s.getOwned(ctx, userID, id, tenant.RoleEditor); err != nil {
+		return err
+	}
+
+	item, err := s.getAttachment(ctx, id, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	// the content goes first, a failed delete leaves a record which can be deleted again
+	if err = s.blobs.Delete(ctx, item.Key()); err != nil {
+		return fmt.Errorf("delete attachment content: %w", err)
+	}
+
+	if err = s.cmdAttachment.Delete(ctx, item.ID); err != nil {
+		return fmt.Errorf("attachment delete: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) getAttachment(ctx context.Context, id, attachmentID string) (*attachment.Attachment, error) {
+	attachments, err := s.qrAttachment.GetList(ctx, &attachment.Filter{
+		IDs:      option.New([]string{attachmentID}),
+		OrderIDs: option.New([]string{id}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get attachments: %w", err)
+	}
+
+	if len(attachments) == 0 {
+		return nil, model.ErrNotFound
+	}
+
+	return attachments[0], nil
+}
+
+// deleteAttachments removes the content of the attachments of the orders, their records are
+// deleted along with the orders
+func (s *service) deleteAttachments(ctx context.Context, ids []string) error {
+	attachments, err := s.qrAttachment.GetList(ctx, &attachment.Filter{OrderIDs: option.New(ids)})
+	if err != nil {
+		return fmt.Errorf("get attachments: %w", err)
+	}
+
+	for _, item := range attachments {
+		if err = s.blobs.Delete(ctx, item.Key()); err != nil {
+			return fmt.Errorf("delete attachment %s content: %w", item.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// sniffContentType detects the media type from the beginning of the content and returns
+// a reader of the whole content
+func sniffContentType(content io.Reader) (string, io.Reader, error) {
+	head := make([]byte, sniffLen)
+
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", nil, err
+	}
+
+	head = head[:n]
+
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return mediaType, io.MultiReader(bytes.NewReader(head), content), nil
+}
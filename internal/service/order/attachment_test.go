@@ -0,0 +1,234 @@
+package order_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	attachmentMock "github.com/krivenkov/order/internal/model/attachment/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttach(t *testing.T) {
+	var (
+		userID  = "user_id"
+		orderID = "order_id"
+		pdf     = "%PDF-1.7\n%\xe2\xe3\xcf\xd3\n"
+
+		filter = &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:    option.New([]string{orderID}),
+		}
+		attachmentsFilter = &attachment.Filter{OrderIDs: option.New([]string{orderID})}
+
+		cfg = svc.Config{Attachments: svc.AttachmentsConfig{MaxSize: 1024, ContentTypes: []string{"application/pdf"}}}
+
+		item = &orderModel.Order{ID: orderID, UserID: userID, Status: orderModel.StatusCreated}
+
+		expected = &attachment.Attachment{
+			ID:          newID().String(),
+			TSCreate:    now(),
+			OrderID:     orderID,
+			UserID:      userID,
+			FileName:    "invoice.pdf",
+			ContentType: "application/pdf",
+			Size:        int64(len(pdf)),
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			attachmentQr   = attachmentMock.NewMockQuerier(ctrl)
+			attachmentCmd  = attachmentMock.NewMockCommander(ctrl)
+			blobs          = attachmentMock.NewMockBlobStore(ctrl)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(item, nil)
+		attachmentQr.EXPECT().GetList(context.TODO(), attachmentsFilter).Return(nil, nil)
+		blobs.EXPECT().Put(context.TODO(), orderID+"/"+expected.ID, gomock.Any(), expected.Size, "application/pdf").
+			DoAndReturn(func(_ context.Context, _ string, content io.Reader, _ int64, _ string) error {
+				data, err := io.ReadAll(content)
+				require.NoError(t, err)
+				require.Equal(t, pdf, string(data))
+
+				return nil
+			})
+		attachmentCmd.EXPECT().Create(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:          orderPGQuerier,
+			QrAttachment:  attachmentQr,
+			CmdAttachment: attachmentCmd,
+			Blobs:         blobs,
+			Cfg:           cfg,
+			Now:           now,
+			NewID:         newID,
+		})
+
+		res, err := service.Attach(context.TODO(), userID, orderID, &attachment.Form{
+			FileName:    `C:\Users\me\invoice.pdf`,
+			ContentType: "image/png",
+			Size:        expected.Size,
+		}, strings.NewReader(pdf))
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Content type not allowed", func(t *testing.T) {
+		service := svc.New(svc.Params{Cfg: cfg, Now: now, NewID: newID})
+
+		// a script renamed to a pdf is still text
+		_, err := service.Attach(context.TODO(), userID, orderID, &attachment.Form{
+			FileName:    "invoice.pdf",
+			ContentType: "application/pdf",
+			Size:        9,
+		}, strings.NewReader("#!/bin/sh"))
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("Too large", func(t *testing.T) {
+		service := svc.New(svc.Params{Cfg: cfg, Now: now, NewID: newID})
+
+		_, err := service.Attach(context.TODO(), userID, orderID, &attachment.Form{
+			FileName: "invoice.pdf",
+			Size:     2048,
+		}, strings.NewReader(pdf))
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("Too many attachments", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			attachmentQr   = attachmentMock.NewMockQuerier(ctrl)
+
+			attachments = make([]*attachment.Attachment, attachment.MaxAttachments)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(item, nil)
+		attachmentQr.EXPECT().GetList(context.TODO(), attachmentsFilter).Return(attachments, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrAttachment: attachmentQr, Cfg: cfg, Now: now, NewID: newID})
+
+		_, err := service.Attach(context.TODO(), userID, orderID, &attachment.Form{
+			FileName: "invoice.pdf",
+			Size:     expected.Size,
+		}, strings.NewReader(pdf))
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Bad create removes the content", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			attachmentQr   = attachmentMock.NewMockQuerier(ctrl)
+			attachmentCmd  = attachmentMock.NewMockCommander(ctrl)
+			blobs          = attachmentMock.NewMockBlobStore(ctrl)
+
+			someErr = fmt.Errorf("some error")
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(item, nil)
+		attachmentQr.EXPECT().GetList(context.TODO(), attachmentsFilter).Return(nil, nil)
+		blobs.EXPECT().Put(context.TODO(), expected.Key(), gomock.Any(), expected.Size, "application/pdf").Return(nil)
+		attachmentCmd.EXPECT().Create(context.TODO(), expected).Return(someErr)
+		blobs.EXPECT().Delete(context.TODO(), expected.Key()).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:          orderPGQuerier,
+			QrAttachment:  attachmentQr,
+			CmdAttachment: attachmentCmd,
+			Blobs:         blobs,
+			Cfg:           cfg,
+			Now:           now,
+			NewID:         newID,
+		})
+
+		_, err := service.Attach(context.TODO(), userID, orderID, &attachment.Form{
+			FileName: "invoice.pdf",
+			Size:     expected.Size,
+		}, strings.NewReader(pdf))
+
+		require.ErrorIs(t, err, someErr)
+	})
+}
+
+func TestGetAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		userID  = "user_id"
+		orderID = "order_id"
+
+		orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+		attachmentQr   = attachmentMock.NewMockQuerier(ctrl)
+	)
+
+	orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(&orderModel.Order{ID: orderID, UserID: userID}, nil)
+	attachmentQr.EXPECT().GetList(context.TODO(), &attachment.Filter{
+		IDs:      option.New([]string{"attachment_id"}),
+		OrderIDs: option.New([]string{orderID}),
+	}).Return(nil, nil)
+
+	service := svc.New(svc.Params{QrPg: orderPGQuerier, QrAttachment: attachmentQr, Now: now})
+
+	_, _, err := service.GetAttachment(context.TODO(), userID, orderID, "attachment_id")
+
+	require.ErrorIs(t, err, model.ErrNotFound)
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		userID  = "user_id"
+		orderID = "order_id"
+
+		orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+		attachmentQr   = attachmentMock.NewMockQuerier(ctrl)
+		attachmentCmd  = attachmentMock.NewMockCommander(ctrl)
+		blobs          = attachmentMock.NewMockBlobStore(ctrl)
+	)
+
+	orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(&orderModel.Order{ID: orderID, UserID: userID}, nil)
+	attachmentQr.EXPECT().GetList(context.TODO(), gomock.Any()).Return([]*attachment.Attachment{{ID: "attachment_id", OrderID: orderID}}, nil)
+	gomock.InOrder(
+		blobs.EXPECT().Delete(context.TODO(), orderID+"/attachment_id").Return(nil),
+		attachmentCmd.EXPECT().Delete(context.TODO(), "attachment_id").Return(nil),
+	)
+
+	service := svc.New(svc.Params{
+		QrPg:          orderPGQuerier,
+		QrAttachment:  attachmentQr,
+		CmdAttachment: attachmentCmd,
+		Blobs:         blobs,
+		Now:           now,
+	})
+
+	err := service.DeleteAttachment(context.TODO(), userID, orderID, "attachment_id")
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,201 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	"go.uber.org/zap"
+)
+
+// commentSearchLimit caps the orders a search finds through their comments
+const commentSearchLimit = 1000
+
+func (s *service) GetComments(ctx context.Context, actor comment.Actor, id string, pagination paginator.Pagination) ([]*comment.Comment, int, error) {
+	if _, err := s.authorizeComments(ctx, actor, id); err != nil {
+		return nil, 0, err
+	}
+
+	filter := &comment.Filter{OrderID: option.New(id)}
+	if !actor.Staff {
+		filter.Internal = option.New(false)
+	}
+
+	comments, err := s.qrComment.GetList(ctx, filter, &pagination)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get comments: %w", err)
+	}
+
+	total, err := s.qrComment.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count comments: %w", err)
+	}
+
+	return comments, total, nil
+}
+
+func (s *service) AddComment(ctx context.Context, actor comment.Actor, id string, form *comment.Form) (*comment.Comment, error) {
+	if err := form.Validate(actor); err != nil {
+		return nil, err
+	}
+
+	parent, err := s.authorizeComments(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	item := comment.New(id, actor, form, s.now, s.newID)
+	item.OrderUserID, item.OrderTenantID = parent.UserID, parent.TenantID
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		if err := s.cmdComment.Create(ctx, item); err != nil {
+			return fmt.Errorf("comment create: %w", err)
+		}
+
+		if err := s.cmdEsComment.Create(ctx, item); err != nil {
+			return fmt.Errorf("comment create: %w", err)
+		}
+
+		return nil
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	if err := s.commentAdded.Publish(ctx, bus.Message[comment.Comment]{
+		Key: item.OrderID,
+		Value: bus.MessageValue[comment.Comment]{
+			CommandID: item.ID,
+			UserID:    item.AuthorID,
+			CreatedAt: item.TSCreate,
+			Payload:   item,
+		},
+	}); err != nil {
+		mlog.FromContext(ctx).Error("publish comment failed", zap.String("orderID", item.OrderID), zap.String("commentID", item.ID), zap.Error(err))
+	}
+
+	return item, nil
+}
+
+func (s *service) UpdateComment(ctx context.Context, actor comment.Actor, id, commentID, body string) (*comment.Comment, error) {
+	item, err := s.getComment(ctx, actor, id, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = item.Edit(actor, body, s.now()); err != nil {
+		return nil, err
+	}
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		if err = s.cmdComment.Update(ctx, item); err != nil {
+			return fmt.Errorf("comment update: %w", err)
+		}
+
+		if err = s.cmdEsComment.Update(ctx, item); err != nil {
+			return fmt.Errorf("comment update: %w", err)
+		}
+
+		return nil
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	return item, nil
+}
+
+func (s *service) DeleteComment(ctx context.Context, actor comment.Actor, id, commentID string) error {
+	item, err := s.getComment(ctx, actor, id, commentID)
+	if err != nil {
+		return err
+	}
+
+	if !item.CanDelete(actor) {
+		return fmt.Errorf("%w: comment of another author", model.ErrPermissionDenied)
+	}
+
+	return s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		if err = s.cmdComment.Delete(ctx, item.ID); err != nil {
+			return fmt.Errorf("comment delete: %w", err)
+		}
+
+		if err = s.cmdEsComment.Delete(ctx, item.ID); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return fmt.Errorf("comment delete: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// authorizeComments lets staff on the comments of any order, the others on the ones of the
+// orders they see
+func (s *service) authorizeComments(ctx context.Context, actor comment.Actor, id string) (*orderModel.Order, error) {
+	if !actor.Staff {
+		return s.getOwned(ctx, actor.UserID, id, tenant.RoleViewer)
+	}
+
+	item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusCreated)),
+		IDs:    option.New([]string{id}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get item: %w", err)
+	}
+
+	return item, nil
+}
+
+// getComment returns a comment of the order, an internal one is not found unless the actor is staff
+func (s *service) getComment(ctx context.Context, actor comment.Actor, id, commentID string) (*comment.Comment, error) {
+	parent, err := s.authorizeComments(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.qrComment.GetList(ctx, &comment.Filter{
+		IDs:     option.New([]string{commentID}),
+		OrderID: option.New(id),
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get comments: %w", err)
+	}
+
+	if len(comments) == 0 || !comments[0].VisibleTo(actor) {
+		return nil, model.ErrNotFound
+	}
+
+	comments[0].OrderUserID, comments[0].OrderTenantID = parent.UserID, parent.TenantID
+
+	return comments[0], nil
+}
+
+// includeCommented adds the orders with a comment matching the search to the filter, the search
+// goes on without them when the comments cannot be searched
+func (s *service) includeCommented(ctx context.Context, filter *orderModel.Filter) {
+	if !filter.Q.IsSet() {
+		return
+	}
+
+	ids, err := s.commentSearcher.SearchOrders(ctx, &comment.SearchFilter{
+		Q:         filter.Q.Value(),
+		UserID:    filter.UserID,
+		SharedIDs: filter.SharedIDs,
+		TenantID:  filter.TenantID,
+	}, commentSearchLimit)
+	if err != nil {
+		mlog.FromContext(ctx).Warn("search comments failed", zap.Error(err))
+
+		return
+	}
+
+	if len(ids) > 0 {
+		filter.CommentedIDs = option.New(ids)
+	}
+}
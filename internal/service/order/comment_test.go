@@ -0,0 +1,483 @@
+package order_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	commentMock "github.com/krivenkov/order/internal/model/comment/mock"
+	grantMock "github.com/krivenkov/order/internal/model/grant/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/tenant"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetComments(t *testing.T) {
+	var (
+		userID  = "user_id"
+		orderID = "order_id"
+
+		filter = &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:    option.New([]string{orderID}),
+		}
+		pagination = paginator.Pagination{Limit: 50}
+
+		comments = []*comment.Comment{{ID: "comment_id", OrderID: orderID, AuthorID: userID, Body: "where is it?"}}
+	)
+
+	t.Run("Customer sees public comments", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			commentQr      = commentMock.NewMockQuerier(ctrl)
+
+			commentsFilter = &comment.Filter{OrderID: option.New(orderID), Internal: option.New(false)}
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&orderModel.Order{ID: orderID, UserID: userID}, nil)
+		commentQr.EXPECT().GetList(context.TODO(), commentsFilter, &pagination).Return(comments, nil)
+		commentQr.EXPECT().Count(context.TODO(), commentsFilter).Return(1, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrComment: commentQr, Now: now})
+
+		res, total, err := service.GetComments(context.TODO(), comment.Actor{UserID: userID}, orderID, pagination)
+
+		require.NoError(t, err)
+		require.Equal(t, comments, res)
+		require.Equal(t, 1, total)
+	})
+
+	t.Run("Staff sees internal notes of any order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			commentQr      = commentMock.NewMockQuerier(ctrl)
+
+			commentsFilter = &comment.Filter{OrderID: option.New(orderID)}
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&orderModel.Order{ID: orderID, UserID: "other_id"}, nil)
+		commentQr.EXPECT().GetList(context.TODO(), commentsFilter, &pagination).Return(comments, nil)
+		commentQr.EXPECT().Count(context.TODO(), commentsFilter).Return(1, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrComment: commentQr, Now: now})
+
+		_, _, err := service.GetComments(context.TODO(), comment.Actor{UserID: "staff_id", Staff: true}, orderID, pagination)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Order of another user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			grantQuerier   = grantMock.NewMockQuerier(ctrl)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&orderModel.Order{ID: orderID, UserID: "other_id"}, nil)
+		grantQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrGrant: grantQuerier, Now: now})
+
+		_, _, err := service.GetComments(context.TODO(), comment.Actor{UserID: userID}, orderID, pagination)
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+}
+
+func TestAddComment(t *testing.T) {
+	var (
+		userID  = "user_id"
+		orderID = "order_id"
+
+		filter = &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:    option.New([]string{orderID}),
+		}
+
+		expected = &comment.Comment{
+			ID:          newID().String(),
+			TSCreate:    now(),
+			OrderID:     orderID,
+			AuthorID:    userID,
+			Body:        "where is it?",
+			OrderUserID: userID,
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			commentPGCmd   = commentMock.NewMockCommander(ctrl)
+			commentESCmd   = commentMock.NewMockCommander(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+			added          = &publisherStub[comment.Comment]{}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&orderModel.Order{ID: orderID, UserID: userID}, nil)
+		commentPGCmd.EXPECT().Create(context.TODO(), expected).Return(nil)
+		commentESCmd.EXPECT().Create(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:         orderPGQuerier,
+			CmdComment:   commentPGCmd,
+			CmdEsComment: commentESCmd,
+			CommentAdded: added,
+			TXer:         tXer,
+			Now:          now,
+			NewID:        newID,
+		})
+
+		res, err := service.AddComment(context.TODO(), comment.Actor{UserID: userID}, orderID, &comment.Form{Body: "  where is it?\n"})
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+		require.Len(t, added.messages, 1)
+		require.Equal(t, orderID, added.messages[0].Key)
+		require.Equal(t, expected, added.messages[0].Value.Payload)
+	})
+
+	t.Run("Failed publish keeps the comment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			commentPGCmd   = commentMock.NewMockCommander(ctrl)
+			commentESCmd   = commentMock.NewMockCommander(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+			added          = &publisherStub[comment.Comment]{err: fmt.Errorf("some error")}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&orderModel.Order{ID: orderID, UserID: "other_id"}, nil)
+		commentPGCmd.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		commentESCmd.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:         orderPGQuerier,
+			CmdComment:   commentPGCmd,
+			CmdEsComment: commentESCmd,
+			CommentAdded: added,
+			TXer:         tXer,
+			Now:          now,
+			NewID:        newID,
+		})
+
+		res, err := service.AddComment(context.TODO(), comment.Actor{UserID: "staff_id", Staff: true}, orderID, &comment.Form{
+			Body:     "refund approved",
+			Internal: true,
+		})
+
+		require.NoError(t, err)
+		require.True(t, res.Internal)
+	})
+
+	t.Run("Internal by a customer", func(t *testing.T) {
+		service := svc.New(svc.Params{Now: now})
+
+		_, err := service.AddComment(context.TODO(), comment.Actor{UserID: userID}, orderID, &comment.Form{
+			Body:     "note",
+			Internal: true,
+		})
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Empty body", func(t *testing.T) {
+		service := svc.New(svc.Params{Now: now})
+
+		_, err := service.AddComment(context.TODO(), comment.Actor{UserID: userID}, orderID, &comment.Form{Body: " "})
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+}
+
+func TestUpdateComment(t *testing.T) {
+	var (
+		userID  = "user_id"
+		orderID = "order_id"
+
+		filter = &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:    option.New([]string{orderID}),
+		}
+		commentsFilter = &comment.Filter{
+			IDs:     option.New([]string{"comment_id"}),
+			OrderID: option.New(orderID),
+		}
+
+		item = func(authorID string, internal bool) *comment.Comment {
+			return &comment.Comment{
+				ID:       "comment_id",
+				TSCreate: now(),
+				OrderID:  orderID,
+				AuthorID: authorID,
+				Body:     "where is it?",
+				Internal: internal,
+			}
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			commentQr      = commentMock.NewMockQuerier(ctrl)
+			commentPGCmd   = commentMock.NewMockCommander(ctrl)
+			commentESCmd   = commentMock.NewMockCommander(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			edited = now()
+		)
+
+		expected := item(userID, false)
+		expected.Body = "where is my order?"
+		expected.TSEdit = &edited
+		expected.OrderUserID = userID
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&orderModel.Order{ID: orderID, UserID: userID}, nil)
+		commentQr.EXPECT().GetList(context.TODO(), commentsFilter, nil).Return([]*comment.Comment{item(userID, false)}, nil)
+		commentPGCmd.EXPECT().Update(context.TODO(), expected).Return(nil)
+		commentESCmd.EXPECT().Update(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:         orderPGQuerier,
+			QrComment:    commentQr,
+			CmdComment:   commentPGCmd,
+			CmdEsComment: commentESCmd,
+			TXer:         tXer,
+			Now:          now,
+		})
+
+		res, err := service.UpdateComment(context.TODO(), comment.Actor{UserID: userID}, orderID, "comment_id", "where is my order?")
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Comment of another author", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			commentQr      = commentMock.NewMockQuerier(ctrl)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&orderModel.Order{ID: orderID, UserID: userID}, nil)
+		commentQr.EXPECT().GetList(context.TODO(), commentsFilter, nil).Return([]*comment.Comment{item("staff_id", false)}, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrComment: commentQr, Now: now})
+
+		_, err := service.UpdateComment(context.TODO(), comment.Actor{UserID: userID}, orderID, "comment_id", "changed")
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Internal note is not found by a customer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			commentQr      = commentMock.NewMockQuerier(ctrl)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&orderModel.Order{ID: orderID, UserID: userID}, nil)
+		commentQr.EXPECT().GetList(context.TODO(), commentsFilter, nil).Return([]*comment.Comment{item(userID, true)}, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrComment: commentQr, Now: now})
+
+		_, err := service.UpdateComment(context.TODO(), comment.Actor{UserID: userID}, orderID, "comment_id", "changed")
+
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestDeleteComment(t *testing.T) {
+	var (
+		orderID = "order_id"
+
+		commentsFilter = &comment.Filter{
+			IDs:     option.New([]string{"comment_id"}),
+			OrderID: option.New(orderID),
+		}
+		item = &comment.Comment{ID: "comment_id", OrderID: orderID, AuthorID: "user_id", Body: "spam"}
+	)
+
+	t.Run("Staff deletes any comment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			commentQr      = commentMock.NewMockQuerier(ctrl)
+			commentPGCmd   = commentMock.NewMockCommander(ctrl)
+			commentESCmd   = commentMock.NewMockCommander(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(&orderModel.Order{ID: orderID, UserID: "user_id"}, nil)
+		commentQr.EXPECT().GetList(context.TODO(), commentsFilter, nil).Return([]*comment.Comment{item}, nil)
+		commentPGCmd.EXPECT().Delete(context.TODO(), "comment_id").Return(nil)
+		commentESCmd.EXPECT().Delete(context.TODO(), "comment_id").Return(model.ErrNotFound)
+
+		service := svc.New(svc.Params{
+			QrPg:         orderPGQuerier,
+			QrComment:    commentQr,
+			CmdComment:   commentPGCmd,
+			CmdEsComment: commentESCmd,
+			TXer:         tXer,
+			Now:          now,
+		})
+
+		err := service.DeleteComment(context.TODO(), comment.Actor{UserID: "staff_id", Staff: true}, orderID, "comment_id")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Reader deletes no comment of others", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			commentQr      = commentMock.NewMockQuerier(ctrl)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(&orderModel.Order{ID: orderID, UserID: "owner_id"}, nil)
+		commentQr.EXPECT().GetList(context.TODO(), commentsFilter, nil).Return([]*comment.Comment{item}, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrComment: commentQr, Now: now})
+
+		err := service.DeleteComment(context.TODO(), comment.Actor{UserID: "owner_id"}, orderID, "comment_id")
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+}
+
+func TestGetListSearchesComments(t *testing.T) {
+	var (
+		userID = "user_id"
+		q      = "broken zipper"
+
+		filter = func() *orderModel.Filter {
+			return &orderModel.Filter{
+				UserID:   option.New(userID),
+				TenantID: option.New(""),
+				Draft:    option.New(false),
+				Status:   option.New(int(orderModel.StatusCreated)),
+				Q:        option.New(q),
+			}
+		}
+		orders = []*orderModel.Order{{ID: "commented_id", UserID: userID}}
+	)
+
+	t.Run("Commented orders match", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderESQuerier  = orderMock.NewMockQuerier(ctrl)
+			commentSearcher = commentMock.NewMockSearcher(ctrl)
+
+			expected = filter()
+		)
+
+		expected.CommentedIDs = option.New([]string{"commented_id"})
+
+		commentSearcher.EXPECT().SearchOrders(context.TODO(), &comment.SearchFilter{Q: q, UserID: option.New(userID), TenantID: option.New("")}, 1000).Return([]string{"commented_id"}, nil)
+		orderESQuerier.EXPECT().GetList(context.TODO(), expected, nil, nil).Return(orders, nil)
+
+		service := svc.New(svc.Params{QrEs: orderESQuerier, CommentSearcher: commentSearcher, Now: now})
+
+		res, err := service.GetList(context.TODO(), userID, &orderModel.GetListRequest{Q: option.New(q)})
+
+		require.NoError(t, err)
+		require.Equal(t, orders, res)
+	})
+
+	t.Run("Search goes on without comments", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderESQuerier  = orderMock.NewMockQuerier(ctrl)
+			commentSearcher = commentMock.NewMockSearcher(ctrl)
+		)
+
+		commentSearcher.EXPECT().SearchOrders(context.TODO(), &comment.SearchFilter{Q: q, UserID: option.New(userID), TenantID: option.New("")}, 1000).Return(nil, fmt.Errorf("es is unavailable"))
+		orderESQuerier.EXPECT().GetList(context.TODO(), filter(), nil, nil).Return(orders, nil)
+
+		service := svc.New(svc.Params{QrEs: orderESQuerier, CommentSearcher: commentSearcher, Now: now})
+
+		res, err := service.GetList(context.TODO(), userID, &orderModel.GetListRequest{Q: option.New(q)})
+
+		require.NoError(t, err)
+		require.Equal(t, orders, res)
+	})
+
+	t.Run("Comments of the tenant only", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderESQuerier  = orderMock.NewMockQuerier(ctrl)
+			commentSearcher = commentMock.NewMockSearcher(ctrl)
+
+			ctx = tenant.CtxWithScope(context.TODO(), tenant.Scope{TenantID: "tenant_id", UserID: userID, Role: tenant.RoleViewer})
+		)
+
+		commentSearcher.EXPECT().SearchOrders(ctx, &comment.SearchFilter{Q: q, TenantID: option.New("tenant_id")}, 1000).Return([]string{"commented_id"}, nil)
+		orderESQuerier.EXPECT().GetList(ctx, &orderModel.Filter{
+			TenantID:     option.New("tenant_id"),
+			Draft:        option.New(false),
+			Status:       option.New(int(orderModel.StatusCreated)),
+			Q:            option.New(q),
+			CommentedIDs: option.New([]string{"commented_id"}),
+		}, nil, nil).Return(orders, nil)
+
+		service := svc.New(svc.Params{QrEs: orderESQuerier, CommentSearcher: commentSearcher, Now: now})
+
+		res, err := service.GetList(ctx, userID, &orderModel.GetListRequest{Q: option.New(q)})
+
+		require.NoError(t, err)
+		require.Equal(t, orders, res)
+	})
+}
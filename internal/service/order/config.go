@@ -0,0 +1,25 @@
+package order
+
+import "time"
+
+type Config struct {
+	// ReservationTTL is how long the stock of a placed order stays reserved until it is paid
+	ReservationTTL time.Duration     `json:"reservation_ttl" yaml:"reservation_ttl" env:"RESERVATION_TTL" default:"30m"`
+	Approval       ApprovalConfig    `json:"approval" yaml:"approval" envPrefix:"APPROVAL_"`
+	Attachments    AttachmentsConfig `json:"attachments" yaml:"attachments" envPrefix:"ATTACHMENTS_"`
+}
+
+type ApprovalConfig struct {
+	// Thresholds are the totals in minor units per currency above which a tenant order must be
+	// approved, orders in other currencies always need an approval. Without thresholds no order does.
+	Thresholds map[string]int64 `json:"thresholds" yaml:"thresholds" env:"THRESHOLDS"`
+	// Timeout is how long the approvers have before the request escalates
+	Timeout time.Duration `json:"timeout" yaml:"timeout" env:"TIMEOUT" default:"24h"`
+}
+
+type AttachmentsConfig struct {
+	// MaxSize is the largest file in bytes
+	MaxSize int64 `json:"max_size" yaml:"max_size" env:"MAX_SIZE" default:"10485760"`
+	// ContentTypes are the types of the files accepted, detected from their content
+	ContentTypes []string `json:"content_types" yaml:"content_types" env:"CONTENT_TYPES" default:"[application/pdf,image/jpeg,image/png]"`
+}
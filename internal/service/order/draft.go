@@ -0,0 +1,305 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/approval"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/option"
+)
+
+func (s *service) AddLine(ctx context.Context, userID, id string, form *line.Form) (*line.Line, *orderModel.Order, error) {
+	if err := form.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		res  *line.Line
+		item *orderModel.Order
+	)
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		var (
+			lines []*line.Line
+			err   error
+		)
+
+		if item, lines, err = s.getDraft(ctx, userID, id); err != nil {
+			return err
+		}
+
+		if len(lines) >= line.MaxLines {
+			return fmt.Errorf("%w: order has more than %d lines", model.ErrInvalidArgument, line.MaxLines)
+		}
+
+		if form.UnitPrice > 0 && item.Totals.Currency == "" {
+			return fmt.Errorf("%w: currency is required for priced lines", model.ErrInvalidArgument)
+		}
+
+		res = line.New(item.ID, form, s.now, s.newID)
+
+		return s.changeLines(ctx, userID, item, append(lines, res), func(ctx context.Context) error {
+			if err := s.cmdLine.Create(ctx, res); err != nil {
+				return fmt.Errorf("line create: %w", err)
+			}
+
+			if err := s.cmdLine.Update(ctx, lines...); err != nil {
+				return fmt.Errorf("lines update: %w", err)
+			}
+
+			return nil
+		})
+	}); errTx != nil {
+		return nil, nil, errTx
+	}
+
+	return res, item, nil
+}
+
+func (s *service) UpdateLine(ctx context.Context, userID, id, lineID string, form *line.UpdateForm) (*line.Line, *orderModel.Order, error) {
+	if err := form.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		res  *line.Line
+		item *orderModel.Order
+	)
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		var (
+			lines []*line.Line
+			err   error
+		)
+
+		if item, lines, err = s.getDraft(ctx, userID, id); err != nil {
+			return err
+		}
+
+		for _, l := range lines {
+			if l.ID == lineID {
+				res = l
+			}
+		}
+
+		if res == nil {
+			return fmt.Errorf("get line: %w", model.ErrNotFound)
+		}
+
+		res.Quantity = form.Quantity
+
+		return s.changeLines(ctx, userID, item, lines, func(ctx context.Context) error {
+			if err := s.cmdLine.Update(ctx, lines...); err != nil {
+				return fmt.Errorf("lines update: %w", err)
+			}
+
+			return nil
+		})
+	}); errTx != nil {
+		return nil, nil, errTx
+	}
+
+	return res, item, nil
+}
+
+func (s *service) RemoveLine(ctx context.Context, userID, id, lineID string) (*orderModel.Order, error) {
+	var item *orderModel.Order
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		var (
+			lines []*line.Line
+			err   error
+		)
+
+		if item, lines, err = s.getDraft(ctx, userID, id); err != nil {
+			return err
+		}
+
+		left := make([]*line.Line, 0, len(lines))
+		for _, l := range lines {
+			if l.ID != lineID {
+				left = append(left, l)
+			}
+		}
+
+		if len(left) == len(lines) {
+			return fmt.Errorf("get line: %w", model.ErrNotFound)
+		}
+
+		return s.changeLines(ctx, userID, item, left, func(ctx context.Context) error {
+			if err := s.cmdLine.Delete(ctx, item.ID, lineID); err != nil {
+				return fmt.Errorf("line delete: %w", err)
+			}
+
+			if err := s.cmdLine.Update(ctx, left...); err != nil {
+				return fmt.Errorf("lines update: %w", err)
+			}
+
+			return nil
+		})
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	return item, nil
+}
+
+func (s *service) Checkout(ctx context.Context, userID, id string) (*orderModel.Order, error) {
+	var (
+		item *orderModel.Order
+		req  *approval.Approval
+	)
+
+	// the draft is checked out under its row lock, a concurrent checkout of it waits and
+	// finds it placed instead of redeeming the promo codes and reserving the stock again
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		var err error
+		if item, err = s.getLocked(ctx, userID, id, tenant.RoleEditor); err != nil {
+			return err
+		}
+
+		if !item.State.CanTransition(orderModel.StatePlaced) {
+			return fmt.Errorf("%w: order is %s", model.ErrConflict, item.State)
+		}
+
+		lines, err := s.qrLine.GetList(ctx, &line.Filter{OrderID: option.New(id)})
+		if err != nil {
+			return fmt.Errorf("get lines: %w", err)
+		}
+
+		if len(lines) == 0 {
+			return fmt.Errorf("%w: draft has no lines", model.ErrInvalidArgument)
+		}
+
+		before := *item
+
+		// the promo codes and the tax rates may have changed since the draft was priced
+		if err = s.recalculate(ctx, item, lines); err != nil {
+			return err
+		}
+
+		if err = s.cmdLine.Update(ctx, lines...); err != nil {
+			return fmt.Errorf("lines update: %w", err)
+		}
+
+		if s.needsApproval(item) {
+			if req, err = s.requestApproval(ctx, userID, item); err != nil {
+				return err
+			}
+
+			if err = s.cmdApproval.Create(ctx, req); err != nil {
+				return fmt.Errorf("approval create: %w", err)
+			}
+		} else {
+			item.State = orderModel.StatePlaced
+
+			if err = s.place(ctx, userID, item, lines); err != nil {
+				return err
+			}
+		}
+
+		return s.save(ctx, userID, history.ActionCheckout, &before, item, approval.Diff(nil, req)...)
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	if req != nil {
+		s.notifyApproval(ctx, userID, approval.EventRequested, req)
+	}
+
+	return item, nil
+}
+
+func (s *service) ExpireDrafts(ctx context.Context, modifiedBefore time.Time) (int, error) {
+	n, err := s.deleteAll(ctx, &orderModel.Filter{
+		Status:         option.New(int(orderModel.StatusCreated)),
+		Draft:          option.New(true),
+		ModifiedBefore: option.New(modifiedBefore),
+	})
+	if err != nil {
+		return n, fmt.Errorf("expire drafts: %w", err)
+	}
+
+	return n, nil
+}
+
+// getDraft locks the draft of the user and returns it with its lines, call it in the
+// transaction which saves the draft
+func (s *service) getDraft(ctx context.Context, userID, id string) (*orderModel.Order, []*line.Line, error) {
+	item, err := s.getLocked(ctx, userID, id, tenant.RoleEditor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !item.IsDraft() {
+		return nil, nil, fmt.Errorf("%w: order is %s, only the lines of a draft can change", model.ErrConflict, item.State)
+	}
+
+	lines, err := s.qrLine.GetList(ctx, &line.Filter{OrderID: option.New(id)})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get lines: %w", err)
+	}
+
+	return item, lines, nil
+}
+
+// changeLines reprices the draft with its new lines and saves it, write stores the lines,
+// call it in the transaction of getDraft
+func (s *service) changeLines(ctx context.Context, actorID string, item *orderModel.Order, lines []*line.Line, write func(ctx context.Context) error) error {
+	before := *item
+
+	if err := s.recalculate(ctx, item, lines); err != nil {
+		return err
+	}
+
+	if err := write(ctx); err != nil {
+		return err
+	}
+
+	return s.save(ctx, actorID, history.ActionLines, &before, item)
+}
+
+// recalculate prices the lines of a draft and its totals again
+func (s *service) recalculate(ctx context.Context, item *orderModel.Order, lines []*line.Line) error {
+	if err := s.reapplyPromoCodes(ctx, item, lines); err != nil {
+		return err
+	}
+
+	item.Taxes = nil
+	item.Totals.TaxInclusive = false
+	for _, l := range lines {
+		l.Tax = 0
+	}
+
+	if err := s.applyTaxes(ctx, item, lines); err != nil {
+		return err
+	}
+
+	item.Totals.Calculate(lines)
+
+	return nil
+}
+
+// deleteAll hard-deletes every order matching the filter from both stores
+func (s *service) deleteAll(ctx context.Context, filter *orderModel.Filter) (int, error) {
+	var total int
+
+	for {
+		n, err := s.deleteBatch(ctx, s.qrPg, filter, s.cmdPg, s.cmdEs)
+		if err != nil {
+			return total, err
+		}
+
+		total += n
+
+		if n < deleteBatchSize {
+			return total, nil
+		}
+	}
+}
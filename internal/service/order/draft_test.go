@@ -0,0 +1,664 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	attachmentMock "github.com/krivenkov/order/internal/model/attachment/mock"
+	commentMock "github.com/krivenkov/order/internal/model/comment/mock"
+	grantMock "github.com/krivenkov/order/internal/model/grant/mock"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	"github.com/krivenkov/order/internal/model/inventory"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	"github.com/krivenkov/order/internal/model/line"
+	lineMock "github.com/krivenkov/order/internal/model/line/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// a draft changes under the lock of its row
+	draftFilter = &orderModel.Filter{
+		Status:    option.New(int(orderModel.StatusCreated)),
+		IDs:       option.New([]string{newID().String()}),
+		ForUpdate: option.New(true),
+	}
+	draftLinesFilter = &line.Filter{OrderID: option.New(newID().String())}
+)
+
+func draft(userID string) *orderModel.Order {
+	return &orderModel.Order{
+		ID:       newID().String(),
+		TSCreate: now().Add(-time.Hour),
+		TSModify: now().Add(-time.Hour),
+		Status:   orderModel.StatusCreated,
+		State:    orderModel.StateDraft,
+		UserID:   userID,
+		Number:   "ORD-2000-000001",
+		Totals: orderModel.Totals{
+			Currency: "EUR",
+			Subtotal: 1000,
+			Total:    1000,
+		},
+	}
+}
+
+func draftLines() []*line.Line {
+	return []*line.Line{
+		{ID: "line-1", OrderID: newID().String(), SKU: "SKU-1", Quantity: 1, UnitPrice: 1000},
+	}
+}
+
+func TestCreateDraft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		userID = "user_id"
+
+		orderPGCommander = orderMock.NewMockCommander(ctrl)
+		orderESCommander = orderMock.NewMockCommander(ctrl)
+		historyCommander = historyMock.NewMockCommander(ctrl)
+		lineCommander    = lineMock.NewMockCommander(ctrl)
+		numberer         = orderMock.NewMockNumberer(ctrl)
+		tXer             = txerMock.NewMockTXer(ctrl)
+	)
+
+	tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+		return cb(ctx)
+	})
+
+	// a draft holds no stock and redeems no promo codes until the checkout
+	numberer.EXPECT().Next(context.TODO(), "", now()).Return("ORD-2000-000001", nil)
+	orderPGCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+	lineCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+	historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+	orderESCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+
+	service := svc.New(svc.Params{
+		CmdPg:      orderPGCommander,
+		CmdEs:      orderESCommander,
+		CmdHistory: historyCommander,
+		Numberer:   numberer,
+		CmdLine:    lineCommander,
+		TXer:       tXer,
+		Now:        now,
+		NewID:      newID,
+	})
+
+	res, err := service.Create(context.TODO(), userID, &orderModel.Form{
+		Name:     ptr.Pointer("cart"),
+		Currency: ptr.Pointer("EUR"),
+		Lines:    []*line.Form{{SKU: "SKU-1", Quantity: 2, UnitPrice: 500}},
+		Draft:    true,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, orderModel.StateDraft, res.State)
+	require.Equal(t, int64(1000), res.Totals.Total)
+}
+
+func TestAddLine(t *testing.T) {
+	userID := "user_id"
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineQuerier      = lineMock.NewMockQuerier(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			added = &line.Line{
+				ID:        newID().String(),
+				TSCreate:  now(),
+				OrderID:   newID().String(),
+				SKU:       "SKU-2",
+				Name:      "name",
+				Quantity:  2,
+				UnitPrice: 500,
+			}
+
+			expected = draft(userID)
+			entry    = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    userID,
+				Action:   history.ActionLines,
+				Changes: []*history.Change{
+					{Field: "total", Old: "1000", New: "2000"},
+				},
+			}
+		)
+
+		expected.TSModify = now()
+		expected.Totals.Subtotal = 2000
+		expected.Totals.Total = 2000
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(draft(userID), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), draftLinesFilter).Return(draftLines(), nil)
+		lineCommander.EXPECT().Create(context.TODO(), added).Return(nil)
+		lineCommander.EXPECT().Update(context.TODO(), draftLines()[0]).Return(nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			CmdHistory: historyCommander,
+			QrLine:     lineQuerier,
+			CmdLine:    lineCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, item, err := service.AddLine(context.TODO(), userID, newID().String(), &line.Form{
+			SKU:       "SKU-2",
+			Name:      "name",
+			Quantity:  2,
+			UnitPrice: 500,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, added, res)
+		require.Equal(t, expected, item)
+	})
+
+	t.Run("Placed order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			placed = draft(userID)
+		)
+
+		placed.State = orderModel.StatePlaced
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(placed, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			TXer:  tXer,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, _, err := service.AddLine(context.TODO(), userID, newID().String(), &line.Form{SKU: "SKU-2", Quantity: 1})
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Foreign draft", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tXer := txerMock.NewMockTXer(ctrl)
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(draft("other_user"), nil)
+
+		grantQuerier := grantMock.NewMockQuerier(ctrl)
+		grantQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:    orderPGQuerier,
+			QrGrant: grantQuerier,
+			TXer:    tXer,
+			Now:     now,
+			NewID:   newID,
+		})
+
+		_, _, err := service.AddLine(context.TODO(), userID, newID().String(), &line.Form{SKU: "SKU-2", Quantity: 1})
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Invalid form", func(t *testing.T) {
+		service := svc.New(svc.Params{
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, _, err := service.AddLine(context.TODO(), userID, newID().String(), &line.Form{SKU: "SKU-2"})
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+}
+
+func TestUpdateLine(t *testing.T) {
+	userID := "user_id"
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineQuerier      = lineMock.NewMockQuerier(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			changed  = draftLines()[0]
+			expected = draft(userID)
+		)
+
+		changed.Quantity = 3
+
+		expected.TSModify = now()
+		expected.Totals.Subtotal = 3000
+		expected.Totals.Total = 3000
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(draft(userID), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), draftLinesFilter).Return(draftLines(), nil)
+		lineCommander.EXPECT().Update(context.TODO(), changed).Return(nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			CmdHistory: historyCommander,
+			QrLine:     lineQuerier,
+			CmdLine:    lineCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, item, err := service.UpdateLine(context.TODO(), userID, newID().String(), "line-1", &line.UpdateForm{Quantity: 3})
+
+		require.NoError(t, err)
+		require.Equal(t, changed, res)
+		require.Equal(t, expected, item)
+	})
+
+	t.Run("Line not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			lineQuerier    = lineMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(draft(userID), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), draftLinesFilter).Return(draftLines(), nil)
+
+		service := svc.New(svc.Params{
+			QrPg:   orderPGQuerier,
+			QrLine: lineQuerier,
+			TXer:   tXer,
+			Now:    now,
+			NewID:  newID,
+		})
+
+		_, _, err := service.UpdateLine(context.TODO(), userID, newID().String(), "line-2", &line.UpdateForm{Quantity: 3})
+
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("Zero quantity", func(t *testing.T) {
+		service := svc.New(svc.Params{
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, _, err := service.UpdateLine(context.TODO(), userID, newID().String(), "line-1", &line.UpdateForm{})
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+}
+
+func TestRemoveLine(t *testing.T) {
+	userID := "user_id"
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineQuerier      = lineMock.NewMockQuerier(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			expected = draft(userID)
+		)
+
+		expected.TSModify = now()
+		expected.Totals.Subtotal = 0
+		expected.Totals.Total = 0
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(draft(userID), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), draftLinesFilter).Return(draftLines(), nil)
+		lineCommander.EXPECT().Delete(context.TODO(), newID().String(), "line-1").Return(nil)
+		lineCommander.EXPECT().Update(context.TODO()).Return(nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			CmdHistory: historyCommander,
+			QrLine:     lineQuerier,
+			CmdLine:    lineCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.RemoveLine(context.TODO(), userID, newID().String(), "line-1")
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Line not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			lineQuerier    = lineMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(draft(userID), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), draftLinesFilter).Return(draftLines(), nil)
+
+		service := svc.New(svc.Params{
+			QrPg:   orderPGQuerier,
+			QrLine: lineQuerier,
+			TXer:   tXer,
+			Now:    now,
+			NewID:  newID,
+		})
+
+		_, err := service.RemoveLine(context.TODO(), userID, newID().String(), "line-2")
+
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestCheckout(t *testing.T) {
+	userID := "user_id"
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineQuerier      = lineMock.NewMockQuerier(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			expected = draft(userID)
+			entry    = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    userID,
+				Action:   history.ActionCheckout,
+				Changes: []*history.Change{
+					{Field: "state", Old: "draft", New: "placed"},
+				},
+			}
+		)
+
+		expected.State = orderModel.StatePlaced
+		expected.TSModify = now()
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(draft(userID), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), draftLinesFilter).Return(draftLines(), nil)
+		lineCommander.EXPECT().Update(context.TODO(), draftLines()[0]).Return(nil)
+		reserver.EXPECT().Reserve(context.TODO(), &inventory.Reservation{
+			ID:       newID().String(),
+			TSCreate: now(),
+			TSExpire: ptr.Pointer(now().Add(time.Hour)),
+			OrderID:  newID().String(),
+			SKU:      "SKU-1",
+			Quantity: 1,
+			Status:   inventory.StatusHeld,
+		}).Return(nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			CmdHistory: historyCommander,
+			QrLine:     lineQuerier,
+			CmdLine:    lineCommander,
+			Reserver:   reserver,
+			Cfg:        svc.Config{ReservationTTL: time.Hour},
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.Checkout(context.TODO(), userID, newID().String())
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Out of stock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			lineQuerier    = lineMock.NewMockQuerier(ctrl)
+			lineCommander  = lineMock.NewMockCommander(ctrl)
+			reserver       = inventoryMock.NewMockReserver(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(draft(userID), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), draftLinesFilter).Return(draftLines(), nil)
+		lineCommander.EXPECT().Update(context.TODO(), gomock.Any()).Return(nil)
+		reserver.EXPECT().Reserve(context.TODO(), gomock.Any()).Return(inventory.ErrOutOfStock)
+
+		service := svc.New(svc.Params{
+			QrPg:     orderPGQuerier,
+			QrLine:   lineQuerier,
+			CmdLine:  lineCommander,
+			Reserver: reserver,
+			TXer:     tXer,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		_, err := service.Checkout(context.TODO(), userID, newID().String())
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("No lines", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			lineQuerier    = lineMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(draft(userID), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), draftLinesFilter).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:   orderPGQuerier,
+			QrLine: lineQuerier,
+			TXer:   tXer,
+			Now:    now,
+			NewID:  newID,
+		})
+
+		_, err := service.Checkout(context.TODO(), userID, newID().String())
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("Already placed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			placed = draft(userID)
+		)
+
+		placed.State = orderModel.StatePlaced
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), draftFilter).Return(placed, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			TXer:  tXer,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.Checkout(context.TODO(), userID, newID().String())
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+}
+
+func TestExpireDrafts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		modifiedBefore = now().Add(-7 * 24 * time.Hour)
+
+		orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+		orderPGCommander = orderMock.NewMockCommander(ctrl)
+		orderESCommander = orderMock.NewMockCommander(ctrl)
+		reserver         = inventoryMock.NewMockReserver(ctrl)
+		attachmentQr     = attachmentMock.NewMockQuerier(ctrl)
+		commentESCmd     = commentMock.NewMockCommander(ctrl)
+		tXer             = txerMock.NewMockTXer(ctrl)
+
+		expired = draft("user_id")
+	)
+
+	tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+		return cb(ctx)
+	})
+
+	orderPGQuerier.EXPECT().GetList(context.TODO(), &orderModel.Filter{
+		Status:         option.New(int(orderModel.StatusCreated)),
+		Draft:          option.New(true),
+		ModifiedBefore: option.New(modifiedBefore),
+	}, nil, &paginator.Pagination{Limit: 100}).Return([]*orderModel.Order{expired}, nil)
+	attachmentQr.EXPECT().GetList(context.TODO(), &attachment.Filter{OrderIDs: option.New([]string{newID().String()})}).Return(nil, nil)
+	reserver.EXPECT().Release(context.TODO(), newID().String()).Return(nil)
+	orderPGCommander.EXPECT().Delete(context.TODO(), expired).Return(nil)
+	orderESCommander.EXPECT().Delete(context.TODO(), expired).Return(nil)
+	commentESCmd.EXPECT().DeleteByOrders(context.TODO(), newID().String()).Return(nil)
+
+	service := svc.New(svc.Params{
+		CmdPg:        orderPGCommander,
+		CmdEs:        orderESCommander,
+		QrPg:         orderPGQuerier,
+		QrAttachment: attachmentQr,
+		CmdEsComment: commentESCmd,
+		Reserver:     reserver,
+		TXer:         tXer,
+		Now:          now,
+		NewID:        newID,
+	})
+
+	n, err := service.ExpireDrafts(context.TODO(), modifiedBefore)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
@@ -0,0 +1,170 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/erasure"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+const deleteBatchSize = 100
+
+func (s *service) Erase(ctx context.Context, req *orderModel.EraseRequest) (*erasure.Erasure, error) {
+	receipt := erasure.New(req.UserID, req.Source, req.RequestedBy, s.now, s.newID)
+
+	// the orders the user created in a tenant belong to the tenant, they are kept
+	filter := &orderModel.Filter{
+		UserID:   option.New(req.UserID),
+		TenantID: option.New(""),
+	}
+
+	// templates go first, so the scheduler creates no orders during the erasure
+	if err := s.cmdRecurring.DeleteByUser(ctx, req.UserID); err != nil {
+		return nil, fmt.Errorf("erase recurring templates: %w", err)
+	}
+
+	// postgres is the source of truth, every batch is removed from both stores
+	for {
+		n, err := s.deleteBatch(ctx, s.qrPg, filter, s.cmdPg, s.cmdEs)
+		if err != nil {
+			return nil, fmt.Errorf("erase orders: %w", err)
+		}
+
+		receipt.Orders += n
+
+		if n < deleteBatchSize {
+			break
+		}
+	}
+
+	// documents left in es by a previously failed sync
+	for {
+		n, err := s.deleteBatch(ctx, s.qrEs, filter, s.cmdEs)
+		if err != nil {
+			return nil, fmt.Errorf("erase es orders: %w", err)
+		}
+
+		receipt.Orders += n
+
+		if n < deleteBatchSize {
+			break
+		}
+	}
+
+	// what the user said and did on the orders left, the ones of others and of tenants, is kept
+	// without the user, the access the user had to them goes
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		if err := s.cmdComment.ReplaceAuthor(ctx, req.UserID, erasure.ErasedUser); err != nil {
+			return fmt.Errorf("comments: %w", err)
+		}
+
+		if err := s.cmdEsComment.ReplaceAuthor(ctx, req.UserID, erasure.ErasedUser); err != nil {
+			return fmt.Errorf("es comments: %w", err)
+		}
+
+		if err := s.cmdHistory.ReplaceActor(ctx, req.UserID, erasure.ErasedUser); err != nil {
+			return fmt.Errorf("history: %w", err)
+		}
+
+		if err := s.cmdAttachment.ReplaceUser(ctx, req.UserID, erasure.ErasedUser); err != nil {
+			return fmt.Errorf("attachments: %w", err)
+		}
+
+		// the redemptions stay counted in the uses of the codes
+		if err := s.cmdPromo.ReplaceUser(ctx, req.UserID, erasure.ErasedUser); err != nil {
+			return fmt.Errorf("promo redemptions: %w", err)
+		}
+
+		if err := s.cmdGrant.ReplaceGrantor(ctx, req.UserID, erasure.ErasedUser); err != nil {
+			return fmt.Errorf("granted: %w", err)
+		}
+
+		if err := s.cmdGrant.DeleteByUser(ctx, req.UserID); err != nil {
+			return fmt.Errorf("grants: %w", err)
+		}
+
+		if err := s.cmdTenant.RemoveUser(ctx, req.UserID); err != nil {
+			return fmt.Errorf("tenant members: %w", err)
+		}
+
+		// no order of the user is left for a late user event to change
+		if err := s.cmdLedger.Forget(ctx, req.UserID); err != nil {
+			return fmt.Errorf("event versions: %w", err)
+		}
+
+		return nil
+	}); errTx != nil {
+		return nil, fmt.Errorf("anonymise user: %w", errTx)
+	}
+
+	if err := s.cmdErasure.Create(ctx, receipt); err != nil {
+		return nil, fmt.Errorf("save erasure receipt: %w", err)
+	}
+
+	if err := s.erased.Publish(ctx, bus.Message[erasure.Erasure]{
+		Key: receipt.UserID,
+		Value: bus.MessageValue[erasure.Erasure]{
+			CommandID: receipt.ID,
+			UserID:    receipt.RequestedBy,
+			CreatedAt: receipt.TSCreate,
+			Payload:   receipt,
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("publish erasure: %w", err)
+	}
+
+	return receipt, nil
+}
+
+// deleteBatch hard-deletes the next batch of orders matching the filter in every store
+func (s *service) deleteBatch(ctx context.Context, qr orderModel.Querier, filter *orderModel.Filter, cmds ...orderModel.Commander) (int, error) {
+	items, err := qr.GetList(ctx, filter, nil, &paginator.Pagination{Limit: deleteBatchSize})
+	if err != nil {
+		return 0, fmt.Errorf("get orders: %w", err)
+	}
+
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	if err = s.deleteAttachments(ctx, ids); err != nil {
+		return 0, err
+	}
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		// the stock held by the orders goes back before the reservations are deleted with them
+		if err := s.reserver.Release(ctx, ids...); err != nil {
+			return fmt.Errorf("release stock: %w", err)
+		}
+
+		for _, item := range items {
+			for _, cmd := range cmds {
+				if err := cmd.Delete(ctx, item); err != nil && !errors.Is(err, model.ErrNotFound) {
+					return fmt.Errorf("delete order %s: %w", item.ID, err)
+				}
+			}
+		}
+
+		// the rows of the comments go with the orders, their documents do not
+		if err := s.cmdEsComment.DeleteByOrders(ctx, ids...); err != nil {
+			return fmt.Errorf("delete comments: %w", err)
+		}
+
+		return nil
+	}); errTx != nil {
+		return 0, errTx
+	}
+
+	return len(items), nil
+}
@@ -0,0 +1,307 @@
+package order_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	attachmentMock "github.com/krivenkov/order/internal/model/attachment/mock"
+	commentMock "github.com/krivenkov/order/internal/model/comment/mock"
+	"github.com/krivenkov/order/internal/model/erasure"
+	erasureMock "github.com/krivenkov/order/internal/model/erasure/mock"
+	grantMock "github.com/krivenkov/order/internal/model/grant/mock"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	ledgerMock "github.com/krivenkov/order/internal/model/ledger/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	promoMock "github.com/krivenkov/order/internal/model/promo/mock"
+	recurringMock "github.com/krivenkov/order/internal/model/recurring/mock"
+	tenantMock "github.com/krivenkov/order/internal/model/tenant/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type publisherStub[T any] struct {
+	messages []bus.Message[T]
+	err      error
+}
+
+func (p *publisherStub[T]) Publish(_ context.Context, messages ...bus.Message[T]) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.messages = append(p.messages, messages...)
+
+	return nil
+}
+
+func TestErase(t *testing.T) {
+	var (
+		userID  = "user_id"
+		adminID = "admin_id"
+
+		// orders of a tenant created by the user are not erased
+		filter = &orderModel.Filter{
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+		}
+		pagination = &paginator.Pagination{Limit: 100}
+
+		items = []*orderModel.Order{
+			{ID: "1", UserID: userID, Status: orderModel.StatusCreated},
+			{ID: "2", UserID: userID, Status: orderModel.StatusDeleted},
+		}
+		stray = []*orderModel.Order{
+			{ID: "3", UserID: userID, Status: orderModel.StatusCreated},
+		}
+
+		req = &orderModel.EraseRequest{
+			UserID:      userID,
+			Source:      erasure.SourceHTTP,
+			RequestedBy: adminID,
+		}
+
+		receipt = &erasure.Erasure{
+			ID:          newID().String(),
+			TSCreate:    now(),
+			UserID:      userID,
+			Source:      erasure.SourceHTTP,
+			RequestedBy: adminID,
+			Orders:      3,
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			erasureCommander = erasureMock.NewMockCommander(ctrl)
+			recurringCmd     = recurringMock.NewMockCommander(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			attachmentQr     = attachmentMock.NewMockQuerier(ctrl)
+			commentPGCmd     = commentMock.NewMockCommander(ctrl)
+			commentESCmd     = commentMock.NewMockCommander(ctrl)
+			historyCmd       = historyMock.NewMockCommander(ctrl)
+			attachmentCmd    = attachmentMock.NewMockCommander(ctrl)
+			promoCmd         = promoMock.NewMockCommander(ctrl)
+			grantCmd         = grantMock.NewMockCommander(ctrl)
+			tenantCmd        = tenantMock.NewMockCommander(ctrl)
+			ledgerCmd        = ledgerMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+			erased           = &publisherStub[erasure.Erasure]{}
+		)
+
+		recurringCmd.EXPECT().DeleteByUser(context.TODO(), userID).Return(nil)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).AnyTimes()
+
+		orderPGQuerier.EXPECT().GetList(context.TODO(), filter, nil, pagination).Return(items, nil)
+		orderESQuerier.EXPECT().GetList(context.TODO(), filter, nil, pagination).Return(stray, nil)
+		attachmentQr.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil).Times(2)
+
+		reserver.EXPECT().Release(context.TODO(), "1", "2").Return(nil)
+		reserver.EXPECT().Release(context.TODO(), "3").Return(nil)
+
+		for _, item := range items {
+			orderPGCommander.EXPECT().Delete(context.TODO(), item).Return(nil)
+		}
+
+		orderESCommander.EXPECT().Delete(context.TODO(), items[0]).Return(nil)
+		orderESCommander.EXPECT().Delete(context.TODO(), items[1]).Return(model.ErrNotFound)
+		orderESCommander.EXPECT().Delete(context.TODO(), stray[0]).Return(nil)
+
+		commentESCmd.EXPECT().DeleteByOrders(context.TODO(), "1", "2").Return(nil)
+		commentESCmd.EXPECT().DeleteByOrders(context.TODO(), "3").Return(nil)
+
+		// what the user wrote on the orders of others stays without the user
+		commentPGCmd.EXPECT().ReplaceAuthor(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		commentESCmd.EXPECT().ReplaceAuthor(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		historyCmd.EXPECT().ReplaceActor(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		attachmentCmd.EXPECT().ReplaceUser(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		promoCmd.EXPECT().ReplaceUser(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		grantCmd.EXPECT().ReplaceGrantor(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		grantCmd.EXPECT().DeleteByUser(context.TODO(), userID).Return(nil)
+		tenantCmd.EXPECT().RemoveUser(context.TODO(), userID).Return(nil)
+		ledgerCmd.EXPECT().Forget(context.TODO(), userID).Return(nil)
+
+		erasureCommander.EXPECT().Create(context.TODO(), receipt).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:         orderPGCommander,
+			CmdEs:         orderESCommander,
+			QrPg:          orderPGQuerier,
+			QrEs:          orderESQuerier,
+			CmdErasure:    erasureCommander,
+			Erased:        erased,
+			CmdRecurring:  recurringCmd,
+			QrAttachment:  attachmentQr,
+			CmdComment:    commentPGCmd,
+			CmdEsComment:  commentESCmd,
+			CmdHistory:    historyCmd,
+			CmdAttachment: attachmentCmd,
+			CmdPromo:      promoCmd,
+			CmdGrant:      grantCmd,
+			CmdTenant:     tenantCmd,
+			CmdLedger:     ledgerCmd,
+			Reserver:      reserver,
+			TXer:          tXer,
+			Now:           now,
+			NewID:         newID,
+		})
+
+		res, err := service.Erase(context.TODO(), req)
+
+		require.NoError(t, err)
+		require.Equal(t, receipt, res)
+		require.Len(t, erased.messages, 1)
+		require.Equal(t, userID, erased.messages[0].Key)
+		require.Equal(t, receipt, erased.messages[0].Value.Payload)
+	})
+
+	t.Run("Bad delete in es", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			erasureCommander = erasureMock.NewMockCommander(ctrl)
+			recurringCmd     = recurringMock.NewMockCommander(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			attachmentQr     = attachmentMock.NewMockQuerier(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+			erased           = &publisherStub[erasure.Erasure]{}
+
+			someErr = fmt.Errorf("some error")
+		)
+
+		recurringCmd.EXPECT().DeleteByUser(context.TODO(), userID).Return(nil)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).AnyTimes()
+
+		orderPGQuerier.EXPECT().GetList(context.TODO(), filter, nil, pagination).Return(items, nil)
+		attachmentQr.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil)
+		reserver.EXPECT().Release(context.TODO(), "1", "2").Return(nil)
+		orderPGCommander.EXPECT().Delete(context.TODO(), items[0]).Return(nil)
+		orderESCommander.EXPECT().Delete(context.TODO(), items[0]).Return(someErr)
+
+		service := svc.New(svc.Params{
+			CmdPg:        orderPGCommander,
+			CmdEs:        orderESCommander,
+			QrPg:         orderPGQuerier,
+			QrEs:         orderESQuerier,
+			CmdErasure:   erasureCommander,
+			Erased:       erased,
+			CmdRecurring: recurringCmd,
+			QrAttachment: attachmentQr,
+			Reserver:     reserver,
+			TXer:         tXer,
+			Now:          now,
+			NewID:        newID,
+		})
+
+		_, err := service.Erase(context.TODO(), req)
+
+		require.ErrorIs(t, err, someErr)
+		require.Empty(t, erased.messages)
+	})
+
+	t.Run("Bad publish", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			erasureCommander = erasureMock.NewMockCommander(ctrl)
+			recurringCmd     = recurringMock.NewMockCommander(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			commentPGCmd     = commentMock.NewMockCommander(ctrl)
+			commentESCmd     = commentMock.NewMockCommander(ctrl)
+			historyCmd       = historyMock.NewMockCommander(ctrl)
+			attachmentCmd    = attachmentMock.NewMockCommander(ctrl)
+			promoCmd         = promoMock.NewMockCommander(ctrl)
+			grantCmd         = grantMock.NewMockCommander(ctrl)
+			tenantCmd        = tenantMock.NewMockCommander(ctrl)
+			ledgerCmd        = ledgerMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			someErr = fmt.Errorf("some error")
+			erased  = &publisherStub[erasure.Erasure]{err: someErr}
+
+			empty = &erasure.Erasure{
+				ID:          newID().String(),
+				TSCreate:    now(),
+				UserID:      userID,
+				Source:      erasure.SourceHTTP,
+				RequestedBy: adminID,
+			}
+		)
+
+		recurringCmd.EXPECT().DeleteByUser(context.TODO(), userID).Return(nil)
+		orderPGQuerier.EXPECT().GetList(context.TODO(), filter, nil, pagination).Return(nil, nil)
+		orderESQuerier.EXPECT().GetList(context.TODO(), filter, nil, pagination).Return(nil, nil)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		commentPGCmd.EXPECT().ReplaceAuthor(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		commentESCmd.EXPECT().ReplaceAuthor(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		historyCmd.EXPECT().ReplaceActor(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		attachmentCmd.EXPECT().ReplaceUser(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		promoCmd.EXPECT().ReplaceUser(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		grantCmd.EXPECT().ReplaceGrantor(context.TODO(), userID, erasure.ErasedUser).Return(nil)
+		grantCmd.EXPECT().DeleteByUser(context.TODO(), userID).Return(nil)
+		tenantCmd.EXPECT().RemoveUser(context.TODO(), userID).Return(nil)
+		ledgerCmd.EXPECT().Forget(context.TODO(), userID).Return(nil)
+		erasureCommander.EXPECT().Create(context.TODO(), empty).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:         orderPGCommander,
+			CmdEs:         orderESCommander,
+			QrPg:          orderPGQuerier,
+			QrEs:          orderESQuerier,
+			CmdErasure:    erasureCommander,
+			Erased:        erased,
+			CmdRecurring:  recurringCmd,
+			CmdComment:    commentPGCmd,
+			CmdEsComment:  commentESCmd,
+			CmdHistory:    historyCmd,
+			CmdAttachment: attachmentCmd,
+			CmdPromo:      promoCmd,
+			CmdGrant:      grantCmd,
+			CmdTenant:     tenantCmd,
+			CmdLedger:     ledgerCmd,
+			Reserver:      reserver,
+			TXer:          tXer,
+			Now:           now,
+			NewID:         newID,
+		})
+
+		_, err := service.Erase(context.TODO(), req)
+
+		require.ErrorIs(t, err, someErr)
+	})
+}
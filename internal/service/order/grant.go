@@ -0,0 +1,172 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/grant"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/option"
+)
+
+// grantPermissions are what a grant must allow to act on a shared order with the role,
+// nothing a grantee does needs more than an editor
+var grantPermissions = map[tenant.Role]grant.Permission{
+	tenant.RoleViewer: grant.PermissionRead,
+	tenant.RoleEditor: grant.PermissionEdit,
+}
+
+// authorize resolves whether the user may act on the order with the role, as its owner or
+// through an active grant on a personal order
+func (s *service) authorize(ctx context.Context, userID string, item *orderModel.Order, role tenant.Role) error {
+	err := authorizeOwner(ctx, userID, item, role)
+	if err == nil || !errors.Is(err, model.ErrPermissionDenied) {
+		return err
+	}
+
+	if _, ok := tenant.ScopeFromContext(ctx); ok || item.TenantID != "" {
+		return err
+	}
+
+	required, ok := grantPermissions[role]
+	if !ok {
+		return err
+	}
+
+	grants, errGet := s.qrGrant.GetList(ctx, &grant.Filter{
+		OrderID:  option.New(item.ID),
+		UserID:   option.New(userID),
+		ActiveAt: option.New(s.now()),
+	})
+	if errGet != nil {
+		return fmt.Errorf("get grants: %w", errGet)
+	}
+
+	for _, g := range grants {
+		if g.Permission.Allows(required) {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// includeShared adds the orders shared with the user to the personal ones of the filter
+func (s *service) includeShared(ctx context.Context, userID string, filter *orderModel.Filter) error {
+	if _, ok := tenant.ScopeFromContext(ctx); ok {
+		return nil
+	}
+
+	grants, err := s.qrGrant.GetList(ctx, &grant.Filter{
+		UserID:   option.New(userID),
+		ActiveAt: option.New(s.now()),
+	})
+	if err != nil {
+		return fmt.Errorf("get grants: %w", err)
+	}
+
+	if len(grants) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(grants))
+	for _, g := range grants {
+		ids = append(ids, g.OrderID)
+	}
+
+	filter.SharedIDs = option.New(ids)
+
+	return nil
+}
+
+func (s *service) GetGrants(ctx context.Context, userID, id string) ([]*grant.Grant, error) {
+	if _, err := s.getShareable(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	grants, err := s.qrGrant.GetList(ctx, &grant.Filter{OrderID: option.New(id)})
+	if err != nil {
+		return nil, fmt.Errorf("get grants: %w", err)
+	}
+
+	return grants, nil
+}
+
+func (s *service) Share(ctx context.Context, userID, id, granteeID string, form *grant.Form) (*grant.Grant, error) {
+	if err := form.Validate(s.now()); err != nil {
+		return nil, err
+	}
+
+	if granteeID == userID {
+		return nil, fmt.Errorf("%w: an order cannot be shared with its owner", model.ErrInvalidArgument)
+	}
+
+	item, err := s.getShareable(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := s.qrGrant.GetList(ctx, &grant.Filter{OrderID: option.New(id)})
+	if err != nil {
+		return nil, fmt.Errorf("get grants: %w", err)
+	}
+
+	res := grant.New(item.ID, granteeID, userID, form, s.now)
+
+	var existing *grant.Grant
+	for _, g := range grants {
+		if g.UserID == granteeID {
+			existing = g
+		}
+	}
+
+	switch {
+	case existing != nil:
+		// a changed grant keeps the time it was first given
+		res.TSCreate = existing.TSCreate
+	case len(grants) >= grant.MaxGrants:
+		return nil, fmt.Errorf("%w: order is shared with %d users already", model.ErrConflict, grant.MaxGrants)
+	}
+
+	if err = s.cmdGrant.Save(ctx, res); err != nil {
+		return nil, fmt.Errorf("save grant: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *service) Unshare(ctx context.Context, userID, id, granteeID string) error {
+	if _, err := s.getShareable(ctx, userID, id); err != nil {
+		return err
+	}
+
+	if err := s.cmdGrant.Delete(ctx, id, granteeID); err != nil {
+		return fmt.Errorf("delete grant: %w", err)
+	}
+
+	return nil
+}
+
+// getShareable returns the personal order of the user, only its owner shares it
+func (s *service) getShareable(ctx context.Context, userID, id string) (*orderModel.Order, error) {
+	item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusCreated)),
+		IDs:    option.New([]string{id}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get item: %w", err)
+	}
+
+	if err = authorizeOwner(ctx, userID, item, tenant.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	if item.TenantID != "" {
+		return nil, fmt.Errorf("%w: orders of an organisation are shared through its members", model.ErrInvalidArgument)
+	}
+
+	return item, nil
+}
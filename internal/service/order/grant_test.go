@@ -0,0 +1,308 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/grant"
+	grantMock "github.com/krivenkov/order/internal/model/grant/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/ptr"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorizeGrant(t *testing.T) {
+	var (
+		ownerID   = "owner_id"
+		granteeID = "grantee_id"
+		orderID   = newID().String()
+
+		filter = &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:    option.New([]string{orderID}),
+		}
+		grantFilter = &grant.Filter{
+			OrderID:  option.New(orderID),
+			UserID:   option.New(granteeID),
+			ActiveAt: option.New(now()),
+		}
+
+		item = func() *orderModel.Order {
+			return &orderModel.Order{ID: orderID, UserID: ownerID, Status: orderModel.StatusCreated}
+		}
+		shared = func(permission grant.Permission) []*grant.Grant {
+			return []*grant.Grant{{OrderID: orderID, UserID: granteeID, Permission: permission, GrantedBy: ownerID}}
+		}
+	)
+
+	t.Run("Grantee reads", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			grantQuerier   = grantMock.NewMockQuerier(ctrl)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(item(), nil)
+		grantQuerier.EXPECT().GetList(context.TODO(), grantFilter).Return(shared(grant.PermissionRead), nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrGrant: grantQuerier, Now: now})
+
+		res, err := service.GetItem(context.TODO(), granteeID, orderID)
+
+		require.NoError(t, err)
+		require.Equal(t, item(), res)
+	})
+
+	t.Run("Read grant does not edit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			grantQuerier   = grantMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			locked = &orderModel.Filter{
+				Status:    option.New(int(orderModel.StatusCreated)),
+				IDs:       option.New([]string{orderID}),
+				ForUpdate: option.New(true),
+			}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), locked).Return(item(), nil)
+		grantQuerier.EXPECT().GetList(context.TODO(), grantFilter).Return(shared(grant.PermissionRead), nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrGrant: grantQuerier, TXer: tXer, Now: now})
+
+		_, err := service.Update(context.TODO(), granteeID, orderID, &orderModel.Form{Name: ptr.Pointer("test")})
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Grant of a tenant order is not looked up", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+
+		tenantOrder := item()
+		tenantOrder.TenantID = "tenant_id"
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(tenantOrder, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, Now: now})
+
+		_, err := service.GetItem(context.TODO(), granteeID, orderID)
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+}
+
+func TestShare(t *testing.T) {
+	var (
+		ownerID   = "owner_id"
+		granteeID = "grantee_id"
+		orderID   = newID().String()
+
+		filter = &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:    option.New([]string{orderID}),
+		}
+		grantsFilter = &grant.Filter{OrderID: option.New(orderID)}
+
+		form = &grant.Form{Permission: grant.PermissionEdit}
+
+		item = func() *orderModel.Order {
+			return &orderModel.Order{ID: orderID, UserID: ownerID, Status: orderModel.StatusCreated}
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			grantQuerier   = grantMock.NewMockQuerier(ctrl)
+			grantCommander = grantMock.NewMockCommander(ctrl)
+
+			expected = &grant.Grant{
+				OrderID:    orderID,
+				UserID:     granteeID,
+				Permission: grant.PermissionEdit,
+				GrantedBy:  ownerID,
+				TSCreate:   now(),
+			}
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(item(), nil)
+		grantQuerier.EXPECT().GetList(context.TODO(), grantsFilter).Return(nil, nil)
+		grantCommander.EXPECT().Save(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrGrant: grantQuerier, CmdGrant: grantCommander, Now: now})
+
+		res, err := service.Share(context.TODO(), ownerID, orderID, granteeID, form)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Changed grant keeps its creation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			grantQuerier   = grantMock.NewMockQuerier(ctrl)
+			grantCommander = grantMock.NewMockCommander(ctrl)
+
+			created = now().AddDate(0, -1, 0)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(item(), nil)
+		grantQuerier.EXPECT().GetList(context.TODO(), grantsFilter).Return([]*grant.Grant{
+			{OrderID: orderID, UserID: granteeID, Permission: grant.PermissionRead, GrantedBy: ownerID, TSCreate: created},
+		}, nil)
+		grantCommander.EXPECT().Save(context.TODO(), &grant.Grant{
+			OrderID:    orderID,
+			UserID:     granteeID,
+			Permission: grant.PermissionEdit,
+			GrantedBy:  ownerID,
+			TSCreate:   created,
+		}).Return(nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrGrant: grantQuerier, CmdGrant: grantCommander, Now: now})
+
+		_, err := service.Share(context.TODO(), ownerID, orderID, granteeID, form)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Too many grants", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			grantQuerier   = grantMock.NewMockQuerier(ctrl)
+
+			grants = make([]*grant.Grant, 0, grant.MaxGrants)
+		)
+
+		for range grant.MaxGrants {
+			grants = append(grants, &grant.Grant{OrderID: orderID, UserID: "other_id", Permission: grant.PermissionRead})
+		}
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(item(), nil)
+		grantQuerier.EXPECT().GetList(context.TODO(), grantsFilter).Return(grants, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, QrGrant: grantQuerier, Now: now})
+
+		_, err := service.Share(context.TODO(), ownerID, orderID, granteeID, form)
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Grantee cannot share", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(item(), nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, Now: now})
+
+		_, err := service.Share(context.TODO(), granteeID, orderID, "other_id", form)
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("With its owner", func(t *testing.T) {
+		service := svc.New(svc.Params{Now: now})
+
+		_, err := service.Share(context.TODO(), ownerID, orderID, ownerID, form)
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		service := svc.New(svc.Params{Now: now})
+
+		_, err := service.Share(context.TODO(), ownerID, orderID, granteeID, &grant.Form{
+			Permission: grant.PermissionRead,
+			TSExpire:   ptr.Pointer(now().Add(-1)),
+		})
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+}
+
+func TestUnshare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ownerID = "owner_id"
+		orderID = newID().String()
+
+		orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+		grantCommander = grantMock.NewMockCommander(ctrl)
+	)
+
+	orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(&orderModel.Order{ID: orderID, UserID: ownerID}, nil)
+	grantCommander.EXPECT().Delete(context.TODO(), orderID, "grantee_id").Return(model.ErrNotFound)
+
+	service := svc.New(svc.Params{QrPg: orderPGQuerier, CmdGrant: grantCommander, Now: now})
+
+	err := service.Unshare(context.TODO(), ownerID, orderID, "grantee_id")
+
+	require.ErrorIs(t, err, model.ErrNotFound)
+}
+
+func TestGetListIncludeShared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		userID = "user_id"
+
+		orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+		grantQuerier   = grantMock.NewMockQuerier(ctrl)
+
+		orders = []*orderModel.Order{{ID: "shared_id", UserID: "owner_id"}}
+	)
+
+	grantQuerier.EXPECT().GetList(context.TODO(), &grant.Filter{
+		UserID:   option.New(userID),
+		ActiveAt: option.New(now()),
+	}).Return([]*grant.Grant{{OrderID: "shared_id", UserID: userID, Permission: grant.PermissionRead}}, nil)
+
+	orderPGQuerier.EXPECT().GetList(context.TODO(), &orderModel.Filter{
+		UserID:    option.New(userID),
+		SharedIDs: option.New([]string{"shared_id"}),
+		TenantID:  option.New(""),
+		Draft:     option.New(false),
+		Status:    option.New(int(orderModel.StatusCreated)),
+	}, nil, nil).Return(orders, nil)
+
+	service := svc.New(svc.Params{QrPg: orderPGQuerier, QrGrant: grantQuerier, Now: now})
+
+	res, err := service.GetList(context.TODO(), userID, &orderModel.GetListRequest{
+		IncludeShared: option.New(true),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, orders, res)
+}
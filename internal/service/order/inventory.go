@@ -0,0 +1,72 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/model/inventory"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/pkg/option"
+)
+
+// place redeems the promo codes of the order and reserves its stock, it is called inside a transaction
+func (s *service) place(ctx context.Context, userID string, item *orderModel.Order, lines []*line.Line) error {
+	if len(item.Discounts) > 0 {
+		redemptions := promo.NewRedemptions(item.ID, userID, item.Discounts, s.now, s.newID)
+		if err := s.cmdPromo.Redeem(ctx, redemptions...); err != nil {
+			return fmt.Errorf("promo codes redeem: %w", err)
+		}
+	}
+
+	return s.reserve(ctx, item, lines)
+}
+
+// reserve holds the stock of the order lines, it is called inside a transaction. The stock
+// of an order awaiting payment expires after the TTL, the stock of a paid order is kept until
+// it is fulfilled.
+func (s *service) reserve(ctx context.Context, item *orderModel.Order, lines []*line.Line) error {
+	var expire *time.Time
+	if item.State != orderModel.StatePaid {
+		t := s.now().Add(s.reservationTTL)
+		expire = &t
+	}
+
+	reservations := inventory.NewReservations(item.ID, lines, expire, s.now, s.newID)
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	if err := s.reserver.Reserve(ctx, reservations...); err != nil {
+		return fmt.Errorf("reserve stock: %w", err)
+	}
+
+	return nil
+}
+
+// reserveAgain holds the stock of a restored order which has not shipped yet
+func (s *service) reserveAgain(ctx context.Context, item *orderModel.Order) error {
+	switch item.State {
+	case orderModel.StatePlaced, orderModel.StatePaymentFailed, orderModel.StatePaid:
+	default:
+		return nil
+	}
+
+	lines, err := s.qrLine.GetList(ctx, &line.Filter{OrderID: option.New(item.ID)})
+	if err != nil {
+		return fmt.Errorf("get lines: %w", err)
+	}
+
+	return s.reserve(ctx, item, lines)
+}
+
+func (s *service) ExpireReservations(ctx context.Context, before time.Time) (int, error) {
+	n, err := s.reserver.Expire(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("expire reservations: %w", err)
+	}
+
+	return n, nil
+}
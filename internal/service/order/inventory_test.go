@@ -0,0 +1,287 @@
+package order_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	"github.com/krivenkov/order/internal/model/inventory"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	"github.com/krivenkov/order/internal/model/line"
+	lineMock "github.com/krivenkov/order/internal/model/line/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/ptr"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOutOfStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		userID = "user_id"
+
+		orderPGCommander = orderMock.NewMockCommander(ctrl)
+		lineCommander    = lineMock.NewMockCommander(ctrl)
+		numberer         = orderMock.NewMockNumberer(ctrl)
+		reserver         = inventoryMock.NewMockReserver(ctrl)
+		tXer             = txerMock.NewMockTXer(ctrl)
+
+		reservation = func(sku string, quantity int) *inventory.Reservation {
+			return &inventory.Reservation{
+				ID:       newID().String(),
+				TSCreate: now(),
+				TSExpire: ptr.Pointer(now().Add(time.Hour)),
+				OrderID:  newID().String(),
+				SKU:      sku,
+				Quantity: quantity,
+				Status:   inventory.StatusHeld,
+			}
+		}
+	)
+
+	tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+		return cb(ctx)
+	})
+
+	numberer.EXPECT().Next(context.TODO(), "", now()).Return("ORD-2000-000001", nil)
+	orderPGCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+	lineCommander.EXPECT().Create(context.TODO(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	// the lines of a SKU are held together and the SKUs come in order
+	reserver.EXPECT().Reserve(context.TODO(), reservation("SKU-1", 3), reservation("SKU-2", 1)).
+		Return(fmt.Errorf("%w: SKU-2", inventory.ErrOutOfStock))
+
+	service := svc.New(svc.Params{
+		CmdPg:    orderPGCommander,
+		Numberer: numberer,
+		CmdLine:  lineCommander,
+		Reserver: reserver,
+		Cfg:      svc.Config{ReservationTTL: time.Hour},
+		TXer:     tXer,
+		Now:      now,
+		NewID:    newID,
+	})
+
+	res, err := service.Create(context.TODO(), userID, &orderModel.Form{
+		Lines: []*line.Form{
+			{SKU: "SKU-2", Quantity: 1},
+			{SKU: "SKU-1", Quantity: 1},
+			{SKU: "SKU-1", Quantity: 2},
+		},
+	})
+
+	require.ErrorIs(t, err, inventory.ErrOutOfStock)
+	require.ErrorIs(t, err, model.ErrConflict)
+	require.Nil(t, res)
+}
+
+func TestRestoreReservesStock(t *testing.T) {
+	var (
+		userID = "user_id"
+
+		filter = &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusDeleted)),
+			IDs:    option.New([]string{newID().String()}),
+		}
+
+		deleted = func(state orderModel.State) *orderModel.Order {
+			return &orderModel.Order{
+				ID:       newID().String(),
+				TSCreate: now().Add(-time.Hour),
+				TSModify: now().Add(-time.Hour),
+				Status:   orderModel.StatusDeleted,
+				State:    state,
+				UserID:   userID,
+			}
+		}
+
+		lines = []*line.Line{{ID: "line-1", OrderID: newID().String(), SKU: "SKU-1", Quantity: 2}}
+
+		reservation = func(expire *time.Time) *inventory.Reservation {
+			return &inventory.Reservation{
+				ID:       newID().String(),
+				TSCreate: now(),
+				TSExpire: expire,
+				OrderID:  newID().String(),
+				SKU:      "SKU-1",
+				Quantity: 2,
+				Status:   inventory.StatusHeld,
+			}
+		}
+	)
+
+	tests := []struct {
+		name        string
+		state       orderModel.State
+		reservation *inventory.Reservation
+	}{
+		{
+			name:        "Placed order expires",
+			state:       orderModel.StatePlaced,
+			reservation: reservation(ptr.Pointer(now().Add(30 * time.Minute))),
+		},
+		{
+			name:        "Paid order is kept",
+			state:       orderModel.StatePaid,
+			reservation: reservation(nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var (
+				orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+				orderPGCommander = orderMock.NewMockCommander(ctrl)
+				orderESCommander = orderMock.NewMockCommander(ctrl)
+				historyCommander = historyMock.NewMockCommander(ctrl)
+				lineQuerier      = lineMock.NewMockQuerier(ctrl)
+				reserver         = inventoryMock.NewMockReserver(ctrl)
+				tXer             = txerMock.NewMockTXer(ctrl)
+			)
+
+			tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+				return cb(ctx)
+			})
+
+			orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(deleted(tt.state), nil)
+			orderPGCommander.EXPECT().Update(context.TODO(), gomock.Any()).Return(nil)
+			lineQuerier.EXPECT().GetList(context.TODO(), &line.Filter{OrderID: option.New(newID().String())}).Return(lines, nil)
+			reserver.EXPECT().Reserve(context.TODO(), tt.reservation).Return(nil)
+			historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+			orderESCommander.EXPECT().Update(context.TODO(), gomock.Any()).Return(nil)
+
+			service := svc.New(svc.Params{
+				CmdPg:      orderPGCommander,
+				CmdEs:      orderESCommander,
+				QrPg:       orderPGQuerier,
+				CmdHistory: historyCommander,
+				QrLine:     lineQuerier,
+				Reserver:   reserver,
+				Cfg:        svc.Config{ReservationTTL: 30 * time.Minute},
+				TXer:       tXer,
+				Now:        now,
+				NewID:      newID,
+			})
+
+			res, err := service.Restore(context.TODO(), userID, newID().String())
+
+			require.NoError(t, err)
+			require.Equal(t, orderModel.StatusCreated, res.Status)
+		})
+	}
+
+	t.Run("Out of stock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			lineQuerier      = lineMock.NewMockQuerier(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(deleted(orderModel.StatePlaced), nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), gomock.Any()).Return(nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(lines, nil)
+		reserver.EXPECT().Reserve(context.TODO(), gomock.Any()).Return(inventory.ErrOutOfStock)
+
+		service := svc.New(svc.Params{
+			CmdPg:    orderPGCommander,
+			QrPg:     orderPGQuerier,
+			QrLine:   lineQuerier,
+			Reserver: reserver,
+			TXer:     tXer,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		res, err := service.Restore(context.TODO(), userID, newID().String())
+
+		require.ErrorIs(t, err, model.ErrConflict)
+		require.Nil(t, res)
+	})
+
+	t.Run("Fulfilled order holds nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(deleted(orderModel.StateFulfilled), nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), gomock.Any()).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), &history.Entry{
+			ID:       newID().String(),
+			TSCreate: now(),
+			OrderID:  newID().String(),
+			Actor:    userID,
+			Action:   history.ActionRestore,
+			Changes: []*history.Change{
+				{Field: "status", Old: "deleted", New: "created"},
+			},
+		}).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), gomock.Any()).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		_, err := service.Restore(context.TODO(), userID, newID().String())
+
+		require.NoError(t, err)
+	})
+}
+
+func TestExpireReservations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reserver := inventoryMock.NewMockReserver(ctrl)
+
+	reserver.EXPECT().Expire(context.TODO(), now()).Return(2, nil)
+
+	service := svc.New(svc.Params{
+		Reserver: reserver,
+		Now:      now,
+		NewID:    newID,
+	})
+
+	n, err := service.ExpireReservations(context.TODO(), now())
+
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+}
@@ -0,0 +1,43 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		userID = "user_id"
+
+		orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+
+		metadata = map[string]string{"crm-id": "42"}
+	)
+
+	orderPGQuerier.EXPECT().Count(context.TODO(), &orderModel.Filter{
+		Status:   option.New(int(orderModel.StatusCreated)),
+		UserID:   option.New(userID),
+		TenantID: option.New(""),
+		Draft:    option.New(false),
+		Metadata: option.New(metadata),
+	}).Return(1, nil)
+
+	service := svc.New(svc.Params{QrPg: orderPGQuerier})
+
+	res, err := service.Count(context.TODO(), userID, &orderModel.GetCountRequest{
+		Metadata: option.New(metadata),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 1, res)
+}
@@ -0,0 +1,100 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/option"
+)
+
+func (s *service) GetPayments(ctx context.Context, userID, id string) ([]*payment.Payment, error) {
+	if _, err := s.getOwned(ctx, userID, id, tenant.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	payments, err := s.qrPayment.GetList(ctx, &payment.Filter{OrderID: option.New(id)})
+	if err != nil {
+		return nil, fmt.Errorf("get payments: %w", err)
+	}
+
+	return payments, nil
+}
+
+func (s *service) ApplyPaymentResult(ctx context.Context, result *payment.Result) (*payment.Payment, error) {
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+
+	res := payment.New(result, s.now, s.newID)
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		// the row lock keeps a concurrent or redelivered result from losing the amount of this one
+		item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+			IDs:       option.New([]string{result.OrderID}),
+			ForUpdate: option.New(true),
+		})
+		if err != nil {
+			return fmt.Errorf("get item: %w", err)
+		}
+
+		// the es document of a disabled order must keep its status to restore, the result
+		// is retried from the dead letter queue once the order is back
+		if item.Status != orderModel.StatusCreated {
+			return fmt.Errorf("%w: order is %s", model.ErrConflict, item.Status)
+		}
+
+		if res.Currency != item.Totals.Currency {
+			return fmt.Errorf("%w: payment in %s for an order in %s", model.ErrInvalidArgument, res.Currency, item.Totals.Currency)
+		}
+
+		if err = s.cmdPayment.Create(ctx, res); err != nil {
+			return fmt.Errorf("payment create: %w", err)
+		}
+
+		before := *item
+
+		to := orderModel.StatePaymentFailed
+		if res.Status == payment.StatusSucceeded {
+			item.Totals.Paid += res.Amount
+
+			// a part of the total keeps the order in its state
+			to = item.State
+			if item.Totals.Paid >= item.Totals.Total {
+				to = orderModel.StatePaid
+			}
+		}
+
+		if item.State.CanTransition(to) {
+			item.State = to
+		}
+
+		if item.State == before.State && item.Totals == before.Totals {
+			// a late failure, e.g. a failed retry of a paid order, is only recorded
+			return nil
+		}
+
+		if err = s.save(ctx, res.Provider, history.ActionPayment, &before, item); err != nil {
+			return err
+		}
+
+		if item.State != orderModel.StatePaid {
+			return nil
+		}
+
+		// a paid order keeps its stock until it ships
+		if err = s.reserver.Keep(ctx, item.ID); err != nil {
+			return fmt.Errorf("keep stock: %w", err)
+		}
+
+		return nil
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	return res, nil
+}
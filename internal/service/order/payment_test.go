@@ -0,0 +1,312 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/payment"
+	paymentMock "github.com/krivenkov/order/internal/model/payment/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPaymentResult(t *testing.T) {
+	var (
+		filter = &orderModel.Filter{
+			IDs:       option.New([]string{newID().String()}),
+			ForUpdate: option.New(true),
+		}
+
+		order = func(state orderModel.State) *orderModel.Order {
+			return &orderModel.Order{
+				ID:       newID().String(),
+				TSCreate: now().Add(-time.Hour),
+				TSModify: now().Add(-time.Hour),
+				Status:   orderModel.StatusCreated,
+				State:    state,
+				UserID:   "user_id",
+				Totals: orderModel.Totals{
+					Currency: "EUR",
+					Subtotal: 2999,
+					Total:    2999,
+				},
+			}
+		}
+
+		result = func(succeeded bool) *payment.Result {
+			return &payment.Result{
+				OrderID:     newID().String(),
+				Provider:    " stripe ",
+				ExternalRef: "pi_1",
+				Amount:      1999,
+				Currency:    "eur",
+				Succeeded:   succeeded,
+			}
+		}
+
+		withTX = func(tXer *txerMock.MockTXer) {
+			tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+				return cb(ctx)
+			})
+		}
+	)
+
+	t.Run("Paid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			paymentCommander = paymentMock.NewMockCommander(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			expected = &payment.Payment{
+				ID:          newID().String(),
+				TSCreate:    now(),
+				OrderID:     newID().String(),
+				Provider:    "stripe",
+				ExternalRef: "pi_1",
+				Amount:      1999,
+				Currency:    "EUR",
+				Status:      payment.StatusSucceeded,
+			}
+
+			placed = order(orderModel.StatePlaced)
+			paid   = order(orderModel.StatePaid)
+
+			entry = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    "stripe",
+				Action:   history.ActionPayment,
+				Changes: []*history.Change{
+					{Field: "state", Old: "placed", New: "paid"},
+					{Field: "paid", Old: "1000", New: "2999"},
+				},
+			}
+		)
+
+		// the result covers the rest of the total
+		placed.Totals.Paid = 1000
+		paid.TSModify = now()
+		paid.Totals.Paid = 2999
+
+		withTX(tXer)
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(placed, nil)
+		paymentCommander.EXPECT().Create(context.TODO(), expected).Return(nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), paid).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), paid).Return(nil)
+		reserver.EXPECT().Keep(context.TODO(), newID().String()).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			CmdHistory: historyCommander,
+			CmdPayment: paymentCommander,
+			Reserver:   reserver,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.ApplyPaymentResult(context.TODO(), result(true))
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Part of the total", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			paymentCommander = paymentMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			placed = order(orderModel.StatePlaced)
+
+			entry = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    "stripe",
+				Action:   history.ActionPayment,
+				Changes: []*history.Change{
+					{Field: "paid", Old: "0", New: "1999"},
+				},
+			}
+		)
+
+		placed.TSModify = now()
+		placed.Totals.Paid = 1999
+
+		withTX(tXer)
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(order(orderModel.StatePlaced), nil)
+		paymentCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), placed).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), placed).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			CmdHistory: historyCommander,
+			CmdPayment: paymentCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.ApplyPaymentResult(context.TODO(), result(true))
+
+		require.NoError(t, err)
+		require.Equal(t, payment.StatusSucceeded, res.Status)
+	})
+
+	t.Run("Other currency", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			usd = result(true)
+		)
+
+		usd.Currency = "USD"
+
+		withTX(tXer)
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(order(orderModel.StatePlaced), nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			TXer:  tXer,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.ApplyPaymentResult(context.TODO(), usd)
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("Disabled order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			disabled = order(orderModel.StatePlaced)
+		)
+
+		disabled.Status = orderModel.StatusDisabled
+
+		withTX(tXer)
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(disabled, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			TXer:  tXer,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.ApplyPaymentResult(context.TODO(), result(true))
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Failed retry of a paid order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			paymentCommander = paymentMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+		)
+
+		withTX(tXer)
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(order(orderModel.StatePaid), nil)
+		paymentCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPayment: paymentCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.ApplyPaymentResult(context.TODO(), result(false))
+
+		require.NoError(t, err)
+		require.Equal(t, payment.StatusFailed, res.Status)
+	})
+
+	t.Run("Duplicate reference", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			paymentCommander = paymentMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+		)
+
+		withTX(tXer)
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(order(orderModel.StatePaid), nil)
+		paymentCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(payment.ErrDuplicate)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPayment: paymentCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		_, err := service.ApplyPaymentResult(context.TODO(), result(true))
+
+		require.ErrorIs(t, err, payment.ErrDuplicate)
+	})
+
+	t.Run("Invalid result", func(t *testing.T) {
+		service := svc.New(svc.Params{
+			Now:   now,
+			NewID: newID,
+		})
+
+		invalid := result(true)
+		invalid.Currency = "euro"
+
+		_, err := service.ApplyPaymentResult(context.TODO(), invalid)
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+}
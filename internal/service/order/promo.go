@@ -0,0 +1,107 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/pkg/option"
+)
+
+// applyPromoCodes evaluates the promo codes against the lines and sets the
+// discounts of the order and its lines
+func (s *service) applyPromoCodes(ctx context.Context, item *orderModel.Order, lines []*line.Line, codes []string) error {
+	if len(codes) == 0 {
+		return nil
+	}
+
+	byCode, err := s.findPromos(ctx, codes)
+	if err != nil {
+		return err
+	}
+
+	promos := make([]*promo.Promo, 0, len(codes))
+	for _, code := range codes {
+		p, ok := byCode[code]
+		if !ok {
+			return fmt.Errorf("%w: unknown promo code %s", model.ErrInvalidArgument, code)
+		}
+
+		promos = append(promos, p)
+	}
+
+	if item.Discounts, err = promo.Apply(promos, lines, item.Totals.Currency, s.now()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// reapplyPromoCodes evaluates the promo codes of a draft again after its lines changed,
+// the codes which no longer apply are dropped as a cart does
+func (s *service) reapplyPromoCodes(ctx context.Context, item *orderModel.Order, lines []*line.Line) error {
+	codes := make([]string, 0, len(item.Discounts))
+	for _, d := range item.Discounts {
+		codes = append(codes, d.Code)
+	}
+
+	item.Discounts = nil
+	for _, l := range lines {
+		l.Discount = 0
+	}
+
+	if len(codes) == 0 {
+		return nil
+	}
+
+	byCode, err := s.findPromos(ctx, codes)
+	if err != nil {
+		return err
+	}
+
+	kept := make([]*promo.Promo, 0, len(codes))
+	for _, code := range codes {
+		p, ok := byCode[code]
+		if !ok {
+			continue
+		}
+
+		if _, err = promo.Apply(append(kept[:len(kept):len(kept)], p), lines, item.Totals.Currency, s.now()); err != nil {
+			if errors.Is(err, model.ErrInvalidArgument) {
+				continue
+			}
+
+			return err
+		}
+
+		kept = append(kept, p)
+	}
+
+	if len(kept) == 0 {
+		return nil
+	}
+
+	if item.Discounts, err = promo.Apply(kept, lines, item.Totals.Currency, s.now()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) findPromos(ctx context.Context, codes []string) (map[string]*promo.Promo, error) {
+	found, err := s.qrPromo.GetList(ctx, &promo.Filter{Codes: option.New(codes)}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get promo codes: %w", err)
+	}
+
+	byCode := make(map[string]*promo.Promo, len(found))
+	for _, p := range found {
+		byCode[p.Code] = p
+	}
+
+	return byCode, nil
+}
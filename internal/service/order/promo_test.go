@@ -0,0 +1,211 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	"github.com/krivenkov/order/internal/model/line"
+	lineMock "github.com/krivenkov/order/internal/model/line/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/promo"
+	promoMock "github.com/krivenkov/order/internal/model/promo/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/ptr"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWithPromoCodes(t *testing.T) {
+	var (
+		userID = "user_id"
+		number = "ORD-2000-000001"
+
+		form = func(codes ...string) *orderModel.Form {
+			return &orderModel.Form{
+				Currency:   ptr.Pointer("EUR"),
+				Lines:      []*line.Form{{SKU: "SKU-1", Quantity: 2, UnitPrice: 250}},
+				PromoCodes: codes,
+			}
+		}
+
+		tenOff = &promo.Promo{
+			ID:    "promo_id",
+			Code:  "TEN",
+			Kind:  promo.KindPercentage,
+			Scope: promo.ScopeOrder,
+			Value: 10,
+		}
+	)
+
+	t.Run("Applied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			promoCommander   = promoMock.NewMockCommander(ctrl)
+			promoQuerier     = promoMock.NewMockQuerier(ctrl)
+			numberer         = orderMock.NewMockNumberer(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			discounts = []*promo.Discount{{PromoID: "promo_id", Code: "TEN", Amount: 50}}
+
+			orderItem = &orderModel.Order{
+				ID:       newID().String(),
+				TSCreate: now(),
+				TSModify: now(),
+				Status:   orderModel.StatusCreated,
+				State:    orderModel.StatePlaced,
+				Number:   number,
+				UserID:   userID,
+				Totals: orderModel.Totals{
+					Currency: "EUR",
+					Subtotal: 500,
+					Discount: 50,
+					Total:    450,
+				},
+				Discounts: discounts,
+			}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		promoQuerier.EXPECT().GetList(context.TODO(), &promo.Filter{Codes: option.New([]string{"TEN"})}, nil).Return([]*promo.Promo{tenOff}, nil)
+
+		numberer.EXPECT().Next(context.TODO(), "", now()).Return(number, nil)
+
+		orderPGCommander.EXPECT().Create(context.TODO(), orderItem).Return(nil)
+
+		lineCommander.EXPECT().Create(context.TODO(), &line.Line{
+			ID:        newID().String(),
+			TSCreate:  now(),
+			OrderID:   newID().String(),
+			SKU:       "SKU-1",
+			Quantity:  2,
+			UnitPrice: 250,
+			Discount:  50,
+		}).Return(nil)
+
+		promoCommander.EXPECT().Redeem(context.TODO(), &promo.Redemption{
+			ID:       newID().String(),
+			TSCreate: now(),
+			PromoID:  "promo_id",
+			OrderID:  newID().String(),
+			UserID:   userID,
+			Amount:   50,
+		}).Return(nil)
+
+		reserver.EXPECT().Reserve(context.TODO(), gomock.Any()).Return(nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), &history.Entry{
+			ID:       newID().String(),
+			TSCreate: now(),
+			OrderID:  newID().String(),
+			Actor:    userID,
+			Action:   history.ActionCreate,
+			Changes: []*history.Change{
+				{Field: "status", Old: "", New: "created"},
+				{Field: "state", Old: "", New: "placed"},
+				{Field: "number", Old: "", New: number},
+				{Field: "currency", Old: "", New: "EUR"},
+				{Field: "promo_codes", Old: "", New: "TEN"},
+				{Field: "discount", Old: "0", New: "50"},
+				{Field: "total", Old: "0", New: "450"},
+			},
+		}).Return(nil)
+
+		orderESCommander.EXPECT().Create(context.TODO(), orderItem).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			Numberer:   numberer,
+			CmdHistory: historyCommander,
+			CmdLine:    lineCommander,
+			CmdPromo:   promoCommander,
+			QrPromo:    promoQuerier,
+			Reserver:   reserver,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.Create(context.TODO(), userID, form(" ten "))
+
+		require.NoError(t, err)
+		require.Equal(t, orderItem, res)
+	})
+
+	t.Run("Usage limit reached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			promoCommander   = promoMock.NewMockCommander(ctrl)
+			promoQuerier     = promoMock.NewMockQuerier(ctrl)
+			numberer         = orderMock.NewMockNumberer(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		promoQuerier.EXPECT().GetList(context.TODO(), gomock.Any(), nil).Return([]*promo.Promo{tenOff}, nil)
+		numberer.EXPECT().Next(context.TODO(), "", now()).Return(number, nil)
+		orderPGCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		lineCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		promoCommander.EXPECT().Redeem(context.TODO(), gomock.Any()).Return(promo.ErrLimitReached)
+
+		service := svc.New(svc.Params{
+			CmdPg:    orderPGCommander,
+			Numberer: numberer,
+			CmdLine:  lineCommander,
+			CmdPromo: promoCommander,
+			QrPromo:  promoQuerier,
+			TXer:     tXer,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		res, err := service.Create(context.TODO(), userID, form("TEN"))
+
+		require.ErrorIs(t, err, model.ErrConflict)
+		require.Nil(t, res)
+	})
+
+	t.Run("Unknown code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		promoQuerier := promoMock.NewMockQuerier(ctrl)
+
+		promoQuerier.EXPECT().GetList(context.TODO(), gomock.Any(), nil).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			QrPromo: promoQuerier,
+			Now:     now,
+			NewID:   newID,
+		})
+
+		res, err := service.Create(context.TODO(), userID, form("NOPE"))
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+		require.Nil(t, res)
+	})
+}
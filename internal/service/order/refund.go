@@ -0,0 +1,354 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/option"
+	"go.uber.org/zap"
+)
+
+func (s *service) GetReturns(ctx context.Context, userID, id string) ([]*refund.Return, error) {
+	if _, err := s.getOwned(ctx, userID, id, tenant.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return s.InnerGetReturns(ctx, id)
+}
+
+func (s *service) InnerGetReturns(ctx context.Context, id string) ([]*refund.Return, error) {
+	returns, err := s.qrRefund.GetReturns(ctx, &refund.Filter{OrderID: option.New(id)})
+	if err != nil {
+		return nil, fmt.Errorf("get returns: %w", err)
+	}
+
+	return returns, nil
+}
+
+func (s *service) CreateReturn(ctx context.Context, userID, id string, form *refund.ReturnForm) (*refund.Return, error) {
+	if err := form.Validate(); err != nil {
+		return nil, err
+	}
+
+	var res *refund.Return
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		var err error
+
+		res, err = s.createReturn(ctx, userID, id, form)
+
+		return err
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	return res, nil
+}
+
+// createReturn runs in the transaction of CreateReturn. The order is read with a row lock,
+// so the returns of a line requested at once never exceed its quantity together and an
+// approval does not change the order in between.
+func (s *service) createReturn(ctx context.Context, userID, id string, form *refund.ReturnForm) (*refund.Return, error) {
+	item, err := s.getLocked(ctx, userID, id, tenant.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkReturnable(item); err != nil {
+		return nil, err
+	}
+
+	lines, err := s.qrLine.GetList(ctx, &line.Filter{
+		IDs:     option.New([]string{form.LineID}),
+		OrderID: option.New(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get lines: %w", err)
+	}
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: line %s is not in the order", model.ErrInvalidArgument, form.LineID)
+	}
+
+	returns, err := s.qrRefund.GetReturns(ctx, &refund.Filter{OrderID: option.New(id)})
+	if err != nil {
+		return nil, fmt.Errorf("get returns: %w", err)
+	}
+
+	if left := lines[0].Quantity - refund.Requested(returns)[form.LineID]; form.Quantity > left {
+		return nil, fmt.Errorf("%w: line %s has %d left to return", model.ErrInvalidArgument, form.LineID, left)
+	}
+
+	res := refund.NewReturn(id, form, s.now, s.newID)
+
+	if err = s.cmdRefund.CreateReturn(ctx, res); err != nil {
+		return nil, fmt.Errorf("return create: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *service) ApproveReturn(ctx context.Context, actorID, id, returnID, comment string) (*refund.Return, error) {
+	var ret *refund.Return
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		var err error
+
+		ret, err = s.approveReturn(ctx, actorID, id, returnID, comment)
+
+		return err
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	s.publishRefunds(ctx, actorID, ret.Refunds)
+
+	return ret, nil
+}
+
+// approveReturn runs in the transaction of ApproveReturn. The order is read with a row lock,
+// so two approvals of its returns run one after another and the second one sees the
+// decision and the refunded amount of the first.
+func (s *service) approveReturn(ctx context.Context, actorID, id, returnID, comment string) (*refund.Return, error) {
+	item, ret, err := s.getReturn(ctx, id, returnID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkReturnable(item); err != nil {
+		return nil, err
+	}
+
+	if err = ret.Decide(refund.StatusApproved, actorID, comment, s.now()); err != nil {
+		return nil, err
+	}
+
+	lines, err := s.qrLine.GetList(ctx, &line.Filter{
+		IDs:     option.New([]string{ret.LineID}),
+		OrderID: option.New(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get lines: %w", err)
+	}
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("get line: %w", model.ErrNotFound)
+	}
+
+	amount := lines[0].UnitsNet(ret.Quantity)
+	if !item.Totals.TaxInclusive {
+		amount += lines[0].UnitsTax(ret.Quantity)
+	}
+
+	if amount > item.Totals.Refundable() {
+		return nil, fmt.Errorf("%w: refund of %d exceeds the %d left of the amount paid", model.ErrConflict, amount, item.Totals.Refundable())
+	}
+
+	if amount > 0 {
+		payments, errGet := s.qrPayment.GetList(ctx, &payment.Filter{OrderID: option.New(id)})
+		if errGet != nil {
+			return nil, fmt.Errorf("get payments: %w", errGet)
+		}
+
+		refunds, errGet := s.qrRefund.GetRefunds(ctx, &refund.Filter{OrderID: option.New(id)})
+		if errGet != nil {
+			return nil, fmt.Errorf("get refunds: %w", errGet)
+		}
+
+		if ret.Refunds, err = refund.Allocate(ret, amount, payments, refunds, s.now, s.newID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.cmdRefund.UpdateReturn(ctx, ret); err != nil {
+		return nil, fmt.Errorf("return update: %w", err)
+	}
+
+	if len(ret.Refunds) == 0 {
+		return ret, nil
+	}
+
+	if err = s.cmdRefund.CreateRefunds(ctx, ret.Refunds...); err != nil {
+		return nil, fmt.Errorf("refunds create: %w", err)
+	}
+
+	before := *item
+
+	item.Totals.Refunded += amount
+
+	to := orderModel.StatePartiallyRefunded
+	if item.Totals.Refundable() == 0 {
+		to = orderModel.StateRefunded
+	}
+
+	if item.State.CanTransition(to) {
+		item.State = to
+	}
+
+	if err = s.save(ctx, actorID, history.ActionRefund, &before, item); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (s *service) RejectReturn(ctx context.Context, actorID, id, returnID, comment string) (*refund.Return, error) {
+	_, ret, err := s.getReturn(ctx, id, returnID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ret.Decide(refund.StatusRejected, actorID, comment, s.now()); err != nil {
+		return nil, err
+	}
+
+	if err = s.cmdRefund.UpdateReturn(ctx, ret); err != nil {
+		return nil, fmt.Errorf("return update: %w", err)
+	}
+
+	return ret, nil
+}
+
+// checkReturnable refunds only fulfilled orders, a cancelled shipment takes the order back to paid
+func checkReturnable(item *orderModel.Order) error {
+	if item.State != orderModel.StateFulfilled && item.State != orderModel.StatePartiallyRefunded {
+		return fmt.Errorf("%w: order is %s", model.ErrConflict, item.State)
+	}
+
+	return nil
+}
+
+// getReturn reads the order along with its return, lock keeps the order row till the end of the transaction
+func (s *service) getReturn(ctx context.Context, id, returnID string, lock bool) (*orderModel.Order, *refund.Return, error) {
+	filter := &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusCreated)),
+		IDs:    option.New([]string{id}),
+	}
+
+	if lock {
+		filter.ForUpdate = option.New(true)
+	}
+
+	item, err := s.qrPg.GetItem(ctx, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get item: %w", err)
+	}
+
+	returns, err := s.qrRefund.GetReturns(ctx, &refund.Filter{
+		IDs:     option.New([]string{returnID}),
+		OrderID: option.New(id),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get returns: %w", err)
+	}
+
+	if len(returns) == 0 {
+		return nil, nil, fmt.Errorf("get return: %w", model.ErrNotFound)
+	}
+
+	return item, returns[0], nil
+}
+
+// publishRefunds notifies the payment integration, refunds are already committed
+// so a failed publish is logged and left to PublishRefunds
+func (s *service) publishRefunds(ctx context.Context, actorID string, refunds []*refund.Refund) {
+	if len(refunds) == 0 {
+		return
+	}
+
+	messages := make([]bus.Message[refund.Refund], 0, len(refunds))
+	ids := make([]string, 0, len(refunds))
+
+	for _, r := range refunds {
+		messages = append(messages, refundMessage(actorID, r))
+		ids = append(ids, r.ID)
+	}
+
+	if err := s.refunded.Publish(ctx, messages...); err != nil {
+		mlog.FromContext(ctx).Error("publish refunds failed", zap.String("orderID", refunds[0].OrderID), zap.Error(err))
+		return
+	}
+
+	if err := s.cmdRefund.SetPublished(ctx, s.now(), ids...); err != nil {
+		mlog.FromContext(ctx).Error("refunds set published failed", zap.String("orderID", refunds[0].OrderID), zap.Error(err))
+	}
+}
+
+// PublishRefunds publishes again the refunds left unpublished by ApproveReturn. A refund
+// may be published twice, the payment integration drops the repeats by the command id.
+func (s *service) PublishRefunds(ctx context.Context, createdBefore time.Time) (int, error) {
+	refunds, err := s.qrRefund.GetRefunds(ctx, &refund.Filter{
+		Published:     option.New(false),
+		CreatedBefore: option.New(createdBefore),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get refunds: %w", err)
+	}
+
+	if len(refunds) == 0 {
+		return 0, nil
+	}
+
+	var (
+		returnIDs []string
+		ids       = make([]string, 0, len(refunds))
+		seen      = make(map[string]bool, len(refunds))
+	)
+
+	for _, r := range refunds {
+		ids = append(ids, r.ID)
+
+		if !seen[r.ReturnID] {
+			seen[r.ReturnID] = true
+			returnIDs = append(returnIDs, r.ReturnID)
+		}
+	}
+
+	returns, err := s.qrRefund.GetReturns(ctx, &refund.Filter{IDs: option.New(returnIDs)})
+	if err != nil {
+		return 0, fmt.Errorf("get returns: %w", err)
+	}
+
+	// a refund is published on behalf of the staff member who approved its return
+	decidedBy := make(map[string]string, len(returns))
+	for _, ret := range returns {
+		decidedBy[ret.ID] = ret.DecidedBy
+	}
+
+	messages := make([]bus.Message[refund.Refund], 0, len(refunds))
+	for _, r := range refunds {
+		messages = append(messages, refundMessage(decidedBy[r.ReturnID], r))
+	}
+
+	if err = s.refunded.Publish(ctx, messages...); err != nil {
+		return 0, fmt.Errorf("publish refunds: %w", err)
+	}
+
+	if err = s.cmdRefund.SetPublished(ctx, s.now(), ids...); err != nil {
+		return 0, fmt.Errorf("refunds set published: %w", err)
+	}
+
+	return len(refunds), nil
+}
+
+func refundMessage(actorID string, r *refund.Refund) bus.Message[refund.Refund] {
+	return bus.Message[refund.Refund]{
+		Key: r.OrderID,
+		Value: bus.MessageValue[refund.Refund]{
+			CommandID: r.ID,
+			UserID:    actorID,
+			CreatedAt: r.TSCreate,
+			Payload:   r,
+		},
+	}
+}
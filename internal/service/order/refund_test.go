@@ -0,0 +1,684 @@
+package order_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	"github.com/krivenkov/order/internal/model/line"
+	lineMock "github.com/krivenkov/order/internal/model/line/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/payment"
+	paymentMock "github.com/krivenkov/order/internal/model/payment/mock"
+	"github.com/krivenkov/order/internal/model/refund"
+	refundMock "github.com/krivenkov/order/internal/model/refund/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateReturn(t *testing.T) {
+	var (
+		userID = "user_id"
+
+		fulfilled = func() *orderModel.Order {
+			return &orderModel.Order{
+				ID:     newID().String(),
+				Status: orderModel.StatusCreated,
+				State:  orderModel.StateFulfilled,
+				UserID: userID,
+			}
+		}
+
+		// the order is read under the lock of its row, returns requested at once are checked one after another
+		orderFilter = &orderModel.Filter{
+			Status:    option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			ForUpdate: option.New(true),
+		}
+
+		lineFilter = &line.Filter{
+			IDs:     option.New([]string{"line-1"}),
+			OrderID: option.New(newID().String()),
+		}
+
+		lines = []*line.Line{{ID: "line-1", OrderID: newID().String(), SKU: "SKU-1", Quantity: 3, UnitPrice: 500}}
+
+		// one unit is already being returned, a rejected request does not count
+		returns = []*refund.Return{
+			{ID: "return-1", LineID: "line-1", Quantity: 1, Status: refund.StatusApproved},
+			{ID: "return-2", LineID: "line-1", Quantity: 2, Status: refund.StatusRejected},
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier  = orderMock.NewMockQuerier(ctrl)
+			lineQuerier     = lineMock.NewMockQuerier(ctrl)
+			refundQuerier   = refundMock.NewMockQuerier(ctrl)
+			refundCommander = refundMock.NewMockCommander(ctrl)
+			tXer            = txerMock.NewMockTXer(ctrl)
+
+			expected = &refund.Return{
+				ID:       newID().String(),
+				TSCreate: now(),
+				TSModify: now(),
+				OrderID:  newID().String(),
+				LineID:   "line-1",
+				Quantity: 2,
+				Reason:   "broken",
+				Status:   refund.StatusRequested,
+			}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), orderFilter).Return(fulfilled(), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), lineFilter).Return(lines, nil)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), &refund.Filter{OrderID: option.New(newID().String())}).Return(returns, nil)
+		refundCommander.EXPECT().CreateReturn(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:      orderPGQuerier,
+			QrLine:    lineQuerier,
+			QrRefund:  refundQuerier,
+			CmdRefund: refundCommander,
+			TXer:      tXer,
+			Now:       now,
+			NewID:     newID,
+		})
+
+		res, err := service.CreateReturn(context.TODO(), userID, newID().String(), &refund.ReturnForm{
+			LineID:   "line-1",
+			Quantity: 2,
+			Reason:   " broken ",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("More than ordered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			lineQuerier    = lineMock.NewMockQuerier(ctrl)
+			refundQuerier  = refundMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), orderFilter).Return(fulfilled(), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), lineFilter).Return(lines, nil)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), gomock.Any()).Return(returns, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:     orderPGQuerier,
+			QrLine:   lineQuerier,
+			QrRefund: refundQuerier,
+			TXer:     tXer,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		_, err := service.CreateReturn(context.TODO(), userID, newID().String(), &refund.ReturnForm{
+			LineID:   "line-1",
+			Quantity: 3,
+			Reason:   "broken",
+		})
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("Not fulfilled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			item = fulfilled()
+		)
+
+		item.State = orderModel.StatePaid
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), orderFilter).Return(item, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			TXer:  tXer,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.CreateReturn(context.TODO(), userID, newID().String(), &refund.ReturnForm{
+			LineID:   "line-1",
+			Quantity: 1,
+			Reason:   "broken",
+		})
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+}
+
+func TestApproveReturn(t *testing.T) {
+	var (
+		adminID = "admin_id"
+
+		returnFilter = &refund.Filter{
+			IDs:     option.New([]string{"return-1"}),
+			OrderID: option.New(newID().String()),
+		}
+
+		fulfilled = func(paid, refunded int64) *orderModel.Order {
+			return &orderModel.Order{
+				ID:       newID().String(),
+				TSCreate: now().Add(-time.Hour),
+				TSModify: now().Add(-time.Hour),
+				Status:   orderModel.StatusCreated,
+				State:    orderModel.StateFulfilled,
+				UserID:   "user_id",
+				Totals: orderModel.Totals{
+					Currency: "EUR",
+					Subtotal: 1500,
+					Total:    1500,
+					Paid:     paid,
+					Refunded: refunded,
+				},
+			}
+		}
+
+		requested = func() *refund.Return {
+			return &refund.Return{
+				ID:       "return-1",
+				OrderID:  newID().String(),
+				LineID:   "line-1",
+				Quantity: 2,
+				Reason:   "broken",
+				Status:   refund.StatusRequested,
+			}
+		}
+
+		lines = []*line.Line{{ID: "line-1", OrderID: newID().String(), SKU: "SKU-1", Quantity: 3, UnitPrice: 500}}
+
+		// the order was paid in two parts, the first one is already partially refunded
+		payments = []*payment.Payment{
+			{ID: "payment-1", Amount: 600, Currency: "EUR", Status: payment.StatusSucceeded},
+			{ID: "payment-2", Amount: 300, Currency: "EUR", Status: payment.StatusFailed},
+			{ID: "payment-3", Amount: 900, Currency: "EUR", Status: payment.StatusSucceeded},
+		}
+	)
+
+	t.Run("Partially refunded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineQuerier      = lineMock.NewMockQuerier(ctrl)
+			paymentQuerier   = paymentMock.NewMockQuerier(ctrl)
+			refundQuerier    = refundMock.NewMockQuerier(ctrl)
+			refundCommander  = refundMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+			refunded         = &publisherStub[refund.Refund]{}
+
+			refunds = []*refund.Refund{
+				{ID: newID().String(), TSCreate: now(), OrderID: newID().String(), ReturnID: "return-1", PaymentID: "payment-1", Amount: 400, Currency: "EUR"},
+				{ID: newID().String(), TSCreate: now(), OrderID: newID().String(), ReturnID: "return-1", PaymentID: "payment-3", Amount: 600, Currency: "EUR"},
+			}
+
+			approved = requested()
+			updated  = fulfilled(1500, 1200)
+
+			entry = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    adminID,
+				Action:   history.ActionRefund,
+				Changes: []*history.Change{
+					{Field: "state", Old: "fulfilled", New: "partially_refunded"},
+					{Field: "refunded", Old: "200", New: "1200"},
+				},
+			}
+		)
+
+		approved.Status = refund.StatusApproved
+		approved.Comment = "ok"
+		approved.DecidedBy = adminID
+		approved.TSModify = now()
+		approved.Refunds = refunds
+
+		updated.State = orderModel.StatePartiallyRefunded
+		updated.TSModify = now()
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(fulfilled(1500, 200), nil)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), returnFilter).Return([]*refund.Return{requested()}, nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(lines, nil)
+		paymentQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(payments, nil)
+		refundQuerier.EXPECT().GetRefunds(context.TODO(), gomock.Any()).Return([]*refund.Refund{
+			{PaymentID: "payment-1", Amount: 200},
+		}, nil)
+		refundCommander.EXPECT().UpdateReturn(context.TODO(), approved).Return(nil)
+		refundCommander.EXPECT().CreateRefunds(context.TODO(), refunds[0], refunds[1]).Return(nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), updated).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), updated).Return(nil)
+		refundCommander.EXPECT().SetPublished(context.TODO(), now(), refunds[0].ID, refunds[1].ID).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			CmdHistory: historyCommander,
+			QrLine:     lineQuerier,
+			QrPayment:  paymentQuerier,
+			QrRefund:   refundQuerier,
+			CmdRefund:  refundCommander,
+			Refunded:   refunded,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.ApproveReturn(context.TODO(), adminID, newID().String(), "return-1", " ok ")
+
+		require.NoError(t, err)
+		require.Equal(t, approved, res)
+		require.Len(t, refunded.messages, 2)
+		require.Equal(t, refunds[1], refunded.messages[1].Value.Payload)
+	})
+
+	t.Run("Exceeds the amount paid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			lineQuerier    = lineMock.NewMockQuerier(ctrl)
+			refundQuerier  = refundMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(fulfilled(1500, 600), nil)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), returnFilter).Return([]*refund.Return{requested()}, nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(lines, nil)
+
+		service := svc.New(svc.Params{
+			TXer:     tXer,
+			QrPg:     orderPGQuerier,
+			QrLine:   lineQuerier,
+			QrRefund: refundQuerier,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		_, err := service.ApproveReturn(context.TODO(), adminID, newID().String(), "return-1", "")
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Exclusive tax exceeds the amount paid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			lineQuerier    = lineMock.NewMockQuerier(ctrl)
+			refundQuerier  = refundMock.NewMockQuerier(ctrl)
+
+			// 1000 of the units and 100 of their tax against 1000 left of the amount paid
+			taxed = []*line.Line{{ID: "line-1", OrderID: newID().String(), SKU: "SKU-1", Quantity: 3, UnitPrice: 500, Tax: 150}}
+			tXer  = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(fulfilled(1650, 650), nil)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), returnFilter).Return([]*refund.Return{requested()}, nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(taxed, nil)
+
+		service := svc.New(svc.Params{
+			TXer:     tXer,
+			QrPg:     orderPGQuerier,
+			QrLine:   lineQuerier,
+			QrRefund: refundQuerier,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		_, err := service.ApproveReturn(context.TODO(), adminID, newID().String(), "return-1", "")
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Shipment cancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			refundQuerier  = refundMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			// the return was requested before the shipment was cancelled and the order went back to paid
+			reopened = fulfilled(1500, 0)
+		)
+
+		reopened.State = orderModel.StatePaid
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(reopened, nil)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), returnFilter).Return([]*refund.Return{requested()}, nil)
+
+		service := svc.New(svc.Params{
+			TXer:     tXer,
+			QrPg:     orderPGQuerier,
+			QrRefund: refundQuerier,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		_, err := service.ApproveReturn(context.TODO(), adminID, newID().String(), "return-1", "")
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Already decided", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			refundQuerier  = refundMock.NewMockQuerier(ctrl)
+
+			rejected = requested()
+			tXer     = txerMock.NewMockTXer(ctrl)
+		)
+
+		rejected.Status = refund.StatusRejected
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(fulfilled(1500, 0), nil)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), returnFilter).Return([]*refund.Return{rejected}, nil)
+
+		service := svc.New(svc.Params{
+			TXer:     tXer,
+			QrPg:     orderPGQuerier,
+			QrRefund: refundQuerier,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		_, err := service.ApproveReturn(context.TODO(), adminID, newID().String(), "return-1", "")
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Concurrent approvals", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineQuerier      = lineMock.NewMockQuerier(ctrl)
+			paymentQuerier   = paymentMock.NewMockQuerier(ctrl)
+			refundQuerier    = refundMock.NewMockQuerier(ctrl)
+			refundCommander  = refundMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+			refunded         = &publisherStub[refund.Refund]{}
+
+			// rowLock stands for the lock of the order row, the state below is the stored one
+			rowLock  sync.Mutex
+			stored   = fulfilled(1500, 0)
+			status   = refund.StatusRequested
+			inserted int
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).Times(2)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).DoAndReturn(func(_ context.Context, filter *orderModel.Filter) (*orderModel.Order, error) {
+			require.True(t, filter.ForUpdate.Value())
+
+			rowLock.Lock()
+			item := *stored
+
+			return &item, nil
+		}).Times(2)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), returnFilter).DoAndReturn(func(context.Context, *refund.Filter) ([]*refund.Return, error) {
+			ret := requested()
+			ret.Status = status
+
+			return []*refund.Return{ret}, nil
+		}).Times(2)
+		lineQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(lines, nil)
+		paymentQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(payments, nil)
+		refundQuerier.EXPECT().GetRefunds(context.TODO(), gomock.Any()).Return(nil, nil)
+		refundCommander.EXPECT().UpdateReturn(context.TODO(), gomock.Any()).DoAndReturn(func(_ context.Context, ret *refund.Return) error {
+			status = ret.Status
+
+			return nil
+		})
+		refundCommander.EXPECT().CreateRefunds(context.TODO(), gomock.Any()).DoAndReturn(func(_ context.Context, items ...*refund.Refund) error {
+			inserted += len(items)
+
+			return nil
+		})
+		orderPGCommander.EXPECT().Update(context.TODO(), gomock.Any()).DoAndReturn(func(_ context.Context, item *orderModel.Order) error {
+			*stored = *item
+
+			return nil
+		})
+		historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), gomock.Any()).Return(nil)
+		refundCommander.EXPECT().SetPublished(context.TODO(), now(), gomock.Any(), gomock.Any()).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			CmdHistory: historyCommander,
+			QrLine:     lineQuerier,
+			QrPayment:  paymentQuerier,
+			QrRefund:   refundQuerier,
+			CmdRefund:  refundCommander,
+			Refunded:   refunded,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		var (
+			wg   sync.WaitGroup
+			errs = make([]error, 2)
+		)
+
+		for i := range errs {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+				// the lock is held from the read of the order till the end of its transaction
+				defer rowLock.Unlock()
+
+				_, errs[i] = service.ApproveReturn(context.TODO(), adminID, newID().String(), "return-1", "")
+			}(i)
+		}
+
+		wg.Wait()
+
+		if errs[0] != nil {
+			errs[0], errs[1] = errs[1], errs[0]
+		}
+
+		require.NoError(t, errs[0])
+		require.ErrorIs(t, errs[1], model.ErrConflict)
+		require.Equal(t, 2, inserted)
+		require.Equal(t, int64(1000), stored.Totals.Refunded)
+		require.Len(t, refunded.messages, 2)
+	})
+}
+
+func TestRejectReturn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orderPGQuerier  = orderMock.NewMockQuerier(ctrl)
+		refundQuerier   = refundMock.NewMockQuerier(ctrl)
+		refundCommander = refundMock.NewMockCommander(ctrl)
+
+		rejected = &refund.Return{
+			ID:        "return-1",
+			TSModify:  now(),
+			LineID:    "line-1",
+			Quantity:  1,
+			Status:    refund.StatusRejected,
+			Comment:   "used",
+			DecidedBy: "admin_id",
+		}
+	)
+
+	orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(&orderModel.Order{ID: newID().String()}, nil)
+	refundQuerier.EXPECT().GetReturns(context.TODO(), gomock.Any()).Return([]*refund.Return{
+		{ID: "return-1", LineID: "line-1", Quantity: 1, Status: refund.StatusRequested},
+	}, nil)
+	refundCommander.EXPECT().UpdateReturn(context.TODO(), rejected).Return(nil)
+
+	service := svc.New(svc.Params{
+		QrPg:      orderPGQuerier,
+		QrRefund:  refundQuerier,
+		CmdRefund: refundCommander,
+		Now:       now,
+		NewID:     newID,
+	})
+
+	res, err := service.RejectReturn(context.TODO(), "admin_id", newID().String(), "return-1", "used")
+
+	require.NoError(t, err)
+	require.Equal(t, rejected, res)
+}
+
+func TestPublishRefunds(t *testing.T) {
+	var (
+		createdBefore = now().Add(-time.Minute)
+
+		unpublished = &refund.Filter{
+			Published:     option.New(false),
+			CreatedBefore: option.New(createdBefore),
+		}
+
+		refunds = []*refund.Refund{
+			{ID: "refund-1", TSCreate: now(), OrderID: newID().String(), ReturnID: "return-1", PaymentID: "payment-1", Amount: 400, Currency: "EUR"},
+			{ID: "refund-2", TSCreate: now(), OrderID: newID().String(), ReturnID: "return-1", PaymentID: "payment-3", Amount: 600, Currency: "EUR"},
+		}
+
+		returns = []*refund.Return{
+			{ID: "return-1", LineID: "line-1", Quantity: 1, Status: refund.StatusApproved, DecidedBy: "admin_id"},
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			refundQuerier   = refundMock.NewMockQuerier(ctrl)
+			refundCommander = refundMock.NewMockCommander(ctrl)
+			refunded        = &publisherStub[refund.Refund]{}
+		)
+
+		refundQuerier.EXPECT().GetRefunds(context.TODO(), unpublished).Return(refunds, nil)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), &refund.Filter{IDs: option.New([]string{"return-1"})}).Return(returns, nil)
+		refundCommander.EXPECT().SetPublished(context.TODO(), now(), "refund-1", "refund-2").Return(nil)
+
+		service := svc.New(svc.Params{
+			QrRefund:  refundQuerier,
+			CmdRefund: refundCommander,
+			Refunded:  refunded,
+			Now:       now,
+			NewID:     newID,
+		})
+
+		n, err := service.PublishRefunds(context.TODO(), createdBefore)
+
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+		require.Len(t, refunded.messages, 2)
+		require.Equal(t, "refund-2", refunded.messages[1].Value.CommandID)
+		require.Equal(t, "admin_id", refunded.messages[1].Value.UserID)
+	})
+
+	t.Run("Publish failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			refundQuerier = refundMock.NewMockQuerier(ctrl)
+			someErr       = fmt.Errorf("some error")
+			refunded      = &publisherStub[refund.Refund]{err: someErr}
+		)
+
+		// the refunds are left unpublished for the next run
+		refundQuerier.EXPECT().GetRefunds(context.TODO(), unpublished).Return(refunds, nil)
+		refundQuerier.EXPECT().GetReturns(context.TODO(), gomock.Any()).Return(returns, nil)
+
+		service := svc.New(svc.Params{
+			QrRefund: refundQuerier,
+			Refunded: refunded,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		_, err := service.PublishRefunds(context.TODO(), createdBefore)
+
+		require.ErrorIs(t, err, someErr)
+	})
+}
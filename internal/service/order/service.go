@@ -2,23 +2,98 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/approval"
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/order/internal/model/erasure"
+	"github.com/krivenkov/order/internal/model/grant"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/inventory"
+	"github.com/krivenkov/order/internal/model/ledger"
+	"github.com/krivenkov/order/internal/model/line"
 	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/model/recurring"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/order/internal/model/tax"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/bus"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/mlog"
 	"github.com/krivenkov/pkg/option"
 	"github.com/krivenkov/pkg/order"
 	"github.com/krivenkov/pkg/paginator"
 	"github.com/krivenkov/pkg/ptr"
 	"github.com/krivenkov/pkg/txer"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
 type service struct {
 	cmdPg, cmdEs orderModel.Commander
 	qrPg, qrEs   orderModel.Querier
+	tagger       orderModel.Tagger
+	numberer     orderModel.Numberer
+
+	cmdErasure erasure.Commander
+	erased     bus.Publisher[erasure.Erasure]
+
+	cmdHistory history.Commander
+	qrHistory  history.Querier
+
+	cmdLine line.Commander
+	qrLine  line.Querier
+
+	cmdShipment shipment.Commander
+	qrShipment  shipment.Querier
+
+	cmdPayment payment.Commander
+	qrPayment  payment.Querier
+
+	cmdRefund refund.Commander
+	qrRefund  refund.Querier
+	refunded  bus.Publisher[refund.Refund]
+
+	cmdPromo promo.Commander
+	qrPromo  promo.Querier
+
+	cmdRecurring recurring.Commander
+
+	cmdTenant tenant.Commander
+	qrTenant  tenant.Querier
+
+	cmdLedger ledger.Commander
+
+	cmdGrant grant.Commander
+	qrGrant  grant.Querier
+
+	cmdApproval      approval.Commander
+	qrApproval       approval.Querier
+	approvalNotified bus.Publisher[approval.Notification]
+
+	cmdAttachment    attachment.Commander
+	qrAttachment     attachment.Querier
+	blobs            attachment.BlobStore
+	attachmentLimits attachment.Limits
+
+	cmdComment, cmdEsComment comment.Commander
+	qrComment                comment.Querier
+	commentSearcher          comment.Searcher
+	commentAdded             bus.Publisher[comment.Comment]
+
+	tax      tax.Calculator
+	reserver inventory.Reserver
+
+	reservationTTL time.Duration
+	approval       ApprovalConfig
 
 	tXer  txer.TXer
 	now   func() time.Time
@@ -33,6 +108,60 @@ type Params struct {
 	QrPg  orderModel.Querier   `name:"order_pg_qr"`
 	QrEs  orderModel.Querier   `name:"order_es_qr"`
 
+	Tagger   orderModel.Tagger   `name:"order_pg_tagger"`
+	Numberer orderModel.Numberer `name:"order_pg_numberer"`
+
+	CmdErasure erasure.Commander              `name:"erasure_pg_cmd"`
+	Erased     bus.Publisher[erasure.Erasure] `name:"erasure_bus_erased"`
+
+	CmdHistory history.Commander `name:"history_pg_cmd"`
+	QrHistory  history.Querier   `name:"history_pg_qr"`
+
+	CmdLine line.Commander `name:"line_pg_cmd"`
+	QrLine  line.Querier   `name:"line_pg_qr"`
+
+	CmdShipment shipment.Commander `name:"shipment_pg_cmd"`
+	QrShipment  shipment.Querier   `name:"shipment_pg_qr"`
+
+	CmdPayment payment.Commander `name:"payment_pg_cmd"`
+	QrPayment  payment.Querier   `name:"payment_pg_qr"`
+
+	CmdRefund refund.Commander             `name:"refund_pg_cmd"`
+	QrRefund  refund.Querier               `name:"refund_pg_qr"`
+	Refunded  bus.Publisher[refund.Refund] `name:"refund_bus_refunded"`
+
+	CmdPromo promo.Commander `name:"promo_pg_cmd"`
+	QrPromo  promo.Querier   `name:"promo_pg_qr"`
+
+	CmdRecurring recurring.Commander `name:"recurring_pg_cmd"`
+
+	CmdTenant tenant.Commander `name:"tenant_pg_cmd"`
+	QrTenant  tenant.Querier   `name:"tenant_pg_qr"`
+
+	CmdLedger ledger.Commander `name:"ledger_pg_cmd"`
+
+	CmdGrant grant.Commander `name:"grant_pg_cmd"`
+	QrGrant  grant.Querier   `name:"grant_pg_qr"`
+
+	CmdApproval      approval.Commander                   `name:"approval_pg_cmd"`
+	QrApproval       approval.Querier                     `name:"approval_pg_qr"`
+	ApprovalNotified bus.Publisher[approval.Notification] `name:"approval_bus_notified"`
+
+	CmdAttachment attachment.Commander `name:"attachment_pg_cmd"`
+	QrAttachment  attachment.Querier   `name:"attachment_pg_qr"`
+	Blobs         attachment.BlobStore `name:"attachment_blob"`
+
+	CmdComment      comment.Commander              `name:"comment_pg_cmd"`
+	CmdEsComment    comment.Commander              `name:"comment_es_cmd"`
+	QrComment       comment.Querier                `name:"comment_pg_qr"`
+	CommentSearcher comment.Searcher               `name:"comment_es_searcher"`
+	CommentAdded    bus.Publisher[comment.Comment] `name:"comment_bus_added"`
+
+	Tax      tax.Calculator
+	Reserver inventory.Reserver `name:"inventory_pg_reserver"`
+
+	Cfg Config
+
 	TXer  txer.TXer
 	Now   func() time.Time
 	NewID func() uuid.UUID
@@ -44,6 +173,66 @@ func New(params Params) orderModel.Service {
 		cmdEs: params.CmdEs,
 		qrPg:  params.QrPg,
 		qrEs:  params.QrEs,
+
+		tagger:   params.Tagger,
+		numberer: params.Numberer,
+
+		cmdErasure: params.CmdErasure,
+		erased:     params.Erased,
+
+		cmdHistory: params.CmdHistory,
+		qrHistory:  params.QrHistory,
+
+		cmdLine: params.CmdLine,
+		qrLine:  params.QrLine,
+
+		cmdShipment: params.CmdShipment,
+		qrShipment:  params.QrShipment,
+
+		cmdPayment: params.CmdPayment,
+		qrPayment:  params.QrPayment,
+
+		cmdRefund: params.CmdRefund,
+		qrRefund:  params.QrRefund,
+		refunded:  params.Refunded,
+
+		cmdPromo: params.CmdPromo,
+		qrPromo:  params.QrPromo,
+
+		cmdRecurring: params.CmdRecurring,
+
+		cmdTenant: params.CmdTenant,
+		qrTenant:  params.QrTenant,
+
+		cmdLedger: params.CmdLedger,
+
+		cmdGrant: params.CmdGrant,
+		qrGrant:  params.QrGrant,
+
+		cmdApproval:      params.CmdApproval,
+		qrApproval:       params.QrApproval,
+		approvalNotified: params.ApprovalNotified,
+
+		cmdAttachment: params.CmdAttachment,
+		qrAttachment:  params.QrAttachment,
+		blobs:         params.Blobs,
+		attachmentLimits: attachment.Limits{
+			MaxSize:      params.Cfg.Attachments.MaxSize,
+			ContentTypes: params.Cfg.Attachments.ContentTypes,
+		},
+
+		cmdComment:      params.CmdComment,
+		cmdEsComment:    params.CmdEsComment,
+		qrComment:       params.QrComment,
+		commentSearcher: params.CommentSearcher,
+		commentAdded:    params.CommentAdded,
+
+		tax:      params.Tax,
+		reserver: params.Reserver,
+
+		reservationTTL: params.Cfg.ReservationTTL,
+		approval:       params.Cfg.Approval,
+
 		tXer:  params.TXer,
 		now:   params.Now,
 		newID: params.NewID,
@@ -51,15 +240,83 @@ func New(params Params) orderModel.Service {
 }
 
 func (s *service) Create(ctx context.Context, userID string, form *orderModel.Form) (*orderModel.Order, error) {
+	if err := form.Validate(); err != nil {
+		return nil, err
+	}
+
 	item := orderModel.New(userID, s.now, s.newID)
 	item.FillForm(form)
 
+	if scope, ok := tenant.ScopeFromContext(ctx); ok {
+		if !scope.Role.Allows(tenant.RoleEditor) {
+			return nil, model.ErrPermissionDenied
+		}
+
+		item.TenantID = scope.TenantID
+	}
+
+	lines := make([]*line.Line, 0, len(form.Lines))
+	for _, f := range form.Lines {
+		lines = append(lines, line.New(item.ID, f, s.now, s.newID))
+	}
+
+	if err := s.applyPromoCodes(ctx, item, lines, form.PromoCodes); err != nil {
+		return nil, err
+	}
+
+	if err := s.applyTaxes(ctx, item, lines); err != nil {
+		return nil, err
+	}
+
+	item.Totals.Calculate(lines)
+
+	// a draft redeems its promo codes and reserves its stock at the checkout, an order
+	// waiting for an approval once approved
+	var req *approval.Approval
+
+	switch {
+	case form.Draft:
+		item.State = orderModel.StateDraft
+	case s.needsApproval(item):
+		var err error
+		if req, err = s.requestApproval(ctx, userID, item); err != nil {
+			return nil, err
+		}
+	}
+
 	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
-		if err := s.cmdPg.Create(ctx, item); err != nil {
+		number, err := s.numberer.Next(ctx, item.TenantID, item.TSCreate)
+		if err != nil {
 			return fmt.Errorf("order create: %w", err)
 		}
 
-		if err := s.cmdEs.Create(ctx, item); err != nil {
+		item.Number = number
+
+		if err = s.cmdPg.Create(ctx, item); err != nil {
+			return fmt.Errorf("order create: %w", err)
+		}
+
+		if err = s.cmdLine.Create(ctx, lines...); err != nil {
+			return fmt.Errorf("order lines create: %w", err)
+		}
+
+		if item.State == orderModel.StatePlaced {
+			if err = s.place(ctx, userID, item, lines); err != nil {
+				return err
+			}
+		}
+
+		if req != nil {
+			if err = s.cmdApproval.Create(ctx, req); err != nil {
+				return fmt.Errorf("approval create: %w", err)
+			}
+		}
+
+		if err = s.record(ctx, userID, history.ActionCreate, nil, item, approval.Diff(nil, req)...); err != nil {
+			return err
+		}
+
+		if err = s.cmdEs.Create(ctx, item); err != nil {
 			return fmt.Errorf("order create: %w", err)
 		}
 
@@ -68,30 +325,58 @@ func (s *service) Create(ctx context.Context, userID string, form *orderModel.Fo
 		return nil, errTx
 	}
 
+	if req != nil {
+		s.notifyApproval(ctx, userID, approval.EventRequested, req)
+	}
+
 	return item, nil
 }
 
 func (s *service) Update(ctx context.Context, userID, id string, form *orderModel.Form) (*orderModel.Order, error) {
-	item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
-		Status: option.New(int(orderModel.StatusCreated)),
-		IDs:    option.New([]string{id}),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("get item: %w", err)
-	}
-
-	if item.UserID != userID {
-		return nil, model.ErrPermissionDenied
+	if err := form.Validate(); err != nil {
+		return nil, err
 	}
 
-	item.FillForm(form)
-	item.TSModify = s.now()
+	var item *orderModel.Order
 
+	// the whole row is written back, the lock keeps a payment applied meanwhile
 	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		var err error
+		if item, err = s.getLocked(ctx, userID, id, tenant.RoleEditor); err != nil {
+			return err
+		}
+
+		before := *item
+
+		item.FillForm(form)
+		item.TSModify = s.now()
+
+		// the taxes of a draft follow its address
+		if item.IsDraft() {
+			lines, err := s.qrLine.GetList(ctx, &line.Filter{OrderID: option.New(id)})
+			if err != nil {
+				return fmt.Errorf("get lines: %w", err)
+			}
+
+			if err = s.recalculate(ctx, item, lines); err != nil {
+				return err
+			}
+
+			if len(lines) > 0 {
+				if err = s.cmdLine.Update(ctx, lines...); err != nil {
+					return fmt.Errorf("lines update: %w", err)
+				}
+			}
+		}
+
 		if err = s.cmdPg.Update(ctx, item); err != nil {
 			return fmt.Errorf("order update: %w", err)
 		}
 
+		if err = s.record(ctx, userID, history.ActionUpdate, &before, item); err != nil {
+			return err
+		}
+
 		if err = s.cmdEs.Update(ctx, item); err != nil {
 			return fmt.Errorf("order update: %w", err)
 		}
@@ -105,26 +390,37 @@ func (s *service) Update(ctx context.Context, userID, id string, form *orderMode
 }
 
 func (s *service) SoftDelete(ctx context.Context, userID, id string) error {
-	item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
-		Status: option.New(int(orderModel.StatusCreated)),
-		IDs:    option.New([]string{id}),
-	})
-	if err != nil {
-		return fmt.Errorf("get item: %w", err)
-	}
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+			Status:    option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{id}),
+			ForUpdate: option.New(true),
+		})
+		if err != nil {
+			return fmt.Errorf("get item: %w", err)
+		}
 
-	if item.UserID != userID {
-		return model.ErrPermissionDenied
-	}
+		if err = authorizeOwner(ctx, userID, item, tenant.RoleEditor); err != nil {
+			return err
+		}
 
-	item.Status = orderModel.StatusDeleted
-	item.TSModify = s.now()
+		before := *item
+
+		item.Status = orderModel.StatusDeleted
+		item.TSModify = s.now()
 
-	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
 		if err = s.cmdPg.Update(ctx, item); err != nil {
 			return fmt.Errorf("order update: %w", err)
 		}
 
+		if err = s.reserver.Release(ctx, item.ID); err != nil {
+			return fmt.Errorf("release stock: %w", err)
+		}
+
+		if err = s.record(ctx, userID, history.ActionDelete, &before, item); err != nil {
+			return err
+		}
+
 		if err = s.cmdEs.Update(ctx, item); err != nil {
 			return fmt.Errorf("order update: %w", err)
 		}
@@ -138,12 +434,17 @@ func (s *service) SoftDelete(ctx context.Context, userID, id string) error {
 }
 
 func (s *service) Disable(ctx context.Context, userID string) error {
-	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
-		if err := s.cmdPg.Disable(ctx, userID); err != nil {
+	if errTx := s.joinTX(ctx, func(ctx context.Context) error {
+		transitions, err := s.cmdPg.Disable(ctx, userID)
+		if err != nil {
 			return fmt.Errorf("disable orders: %w", err)
 		}
 
-		if err := s.cmdEs.Disable(ctx, userID); err != nil {
+		if err = s.recordTransitions(ctx, userID, history.ActionDisable, transitions); err != nil {
+			return err
+		}
+
+		if _, err = s.cmdEs.Disable(ctx, userID); err != nil {
 			return fmt.Errorf("disable orders: %w", err)
 		}
 
@@ -155,6 +456,72 @@ func (s *service) Disable(ctx context.Context, userID string) error {
 	return nil
 }
 
+func (s *service) Enable(ctx context.Context, userID string) error {
+	if errTx := s.joinTX(ctx, func(ctx context.Context) error {
+		transitions, err := s.cmdPg.Enable(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("enable orders: %w", err)
+		}
+
+		if err = s.recordTransitions(ctx, userID, history.ActionEnable, transitions); err != nil {
+			return err
+		}
+
+		if _, err = s.cmdEs.Enable(ctx, userID); err != nil {
+			return fmt.Errorf("enable orders: %w", err)
+		}
+
+		return nil
+	}); errTx != nil {
+		return errTx
+	}
+
+	return nil
+}
+
+// record writes the changes of the order to its history, extra changes are those of its
+// related records such as the approval
+func (s *service) record(ctx context.Context, userID string, action history.Action, before, after *orderModel.Order, extra ...*history.Change) error {
+	changes := append(orderModel.Diff(before, after), extra...)
+	entry := history.New(after.ID, history.OriginFromContext(ctx, userID), action, changes, s.now, s.newID)
+
+	if err := s.cmdHistory.Create(ctx, entry); err != nil {
+		return fmt.Errorf("write history: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) recordTransitions(ctx context.Context, userID string, action history.Action, transitions []*orderModel.Transition) error {
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	origin := history.OriginFromContext(ctx, userID)
+
+	entries := make([]*history.Entry, 0, len(transitions))
+	for _, t := range transitions {
+		entries = append(entries, history.New(t.ID, origin, action, t.Changes(), s.now, s.newID))
+	}
+
+	if err := s.cmdHistory.Create(ctx, entries...); err != nil {
+		return fmt.Errorf("write history: %w", err)
+	}
+
+	return nil
+}
+
+// joinTX runs f in the transaction already started by the caller (e.g. a bus handler
+// writing its ledger) or in a new one
+func (s *service) joinTX(ctx context.Context, f func(ctx context.Context) error) error {
+	err := s.tXer.WithTX(ctx, f)
+	if errors.Is(err, database.ErrTxAlready) {
+		return f(ctx)
+	}
+
+	return err
+}
+
 func (s *service) GetList(ctx context.Context, userID string, req *orderModel.GetListRequest) ([]*orderModel.Order, error) {
 	var (
 		orders     []*order.Order
@@ -169,10 +536,23 @@ func (s *service) GetList(ctx context.Context, userID string, req *orderModel.Ge
 		pagination = ptr.Pointer(req.Pagination.Value())
 	}
 
-	filter := s.prepareListCondition(userID, req)
+	filter := s.prepareListCondition(ctx, userID, req)
+
+	if req != nil && req.IncludeShared.IsSet() && req.IncludeShared.Value() {
+		if err := s.includeShared(ctx, userID, filter); err != nil {
+			return nil, err
+		}
+	}
+
+	s.includeCommented(ctx, filter)
 
 	if filter.Q.IsSet() {
-		return s.qrEs.GetList(ctx, filter, orders, pagination)
+		list, err := s.qrEs.GetList(ctx, filter, orders, pagination)
+		if err == nil {
+			return list, nil
+		}
+
+		mlog.FromContext(ctx).Warn("es get list failed, fallback to pg", zap.Error(err))
 	}
 
 	return s.qrPg.GetList(ctx, filter, orders, pagination)
@@ -187,27 +567,125 @@ func (s *service) GetItem(ctx context.Context, userID string, id string) (*order
 		return nil, fmt.Errorf("get item: %w", err)
 	}
 
-	if item.UserID != userID {
-		return nil, model.ErrPermissionDenied
+	if err = s.authorize(ctx, userID, item, tenant.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (s *service) GetItemByNumber(ctx context.Context, userID string, number string) (*orderModel.Order, error) {
+	item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusCreated)),
+		Number: option.New(number),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get item: %w", err)
+	}
+
+	if err = s.authorize(ctx, userID, item, tenant.RoleViewer); err != nil {
+		return nil, err
 	}
 
 	return item, nil
 }
 
 func (s *service) Count(ctx context.Context, userID string, req *orderModel.GetCountRequest) (int, error) {
-	filter := s.prepareCountCondition(userID, req)
+	filter := s.prepareCountCondition(ctx, userID, req)
+
+	if req != nil && req.IncludeShared.IsSet() && req.IncludeShared.Value() {
+		if err := s.includeShared(ctx, userID, filter); err != nil {
+			return 0, err
+		}
+	}
+
+	s.includeCommented(ctx, filter)
 
 	if filter.Q.IsSet() {
-		return s.qrEs.Count(ctx, filter)
+		count, err := s.qrEs.Count(ctx, filter)
+		if err == nil {
+			return count, nil
+		}
+
+		mlog.FromContext(ctx).Warn("es count failed, fallback to pg", zap.Error(err))
 	}
 
 	return s.qrPg.Count(ctx, filter)
 }
 
+func (s *service) GetFacets(ctx context.Context, userID string, req *orderModel.GetFacetsRequest) (*orderModel.Facets, error) {
+	filter := s.prepareFacetsCondition(ctx, userID, req)
+	interval := orderModel.DateIntervalDay
+
+	if req != nil && req.IncludeShared.IsSet() && req.IncludeShared.Value() {
+		if err := s.includeShared(ctx, userID, filter); err != nil {
+			return nil, err
+		}
+	}
+
+	if req != nil && req.Interval.IsSet() {
+		interval = req.Interval.Value()
+	}
+
+	return s.facets(ctx, filter, interval)
+}
+
+func (s *service) GetTags(ctx context.Context, userID string) ([]*orderModel.TagFacet, error) {
+	filter := ownerFilter(ctx, userID, &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusCreated)),
+	})
+
+	tags, err := s.tagger.Tags(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("get tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (s *service) GetHistory(ctx context.Context, userID, id string, pagination paginator.Pagination) ([]*history.Entry, int, error) {
+	item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+		IDs: option.New([]string{id}),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("get item: %w", err)
+	}
+
+	if err = s.authorize(ctx, userID, item, tenant.RoleViewer); err != nil {
+		return nil, 0, err
+	}
+
+	return s.history(ctx, id, pagination)
+}
+
+func (s *service) InnerGetHistory(ctx context.Context, id string, pagination paginator.Pagination) ([]*history.Entry, int, error) {
+	return s.history(ctx, id, pagination)
+}
+
+func (s *service) history(ctx context.Context, id string, pagination paginator.Pagination) ([]*history.Entry, int, error) {
+	filter := &history.Filter{
+		OrderID: option.New(id),
+	}
+
+	entries, err := s.qrHistory.GetList(ctx, filter, &pagination)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get history: %w", err)
+	}
+
+	total, err := s.qrHistory.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count history: %w", err)
+	}
+
+	return entries, total, nil
+}
+
 func (s *service) InnerGetItem(ctx context.Context, req *orderModel.InnerGetItemRequest) (*orderModel.Order, error) {
 	item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
-		IDs:    req.IDs,
-		UserID: req.UserID,
+		IDs:      req.IDs,
+		UserID:   req.UserID,
+		TenantID: req.TenantID,
+		Number:   req.Number,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("get item: %w", err)
@@ -235,45 +713,141 @@ func (s *service) InnerGetList(ctx context.Context, req *orderModel.InnerGetList
 	return s.qrPg.GetList(ctx, filter, orders, pagination)
 }
 
+func (s *service) InnerGetFacets(ctx context.Context, req *orderModel.InnerGetFacetsRequest) (*orderModel.Facets, error) {
+	filter := &orderModel.Filter{}
+	interval := orderModel.DateIntervalDay
+
+	if req != nil {
+		filter.IDs = req.IDs
+		filter.UserID = req.UserID
+		filter.TenantID = req.TenantID
+		filter.Number = req.Number
+		filter.Q = req.Q
+		filter.Tags = normalizeTags(req.Tags)
+		filter.TagsAny = normalizeTags(req.TagsAny)
+		filter.Metadata = req.Metadata
+
+		if req.Interval.IsSet() {
+			interval = req.Interval.Value()
+		}
+	}
+
+	return s.facets(ctx, filter, interval)
+}
+
+func (s *service) facets(ctx context.Context, filter *orderModel.Filter, interval orderModel.DateInterval) (*orderModel.Facets, error) {
+	s.includeCommented(ctx, filter)
+
+	if filter.Q.IsSet() {
+		facets, err := s.qrEs.Facets(ctx, filter, interval)
+		if err == nil {
+			return facets, nil
+		}
+
+		mlog.FromContext(ctx).Warn("es facets failed, fallback to pg", zap.Error(err))
+	}
+
+	return s.qrPg.Facets(ctx, filter, interval)
+}
+
 func (s *service) prepareInnerListCondition(req *orderModel.InnerGetListRequest) *orderModel.Filter {
 	if req == nil {
 		return &orderModel.Filter{}
 	}
 
 	return &orderModel.Filter{
-		UserID: req.UserID,
-		IDs:    req.IDs,
+		UserID:   req.UserID,
+		TenantID: req.TenantID,
+		IDs:      req.IDs,
+		Number:   req.Number,
+		Tags:     normalizeTags(req.Tags),
+		TagsAny:  normalizeTags(req.TagsAny),
+		Metadata: req.Metadata,
 	}
 }
 
-func (s *service) prepareListCondition(userID string, req *orderModel.GetListRequest) *orderModel.Filter {
-	filter := &orderModel.Filter{
-		Status: option.New(int(orderModel.StatusCreated)),
-		UserID: option.New(userID),
+// normalizeTags matches the tags of a filter as they are stored, regardless of case
+func normalizeTags(tags option.Option[[]string]) option.Option[[]string] {
+	if !tags.IsSet() {
+		return tags
+	}
+
+	res := make([]string, 0, len(tags.Value()))
+	for _, tag := range tags.Value() {
+		if tag = orderModel.NormalizeTag(tag); tag != "" {
+			res = append(res, tag)
+		}
+	}
+
+	if len(res) == 0 {
+		return option.Nil[[]string]()
 	}
 
+	return option.New(res)
+}
+
+func (s *service) prepareListCondition(ctx context.Context, userID string, req *orderModel.GetListRequest) *orderModel.Filter {
+	filter := ownerFilter(ctx, userID, &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusCreated)),
+	})
+
 	if req == nil {
+		filter.Draft = option.New(false)
+
 		return filter
 	}
 
+	filter.Draft = option.New(req.Draft.IsSet() && req.Draft.Value())
+
 	filter.Q = req.Q
 	filter.IDs = req.IDs
+	filter.Tags = normalizeTags(req.Tags)
+	filter.TagsAny = normalizeTags(req.TagsAny)
+	filter.Metadata = req.Metadata
 
 	return filter
 }
 
-func (s *service) prepareCountCondition(userID string, req *orderModel.GetCountRequest) *orderModel.Filter {
-	filter := &orderModel.Filter{
+func (s *service) prepareCountCondition(ctx context.Context, userID string, req *orderModel.GetCountRequest) *orderModel.Filter {
+	filter := ownerFilter(ctx, userID, &orderModel.Filter{
 		Status: option.New(int(orderModel.StatusCreated)),
-		UserID: option.New(userID),
+	})
+
+	if req == nil {
+		filter.Draft = option.New(false)
+
+		return filter
 	}
 
+	filter.Draft = option.New(req.Draft.IsSet() && req.Draft.Value())
+
+	filter.Q = req.Q
+	filter.IDs = req.IDs
+	filter.Tags = normalizeTags(req.Tags)
+	filter.TagsAny = normalizeTags(req.TagsAny)
+	filter.Metadata = req.Metadata
+
+	return filter
+}
+
+func (s *service) prepareFacetsCondition(ctx context.Context, userID string, req *orderModel.GetFacetsRequest) *orderModel.Filter {
+	filter := ownerFilter(ctx, userID, &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusCreated)),
+	})
+
 	if req == nil {
+		filter.Draft = option.New(false)
+
 		return filter
 	}
 
+	filter.Draft = option.New(req.Draft.IsSet() && req.Draft.Value())
+
 	filter.Q = req.Q
 	filter.IDs = req.IDs
+	filter.Tags = normalizeTags(req.Tags)
+	filter.TagsAny = normalizeTags(req.TagsAny)
+	filter.Metadata = req.Metadata
 
 	return filter
 }
@@ -9,12 +9,23 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	commentMock "github.com/krivenkov/order/internal/model/comment/mock"
+	grantMock "github.com/krivenkov/order/internal/model/grant/mock"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	"github.com/krivenkov/order/internal/model/inventory"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	"github.com/krivenkov/order/internal/model/line"
+	lineMock "github.com/krivenkov/order/internal/model/line/mock"
 	orderModel "github.com/krivenkov/order/internal/model/order"
 	orderMock "github.com/krivenkov/order/internal/model/order/mock"
 	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/clients/database"
 	"github.com/krivenkov/pkg/option"
 	"github.com/krivenkov/pkg/order"
 	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
 	txerMock "github.com/krivenkov/pkg/txer/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -26,6 +37,7 @@ func TestCreate(t *testing.T) {
 
 		var (
 			userID      = "user_id"
+			number      = "ORD-2000-000001"
 			name        = "test"
 			description = "some text"
 
@@ -33,6 +45,10 @@ func TestCreate(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			numberer         = orderMock.NewMockNumberer(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -40,9 +56,33 @@ func TestCreate(t *testing.T) {
 				TSCreate:    now(),
 				TSModify:    now(),
 				Status:      orderModel.StatusCreated,
+				State:       orderModel.StatePlaced,
+				Number:      number,
 				UserID:      userID,
 				Name:        name,
 				Description: description,
+				Totals: orderModel.Totals{
+					Currency: "EUR",
+					Subtotal: 500,
+					Total:    500,
+				},
+			}
+
+			entry = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    userID,
+				Action:   history.ActionCreate,
+				Changes: []*history.Change{
+					{Field: "status", Old: "", New: "created"},
+					{Field: "state", Old: "", New: "placed"},
+					{Field: "number", Old: "", New: number},
+					{Field: "name", Old: "", New: name},
+					{Field: "description", Old: "", New: description},
+					{Field: "currency", Old: "", New: "EUR"},
+					{Field: "total", Old: "0", New: "500"},
+				},
 			}
 		)
 
@@ -50,23 +90,54 @@ func TestCreate(t *testing.T) {
 			return cb(ctx)
 		}).AnyTimes()
 
+		numberer.EXPECT().Next(context.TODO(), "", now()).Return(number, nil)
+
 		orderPGCommander.EXPECT().Create(context.TODO(), orderItem).Return(nil)
 
+		lineCommander.EXPECT().Create(context.TODO(), &line.Line{
+			ID:        newID().String(),
+			TSCreate:  now(),
+			OrderID:   newID().String(),
+			SKU:       "SKU-1",
+			Name:      "Widget",
+			Quantity:  2,
+			UnitPrice: 250,
+		}).Return(nil)
+
+		reserver.EXPECT().Reserve(context.TODO(), &inventory.Reservation{
+			ID:       newID().String(),
+			TSCreate: now(),
+			TSExpire: ptr.Pointer(now().Add(30 * time.Minute)),
+			OrderID:  newID().String(),
+			SKU:      "SKU-1",
+			Quantity: 2,
+			Status:   inventory.StatusHeld,
+		}).Return(nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+
 		orderESCommander.EXPECT().Create(context.TODO(), orderItem).Return(nil)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			Numberer:   numberer,
+			CmdHistory: historyCommander,
+			CmdLine:    lineCommander,
+			Reserver:   reserver,
+			Cfg:        svc.Config{ReservationTTL: 30 * time.Minute},
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		res, err := service.Create(context.TODO(), userID, &orderModel.Form{
 			Name:        &name,
 			Description: &description,
+			Currency:    ptr.Pointer("eur"),
+			Lines:       []*line.Form{{SKU: " SKU-1 ", Name: "Widget", Quantity: 2, UnitPrice: 250}},
 		})
 
 		require.NoError(t, err)
@@ -79,6 +150,7 @@ func TestCreate(t *testing.T) {
 
 		var (
 			userID      = "user_id"
+			number      = "ORD-2000-000001"
 			name        = "test"
 			description = "some text"
 
@@ -86,6 +158,9 @@ func TestCreate(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			numberer         = orderMock.NewMockNumberer(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -93,6 +168,8 @@ func TestCreate(t *testing.T) {
 				TSCreate:    now(),
 				TSModify:    now(),
 				Status:      orderModel.StatusCreated,
+				State:       orderModel.StatePlaced,
+				Number:      number,
 				UserID:      userID,
 				Name:        name,
 				Description: description,
@@ -105,18 +182,27 @@ func TestCreate(t *testing.T) {
 			return cb(ctx)
 		}).AnyTimes()
 
+		numberer.EXPECT().Next(context.TODO(), "", now()).Return(number, nil)
+
 		orderPGCommander.EXPECT().Create(context.TODO(), orderItem).Return(nil)
 
+		lineCommander.EXPECT().Create(context.TODO()).Return(nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+
 		orderESCommander.EXPECT().Create(context.TODO(), orderItem).Return(someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			Numberer:   numberer,
+			CmdHistory: historyCommander,
+			CmdLine:    lineCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		res, err := service.Create(context.TODO(), userID, &orderModel.Form{
@@ -134,6 +220,7 @@ func TestCreate(t *testing.T) {
 
 		var (
 			userID      = "user_id"
+			number      = "ORD-2000-000001"
 			name        = "test"
 			description = "some text"
 
@@ -141,6 +228,9 @@ func TestCreate(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			numberer         = orderMock.NewMockNumberer(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -148,6 +238,8 @@ func TestCreate(t *testing.T) {
 				TSCreate:    now(),
 				TSModify:    now(),
 				Status:      orderModel.StatusCreated,
+				State:       orderModel.StatePlaced,
+				Number:      number,
 				UserID:      userID,
 				Name:        name,
 				Description: description,
@@ -160,16 +252,21 @@ func TestCreate(t *testing.T) {
 			return cb(ctx)
 		}).AnyTimes()
 
+		numberer.EXPECT().Next(context.TODO(), "", now()).Return(number, nil)
+
 		orderPGCommander.EXPECT().Create(context.TODO(), orderItem).Return(someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			Numberer:   numberer,
+			CmdHistory: historyCommander,
+			CmdLine:    lineCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		res, err := service.Create(context.TODO(), userID, &orderModel.Form{
@@ -196,6 +293,7 @@ func TestUpdate(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -207,6 +305,15 @@ func TestUpdate(t *testing.T) {
 				Name:        name,
 				Description: description,
 			}
+
+			entry = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    userID,
+				Action:   history.ActionUpdate,
+				Changes:  []*history.Change{},
+			}
 		)
 
 		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
@@ -214,22 +321,26 @@ func TestUpdate(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, nil)
 
 		orderPGCommander.EXPECT().Update(context.TODO(), orderItem).Return(nil)
 
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+
 		orderESCommander.EXPECT().Update(context.TODO(), orderItem).Return(nil)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		res, err := service.Update(context.TODO(), userID, newID().String(), &orderModel.Form{
@@ -241,6 +352,131 @@ func TestUpdate(t *testing.T) {
 		require.Equal(t, orderItem, res)
 	})
 
+	t.Run("Shipping address only", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			billing = &orderModel.Address{
+				Name:       "John Smith",
+				Line1:      "1 Main St",
+				City:       "Springfield",
+				Region:     "IL",
+				PostalCode: "62701",
+				Country:    "US",
+				Phone:      "+12175550100",
+			}
+
+			shipping = &orderModel.Address{
+				Name:       "John Smith",
+				Line1:      "221B Baker Street",
+				City:       "London",
+				PostalCode: "NW1 6XE",
+				Country:    "GB",
+				Phone:      "+442071234567",
+			}
+
+			orderItem = &orderModel.Order{
+				ID:             newID().String(),
+				TSCreate:       now(),
+				TSModify:       now(),
+				Status:         orderModel.StatusCreated,
+				UserID:         userID,
+				BillingAddress: billing,
+			}
+
+			updated = &orderModel.Order{
+				ID:              newID().String(),
+				TSCreate:        now(),
+				TSModify:        now(),
+				Status:          orderModel.StatusCreated,
+				UserID:          userID,
+				ShippingAddress: shipping,
+				BillingAddress:  billing,
+			}
+
+			entry = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    userID,
+				Action:   history.ActionUpdate,
+				Changes: []*history.Change{
+					{Field: "shipping_address", Old: "", New: shipping.String()},
+				},
+			}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).AnyTimes()
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
+		}).Return(orderItem, nil)
+
+		orderPGCommander.EXPECT().Update(context.TODO(), updated).Return(nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+
+		orderESCommander.EXPECT().Update(context.TODO(), updated).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.Update(context.TODO(), userID, newID().String(), &orderModel.Form{
+			ShippingAddress: &orderModel.Address{
+				Name:       " John Smith ",
+				Line1:      "221B Baker Street",
+				City:       "London",
+				PostalCode: "nw1  6xe",
+				Country:    "gb",
+				Phone:      "+44 20 7123 4567",
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, updated, res)
+	})
+
+	t.Run("Invalid address", func(t *testing.T) {
+		service := svc.New(svc.Params{
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.Update(context.TODO(), "user_id", newID().String(), &orderModel.Form{
+			BillingAddress: &orderModel.Address{
+				Name:    "John Smith",
+				Line1:   "1 Main St",
+				City:    "Springfield",
+				Country: "US",
+				Phone:   "+12175550100",
+			},
+		})
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
 	t.Run("Error update in es", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -254,6 +490,7 @@ func TestUpdate(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -274,22 +511,26 @@ func TestUpdate(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, nil)
 
 		orderPGCommander.EXPECT().Update(context.TODO(), orderItem).Return(nil)
 
+		historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+
 		orderESCommander.EXPECT().Update(context.TODO(), orderItem).Return(someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		res, err := service.Update(context.TODO(), userID, newID().String(), &orderModel.Form{
@@ -314,6 +555,7 @@ func TestUpdate(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -334,20 +576,22 @@ func TestUpdate(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, nil)
 
 		orderPGCommander.EXPECT().Update(context.TODO(), orderItem).Return(someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		res, err := service.Update(context.TODO(), userID, newID().String(), &orderModel.Form{
@@ -372,6 +616,7 @@ func TestUpdate(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -392,18 +637,20 @@ func TestUpdate(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		res, err := service.Update(context.TODO(), userID, newID().String(), &orderModel.Form{
@@ -428,6 +675,7 @@ func TestUpdate(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -446,18 +694,24 @@ func TestUpdate(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, nil)
 
+		grantQuerier := grantMock.NewMockQuerier(ctrl)
+		grantQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil)
+
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrGrant:    grantQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		res, err := service.Update(context.TODO(), userID, newID().String(), &orderModel.Form{
@@ -484,6 +738,8 @@ func TestSoftDelete(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -495,6 +751,17 @@ func TestSoftDelete(t *testing.T) {
 				Name:        name,
 				Description: description,
 			}
+
+			entry = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    userID,
+				Action:   history.ActionDelete,
+				Changes: []*history.Change{
+					{Field: "status", Old: "created", New: "deleted"},
+				},
+			}
 		)
 
 		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
@@ -502,22 +769,29 @@ func TestSoftDelete(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, nil)
 
 		orderPGCommander.EXPECT().Update(context.TODO(), orderItem).Return(nil)
 
+		reserver.EXPECT().Release(context.TODO(), newID().String()).Return(nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+
 		orderESCommander.EXPECT().Update(context.TODO(), orderItem).Return(nil)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			Reserver:   reserver,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		err := service.SoftDelete(context.TODO(), userID, newID().String())
@@ -538,6 +812,8 @@ func TestSoftDelete(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -558,22 +834,29 @@ func TestSoftDelete(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, nil)
 
 		orderPGCommander.EXPECT().Update(context.TODO(), orderItem).Return(nil)
 
+		reserver.EXPECT().Release(context.TODO(), newID().String()).Return(nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+
 		orderESCommander.EXPECT().Update(context.TODO(), orderItem).Return(someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			Reserver:   reserver,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		err := service.SoftDelete(context.TODO(), userID, newID().String())
@@ -594,6 +877,7 @@ func TestSoftDelete(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -614,20 +898,22 @@ func TestSoftDelete(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, nil)
 
 		orderPGCommander.EXPECT().Update(context.TODO(), orderItem).Return(someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		err := service.SoftDelete(context.TODO(), userID, newID().String())
@@ -648,6 +934,7 @@ func TestSoftDelete(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -668,18 +955,20 @@ func TestSoftDelete(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		err := service.SoftDelete(context.TODO(), userID, newID().String())
@@ -700,6 +989,7 @@ func TestSoftDelete(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			orderItem = &orderModel.Order{
@@ -718,18 +1008,20 @@ func TestSoftDelete(t *testing.T) {
 		}).AnyTimes()
 
 		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			Status:    option.New(int(orderModel.StatusCreated)),
+			ForUpdate: option.New(true),
 		}).Return(orderItem, nil)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		err := service.SoftDelete(context.TODO(), userID, newID().String())
@@ -746,10 +1038,34 @@ func TestDisable(t *testing.T) {
 		var (
 			userID = "user_id"
 
+			transitions = []*orderModel.Transition{
+				{ID: "1", From: orderModel.StatusCreated, To: orderModel.StatusDisabled},
+				{ID: "2", From: orderModel.StatusDeleted, To: orderModel.StatusDisabled},
+			}
+			entries = []interface{}{
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "1",
+					Actor:    userID,
+					Action:   history.ActionDisable,
+					Changes:  transitions[0].Changes(),
+				},
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "2",
+					Actor:    userID,
+					Action:   history.ActionDisable,
+					Changes:  transitions[1].Changes(),
+				},
+			}
+
 			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 		)
 
@@ -757,18 +1073,21 @@ func TestDisable(t *testing.T) {
 			return cb(ctx)
 		}).AnyTimes()
 
-		orderPGCommander.EXPECT().Disable(context.TODO(), userID).Return(nil)
+		orderPGCommander.EXPECT().Disable(context.TODO(), userID).Return(transitions, nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), entries...).Return(nil)
 
-		orderESCommander.EXPECT().Disable(context.TODO(), userID).Return(nil)
+		orderESCommander.EXPECT().Disable(context.TODO(), userID).Return(nil, nil)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		err := service.Disable(context.TODO(), userID)
@@ -783,10 +1102,34 @@ func TestDisable(t *testing.T) {
 		var (
 			userID = "user_id"
 
+			transitions = []*orderModel.Transition{
+				{ID: "1", From: orderModel.StatusCreated, To: orderModel.StatusDisabled},
+				{ID: "2", From: orderModel.StatusDeleted, To: orderModel.StatusDisabled},
+			}
+			entries = []interface{}{
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "1",
+					Actor:    userID,
+					Action:   history.ActionDisable,
+					Changes:  transitions[0].Changes(),
+				},
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "2",
+					Actor:    userID,
+					Action:   history.ActionDisable,
+					Changes:  transitions[1].Changes(),
+				},
+			}
+
 			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			someErr = fmt.Errorf("some error")
@@ -796,18 +1139,21 @@ func TestDisable(t *testing.T) {
 			return cb(ctx)
 		}).AnyTimes()
 
-		orderPGCommander.EXPECT().Disable(context.TODO(), userID).Return(nil)
+		orderPGCommander.EXPECT().Disable(context.TODO(), userID).Return(transitions, nil)
 
-		orderESCommander.EXPECT().Disable(context.TODO(), userID).Return(someErr)
+		historyCommander.EXPECT().Create(context.TODO(), entries...).Return(nil)
+
+		orderESCommander.EXPECT().Disable(context.TODO(), userID).Return(nil, someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		err := service.Disable(context.TODO(), userID)
@@ -826,6 +1172,7 @@ func TestDisable(t *testing.T) {
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
 
 			someErr = fmt.Errorf("some error")
@@ -835,77 +1182,260 @@ func TestDisable(t *testing.T) {
 			return cb(ctx)
 		}).AnyTimes()
 
-		orderPGCommander.EXPECT().Disable(context.TODO(), userID).Return(someErr)
+		orderPGCommander.EXPECT().Disable(context.TODO(), userID).Return(nil, someErr)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
 		err := service.Disable(context.TODO(), userID)
 
 		require.ErrorIs(t, err, someErr)
 	})
-}
 
-func TestGetItem(t *testing.T) {
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Joins running tx", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		var (
-			userID      = "user_id"
-			name        = "test"
-			description = "some text"
+			userID = "user_id"
+
+			transitions = []*orderModel.Transition{
+				{ID: "1", From: orderModel.StatusCreated, To: orderModel.StatusDisabled},
+				{ID: "2", From: orderModel.StatusDeleted, To: orderModel.StatusDisabled},
+			}
+			entries = []interface{}{
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "1",
+					Actor:    userID,
+					Action:   history.ActionDisable,
+					Changes:  transitions[0].Changes(),
+				},
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "2",
+					Actor:    userID,
+					Action:   history.ActionDisable,
+					Changes:  transitions[1].Changes(),
+				},
+			}
 
 			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
-
-			orderItem = &orderModel.Order{
-				ID:          newID().String(),
-				TSCreate:    now(),
-				TSModify:    now(),
-				Status:      orderModel.StatusCreated,
-				UserID:      userID,
-				Name:        name,
-				Description: description,
-			}
 		)
 
-		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
-			return cb(ctx)
-		}).AnyTimes()
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).Return(database.ErrTxAlready)
 
-		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			Status: option.New(int(orderModel.StatusCreated)),
-		}).Return(orderItem, nil)
+		orderPGCommander.EXPECT().Disable(context.TODO(), userID).Return(transitions, nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), entries...).Return(nil)
+
+		orderESCommander.EXPECT().Disable(context.TODO(), userID).Return(nil, nil)
 
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
 		})
 
-		res, err := service.GetItem(context.TODO(), userID, newID().String())
+		err := service.Disable(context.TODO(), userID)
 
 		require.NoError(t, err)
-		require.Equal(t, res, orderItem)
 	})
+}
 
-	t.Run("Permission denied", func(t *testing.T) {
+func TestEnable(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+
+			transitions = []*orderModel.Transition{
+				{ID: "1", From: orderModel.StatusDisabled, To: orderModel.StatusCreated},
+				{ID: "2", From: orderModel.StatusDisabled, To: orderModel.StatusDeleted},
+			}
+			entries = []interface{}{
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "1",
+					Actor:    userID,
+					Action:   history.ActionEnable,
+					Changes:  transitions[0].Changes(),
+				},
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "2",
+					Actor:    userID,
+					Action:   history.ActionEnable,
+					Changes:  transitions[1].Changes(),
+				},
+			}
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).AnyTimes()
+
+		orderPGCommander.EXPECT().Enable(context.TODO(), userID).Return(transitions, nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), entries...).Return(nil)
+
+		orderESCommander.EXPECT().Enable(context.TODO(), userID).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		err := service.Enable(context.TODO(), userID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Bad save in es", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+
+			transitions = []*orderModel.Transition{
+				{ID: "1", From: orderModel.StatusDisabled, To: orderModel.StatusCreated},
+				{ID: "2", From: orderModel.StatusDisabled, To: orderModel.StatusDeleted},
+			}
+			entries = []interface{}{
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "1",
+					Actor:    userID,
+					Action:   history.ActionEnable,
+					Changes:  transitions[0].Changes(),
+				},
+				&history.Entry{
+					ID:       newID().String(),
+					TSCreate: now(),
+					OrderID:  "2",
+					Actor:    userID,
+					Action:   history.ActionEnable,
+					Changes:  transitions[1].Changes(),
+				},
+			}
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			someErr = fmt.Errorf("some error")
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).AnyTimes()
+
+		orderPGCommander.EXPECT().Enable(context.TODO(), userID).Return(transitions, nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), entries...).Return(nil)
+
+		orderESCommander.EXPECT().Enable(context.TODO(), userID).Return(nil, someErr)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		err := service.Enable(context.TODO(), userID)
+
+		require.ErrorIs(t, err, someErr)
+	})
+
+	t.Run("Bad save in pg", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			someErr = fmt.Errorf("some error")
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).AnyTimes()
+
+		orderPGCommander.EXPECT().Enable(context.TODO(), userID).Return(nil, someErr)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			QrEs:       orderESQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		err := service.Enable(context.TODO(), userID)
+
+		require.ErrorIs(t, err, someErr)
+	})
+}
+
+func TestGetItem(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -925,7 +1455,7 @@ func TestGetItem(t *testing.T) {
 				TSCreate:    now(),
 				TSModify:    now(),
 				Status:      orderModel.StatusCreated,
-				UserID:      userID + "bad",
+				UserID:      userID,
 				Name:        name,
 				Description: description,
 			}
@@ -952,6 +1482,61 @@ func TestGetItem(t *testing.T) {
 
 		res, err := service.GetItem(context.TODO(), userID, newID().String())
 
+		require.NoError(t, err)
+		require.Equal(t, res, orderItem)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID      = "user_id"
+			name        = "test"
+			description = "some text"
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			orderItem = &orderModel.Order{
+				ID:          newID().String(),
+				TSCreate:    now(),
+				TSModify:    now(),
+				Status:      orderModel.StatusCreated,
+				UserID:      userID + "bad",
+				Name:        name,
+				Description: description,
+			}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).AnyTimes()
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), &orderModel.Filter{
+			IDs:    option.New([]string{newID().String()}),
+			Status: option.New(int(orderModel.StatusCreated)),
+		}).Return(orderItem, nil)
+
+		grantQuerier := grantMock.NewMockQuerier(ctrl)
+		grantQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:   orderPGCommander,
+			CmdEs:   orderESCommander,
+			QrPg:    orderPGQuerier,
+			QrGrant: grantQuerier,
+			QrEs:    orderESQuerier,
+			TXer:    tXer,
+			Now:     now,
+			NewID:   newID,
+		})
+
+		res, err := service.GetItem(context.TODO(), userID, newID().String())
+
 		require.ErrorIs(t, err, model.ErrPermissionDenied)
 		require.Nil(t, res)
 	})
@@ -998,6 +1583,89 @@ func TestGetItem(t *testing.T) {
 	})
 }
 
+func TestGetItemByNumber(t *testing.T) {
+	var (
+		userID = "user_id"
+		number = "ORD-2000-000001"
+
+		filter = &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusCreated)),
+			Number: option.New(number),
+		}
+
+		orderItem = &orderModel.Order{
+			ID:       newID().String(),
+			TSCreate: now(),
+			TSModify: now(),
+			Status:   orderModel.StatusCreated,
+			Number:   number,
+			UserID:   userID,
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(orderItem, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.GetItemByNumber(context.TODO(), userID, number)
+
+		require.NoError(t, err)
+		require.Equal(t, orderItem, res)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(orderItem, nil)
+
+		grantQuerier := grantMock.NewMockQuerier(ctrl)
+		grantQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:    orderPGQuerier,
+			QrGrant: grantQuerier,
+			Now:     now,
+			NewID:   newID,
+		})
+
+		_, err := service.GetItemByNumber(context.TODO(), "other_user", number)
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(nil, model.ErrNotFound)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.GetItemByNumber(context.TODO(), userID, number)
+
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
 func TestInnerGetItem(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -1115,9 +1783,11 @@ func TestCount(t *testing.T) {
 		)
 
 		orderPGQuerier.EXPECT().Count(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			UserID: option.New(userID),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:      option.New([]string{newID().String()}),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+			Draft:    option.New(false),
+			Status:   option.New(int(orderModel.StatusCreated)),
 		}).Return(count, nil)
 
 		service := svc.New(svc.Params{
@@ -1152,23 +1822,29 @@ func TestCount(t *testing.T) {
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
+			commentSearcher  = commentMock.NewMockSearcher(ctrl)
 		)
 
 		orderESQuerier.EXPECT().Count(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			UserID: option.New(userID),
-			Status: option.New(int(orderModel.StatusCreated)),
-			Q:      option.New(q),
+			IDs:      option.New([]string{newID().String()}),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+			Draft:    option.New(false),
+			Status:   option.New(int(orderModel.StatusCreated)),
+			Q:        option.New(q),
 		}).Return(count, nil)
 
+		commentSearcher.EXPECT().SearchOrders(context.TODO(), &comment.SearchFilter{Q: q, UserID: option.New(userID), TenantID: option.New("")}, 1000).Return(nil, nil)
+
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:           orderPGCommander,
+			CmdEs:           orderESCommander,
+			QrPg:            orderPGQuerier,
+			QrEs:            orderESQuerier,
+			CommentSearcher: commentSearcher,
+			TXer:            tXer,
+			Now:             now,
+			NewID:           newID,
 		})
 
 		res, err := service.Count(context.TODO(), userID, &orderModel.GetCountRequest{
@@ -1196,8 +1872,10 @@ func TestCount(t *testing.T) {
 		)
 
 		orderPGQuerier.EXPECT().Count(context.TODO(), &orderModel.Filter{
-			UserID: option.New(userID),
-			Status: option.New(int(orderModel.StatusCreated)),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+			Draft:    option.New(false),
+			Status:   option.New(int(orderModel.StatusCreated)),
 		}).Return(count, nil)
 
 		service := svc.New(svc.Params{
@@ -1215,6 +1893,56 @@ func TestCount(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, res, count)
 	})
+
+	t.Run("Fallback to pg", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+			q      = "test"
+			count  = 103
+			esErr  = fmt.Errorf("es is unavailable")
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+			commentSearcher  = commentMock.NewMockSearcher(ctrl)
+
+			filter = &orderModel.Filter{
+				UserID:   option.New(userID),
+				TenantID: option.New(""),
+				Draft:    option.New(false),
+				Status:   option.New(int(orderModel.StatusCreated)),
+				Q:        option.New(q),
+			}
+		)
+
+		orderESQuerier.EXPECT().Count(context.TODO(), filter).Return(0, esErr)
+		orderPGQuerier.EXPECT().Count(context.TODO(), filter).Return(count, nil)
+
+		commentSearcher.EXPECT().SearchOrders(context.TODO(), &comment.SearchFilter{Q: q, UserID: option.New(userID), TenantID: option.New("")}, 1000).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:           orderPGCommander,
+			CmdEs:           orderESCommander,
+			QrPg:            orderPGQuerier,
+			QrEs:            orderESQuerier,
+			CommentSearcher: commentSearcher,
+			TXer:            tXer,
+			Now:             now,
+			NewID:           newID,
+		})
+
+		res, err := service.Count(context.TODO(), userID, &orderModel.GetCountRequest{
+			Q: option.New(q),
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, res, count)
+	})
 }
 
 func TestGetList(t *testing.T) {
@@ -1247,9 +1975,11 @@ func TestGetList(t *testing.T) {
 		)
 
 		orderPGQuerier.EXPECT().GetList(context.TODO(), &orderModel.Filter{
-			IDs:    option.New([]string{newID().String()}),
-			UserID: option.New(userID),
-			Status: option.New(int(orderModel.StatusCreated)),
+			IDs:      option.New([]string{newID().String()}),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+			Draft:    option.New(false),
+			Status:   option.New(int(orderModel.StatusCreated)),
 		}, []*order.Order{
 			{
 				Column:    orderModel.NameSortKey,
@@ -1303,6 +2033,7 @@ func TestGetList(t *testing.T) {
 			orderPGCommander = orderMock.NewMockCommander(ctrl)
 			orderESCommander = orderMock.NewMockCommander(ctrl)
 			tXer             = txerMock.NewMockTXer(ctrl)
+			commentSearcher  = commentMock.NewMockSearcher(ctrl)
 
 			orderItem = &orderModel.Order{
 				ID:          newID().String(),
@@ -1318,10 +2049,12 @@ func TestGetList(t *testing.T) {
 		)
 
 		orderESQuerier.EXPECT().GetList(context.TODO(), &orderModel.Filter{
-			Q:      option.New(q),
-			IDs:    option.New([]string{newID().String()}),
-			UserID: option.New(userID),
-			Status: option.New(int(orderModel.StatusCreated)),
+			Q:        option.New(q),
+			IDs:      option.New([]string{newID().String()}),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+			Draft:    option.New(false),
+			Status:   option.New(int(orderModel.StatusCreated)),
 		}, []*order.Order{
 			{
 				Column:    orderModel.NameSortKey,
@@ -1332,14 +2065,17 @@ func TestGetList(t *testing.T) {
 			Offset: 0,
 		}).Return(orders, nil)
 
+		commentSearcher.EXPECT().SearchOrders(context.TODO(), &comment.SearchFilter{Q: q, UserID: option.New(userID), TenantID: option.New("")}, 1000).Return(nil, nil)
+
 		service := svc.New(svc.Params{
-			CmdPg: orderPGCommander,
-			CmdEs: orderESCommander,
-			QrPg:  orderPGQuerier,
-			QrEs:  orderESQuerier,
-			TXer:  tXer,
-			Now:   now,
-			NewID: newID,
+			CmdPg:           orderPGCommander,
+			CmdEs:           orderESCommander,
+			QrPg:            orderPGQuerier,
+			QrEs:            orderESQuerier,
+			CommentSearcher: commentSearcher,
+			TXer:            tXer,
+			Now:             now,
+			NewID:           newID,
 		})
 
 		res, err := service.GetList(context.TODO(), userID, &orderModel.GetListRequest{
@@ -1360,6 +2096,81 @@ func TestGetList(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, res, orders)
 	})
+
+	t.Run("Fallback to pg", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			q           = "test"
+			userID      = "user_id"
+			name        = "test"
+			description = "some text"
+			esErr       = fmt.Errorf("es is unavailable")
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+			commentSearcher  = commentMock.NewMockSearcher(ctrl)
+
+			orderItem = &orderModel.Order{
+				ID:          newID().String(),
+				TSCreate:    now(),
+				TSModify:    now(),
+				Status:      orderModel.StatusCreated,
+				UserID:      userID,
+				Name:        name,
+				Description: description,
+			}
+
+			orders = []*orderModel.Order{orderItem}
+
+			filter = &orderModel.Filter{
+				Q:        option.New(q),
+				UserID:   option.New(userID),
+				TenantID: option.New(""),
+				Draft:    option.New(false),
+				Status:   option.New(int(orderModel.StatusCreated)),
+			}
+			ordering = []*order.Order{
+				{
+					Column:    orderModel.NameSortKey,
+					Direction: "asc",
+				},
+			}
+			pagination = &paginator.Pagination{
+				Limit:  10,
+				Offset: 0,
+			}
+		)
+
+		orderESQuerier.EXPECT().GetList(context.TODO(), filter, ordering, pagination).Return(nil, esErr)
+		orderPGQuerier.EXPECT().GetList(context.TODO(), filter, ordering, pagination).Return(orders, nil)
+
+		commentSearcher.EXPECT().SearchOrders(context.TODO(), &comment.SearchFilter{Q: q, UserID: option.New(userID), TenantID: option.New("")}, 1000).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:           orderPGCommander,
+			CmdEs:           orderESCommander,
+			QrPg:            orderPGQuerier,
+			QrEs:            orderESQuerier,
+			CommentSearcher: commentSearcher,
+			TXer:            tXer,
+			Now:             now,
+			NewID:           newID,
+		})
+
+		res, err := service.GetList(context.TODO(), userID, &orderModel.GetListRequest{
+			Q:          option.New(q),
+			Orders:     option.New(ordering),
+			Pagination: option.New(*pagination),
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, res, orders)
+	})
 }
 
 func TestInnerGetList(t *testing.T) {
@@ -1434,6 +2245,325 @@ func TestInnerGetList(t *testing.T) {
 	})
 }
 
+func TestGetFacets(t *testing.T) {
+	t.Run("Success in pg", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			facets = &orderModel.Facets{
+				Statuses: []*orderModel.StatusFacet{
+					{Status: orderModel.StatusCreated, Count: 3},
+				},
+				Dates: []*orderModel.DateFacet{
+					{Date: now(), Count: 3},
+				},
+				Tags: []*orderModel.TagFacet{
+					{Tag: "urgent", Count: 3},
+				},
+			}
+		)
+
+		orderPGQuerier.EXPECT().Facets(context.TODO(), &orderModel.Filter{
+			IDs:      option.New([]string{newID().String()}),
+			Status:   option.New(int(orderModel.StatusCreated)),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+			Draft:    option.New(false),
+			Tags:     option.New([]string{"urgent"}),
+			TagsAny:  option.New([]string{"gifts", "b2b"}),
+			Metadata: option.New(map[string]string{"crm_id": "42"}),
+		}, orderModel.DateIntervalMonth).Return(facets, nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: orderPGCommander,
+			CmdEs: orderESCommander,
+			QrPg:  orderPGQuerier,
+			QrEs:  orderESQuerier,
+			TXer:  tXer,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.GetFacets(context.TODO(), userID, &orderModel.GetFacetsRequest{
+			IDs:      option.New([]string{newID().String()}),
+			Tags:     option.New([]string{"Urgent"}),
+			TagsAny:  option.New([]string{"gifts", " B2B"}),
+			Metadata: option.New(map[string]string{"crm_id": "42"}),
+			Interval: option.New(orderModel.DateIntervalMonth),
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, facets, res)
+	})
+
+	t.Run("Success in es", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+			q      = "test"
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+			commentSearcher  = commentMock.NewMockSearcher(ctrl)
+
+			facets = &orderModel.Facets{
+				Statuses: []*orderModel.StatusFacet{
+					{Status: orderModel.StatusCreated, Count: 2},
+				},
+				Dates: []*orderModel.DateFacet{
+					{Date: now(), Count: 2},
+				},
+			}
+		)
+
+		orderESQuerier.EXPECT().Facets(context.TODO(), &orderModel.Filter{
+			Status:   option.New(int(orderModel.StatusCreated)),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+			Draft:    option.New(false),
+			Q:        option.New(q),
+		}, orderModel.DateIntervalDay).Return(facets, nil)
+
+		commentSearcher.EXPECT().SearchOrders(context.TODO(), &comment.SearchFilter{Q: q, UserID: option.New(userID), TenantID: option.New("")}, 1000).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:           orderPGCommander,
+			CmdEs:           orderESCommander,
+			QrPg:            orderPGQuerier,
+			QrEs:            orderESQuerier,
+			CommentSearcher: commentSearcher,
+			TXer:            tXer,
+			Now:             now,
+			NewID:           newID,
+		})
+
+		res, err := service.GetFacets(context.TODO(), userID, &orderModel.GetFacetsRequest{
+			Q: option.New(q),
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, facets, res)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID  = "user_id"
+			someErr = fmt.Errorf("some error")
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+		)
+
+		orderPGQuerier.EXPECT().Facets(context.TODO(), &orderModel.Filter{
+			Status:   option.New(int(orderModel.StatusCreated)),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+			Draft:    option.New(false),
+		}, orderModel.DateIntervalDay).Return(nil, someErr)
+
+		service := svc.New(svc.Params{
+			CmdPg: orderPGCommander,
+			CmdEs: orderESCommander,
+			QrPg:  orderPGQuerier,
+			QrEs:  orderESQuerier,
+			TXer:  tXer,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.GetFacets(context.TODO(), userID, nil)
+
+		require.ErrorIs(t, err, someErr)
+		require.Nil(t, res)
+	})
+}
+
+func TestInnerGetFacets(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderESQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			facets = &orderModel.Facets{
+				Statuses: []*orderModel.StatusFacet{
+					{Status: orderModel.StatusCreated, Count: 1},
+				},
+				Dates: []*orderModel.DateFacet{
+					{Date: now(), Count: 1},
+				},
+			}
+		)
+
+		orderPGQuerier.EXPECT().Facets(context.TODO(), &orderModel.Filter{
+			UserID: option.New(userID),
+		}, orderModel.DateIntervalWeek).Return(facets, nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: orderPGCommander,
+			CmdEs: orderESCommander,
+			QrPg:  orderPGQuerier,
+			QrEs:  orderESQuerier,
+			TXer:  tXer,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.InnerGetFacets(context.TODO(), &orderModel.InnerGetFacetsRequest{
+			UserID:   option.New(userID),
+			Interval: option.New(orderModel.DateIntervalWeek),
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, facets, res)
+	})
+}
+
+func TestGetHistory(t *testing.T) {
+	var (
+		userID     = "user_id"
+		pagination = paginator.Pagination{Limit: 50}
+
+		itemFilter = &orderModel.Filter{
+			IDs: option.New([]string{newID().String()}),
+		}
+		historyFilter = &history.Filter{
+			OrderID: option.New(newID().String()),
+		}
+
+		entries = []*history.Entry{
+			{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    userID,
+				Source:   history.SourceHTTP,
+				Action:   history.ActionCreate,
+				Changes:  []*history.Change{{Field: "name", New: "test"}},
+			},
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			historyQuerier = historyMock.NewMockQuerier(ctrl)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), itemFilter).Return(&orderModel.Order{
+			ID:     newID().String(),
+			UserID: userID,
+			Status: orderModel.StatusDeleted,
+		}, nil)
+
+		historyQuerier.EXPECT().GetList(context.TODO(), historyFilter, &pagination).Return(entries, nil)
+		historyQuerier.EXPECT().Count(context.TODO(), historyFilter).Return(1, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:      orderPGQuerier,
+			QrHistory: historyQuerier,
+			Now:       now,
+			NewID:     newID,
+		})
+
+		res, total, err := service.GetHistory(context.TODO(), userID, newID().String(), pagination)
+
+		require.NoError(t, err)
+		require.Equal(t, entries, res)
+		require.Equal(t, 1, total)
+	})
+
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			historyQuerier = historyMock.NewMockQuerier(ctrl)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), itemFilter).Return(&orderModel.Order{
+			ID:     newID().String(),
+			UserID: "other_user",
+		}, nil)
+
+		grantQuerier := grantMock.NewMockQuerier(ctrl)
+		grantQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:      orderPGQuerier,
+			QrGrant:   grantQuerier,
+			QrHistory: historyQuerier,
+			Now:       now,
+			NewID:     newID,
+		})
+
+		_, _, err := service.GetHistory(context.TODO(), userID, newID().String(), pagination)
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			historyQuerier = historyMock.NewMockQuerier(ctrl)
+
+			someErr = fmt.Errorf("some error")
+		)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), itemFilter).Return(&orderModel.Order{
+			ID:     newID().String(),
+			UserID: userID,
+		}, nil)
+
+		historyQuerier.EXPECT().GetList(context.TODO(), historyFilter, &pagination).Return(nil, someErr)
+
+		service := svc.New(svc.Params{
+			QrPg:      orderPGQuerier,
+			QrHistory: historyQuerier,
+			Now:       now,
+			NewID:     newID,
+		})
+
+		_, _, err := service.GetHistory(context.TODO(), userID, newID().String(), pagination)
+
+		require.ErrorIs(t, err, someErr)
+	})
+}
+
 func now() time.Time {
 	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
 }
@@ -0,0 +1,248 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/option"
+)
+
+func (s *service) GetLines(ctx context.Context, userID, id string) ([]*line.Line, error) {
+	if _, err := s.getOwned(ctx, userID, id, tenant.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return s.InnerGetLines(ctx, id)
+}
+
+func (s *service) InnerGetLines(ctx context.Context, id string) ([]*line.Line, error) {
+	lines, err := s.qrLine.GetList(ctx, &line.Filter{OrderID: option.New(id)})
+	if err != nil {
+		return nil, fmt.Errorf("get lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+func (s *service) GetShipments(ctx context.Context, userID, id string) ([]*shipment.Shipment, error) {
+	if _, err := s.getOwned(ctx, userID, id, tenant.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return s.InnerGetShipments(ctx, id)
+}
+
+func (s *service) InnerGetShipments(ctx context.Context, id string) ([]*shipment.Shipment, error) {
+	shipments, err := s.qrShipment.GetList(ctx, &shipment.Filter{OrderID: option.New(id)})
+	if err != nil {
+		return nil, fmt.Errorf("get shipments: %w", err)
+	}
+
+	return shipments, nil
+}
+
+func (s *service) CreateShipment(ctx context.Context, actorID, id string, form *shipment.Form) (*shipment.Shipment, error) {
+	if err := form.Validate(); err != nil {
+		return nil, err
+	}
+
+	res := shipment.New(id, form, s.now, s.newID)
+
+	// the row lock makes concurrent shipments of the order count each other
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+			Status:    option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{id}),
+			ForUpdate: option.New(true),
+		})
+		if err != nil {
+			return fmt.Errorf("get item: %w", err)
+		}
+
+		if item.State != orderModel.StatePaid {
+			return fmt.Errorf("%w: order is %s", model.ErrConflict, item.State)
+		}
+
+		lines, err := s.qrLine.GetList(ctx, &line.Filter{OrderID: option.New(id)})
+		if err != nil {
+			return fmt.Errorf("get lines: %w", err)
+		}
+
+		shipments, err := s.qrShipment.GetList(ctx, &shipment.Filter{OrderID: option.New(id)})
+		if err != nil {
+			return fmt.Errorf("get shipments: %w", err)
+		}
+
+		ordered := make(map[string]int, len(lines))
+		for _, l := range lines {
+			ordered[l.ID] = l.Quantity
+		}
+
+		shipped := shipment.Shipped(shipments)
+
+		for _, l := range form.Lines {
+			quantity, ok := ordered[l.LineID]
+			if !ok {
+				return fmt.Errorf("%w: line %s is not in the order", model.ErrInvalidArgument, l.LineID)
+			}
+
+			if left := quantity - shipped[l.LineID]; l.Quantity > left {
+				return fmt.Errorf("%w: line %s has %d left to ship", model.ErrInvalidArgument, l.LineID, left)
+			}
+
+			shipped[l.LineID] += l.Quantity
+		}
+
+		if err = s.cmdShipment.Create(ctx, res); err != nil {
+			return fmt.Errorf("shipment create: %w", err)
+		}
+
+		if !fulfilled(ordered, shipped) {
+			return nil
+		}
+
+		if err = s.setState(ctx, actorID, item, orderModel.StateFulfilled); err != nil {
+			return err
+		}
+
+		if err = s.reserver.Confirm(ctx, id); err != nil {
+			return fmt.Errorf("confirm stock: %w", err)
+		}
+
+		return nil
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	return res, nil
+}
+
+func (s *service) UpdateShipmentStatus(ctx context.Context, actorID, id, shipmentID string, status shipment.Status) (*shipment.Shipment, error) {
+	var res *shipment.Shipment
+
+	// the row lock orders the status changes of the shipments of the order
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+			Status:    option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{id}),
+			ForUpdate: option.New(true),
+		})
+		if err != nil {
+			return fmt.Errorf("get item: %w", err)
+		}
+
+		shipments, err := s.qrShipment.GetList(ctx, &shipment.Filter{
+			IDs:     option.New([]string{shipmentID}),
+			OrderID: option.New(id),
+		})
+		if err != nil {
+			return fmt.Errorf("get shipments: %w", err)
+		}
+
+		if len(shipments) == 0 {
+			return fmt.Errorf("get shipment: %w", model.ErrNotFound)
+		}
+
+		res = shipments[0]
+
+		event, err := res.SetStatus(status, s.now())
+		if err != nil {
+			return err
+		}
+
+		if err = s.cmdShipment.AddEvent(ctx, res.ID, event); err != nil {
+			return fmt.Errorf("shipment update: %w", err)
+		}
+
+		// every line was shipped in full, so any cancelled shipment reopens the order
+		if status != shipment.StatusCancelled || item.State != orderModel.StateFulfilled {
+			return nil
+		}
+
+		return s.setState(ctx, actorID, item, orderModel.StatePaid)
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	return res, nil
+}
+
+// setState moves the order along its lifecycle, it is called inside a transaction
+func (s *service) setState(ctx context.Context, actorID string, item *orderModel.Order, to orderModel.State) error {
+	if !item.State.CanTransition(to) {
+		return fmt.Errorf("%w: order can not move from %s to %s", model.ErrConflict, item.State, to)
+	}
+
+	before := *item
+	item.State = to
+
+	return s.save(ctx, actorID, history.ActionStatus, &before, item)
+}
+
+// save writes the changed order to both stores and its history, it is called inside a transaction
+func (s *service) save(ctx context.Context, actorID string, action history.Action, before, item *orderModel.Order, extra ...*history.Change) error {
+	item.TSModify = s.now()
+
+	if err := s.cmdPg.Update(ctx, item); err != nil {
+		return fmt.Errorf("order update: %w", err)
+	}
+
+	if err := s.record(ctx, actorID, action, before, item, extra...); err != nil {
+		return err
+	}
+
+	if err := s.cmdEs.Update(ctx, item); err != nil {
+		return fmt.Errorf("order update: %w", err)
+	}
+
+	return nil
+}
+
+// getOwned returns the order the user may act on with the role
+func (s *service) getOwned(ctx context.Context, userID, id string, role tenant.Role) (*orderModel.Order, error) {
+	return s.getItem(ctx, userID, id, role, false)
+}
+
+// getLocked is getOwned which locks the order row till the end of the running transaction,
+// an order written back from it loses no concurrent change
+func (s *service) getLocked(ctx context.Context, userID, id string, role tenant.Role) (*orderModel.Order, error) {
+	return s.getItem(ctx, userID, id, role, true)
+}
+
+func (s *service) getItem(ctx context.Context, userID, id string, role tenant.Role, lock bool) (*orderModel.Order, error) {
+	filter := &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusCreated)),
+		IDs:    option.New([]string{id}),
+	}
+
+	if lock {
+		filter.ForUpdate = option.New(true)
+	}
+
+	item, err := s.qrPg.GetItem(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("get item: %w", err)
+	}
+
+	if err = s.authorize(ctx, userID, item, role); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func fulfilled(ordered, shipped map[string]int) bool {
+	for id, quantity := range ordered {
+		if shipped[id] < quantity {
+			return false
+		}
+	}
+
+	return len(ordered) > 0
+}
@@ -0,0 +1,445 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	grantMock "github.com/krivenkov/order/internal/model/grant/mock"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	"github.com/krivenkov/order/internal/model/line"
+	lineMock "github.com/krivenkov/order/internal/model/line/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/shipment"
+	shipmentMock "github.com/krivenkov/order/internal/model/shipment/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateShipment(t *testing.T) {
+	var (
+		userID  = "user_id"
+		adminID = "admin_id"
+
+		filter = &orderModel.Filter{
+			Status:    option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			ForUpdate: option.New(true),
+		}
+
+		paid = func() *orderModel.Order {
+			return &orderModel.Order{
+				ID:       newID().String(),
+				TSCreate: now().Add(-time.Hour),
+				TSModify: now().Add(-time.Hour),
+				Status:   orderModel.StatusCreated,
+				State:    orderModel.StatePaid,
+				UserID:   userID,
+			}
+		}
+
+		lines = []*line.Line{
+			{ID: "line-1", OrderID: newID().String(), SKU: "SKU-1", Quantity: 2},
+			{ID: "line-2", OrderID: newID().String(), SKU: "SKU-2", Quantity: 1},
+		}
+
+		// the first unit of line-1 has already shipped
+		shipped = []*shipment.Shipment{
+			{
+				ID:     "shipment-1",
+				Status: shipment.StatusInTransit,
+				Lines:  []*shipment.Line{{LineID: "line-1", Quantity: 1}},
+			},
+			{
+				ID:     "shipment-2",
+				Status: shipment.StatusCancelled,
+				Lines:  []*shipment.Line{{LineID: "line-2", Quantity: 1}},
+			},
+		}
+	)
+
+	t.Run("Partial", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier    = orderMock.NewMockQuerier(ctrl)
+			lineQuerier       = lineMock.NewMockQuerier(ctrl)
+			shipmentQuerier   = shipmentMock.NewMockQuerier(ctrl)
+			shipmentCommander = shipmentMock.NewMockCommander(ctrl)
+			tXer              = txerMock.NewMockTXer(ctrl)
+
+			form = &shipment.Form{
+				Carrier:        "DHL",
+				TrackingNumber: "JD0001",
+				Lines:          []*shipment.Line{{LineID: "line-2", Quantity: 1}},
+			}
+
+			expected = &shipment.Shipment{
+				ID:             newID().String(),
+				TSCreate:       now(),
+				TSModify:       now(),
+				OrderID:        newID().String(),
+				Carrier:        "DHL",
+				TrackingNumber: "JD0001",
+				Status:         shipment.StatusShipped,
+				Lines:          []*shipment.Line{{LineID: "line-2", Quantity: 1}},
+				Events:         []*shipment.Event{{Status: shipment.StatusShipped, TSCreate: now()}},
+			}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(paid(), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), &line.Filter{OrderID: option.New(newID().String())}).Return(lines, nil)
+		shipmentQuerier.EXPECT().GetList(context.TODO(), &shipment.Filter{OrderID: option.New(newID().String())}).Return(shipped, nil)
+		shipmentCommander.EXPECT().Create(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:        orderPGQuerier,
+			QrLine:      lineQuerier,
+			QrShipment:  shipmentQuerier,
+			CmdShipment: shipmentCommander,
+			TXer:        tXer,
+			Now:         now,
+			NewID:       newID,
+		})
+
+		res, err := service.CreateShipment(context.TODO(), adminID, newID().String(), form)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Fulfils the order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier    = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander  = orderMock.NewMockCommander(ctrl)
+			orderESCommander  = orderMock.NewMockCommander(ctrl)
+			historyCommander  = historyMock.NewMockCommander(ctrl)
+			lineQuerier       = lineMock.NewMockQuerier(ctrl)
+			shipmentQuerier   = shipmentMock.NewMockQuerier(ctrl)
+			shipmentCommander = shipmentMock.NewMockCommander(ctrl)
+			reserver          = inventoryMock.NewMockReserver(ctrl)
+			tXer              = txerMock.NewMockTXer(ctrl)
+
+			fulfilled = paid()
+
+			entry = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    adminID,
+				Action:   history.ActionStatus,
+				Changes: []*history.Change{
+					{Field: "state", Old: "paid", New: "fulfilled"},
+				},
+			}
+		)
+
+		fulfilled.State = orderModel.StateFulfilled
+		fulfilled.TSModify = now()
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(paid(), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(lines, nil)
+		shipmentQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(shipped, nil)
+		shipmentCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), fulfilled).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), fulfilled).Return(nil)
+		reserver.EXPECT().Confirm(context.TODO(), newID().String()).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:        orderPGQuerier,
+			CmdPg:       orderPGCommander,
+			CmdEs:       orderESCommander,
+			CmdHistory:  historyCommander,
+			QrLine:      lineQuerier,
+			QrShipment:  shipmentQuerier,
+			CmdShipment: shipmentCommander,
+			Reserver:    reserver,
+			TXer:        tXer,
+			Now:         now,
+			NewID:       newID,
+		})
+
+		_, err := service.CreateShipment(context.TODO(), adminID, newID().String(), &shipment.Form{
+			Carrier: "DHL",
+			Lines: []*shipment.Line{
+				{LineID: "line-1", Quantity: 1},
+				{LineID: "line-2", Quantity: 1},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("More than ordered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier  = orderMock.NewMockQuerier(ctrl)
+			lineQuerier     = lineMock.NewMockQuerier(ctrl)
+			shipmentQuerier = shipmentMock.NewMockQuerier(ctrl)
+			tXer            = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(paid(), nil)
+		lineQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(lines, nil)
+		shipmentQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(shipped, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			QrLine:     lineQuerier,
+			QrShipment: shipmentQuerier,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		_, err := service.CreateShipment(context.TODO(), adminID, newID().String(), &shipment.Form{
+			Carrier: "DHL",
+			Lines:   []*shipment.Line{{LineID: "line-1", Quantity: 2}},
+		})
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("Already fulfilled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			item = paid()
+		)
+
+		item.State = orderModel.StateFulfilled
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(item, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			TXer:  tXer,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.CreateShipment(context.TODO(), adminID, newID().String(), &shipment.Form{
+			Carrier: "DHL",
+			Lines:   []*shipment.Line{{LineID: "line-1", Quantity: 1}},
+		})
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+}
+
+func TestUpdateShipmentStatus(t *testing.T) {
+	var (
+		adminID = "admin_id"
+
+		filter = &orderModel.Filter{
+			Status:    option.New(int(orderModel.StatusCreated)),
+			IDs:       option.New([]string{newID().String()}),
+			ForUpdate: option.New(true),
+		}
+		shipmentFilter = &shipment.Filter{
+			IDs:     option.New([]string{"shipment-1"}),
+			OrderID: option.New(newID().String()),
+		}
+
+		fulfilled = func() *orderModel.Order {
+			return &orderModel.Order{
+				ID:       newID().String(),
+				TSCreate: now().Add(-time.Hour),
+				TSModify: now().Add(-time.Hour),
+				Status:   orderModel.StatusCreated,
+				State:    orderModel.StateFulfilled,
+				UserID:   "user_id",
+			}
+		}
+
+		delivered = func() *shipment.Shipment {
+			return &shipment.Shipment{
+				ID:     "shipment-1",
+				Status: shipment.StatusDelivered,
+				Events: []*shipment.Event{{Status: shipment.StatusShipped}, {Status: shipment.StatusDelivered}},
+			}
+		}
+
+		inTransit = func() *shipment.Shipment {
+			return &shipment.Shipment{
+				ID:     "shipment-1",
+				Status: shipment.StatusInTransit,
+				Events: []*shipment.Event{{Status: shipment.StatusShipped}, {Status: shipment.StatusInTransit}},
+			}
+		}
+	)
+
+	t.Run("Cancel reopens the order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier    = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander  = orderMock.NewMockCommander(ctrl)
+			orderESCommander  = orderMock.NewMockCommander(ctrl)
+			historyCommander  = historyMock.NewMockCommander(ctrl)
+			shipmentQuerier   = shipmentMock.NewMockQuerier(ctrl)
+			shipmentCommander = shipmentMock.NewMockCommander(ctrl)
+			tXer              = txerMock.NewMockTXer(ctrl)
+
+			reopened = fulfilled()
+		)
+
+		reopened.State = orderModel.StatePaid
+		reopened.TSModify = now()
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(fulfilled(), nil)
+		shipmentQuerier.EXPECT().GetList(context.TODO(), shipmentFilter).Return([]*shipment.Shipment{inTransit()}, nil)
+		shipmentCommander.EXPECT().AddEvent(context.TODO(), "shipment-1", &shipment.Event{
+			Status:   shipment.StatusCancelled,
+			TSCreate: now(),
+		}).Return(nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), reopened).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), reopened).Return(nil)
+
+		service := svc.New(svc.Params{
+			QrPg:        orderPGQuerier,
+			CmdPg:       orderPGCommander,
+			CmdEs:       orderESCommander,
+			CmdHistory:  historyCommander,
+			QrShipment:  shipmentQuerier,
+			CmdShipment: shipmentCommander,
+			TXer:        tXer,
+			Now:         now,
+			NewID:       newID,
+		})
+
+		res, err := service.UpdateShipmentStatus(context.TODO(), adminID, newID().String(), "shipment-1", shipment.StatusCancelled)
+
+		require.NoError(t, err)
+		require.Equal(t, shipment.StatusCancelled, res.Status)
+		require.Len(t, res.Events, 3)
+	})
+
+	t.Run("Delivered is final", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier  = orderMock.NewMockQuerier(ctrl)
+			shipmentQuerier = shipmentMock.NewMockQuerier(ctrl)
+			tXer            = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(fulfilled(), nil)
+		shipmentQuerier.EXPECT().GetList(context.TODO(), shipmentFilter).Return([]*shipment.Shipment{delivered()}, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			QrShipment: shipmentQuerier,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		_, err := service.UpdateShipmentStatus(context.TODO(), adminID, newID().String(), "shipment-1", shipment.StatusCancelled)
+
+		require.ErrorIs(t, err, model.ErrConflict)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier  = orderMock.NewMockQuerier(ctrl)
+			shipmentQuerier = shipmentMock.NewMockQuerier(ctrl)
+			tXer            = txerMock.NewMockTXer(ctrl)
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(fulfilled(), nil)
+		shipmentQuerier.EXPECT().GetList(context.TODO(), shipmentFilter).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:       orderPGQuerier,
+			QrShipment: shipmentQuerier,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		_, err := service.UpdateShipmentStatus(context.TODO(), adminID, newID().String(), "shipment-1", shipment.StatusDelivered)
+
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestGetShipments(t *testing.T) {
+	t.Run("Permission denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(&orderModel.Order{
+			ID:     newID().String(),
+			UserID: "other_user_id",
+		}, nil)
+
+		grantQuerier := grantMock.NewMockQuerier(ctrl)
+		grantQuerier.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:    orderPGQuerier,
+			QrGrant: grantQuerier,
+			Now:     now,
+			NewID:   newID,
+		})
+
+		_, err := service.GetShipments(context.TODO(), "user_id", newID().String())
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,93 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/tenant"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTags(t *testing.T) {
+	t.Run("Personal orders", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+
+			orderPGTagger = orderMock.NewMockTagger(ctrl)
+
+			tags = []*orderModel.TagFacet{{Tag: "urgent", Count: 2}}
+		)
+
+		orderPGTagger.EXPECT().Tags(context.TODO(), &orderModel.Filter{
+			Status:   option.New(int(orderModel.StatusCreated)),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+		}).Return(tags, nil)
+
+		service := svc.New(svc.Params{Tagger: orderPGTagger})
+
+		res, err := service.GetTags(context.TODO(), userID)
+
+		require.NoError(t, err)
+		require.Equal(t, tags, res)
+	})
+
+	t.Run("Orders of a tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGTagger = orderMock.NewMockTagger(ctrl)
+
+			ctx = tenant.CtxWithScope(context.TODO(), tenant.Scope{TenantID: "tenant_id", UserID: "user_id", Role: tenant.RoleViewer})
+		)
+
+		orderPGTagger.EXPECT().Tags(ctx, &orderModel.Filter{
+			Status:   option.New(int(orderModel.StatusCreated)),
+			TenantID: option.New("tenant_id"),
+		}).Return(nil, nil)
+
+		service := svc.New(svc.Params{Tagger: orderPGTagger})
+
+		_, err := service.GetTags(ctx, "user_id")
+
+		require.NoError(t, err)
+	})
+}
+
+func TestTagsFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		userID = "user_id"
+
+		orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+	)
+
+	orderPGQuerier.EXPECT().Count(context.TODO(), &orderModel.Filter{
+		Status:   option.New(int(orderModel.StatusCreated)),
+		UserID:   option.New(userID),
+		TenantID: option.New(""),
+		Draft:    option.New(false),
+		Tags:     option.New([]string{"urgent", "gifts"}),
+	}).Return(3, nil)
+
+	service := svc.New(svc.Params{QrPg: orderPGQuerier})
+
+	res, err := service.Count(context.TODO(), userID, &orderModel.GetCountRequest{
+		Tags:    option.New([]string{" Urgent", "GIFTS"}),
+		TagsAny: option.New([]string{" "}),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 3, res)
+}
@@ -0,0 +1,38 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tax"
+)
+
+// applyTaxes charges the taxes of the jurisdiction the order is shipped to, or billed in
+// when it is not shipped, orders without prices or addresses are not taxed
+func (s *service) applyTaxes(ctx context.Context, item *orderModel.Order, lines []*line.Line) error {
+	address := item.ShippingAddress
+	if address == nil {
+		address = item.BillingAddress
+	}
+
+	if address == nil || item.Totals.Currency == "" || len(lines) == 0 {
+		return nil
+	}
+
+	res, err := s.tax.Calculate(ctx, &tax.Request{
+		Currency: item.Totals.Currency,
+		Country:  address.Country,
+		Region:   address.Region,
+		Lines:    lines,
+	})
+	if err != nil {
+		return fmt.Errorf("calculate taxes: %w", err)
+	}
+
+	item.Taxes = res.Lines
+	item.Totals.TaxInclusive = res.Inclusive
+
+	return nil
+}
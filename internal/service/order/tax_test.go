@@ -0,0 +1,182 @@
+package order_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	"github.com/krivenkov/order/internal/model/line"
+	lineMock "github.com/krivenkov/order/internal/model/line/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/tax"
+	taxMock "github.com/krivenkov/order/internal/model/tax/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/ptr"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWithTaxes(t *testing.T) {
+	var (
+		userID = "user_id"
+		number = "ORD-2000-000001"
+
+		billing = func() *orderModel.Address {
+			return &orderModel.Address{
+				Name:       "John Smith",
+				Line1:      "1 Main St",
+				City:       "Los Angeles",
+				Region:     "CA",
+				PostalCode: "90001",
+				Country:    "US",
+				Phone:      "+12135550100",
+			}
+		}
+
+		form = func() *orderModel.Form {
+			return &orderModel.Form{
+				Currency:       ptr.Pointer("USD"),
+				BillingAddress: billing(),
+				Lines:          []*line.Form{{SKU: "SKU-1", Quantity: 2, UnitPrice: 1000, TaxCategory: " Standard "}},
+			}
+		}
+
+		newLine = func(tax int64) *line.Line {
+			return &line.Line{
+				ID:          newID().String(),
+				TSCreate:    now(),
+				OrderID:     newID().String(),
+				SKU:         "SKU-1",
+				Quantity:    2,
+				UnitPrice:   1000,
+				TaxCategory: "standard",
+				Tax:         tax,
+			}
+		}
+	)
+
+	t.Run("Exclusive", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			numberer         = orderMock.NewMockNumberer(ctrl)
+			calculator       = taxMock.NewMockCalculator(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			taxes = []*tax.Line{{Country: "US", Region: "CA", Rate: 725, Taxable: 2000, Amount: 145}}
+
+			orderItem = &orderModel.Order{
+				ID:             newID().String(),
+				TSCreate:       now(),
+				TSModify:       now(),
+				Status:         orderModel.StatusCreated,
+				State:          orderModel.StatePlaced,
+				Number:         number,
+				UserID:         userID,
+				BillingAddress: billing(),
+				Totals: orderModel.Totals{
+					Currency: "USD",
+					Subtotal: 2000,
+					Tax:      145,
+					Total:    2145,
+				},
+				Taxes: taxes,
+			}
+		)
+
+		calculator.EXPECT().Calculate(context.TODO(), &tax.Request{
+			Currency: "USD",
+			Country:  "US",
+			Region:   "CA",
+			Lines:    []*line.Line{newLine(0)},
+		}).DoAndReturn(func(_ context.Context, req *tax.Request) (*tax.Result, error) {
+			req.Lines[0].Tax = 145
+
+			return &tax.Result{Lines: taxes}, nil
+		})
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		numberer.EXPECT().Next(context.TODO(), "", now()).Return(number, nil)
+
+		orderPGCommander.EXPECT().Create(context.TODO(), orderItem).Return(nil)
+
+		lineCommander.EXPECT().Create(context.TODO(), newLine(145)).Return(nil)
+
+		reserver.EXPECT().Reserve(context.TODO(), gomock.Any()).Return(nil)
+
+		historyCommander.EXPECT().Create(context.TODO(), &history.Entry{
+			ID:       newID().String(),
+			TSCreate: now(),
+			OrderID:  newID().String(),
+			Actor:    userID,
+			Action:   history.ActionCreate,
+			Changes: []*history.Change{
+				{Field: "status", Old: "", New: "created"},
+				{Field: "state", Old: "", New: "placed"},
+				{Field: "number", Old: "", New: number},
+				{Field: "billing_address", Old: "", New: billing().String()},
+				{Field: "currency", Old: "", New: "USD"},
+				{Field: "tax", Old: "0", New: "145"},
+				{Field: "total", Old: "0", New: "2145"},
+			},
+		}).Return(nil)
+
+		orderESCommander.EXPECT().Create(context.TODO(), orderItem).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			Numberer:   numberer,
+			CmdHistory: historyCommander,
+			CmdLine:    lineCommander,
+			Tax:        calculator,
+			Reserver:   reserver,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.Create(context.TODO(), userID, form())
+
+		require.NoError(t, err)
+		require.Equal(t, orderItem, res)
+	})
+
+	t.Run("Calculation failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			calculator = taxMock.NewMockCalculator(ctrl)
+
+			errProvider = errors.New("provider is unavailable")
+		)
+
+		calculator.EXPECT().Calculate(context.TODO(), gomock.Any()).Return(nil, errProvider)
+
+		service := svc.New(svc.Params{
+			Tax:   calculator,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Create(context.TODO(), userID, form())
+
+		require.ErrorIs(t, err, errProvider)
+		require.Nil(t, res)
+	})
+}
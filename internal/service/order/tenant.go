@@ -0,0 +1,44 @@
+package order
+
+import (
+	"context"
+
+	"github.com/krivenkov/order/internal/model"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/option"
+)
+
+// authorizeOwner checks the user may act on the order with the role as its owner: a personal
+// order is of its user, an order of a tenant is of the members acting in that tenant
+func authorizeOwner(ctx context.Context, userID string, item *orderModel.Order, role tenant.Role) error {
+	scope, ok := tenant.ScopeFromContext(ctx)
+	if !ok {
+		if item.TenantID != "" || item.UserID != userID {
+			return model.ErrPermissionDenied
+		}
+
+		return nil
+	}
+
+	if item.TenantID != scope.TenantID || !scope.Role.Allows(role) {
+		return model.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// ownerFilter narrows the filter to the orders of the tenant the request acts in,
+// or to the personal orders of the user
+func ownerFilter(ctx context.Context, userID string, filter *orderModel.Filter) *orderModel.Filter {
+	if scope, ok := tenant.ScopeFromContext(ctx); ok {
+		filter.TenantID = option.New(scope.TenantID)
+
+		return filter
+	}
+
+	filter.UserID = option.New(userID)
+	filter.TenantID = option.New("")
+
+	return filter
+}
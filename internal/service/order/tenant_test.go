@@ -0,0 +1,204 @@
+package order_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	"github.com/krivenkov/order/internal/model/line"
+	lineMock "github.com/krivenkov/order/internal/model/line/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/tenant"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/ptr"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantAccess(t *testing.T) {
+	var (
+		userID   = "user_id"
+		memberID = "member_id"
+		tenantID = "tenant_id"
+
+		filter = &orderModel.Filter{
+			IDs:    option.New([]string{newID().String()}),
+			Status: option.New(int(orderModel.StatusCreated)),
+		}
+
+		scoped = func(role tenant.Role) context.Context {
+			return tenant.CtxWithScope(context.TODO(), tenant.Scope{TenantID: tenantID, UserID: memberID, Role: role})
+		}
+	)
+
+	t.Run("Viewer reads an order of the tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+
+			ctx  = scoped(tenant.RoleViewer)
+			item = &orderModel.Order{ID: newID().String(), UserID: userID, TenantID: tenantID}
+		)
+
+		orderPGQuerier.EXPECT().GetItem(ctx, filter).Return(item, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier})
+
+		res, err := service.GetItem(ctx, memberID, newID().String())
+
+		require.NoError(t, err)
+		require.Equal(t, item, res)
+	})
+
+	t.Run("Viewer cannot delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+			tXer           = txerMock.NewMockTXer(ctrl)
+
+			ctx    = scoped(tenant.RoleViewer)
+			locked = &orderModel.Filter{
+				Status:    option.New(int(orderModel.StatusCreated)),
+				IDs:       option.New([]string{newID().String()}),
+				ForUpdate: option.New(true),
+			}
+		)
+
+		tXer.EXPECT().WithTX(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(ctx, locked).Return(&orderModel.Order{UserID: userID, TenantID: tenantID}, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier, TXer: tXer})
+
+		err := service.SoftDelete(ctx, memberID, newID().String())
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Order of another tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+
+			ctx = scoped(tenant.RoleApprover)
+		)
+
+		orderPGQuerier.EXPECT().GetItem(ctx, filter).Return(&orderModel.Order{UserID: memberID, TenantID: "other_tenant_id"}, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier})
+
+		_, err := service.GetItem(ctx, memberID, newID().String())
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Order of a tenant out of its scope", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&orderModel.Order{UserID: userID, TenantID: tenantID}, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier})
+
+		_, err := service.GetItem(context.TODO(), userID, newID().String())
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("List of the tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+
+			ctx   = scoped(tenant.RoleViewer)
+			items = []*orderModel.Order{{ID: newID().String(), UserID: userID, TenantID: tenantID}}
+		)
+
+		orderPGQuerier.EXPECT().GetList(ctx, &orderModel.Filter{
+			Status:   option.New(int(orderModel.StatusCreated)),
+			TenantID: option.New(tenantID),
+			Draft:    option.New(false),
+		}, nil, nil).Return(items, nil)
+
+		service := svc.New(svc.Params{QrPg: orderPGQuerier})
+
+		res, err := service.GetList(ctx, memberID, &orderModel.GetListRequest{})
+
+		require.NoError(t, err)
+		require.Equal(t, items, res)
+	})
+
+	t.Run("Viewer cannot create", func(t *testing.T) {
+		service := svc.New(svc.Params{Now: now, NewID: newID})
+
+		_, err := service.Create(scoped(tenant.RoleViewer), memberID, &orderModel.Form{
+			Name:  ptr.Pointer("test"),
+			Lines: []*line.Form{{SKU: "SKU-1", Name: "Widget", Quantity: 1}},
+		})
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Editor creates an order of the tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			lineCommander    = lineMock.NewMockCommander(ctrl)
+			numberer         = orderMock.NewMockNumberer(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			ctx = scoped(tenant.RoleEditor)
+		)
+
+		tXer.EXPECT().WithTX(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		numberer.EXPECT().Next(ctx, tenantID, now()).Return("ORD-2000-000001", nil)
+		orderPGCommander.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		lineCommander.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		historyCommander.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		orderESCommander.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			Numberer:   numberer,
+			CmdHistory: historyCommander,
+			CmdLine:    lineCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.Create(ctx, memberID, &orderModel.Form{
+			Name:  ptr.Pointer("test"),
+			Draft: true,
+			Lines: []*line.Form{{SKU: "SKU-1", Name: "Widget", Quantity: 1}},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, tenantID, res.TenantID)
+		require.Equal(t, memberID, res.UserID)
+	})
+}
@@ -0,0 +1,92 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/model/history"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/order"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+func (s *service) GetTrash(ctx context.Context, userID string, pagination paginator.Pagination) ([]*orderModel.Order, int, error) {
+	filter := ownerFilter(ctx, userID, &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusDeleted)),
+	})
+
+	// the most recently deleted first
+	orders := []*order.Order{{Column: orderModel.ModifySortKey, Direction: "desc"}}
+
+	list, err := s.qrPg.GetList(ctx, filter, orders, &pagination)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get trash: %w", err)
+	}
+
+	total, err := s.qrPg.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count trash: %w", err)
+	}
+
+	return list, total, nil
+}
+
+func (s *service) Restore(ctx context.Context, userID, id string) (*orderModel.Order, error) {
+	item, err := s.qrPg.GetItem(ctx, &orderModel.Filter{
+		Status: option.New(int(orderModel.StatusDeleted)),
+		IDs:    option.New([]string{id}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get item: %w", err)
+	}
+
+	if err = authorizeOwner(ctx, userID, item, tenant.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	before := *item
+
+	item.Status = orderModel.StatusCreated
+	item.TSModify = s.now()
+
+	if errTx := s.tXer.WithTX(ctx, func(ctx context.Context) error {
+		if err = s.cmdPg.Update(ctx, item); err != nil {
+			return fmt.Errorf("order restore: %w", err)
+		}
+
+		if err = s.reserveAgain(ctx, item); err != nil {
+			return err
+		}
+
+		if err = s.record(ctx, userID, history.ActionRestore, &before, item); err != nil {
+			return err
+		}
+
+		if err = s.cmdEs.Update(ctx, item); err != nil {
+			return fmt.Errorf("order restore: %w", err)
+		}
+
+		return nil
+	}); errTx != nil {
+		return nil, errTx
+	}
+
+	return item, nil
+}
+
+func (s *service) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	filter := &orderModel.Filter{
+		Status:         option.New(int(orderModel.StatusDeleted)),
+		ModifiedBefore: option.New(deletedBefore),
+	}
+
+	n, err := s.deleteAll(ctx, filter)
+	if err != nil {
+		return n, fmt.Errorf("purge orders: %w", err)
+	}
+
+	return n, nil
+}
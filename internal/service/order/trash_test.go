@@ -0,0 +1,360 @@
+package order_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	attachmentMock "github.com/krivenkov/order/internal/model/attachment/mock"
+	commentMock "github.com/krivenkov/order/internal/model/comment/mock"
+	"github.com/krivenkov/order/internal/model/history"
+	historyMock "github.com/krivenkov/order/internal/model/history/mock"
+	inventoryMock "github.com/krivenkov/order/internal/model/inventory/mock"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	svc "github.com/krivenkov/order/internal/service/order"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/order"
+	"github.com/krivenkov/pkg/paginator"
+	txerMock "github.com/krivenkov/pkg/txer/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTrash(t *testing.T) {
+	var (
+		userID     = "user_id"
+		pagination = paginator.Pagination{Limit: 50, Offset: 10}
+
+		filter = &orderModel.Filter{
+			Status:   option.New(int(orderModel.StatusDeleted)),
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+		}
+		orders = []*order.Order{{Column: orderModel.ModifySortKey, Direction: "desc"}}
+
+		items = []*orderModel.Order{
+			{ID: "1", UserID: userID, Status: orderModel.StatusDeleted},
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+
+		orderPGQuerier.EXPECT().GetList(context.TODO(), filter, orders, &pagination).Return(items, nil)
+		orderPGQuerier.EXPECT().Count(context.TODO(), filter).Return(11, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, total, err := service.GetTrash(context.TODO(), userID, pagination)
+
+		require.NoError(t, err)
+		require.Equal(t, items, res)
+		require.Equal(t, 11, total)
+	})
+
+	t.Run("Bad", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier = orderMock.NewMockQuerier(ctrl)
+
+			someErr = fmt.Errorf("some error")
+		)
+
+		orderPGQuerier.EXPECT().GetList(context.TODO(), filter, orders, &pagination).Return(nil, someErr)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, _, err := service.GetTrash(context.TODO(), userID, pagination)
+
+		require.ErrorIs(t, err, someErr)
+	})
+}
+
+func TestRestore(t *testing.T) {
+	var (
+		userID = "user_id"
+
+		filter = &orderModel.Filter{
+			Status: option.New(int(orderModel.StatusDeleted)),
+			IDs:    option.New([]string{newID().String()}),
+		}
+
+		deleted = func() *orderModel.Order {
+			return &orderModel.Order{
+				ID:       newID().String(),
+				TSCreate: now().Add(-time.Hour),
+				TSModify: now().Add(-time.Hour),
+				Status:   orderModel.StatusDeleted,
+				UserID:   userID,
+				Name:     "test",
+			}
+		}
+		restored = &orderModel.Order{
+			ID:       newID().String(),
+			TSCreate: now().Add(-time.Hour),
+			TSModify: now(),
+			Status:   orderModel.StatusCreated,
+			UserID:   userID,
+			Name:     "test",
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			entry = &history.Entry{
+				ID:       newID().String(),
+				TSCreate: now(),
+				OrderID:  newID().String(),
+				Actor:    userID,
+				Action:   history.ActionRestore,
+				Changes: []*history.Change{
+					{Field: "status", Old: "deleted", New: "created"},
+				},
+			}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(deleted(), nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), restored).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), entry).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), restored).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.Restore(context.TODO(), userID, newID().String())
+
+		require.NoError(t, err)
+		require.Equal(t, restored, res)
+	})
+
+	t.Run("Error update in es", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			historyCommander = historyMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			someErr = fmt.Errorf("some error")
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(deleted(), nil)
+		orderPGCommander.EXPECT().Update(context.TODO(), restored).Return(nil)
+		historyCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(nil)
+		orderESCommander.EXPECT().Update(context.TODO(), restored).Return(someErr)
+
+		service := svc.New(svc.Params{
+			CmdPg:      orderPGCommander,
+			CmdEs:      orderESCommander,
+			QrPg:       orderPGQuerier,
+			CmdHistory: historyCommander,
+			TXer:       tXer,
+			Now:        now,
+			NewID:      newID,
+		})
+
+		res, err := service.Restore(context.TODO(), userID, newID().String())
+
+		require.ErrorIs(t, err, someErr)
+		require.Nil(t, res)
+	})
+
+	t.Run("Not in trash", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(nil, model.ErrNotFound)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.Restore(context.TODO(), userID, newID().String())
+
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("Error permission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		orderPGQuerier := orderMock.NewMockQuerier(ctrl)
+
+		orderPGQuerier.EXPECT().GetItem(context.TODO(), filter).Return(deleted(), nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  orderPGQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.Restore(context.TODO(), "other_user", newID().String())
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+}
+
+func TestPurge(t *testing.T) {
+	var (
+		deletedBefore = now().Add(-30 * 24 * time.Hour)
+
+		filter = &orderModel.Filter{
+			Status:         option.New(int(orderModel.StatusDeleted)),
+			ModifiedBefore: option.New(deletedBefore),
+		}
+		pagination = &paginator.Pagination{Limit: 100}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			attachmentQr     = attachmentMock.NewMockQuerier(ctrl)
+			blobs            = attachmentMock.NewMockBlobStore(ctrl)
+			commentESCmd     = commentMock.NewMockCommander(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			full = make([]*orderModel.Order, 0, 100)
+			tail = []*orderModel.Order{{ID: "tail", Status: orderModel.StatusDeleted}}
+		)
+
+		for i := 0; i < 100; i++ {
+			full = append(full, &orderModel.Order{ID: fmt.Sprint(i), Status: orderModel.StatusDeleted})
+		}
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		}).Times(2)
+
+		gomock.InOrder(
+			orderPGQuerier.EXPECT().GetList(context.TODO(), filter, nil, pagination).Return(full, nil),
+			orderPGQuerier.EXPECT().GetList(context.TODO(), filter, nil, pagination).Return(tail, nil),
+		)
+
+		gomock.InOrder(
+			attachmentQr.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil),
+			attachmentQr.EXPECT().GetList(context.TODO(), &attachment.Filter{OrderIDs: option.New([]string{"tail"})}).
+				Return([]*attachment.Attachment{{ID: "attachment_id", OrderID: "tail"}}, nil),
+		)
+		blobs.EXPECT().Delete(context.TODO(), "tail/attachment_id").Return(nil)
+
+		reserver.EXPECT().Release(context.TODO(), gomock.Any()).Return(nil).Times(2)
+		orderPGCommander.EXPECT().Delete(context.TODO(), gomock.Any()).Return(nil).Times(101)
+		orderESCommander.EXPECT().Delete(context.TODO(), gomock.Any()).Return(model.ErrNotFound).Times(101)
+		commentESCmd.EXPECT().DeleteByOrders(context.TODO(), gomock.Any()).Return(nil).Times(2)
+
+		service := svc.New(svc.Params{
+			CmdPg:        orderPGCommander,
+			CmdEs:        orderESCommander,
+			QrPg:         orderPGQuerier,
+			QrAttachment: attachmentQr,
+			Blobs:        blobs,
+			CmdEsComment: commentESCmd,
+			Reserver:     reserver,
+			TXer:         tXer,
+			Now:          now,
+			NewID:        newID,
+		})
+
+		n, err := service.Purge(context.TODO(), deletedBefore)
+
+		require.NoError(t, err)
+		require.Equal(t, 101, n)
+	})
+
+	t.Run("Bad delete in es", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			orderPGQuerier   = orderMock.NewMockQuerier(ctrl)
+			orderPGCommander = orderMock.NewMockCommander(ctrl)
+			orderESCommander = orderMock.NewMockCommander(ctrl)
+			reserver         = inventoryMock.NewMockReserver(ctrl)
+			attachmentQr     = attachmentMock.NewMockQuerier(ctrl)
+			tXer             = txerMock.NewMockTXer(ctrl)
+
+			someErr = fmt.Errorf("some error")
+			items   = []*orderModel.Order{{ID: "1", Status: orderModel.StatusDeleted}}
+		)
+
+		tXer.EXPECT().WithTX(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, cb func(ctx context.Context) error) error {
+			return cb(ctx)
+		})
+
+		orderPGQuerier.EXPECT().GetList(context.TODO(), filter, nil, pagination).Return(items, nil)
+		attachmentQr.EXPECT().GetList(context.TODO(), gomock.Any()).Return(nil, nil)
+		reserver.EXPECT().Release(context.TODO(), "1").Return(nil)
+		orderPGCommander.EXPECT().Delete(context.TODO(), items[0]).Return(nil)
+		orderESCommander.EXPECT().Delete(context.TODO(), items[0]).Return(someErr)
+
+		service := svc.New(svc.Params{
+			CmdPg:        orderPGCommander,
+			CmdEs:        orderESCommander,
+			QrPg:         orderPGQuerier,
+			QrAttachment: attachmentQr,
+			Reserver:     reserver,
+			TXer:         tXer,
+			Now:          now,
+			NewID:        newID,
+		})
+
+		n, err := service.Purge(context.TODO(), deletedBefore)
+
+		require.ErrorIs(t, err, someErr)
+		require.Zero(t, n)
+	})
+}
@@ -0,0 +1,106 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	"go.uber.org/fx"
+)
+
+type service struct {
+	cmdPg promo.Commander
+	qrPg  promo.Querier
+
+	now   func() time.Time
+	newID func() uuid.UUID
+}
+
+type Params struct {
+	fx.In
+
+	CmdPg promo.Commander `name:"promo_pg_cmd"`
+	QrPg  promo.Querier   `name:"promo_pg_qr"`
+
+	Now   func() time.Time
+	NewID func() uuid.UUID
+}
+
+func New(params Params) promo.Service {
+	return &service{
+		cmdPg: params.CmdPg,
+		qrPg:  params.QrPg,
+
+		now:   params.Now,
+		newID: params.NewID,
+	}
+}
+
+func (s *service) Create(ctx context.Context, form *promo.Form) (*promo.Promo, error) {
+	if err := form.Validate(); err != nil {
+		return nil, err
+	}
+
+	item := promo.New(form, s.now, s.newID)
+
+	if err := s.cmdPg.Create(ctx, item); err != nil {
+		return nil, fmt.Errorf("promo create: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *service) Update(ctx context.Context, id string, form *promo.Form) (*promo.Promo, error) {
+	if err := form.Validate(); err != nil {
+		return nil, err
+	}
+
+	item, err := s.GetItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	item.FillForm(form)
+	item.TSModify = s.now()
+
+	if err = s.cmdPg.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("promo update: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	if err := s.cmdPg.Delete(ctx, id); err != nil {
+		return fmt.Errorf("promo delete: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) GetItem(ctx context.Context, id string) (*promo.Promo, error) {
+	item, err := s.qrPg.GetItem(ctx, &promo.Filter{IDs: option.New([]string{id})})
+	if err != nil {
+		return nil, fmt.Errorf("get item: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *service) GetList(ctx context.Context, pagination paginator.Pagination) ([]*promo.Promo, int, error) {
+	list, err := s.qrPg.GetList(ctx, nil, &pagination)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get list: %w", err)
+	}
+
+	total, err := s.qrPg.Count(ctx, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count: %w", err)
+	}
+
+	return list, total, nil
+}
@@ -0,0 +1,222 @@
+package promo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/promo"
+	promoMock "github.com/krivenkov/order/internal/model/promo/mock"
+	svc "github.com/krivenkov/order/internal/service/promo"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/stretchr/testify/require"
+)
+
+func form() *promo.Form {
+	return &promo.Form{
+		Code:      " spring-10 ",
+		Kind:      promo.KindFixed,
+		Scope:     promo.ScopeLine,
+		Value:     100,
+		Currency:  "eur",
+		SKUs:      []string{"SKU-1", " SKU-1", ""},
+		MinAmount: 1000,
+		MaxUses:   100,
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			promoCommander = promoMock.NewMockCommander(ctrl)
+
+			item = &promo.Promo{
+				ID:        newID().String(),
+				TSCreate:  now(),
+				TSModify:  now(),
+				Code:      "SPRING-10",
+				Kind:      promo.KindFixed,
+				Scope:     promo.ScopeLine,
+				Value:     100,
+				Currency:  "EUR",
+				SKUs:      []string{"SKU-1"},
+				MinAmount: 1000,
+				MaxUses:   100,
+			}
+		)
+
+		promoCommander.EXPECT().Create(context.TODO(), item).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: promoCommander,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Create(context.TODO(), form())
+
+		require.NoError(t, err)
+		require.Equal(t, item, res)
+	})
+
+	t.Run("Fixed without currency", func(t *testing.T) {
+		service := svc.New(svc.Params{
+			Now:   now,
+			NewID: newID,
+		})
+
+		f := form()
+		f.Currency = ""
+
+		res, err := service.Create(context.TODO(), f)
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+		require.Nil(t, res)
+	})
+
+	t.Run("Code taken", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			promoCommander = promoMock.NewMockCommander(ctrl)
+
+			errConflict = fmt.Errorf("%w: promo code already exists", model.ErrConflict)
+		)
+
+		promoCommander.EXPECT().Create(context.TODO(), gomock.Any()).Return(errConflict)
+
+		service := svc.New(svc.Params{
+			CmdPg: promoCommander,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Create(context.TODO(), form())
+
+		require.ErrorIs(t, err, model.ErrConflict)
+		require.Nil(t, res)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	var (
+		id     = newID().String()
+		filter = &promo.Filter{IDs: option.New([]string{id})}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			promoCommander = promoMock.NewMockCommander(ctrl)
+			promoQuerier   = promoMock.NewMockQuerier(ctrl)
+
+			created = now().Add(-time.Hour)
+		)
+
+		promoQuerier.EXPECT().GetItem(context.TODO(), filter).Return(&promo.Promo{
+			ID:       id,
+			TSCreate: created,
+			TSModify: created,
+			Code:     "OLD",
+			Kind:     promo.KindPercentage,
+			Scope:    promo.ScopeOrder,
+			Value:    5,
+			Uses:     3,
+		}, nil)
+
+		item := &promo.Promo{
+			ID:        id,
+			TSCreate:  created,
+			TSModify:  now(),
+			Code:      "SPRING-10",
+			Kind:      promo.KindFixed,
+			Scope:     promo.ScopeLine,
+			Value:     100,
+			Currency:  "EUR",
+			SKUs:      []string{"SKU-1"},
+			MinAmount: 1000,
+			MaxUses:   100,
+			Uses:      3,
+		}
+
+		promoCommander.EXPECT().Update(context.TODO(), item).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: promoCommander,
+			QrPg:  promoQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Update(context.TODO(), id, form())
+
+		require.NoError(t, err)
+		require.Equal(t, item, res)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		promoQuerier := promoMock.NewMockQuerier(ctrl)
+
+		promoQuerier.EXPECT().GetItem(context.TODO(), filter).Return(nil, model.ErrNotFound)
+
+		service := svc.New(svc.Params{
+			QrPg:  promoQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Update(context.TODO(), id, form())
+
+		require.ErrorIs(t, err, model.ErrNotFound)
+		require.Nil(t, res)
+	})
+}
+
+func TestGetList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		promoQuerier = promoMock.NewMockQuerier(ctrl)
+
+		pagination = paginator.Pagination{Limit: 50}
+		items      = []*promo.Promo{{ID: "1", Code: "TEN"}}
+	)
+
+	promoQuerier.EXPECT().GetList(context.TODO(), nil, &pagination).Return(items, nil)
+	promoQuerier.EXPECT().Count(context.TODO(), nil).Return(1, nil)
+
+	service := svc.New(svc.Params{
+		QrPg:  promoQuerier,
+		Now:   now,
+		NewID: newID,
+	})
+
+	res, total, err := service.GetList(context.TODO(), pagination)
+
+	require.NoError(t, err)
+	require.Equal(t, items, res)
+	require.Equal(t, 1, total)
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
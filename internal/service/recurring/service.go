@@ -0,0 +1,260 @@
+package recurring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/recurring"
+	"github.com/krivenkov/pkg/mlog"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// dueBatch is the number of due templates read at once
+const dueBatch = 100
+
+type service struct {
+	cmdPg recurring.Commander
+	qrPg  recurring.Querier
+
+	orderSvc orderModel.Service
+
+	now   func() time.Time
+	newID func() uuid.UUID
+}
+
+type Params struct {
+	fx.In
+
+	CmdPg recurring.Commander `name:"recurring_pg_cmd"`
+	QrPg  recurring.Querier   `name:"recurring_pg_qr"`
+
+	OrderSvc orderModel.Service
+
+	Now   func() time.Time
+	NewID func() uuid.UUID
+}
+
+func New(params Params) recurring.Service {
+	return &service{
+		cmdPg: params.CmdPg,
+		qrPg:  params.QrPg,
+
+		orderSvc: params.OrderSvc,
+
+		now:   params.Now,
+		newID: params.NewID,
+	}
+}
+
+func (s *service) Create(ctx context.Context, userID string, form *recurring.Form) (*recurring.Template, error) {
+	if err := form.Validate(); err != nil {
+		return nil, err
+	}
+
+	item := recurring.New(userID, form, s.now, s.newID)
+
+	if err := s.cmdPg.Create(ctx, item); err != nil {
+		return nil, fmt.Errorf("template create: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *service) Update(ctx context.Context, userID, id string, form *recurring.Form) (*recurring.Template, error) {
+	if err := form.Validate(); err != nil {
+		return nil, err
+	}
+
+	item, err := s.GetItem(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	item.FillForm(form, s.now())
+	item.TSModify = s.now()
+
+	if err = s.cmdPg.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("template update: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *service) Delete(ctx context.Context, userID, id string) error {
+	if _, err := s.GetItem(ctx, userID, id); err != nil {
+		return err
+	}
+
+	if err := s.cmdPg.Delete(ctx, id); err != nil {
+		return fmt.Errorf("template delete: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) Pause(ctx context.Context, userID, id string) (*recurring.Template, error) {
+	return s.setPaused(ctx, userID, id, true)
+}
+
+func (s *service) Resume(ctx context.Context, userID, id string) (*recurring.Template, error) {
+	return s.setPaused(ctx, userID, id, false)
+}
+
+func (s *service) setPaused(ctx context.Context, userID, id string, paused bool) (*recurring.Template, error) {
+	item, err := s.GetItem(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.Paused == paused {
+		return item, nil
+	}
+
+	if paused {
+		item.Paused = true
+	} else {
+		item.Resume(s.now())
+	}
+
+	item.TSModify = s.now()
+
+	if err = s.cmdPg.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("template update: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *service) GetItem(ctx context.Context, userID, id string) (*recurring.Template, error) {
+	item, err := s.qrPg.GetItem(ctx, &recurring.Filter{IDs: option.New([]string{id})})
+	if err != nil {
+		return nil, fmt.Errorf("get item: %w", err)
+	}
+
+	if item.UserID != userID {
+		return nil, model.ErrPermissionDenied
+	}
+
+	return item, nil
+}
+
+func (s *service) GetList(ctx context.Context, userID string, pagination paginator.Pagination) ([]*recurring.Template, int, error) {
+	filter := &recurring.Filter{UserID: option.New(userID)}
+
+	list, err := s.qrPg.GetList(ctx, filter, &pagination)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get list: %w", err)
+	}
+
+	total, err := s.qrPg.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count: %w", err)
+	}
+
+	return list, total, nil
+}
+
+func (s *service) GetRuns(ctx context.Context, userID, id string, pagination paginator.Pagination) ([]*recurring.Run, int, error) {
+	if _, err := s.GetItem(ctx, userID, id); err != nil {
+		return nil, 0, err
+	}
+
+	list, err := s.qrPg.GetRuns(ctx, id, &pagination)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get runs: %w", err)
+	}
+
+	total, err := s.qrPg.CountRuns(ctx, id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count runs: %w", err)
+	}
+
+	return list, total, nil
+}
+
+// RunDue claims the next run of a template before its order is created, so a crash
+// in between skips one order of the template instead of creating it twice
+func (s *service) RunDue(ctx context.Context) (int, error) {
+	now := s.now()
+	filter := &recurring.Filter{
+		Paused:    option.New(false),
+		DueBefore: option.New(now),
+	}
+
+	var (
+		runs   int
+		failed []string
+	)
+
+	for {
+		// claimed templates move past now, so the first page holds the ones still due
+		// along with the failed ones, which wait for the next tick
+		if len(failed) > 0 {
+			filter.ExcludeIDs = option.New(failed)
+		}
+
+		list, err := s.qrPg.GetList(ctx, filter, &paginator.Pagination{Limit: dueBatch})
+		if err != nil {
+			return runs, fmt.Errorf("get due: %w", err)
+		}
+
+		if len(list) == 0 {
+			return runs, nil
+		}
+
+		for _, item := range list {
+			ok, errRun := s.run(ctx, item, now)
+			if errRun != nil {
+				mlog.FromContext(ctx).Error("recurring template failed", zap.String("templateID", item.ID), zap.Error(errRun))
+				failed = append(failed, item.ID)
+
+				continue
+			}
+
+			if ok {
+				runs++
+			}
+		}
+
+		if len(list) < dueBatch {
+			return runs, nil
+		}
+	}
+}
+
+func (s *service) run(ctx context.Context, item *recurring.Template, now time.Time) (bool, error) {
+	if err := s.cmdPg.Claim(ctx, item.ID, item.NextRun, item.Schedule.Next(now)); err != nil {
+		if errors.Is(err, model.ErrConflict) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("claim: %w", err)
+	}
+
+	var orderID string
+
+	form := *item.Order
+
+	created, err := s.orderSvc.Create(history.CtxWithOrigin(ctx, history.Origin{
+		Actor:  item.UserID,
+		Source: history.SourceScheduler,
+	}), item.UserID, &form)
+	if err == nil {
+		orderID = created.ID
+	}
+
+	if err = s.cmdPg.CreateRun(ctx, recurring.NewRun(item, orderID, err, s.now, s.newID)); err != nil {
+		return false, fmt.Errorf("create run: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,526 @@
+package recurring_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/order/internal/model/line"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	orderMock "github.com/krivenkov/order/internal/model/order/mock"
+	"github.com/krivenkov/order/internal/model/recurring"
+	recurringMock "github.com/krivenkov/order/internal/model/recurring/mock"
+	svc "github.com/krivenkov/order/internal/service/recurring"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/krivenkov/pkg/ptr"
+	"github.com/stretchr/testify/require"
+)
+
+func form() *recurring.Form {
+	return &recurring.Form{
+		Name:     " weekly coffee ",
+		Schedule: recurring.Schedule{Cron: "0 9 * * 1"},
+		Order: &orderModel.Form{
+			Currency: ptr.Pointer("EUR"),
+			Lines:    []*line.Form{{SKU: "SKU-1", Quantity: 2, UnitPrice: 500}},
+		},
+	}
+}
+
+func template(userID string) *recurring.Template {
+	return &recurring.Template{
+		ID:       newID().String(),
+		TSCreate: now().Add(-24 * time.Hour),
+		TSModify: now().Add(-24 * time.Hour),
+		UserID:   userID,
+		Name:     "weekly coffee",
+		Schedule: recurring.Schedule{Cron: "0 9 * * 1"},
+		// the monday before now
+		NextRun: time.Date(1999, time.December, 27, 9, 0, 0, 0, time.UTC),
+		Order: &orderModel.Form{
+			Currency: ptr.Pointer("EUR"),
+			Lines:    []*line.Form{{SKU: "SKU-1", Quantity: 2, UnitPrice: 500}},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+
+			recurringCommander = recurringMock.NewMockCommander(ctrl)
+
+			item = &recurring.Template{
+				ID:       newID().String(),
+				TSCreate: now(),
+				TSModify: now(),
+				UserID:   userID,
+				Name:     "weekly coffee",
+				Schedule: recurring.Schedule{Cron: "0 9 * * 1"},
+				// now is a saturday
+				NextRun: time.Date(2000, time.January, 3, 9, 0, 0, 0, time.UTC),
+				Order: &orderModel.Form{
+					Currency: ptr.Pointer("EUR"),
+					Lines:    []*line.Form{{SKU: "SKU-1", Quantity: 2, UnitPrice: 500}},
+				},
+			}
+		)
+
+		recurringCommander.EXPECT().Create(context.TODO(), item).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: recurringCommander,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Create(context.TODO(), userID, form())
+
+		require.NoError(t, err)
+		require.Equal(t, item, res)
+	})
+
+	t.Run("Invalid form", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			modify func(f *recurring.Form)
+		}{
+			{name: "No name", modify: func(f *recurring.Form) { f.Name = " " }},
+			{name: "No schedule", modify: func(f *recurring.Form) { f.Schedule = recurring.Schedule{} }},
+			{name: "Both schedules", modify: func(f *recurring.Form) { f.Schedule.Interval = 24 * time.Hour }},
+			{name: "Short interval", modify: func(f *recurring.Form) { f.Schedule = recurring.Schedule{Interval: time.Minute} }},
+			{name: "Bad cron", modify: func(f *recurring.Form) { f.Schedule.Cron = "0 25 * * *" }},
+			{name: "No lines", modify: func(f *recurring.Form) { f.Order.Lines = nil }},
+			{name: "Draft", modify: func(f *recurring.Form) { f.Order.Draft = true }},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				f := form()
+				tt.modify(f)
+
+				service := svc.New(svc.Params{
+					Now:   now,
+					NewID: newID,
+				})
+
+				res, err := service.Create(context.TODO(), "user_id", f)
+
+				require.ErrorIs(t, err, model.ErrInvalidArgument)
+				require.Nil(t, res)
+			})
+		}
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	filter := &recurring.Filter{IDs: option.New([]string{newID().String()})}
+
+	t.Run("New schedule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			userID = "user_id"
+
+			recurringCommander = recurringMock.NewMockCommander(ctrl)
+			recurringQuerier   = recurringMock.NewMockQuerier(ctrl)
+
+			f = form()
+		)
+
+		f.Schedule = recurring.Schedule{Interval: 48 * time.Hour}
+
+		expected := template(userID)
+		expected.TSModify = now()
+		expected.Schedule = recurring.Schedule{Interval: 48 * time.Hour}
+		expected.NextRun = now().Add(48 * time.Hour)
+
+		recurringQuerier.EXPECT().GetItem(context.TODO(), filter).Return(template(userID), nil)
+		recurringCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: recurringCommander,
+			QrPg:  recurringQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Update(context.TODO(), userID, newID().String(), f)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Foreign template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		recurringQuerier := recurringMock.NewMockQuerier(ctrl)
+
+		recurringQuerier.EXPECT().GetItem(context.TODO(), filter).Return(template("other_user_id"), nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  recurringQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Update(context.TODO(), "user_id", newID().String(), form())
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+		require.Nil(t, res)
+	})
+}
+
+func TestPauseResume(t *testing.T) {
+	var (
+		userID = "user_id"
+		filter = &recurring.Filter{IDs: option.New([]string{newID().String()})}
+	)
+
+	t.Run("Pause", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			recurringCommander = recurringMock.NewMockCommander(ctrl)
+			recurringQuerier   = recurringMock.NewMockQuerier(ctrl)
+		)
+
+		expected := template(userID)
+		expected.TSModify = now()
+		expected.Paused = true
+
+		recurringQuerier.EXPECT().GetItem(context.TODO(), filter).Return(template(userID), nil)
+		recurringCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: recurringCommander,
+			QrPg:  recurringQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Pause(context.TODO(), userID, newID().String())
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Resume skips missed runs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			recurringCommander = recurringMock.NewMockCommander(ctrl)
+			recurringQuerier   = recurringMock.NewMockQuerier(ctrl)
+
+			paused = template(userID)
+		)
+
+		paused.Paused = true
+
+		expected := template(userID)
+		expected.TSModify = now()
+		expected.NextRun = time.Date(2000, time.January, 3, 9, 0, 0, 0, time.UTC)
+
+		recurringQuerier.EXPECT().GetItem(context.TODO(), filter).Return(paused, nil)
+		recurringCommander.EXPECT().Update(context.TODO(), expected).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: recurringCommander,
+			QrPg:  recurringQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Resume(context.TODO(), userID, newID().String())
+
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	})
+
+	t.Run("Already running", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		recurringQuerier := recurringMock.NewMockQuerier(ctrl)
+
+		recurringQuerier.EXPECT().GetItem(context.TODO(), filter).Return(template(userID), nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  recurringQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Resume(context.TODO(), userID, newID().String())
+
+		require.NoError(t, err)
+		require.Equal(t, template(userID), res)
+	})
+}
+
+func TestGetRuns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		userID     = "user_id"
+		pagination = paginator.Pagination{Limit: 10}
+
+		recurringQuerier = recurringMock.NewMockQuerier(ctrl)
+
+		runs = []*recurring.Run{{ID: "run-1", TemplateID: newID().String(), OrderID: "order-1"}}
+	)
+
+	recurringQuerier.EXPECT().GetItem(context.TODO(), gomock.Any()).Return(template(userID), nil)
+	recurringQuerier.EXPECT().GetRuns(context.TODO(), newID().String(), &pagination).Return(runs, nil)
+	recurringQuerier.EXPECT().CountRuns(context.TODO(), newID().String()).Return(1, nil)
+
+	service := svc.New(svc.Params{
+		QrPg:  recurringQuerier,
+		Now:   now,
+		NewID: newID,
+	})
+
+	res, total, err := service.GetRuns(context.TODO(), userID, newID().String(), pagination)
+
+	require.NoError(t, err)
+	require.Equal(t, runs, res)
+	require.Equal(t, 1, total)
+}
+
+func TestRunDue(t *testing.T) {
+	var (
+		userID = "user_id"
+
+		filter = &recurring.Filter{
+			Paused:    option.New(false),
+			DueBefore: option.New(now()),
+		}
+		pagination = &paginator.Pagination{Limit: 100}
+
+		scheduledAt = time.Date(1999, time.December, 27, 9, 0, 0, 0, time.UTC)
+		nextRun     = time.Date(2000, time.January, 3, 9, 0, 0, 0, time.UTC)
+
+		schedulerCtx = history.CtxWithOrigin(context.TODO(), history.Origin{
+			Actor:  userID,
+			Source: history.SourceScheduler,
+		})
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			recurringCommander = recurringMock.NewMockCommander(ctrl)
+			recurringQuerier   = recurringMock.NewMockQuerier(ctrl)
+			orderService       = orderMock.NewMockService(ctrl)
+		)
+
+		recurringQuerier.EXPECT().GetList(context.TODO(), filter, pagination).Return([]*recurring.Template{template(userID)}, nil)
+		recurringCommander.EXPECT().Claim(context.TODO(), newID().String(), scheduledAt, nextRun).Return(nil)
+		orderService.EXPECT().Create(schedulerCtx, userID, template(userID).Order).Return(&orderModel.Order{ID: "order-1"}, nil)
+		recurringCommander.EXPECT().CreateRun(context.TODO(), &recurring.Run{
+			ID:          newID().String(),
+			TSCreate:    now(),
+			TemplateID:  newID().String(),
+			ScheduledAt: scheduledAt,
+			OrderID:     "order-1",
+		}).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:    recurringCommander,
+			QrPg:     recurringQuerier,
+			OrderSvc: orderService,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		n, err := service.RunDue(context.TODO())
+
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	})
+
+	t.Run("Claimed by another run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			recurringCommander = recurringMock.NewMockCommander(ctrl)
+			recurringQuerier   = recurringMock.NewMockQuerier(ctrl)
+			orderService       = orderMock.NewMockService(ctrl)
+		)
+
+		recurringQuerier.EXPECT().GetList(context.TODO(), filter, pagination).Return([]*recurring.Template{template(userID)}, nil)
+		recurringCommander.EXPECT().Claim(context.TODO(), newID().String(), scheduledAt, nextRun).Return(model.ErrConflict)
+
+		service := svc.New(svc.Params{
+			CmdPg:    recurringCommander,
+			QrPg:     recurringQuerier,
+			OrderSvc: orderService,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		n, err := service.RunDue(context.TODO())
+
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+	})
+
+	t.Run("Order failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			recurringCommander = recurringMock.NewMockCommander(ctrl)
+			recurringQuerier   = recurringMock.NewMockQuerier(ctrl)
+			orderService       = orderMock.NewMockService(ctrl)
+
+			outOfStock = fmt.Errorf("%w: SKU-1 is out of stock", model.ErrConflict)
+		)
+
+		recurringQuerier.EXPECT().GetList(context.TODO(), filter, pagination).Return([]*recurring.Template{template(userID)}, nil)
+		recurringCommander.EXPECT().Claim(context.TODO(), newID().String(), scheduledAt, nextRun).Return(nil)
+		orderService.EXPECT().Create(schedulerCtx, userID, gomock.Any()).Return(nil, outOfStock)
+		recurringCommander.EXPECT().CreateRun(context.TODO(), &recurring.Run{
+			ID:          newID().String(),
+			TSCreate:    now(),
+			TemplateID:  newID().String(),
+			ScheduledAt: scheduledAt,
+			Error:       outOfStock.Error(),
+		}).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:    recurringCommander,
+			QrPg:     recurringQuerier,
+			OrderSvc: orderService,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		n, err := service.RunDue(context.TODO())
+
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	})
+
+	t.Run("Template failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			recurringCommander = recurringMock.NewMockCommander(ctrl)
+			recurringQuerier   = recurringMock.NewMockQuerier(ctrl)
+			orderService       = orderMock.NewMockService(ctrl)
+
+			broken = template(userID)
+		)
+
+		broken.ID = "broken"
+
+		// the failed template is skipped and the next one still runs
+		recurringQuerier.EXPECT().GetList(context.TODO(), filter, pagination).Return([]*recurring.Template{broken, template(userID)}, nil)
+		recurringCommander.EXPECT().Claim(context.TODO(), "broken", scheduledAt, nextRun).Return(fmt.Errorf("some error"))
+		recurringCommander.EXPECT().Claim(context.TODO(), newID().String(), scheduledAt, nextRun).Return(nil)
+		orderService.EXPECT().Create(schedulerCtx, userID, template(userID).Order).Return(&orderModel.Order{ID: "order-1"}, nil)
+		recurringCommander.EXPECT().CreateRun(context.TODO(), gomock.Any()).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg:    recurringCommander,
+			QrPg:     recurringQuerier,
+			OrderSvc: orderService,
+			Now:      now,
+			NewID:    newID,
+		})
+
+		n, err := service.RunDue(context.TODO())
+
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	})
+
+	t.Run("Failed template still due", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			recurringCommander = recurringMock.NewMockCommander(ctrl)
+			recurringQuerier   = recurringMock.NewMockQuerier(ctrl)
+
+			page = make([]*recurring.Template, 0, pagination.Limit)
+		)
+
+		for i := 0; i < pagination.Limit; i++ {
+			item := template(userID)
+			item.ID = fmt.Sprintf("template-%d", i)
+
+			page = append(page, item)
+		}
+
+		// the failed template is not claimed, the next page leaves it out till the next tick
+		recurringQuerier.EXPECT().GetList(context.TODO(), filter, pagination).Return(page, nil)
+		recurringCommander.EXPECT().Claim(context.TODO(), "template-0", scheduledAt, nextRun).Return(fmt.Errorf("some error"))
+		recurringCommander.EXPECT().Claim(context.TODO(), gomock.Any(), scheduledAt, nextRun).Return(model.ErrConflict).Times(len(page) - 1)
+		recurringQuerier.EXPECT().GetList(context.TODO(), &recurring.Filter{
+			Paused:     option.New(false),
+			DueBefore:  option.New(now()),
+			ExcludeIDs: option.New([]string{"template-0"}),
+		}, pagination).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: recurringCommander,
+			QrPg:  recurringQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		n, err := service.RunDue(context.TODO())
+
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+	})
+
+	t.Run("Nothing due", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		recurringQuerier := recurringMock.NewMockQuerier(ctrl)
+
+		recurringQuerier.EXPECT().GetList(context.TODO(), filter, pagination).Return(nil, nil)
+
+		service := svc.New(svc.Params{
+			QrPg:  recurringQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		n, err := service.RunDue(context.TODO())
+
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
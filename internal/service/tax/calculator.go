@@ -0,0 +1,152 @@
+package tax
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/order/internal/model/tax"
+)
+
+const rateBase = 10000
+
+type jurisdiction struct {
+	country   string
+	region    string
+	inclusive bool
+	rates     map[string]int64
+}
+
+type calculator struct {
+	jurisdictions map[string]*jurisdiction
+}
+
+// New is the default calculator, it taxes the lines with the rates of the configured
+// jurisdictions. Orders from other jurisdictions are not taxed.
+func New(cfg Config) (tax.Calculator, error) {
+	c := &calculator{
+		jurisdictions: make(map[string]*jurisdiction, len(cfg.Jurisdictions)),
+	}
+
+	for _, j := range cfg.Jurisdictions {
+		item := &jurisdiction{
+			country:   strings.ToUpper(strings.TrimSpace(j.Country)),
+			region:    strings.ToUpper(strings.TrimSpace(j.Region)),
+			inclusive: j.Inclusive,
+			rates:     make(map[string]int64, len(j.Rates)),
+		}
+
+		name := jurisdictionKey(item.country, item.region)
+
+		if len(item.country) != 2 {
+			return nil, fmt.Errorf("tax jurisdiction %q: country must be ISO 3166-1 alpha-2 code", name)
+		}
+
+		if _, ok := c.jurisdictions[name]; ok {
+			return nil, fmt.Errorf("tax jurisdiction %q is configured twice", name)
+		}
+
+		for _, r := range j.Rates {
+			category := line.NormalizeTaxCategory(r.Category)
+
+			if r.Rate < 0 || r.Rate > rateBase {
+				return nil, fmt.Errorf("tax jurisdiction %q: rate of %q must be from 0 to %d", name, category, rateBase)
+			}
+
+			if _, ok := item.rates[category]; ok {
+				return nil, fmt.Errorf("tax jurisdiction %q: rate of %q is configured twice", name, category)
+			}
+
+			item.rates[category] = r.Rate
+		}
+
+		c.jurisdictions[name] = item
+	}
+
+	return c, nil
+}
+
+func (c *calculator) Calculate(_ context.Context, req *tax.Request) (*tax.Result, error) {
+	res := &tax.Result{}
+
+	for _, l := range req.Lines {
+		l.Tax = 0
+	}
+
+	j := c.find(req.Country, req.Region)
+	if j == nil {
+		return res, nil
+	}
+
+	res.Inclusive = j.inclusive
+
+	byCategory := make(map[string]*tax.Line)
+
+	for _, l := range req.Lines {
+		category, rate := j.rate(l.TaxCategory)
+		if rate == 0 || l.Net() <= 0 {
+			continue
+		}
+
+		l.Tax = lineTax(l.Net(), rate, j.inclusive)
+
+		item, ok := byCategory[category]
+		if !ok {
+			item = &tax.Line{
+				Country:  j.country,
+				Region:   j.region,
+				Category: category,
+				Rate:     rate,
+			}
+
+			byCategory[category] = item
+			res.Lines = append(res.Lines, item)
+		}
+
+		item.Taxable += l.Net()
+		item.Amount += l.Tax
+	}
+
+	return res, nil
+}
+
+// find prefers the rates of the region to the ones of the country
+func (c *calculator) find(country, region string) *jurisdiction {
+	country = strings.ToUpper(country)
+	region = strings.ToUpper(region)
+
+	if region != "" {
+		if j, ok := c.jurisdictions[jurisdictionKey(country, region)]; ok {
+			return j
+		}
+	}
+
+	return c.jurisdictions[jurisdictionKey(country, "")]
+}
+
+// rate falls back to the standard rate for unknown categories
+func (j *jurisdiction) rate(category string) (string, int64) {
+	if rate, ok := j.rates[category]; ok {
+		return category, rate
+	}
+
+	return "", j.rates[""]
+}
+
+// lineTax rounds half up, the tax is extracted from the amount when it is inclusive
+func lineTax(amount, rate int64, inclusive bool) int64 {
+	if inclusive {
+		return amount - (amount*rateBase+(rateBase+rate)/2)/(rateBase+rate)
+	}
+
+	return (amount*rate + rateBase/2) / rateBase
+}
+
+func jurisdictionKey(country, region string) string {
+	if region == "" {
+		return country
+	}
+
+	return country + "-" + region
+}
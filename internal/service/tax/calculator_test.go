@@ -0,0 +1,146 @@
+package tax_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/order/internal/model/tax"
+	svc "github.com/krivenkov/order/internal/service/tax"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+
+	calculator, err := svc.New(svc.Config{
+		Jurisdictions: []svc.Jurisdiction{
+			{
+				Country:   "DE",
+				Inclusive: true,
+				Rates:     []svc.Rate{{Rate: 1900}, {Category: "Reduced", Rate: 700}},
+			},
+			{
+				Country: "US",
+				Rates:   []svc.Rate{{Category: "groceries"}},
+			},
+			{
+				Country: "us",
+				Region:  "ca",
+				Rates:   []svc.Rate{{Rate: 725}, {Category: "groceries"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	lines := func() []*line.Line {
+		return []*line.Line{
+			{ID: "a", Quantity: 2, UnitPrice: 1190, Discount: 119},
+			{ID: "b", Quantity: 1, UnitPrice: 1070, TaxCategory: "reduced"},
+			{ID: "c", Quantity: 1, UnitPrice: 999, TaxCategory: "groceries"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		country string
+		region  string
+		result  *tax.Result
+		taxes   []int64
+	}{
+		{
+			name:    "Inclusive with categories",
+			country: "DE",
+			result: &tax.Result{
+				Inclusive: true,
+				Lines: []*tax.Line{
+					{Country: "DE", Category: "", Rate: 1900, Taxable: 3260, Amount: 521},
+					{Country: "DE", Category: "reduced", Rate: 700, Taxable: 1070, Amount: 70},
+				},
+			},
+			taxes: []int64{361, 70, 160},
+		},
+		{
+			name:    "Exclusive in a region",
+			country: "US",
+			region:  "CA",
+			result: &tax.Result{
+				Lines: []*tax.Line{
+					{Country: "US", Region: "CA", Category: "", Rate: 725, Taxable: 3331, Amount: 242},
+				},
+			},
+			taxes: []int64{164, 78, 0},
+		},
+		{
+			name:    "Country without a standard rate",
+			country: "US",
+			region:  "NY",
+			result:  &tax.Result{},
+			taxes:   []int64{0, 0, 0},
+		},
+		{
+			name:    "Unknown jurisdiction",
+			country: "FR",
+			result:  &tax.Result{},
+			taxes:   []int64{0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ll := lines()
+
+			res, err := calculator.Calculate(context.TODO(), &tax.Request{
+				Currency: "EUR",
+				Country:  tt.country,
+				Region:   tt.region,
+				Lines:    ll,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.result, res)
+			require.Equal(t, tt.taxes, []int64{ll[0].Tax, ll[1].Tax, ll[2].Tax})
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  svc.Config
+	}{
+		{
+			name: "Bad country",
+			cfg:  svc.Config{Jurisdictions: []svc.Jurisdiction{{Country: "DEU"}}},
+		},
+		{
+			name: "Jurisdiction twice",
+			cfg:  svc.Config{Jurisdictions: []svc.Jurisdiction{{Country: "DE"}, {Country: "de"}}},
+		},
+		{
+			name: "Rate twice",
+			cfg:  svc.Config{Jurisdictions: []svc.Jurisdiction{{Country: "DE", Rates: []svc.Rate{{Rate: 1900}, {Rate: 700}}}}},
+		},
+		{
+			name: "Rate out of range",
+			cfg:  svc.Config{Jurisdictions: []svc.Jurisdiction{{Country: "DE", Rates: []svc.Rate{{Rate: 19000}}}}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := svc.New(tt.cfg)
+
+			require.Error(t, err)
+			require.Nil(t, res)
+		})
+	}
+}
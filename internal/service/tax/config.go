@@ -0,0 +1,24 @@
+package tax
+
+type Config struct {
+	Jurisdictions []Jurisdiction `json:"jurisdictions" yaml:"jurisdictions"`
+}
+
+// Jurisdiction is a country or a region of it with its own rates, a region replaces the
+// rates of its country
+type Jurisdiction struct {
+	// Country is ISO 3166-1 alpha-2 code
+	Country string `json:"country" yaml:"country"`
+	// Region is a state or a province, empty for the whole country
+	Region string `json:"region" yaml:"region"`
+	// Inclusive is true when the prices include the taxes, as with VAT
+	Inclusive bool   `json:"inclusive" yaml:"inclusive"`
+	Rates     []Rate `json:"rates" yaml:"rates"`
+}
+
+type Rate struct {
+	// Category of the products, the empty one is the standard rate for the rest
+	Category string `json:"category" yaml:"category"`
+	// Rate in basis points, 1900 is 19%
+	Rate int64 `json:"rate" yaml:"rate"`
+}
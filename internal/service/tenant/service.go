@@ -0,0 +1,105 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"go.uber.org/fx"
+)
+
+type service struct {
+	cmdPg tenant.Commander
+	qrPg  tenant.Querier
+
+	now   func() time.Time
+	newID func() uuid.UUID
+}
+
+type Params struct {
+	fx.In
+
+	CmdPg tenant.Commander `name:"tenant_pg_cmd"`
+	QrPg  tenant.Querier   `name:"tenant_pg_qr"`
+
+	Now   func() time.Time
+	NewID func() uuid.UUID
+}
+
+func New(params Params) tenant.Service {
+	return &service{
+		cmdPg: params.CmdPg,
+		qrPg:  params.QrPg,
+
+		now:   params.Now,
+		newID: params.NewID,
+	}
+}
+
+func (s *service) Create(ctx context.Context, name string) (*tenant.Tenant, error) {
+	item, err := tenant.New(name, s.now, s.newID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.cmdPg.Create(ctx, item); err != nil {
+		return nil, fmt.Errorf("tenant create: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *service) SetMember(ctx context.Context, tenantID, userID string, role tenant.Role) (*tenant.Member, error) {
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.qrPg.GetItem(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("get tenant: %w", err)
+	}
+
+	if err := s.cmdPg.SetMember(ctx, &tenant.Member{
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     role,
+		TSCreate: s.now(),
+	}); err != nil {
+		return nil, fmt.Errorf("set member: %w", err)
+	}
+
+	member, err := s.qrPg.GetMember(ctx, tenantID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get member: %w", err)
+	}
+
+	return member, nil
+}
+
+func (s *service) RemoveMember(ctx context.Context, tenantID, userID string) error {
+	if err := s.cmdPg.RemoveMember(ctx, tenantID, userID); err != nil {
+		return fmt.Errorf("remove member: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) Resolve(ctx context.Context, tenantID, userID string) (*tenant.Scope, error) {
+	member, err := s.qrPg.GetMember(ctx, tenantID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, model.ErrPermissionDenied
+		}
+
+		return nil, fmt.Errorf("get member: %w", err)
+	}
+
+	return &tenant.Scope{
+		TenantID: member.TenantID,
+		UserID:   member.UserID,
+		Role:     member.Role,
+	}, nil
+}
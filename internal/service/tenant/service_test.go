@@ -0,0 +1,197 @@
+package tenant_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	tenantMock "github.com/krivenkov/order/internal/model/tenant/mock"
+	svc "github.com/krivenkov/order/internal/service/tenant"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			tenantCommander = tenantMock.NewMockCommander(ctrl)
+
+			item = &tenant.Tenant{
+				ID:       newID().String(),
+				TSCreate: now(),
+				Name:     "Acme",
+			}
+		)
+
+		tenantCommander.EXPECT().Create(context.TODO(), item).Return(nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: tenantCommander,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.Create(context.TODO(), " Acme ")
+
+		require.NoError(t, err)
+		require.Equal(t, item, res)
+	})
+
+	t.Run("Empty name", func(t *testing.T) {
+		service := svc.New(svc.Params{
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.Create(context.TODO(), " ")
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+}
+
+func TestSetMember(t *testing.T) {
+	var (
+		tenantID = "tenant_id"
+		userID   = "user_id"
+
+		member = &tenant.Member{
+			TenantID: tenantID,
+			UserID:   userID,
+			Role:     tenant.RoleEditor,
+			TSCreate: now(),
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			tenantCommander = tenantMock.NewMockCommander(ctrl)
+			tenantQuerier   = tenantMock.NewMockQuerier(ctrl)
+		)
+
+		tenantQuerier.EXPECT().GetItem(context.TODO(), tenantID).Return(&tenant.Tenant{ID: tenantID}, nil)
+		tenantCommander.EXPECT().SetMember(context.TODO(), member).Return(nil)
+		tenantQuerier.EXPECT().GetMember(context.TODO(), tenantID, userID).Return(member, nil)
+
+		service := svc.New(svc.Params{
+			CmdPg: tenantCommander,
+			QrPg:  tenantQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		res, err := service.SetMember(context.TODO(), tenantID, userID, tenant.RoleEditor)
+
+		require.NoError(t, err)
+		require.Equal(t, member, res)
+	})
+
+	t.Run("Unknown role", func(t *testing.T) {
+		service := svc.New(svc.Params{
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.SetMember(context.TODO(), tenantID, userID, "owner")
+
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+
+	t.Run("Unknown tenant", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantQuerier := tenantMock.NewMockQuerier(ctrl)
+
+		tenantQuerier.EXPECT().GetItem(context.TODO(), tenantID).Return(nil, model.ErrNotFound)
+
+		service := svc.New(svc.Params{
+			QrPg:  tenantQuerier,
+			Now:   now,
+			NewID: newID,
+		})
+
+		_, err := service.SetMember(context.TODO(), tenantID, userID, tenant.RoleViewer)
+
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestResolve(t *testing.T) {
+	var (
+		tenantID = "tenant_id"
+		userID   = "user_id"
+	)
+
+	t.Run("Member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantQuerier := tenantMock.NewMockQuerier(ctrl)
+
+		tenantQuerier.EXPECT().GetMember(context.TODO(), tenantID, userID).Return(&tenant.Member{
+			TenantID: tenantID,
+			UserID:   userID,
+			Role:     tenant.RoleApprover,
+		}, nil)
+
+		service := svc.New(svc.Params{QrPg: tenantQuerier})
+
+		res, err := service.Resolve(context.TODO(), tenantID, userID)
+
+		require.NoError(t, err)
+		require.Equal(t, &tenant.Scope{TenantID: tenantID, UserID: userID, Role: tenant.RoleApprover}, res)
+	})
+
+	t.Run("Not a member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tenantQuerier := tenantMock.NewMockQuerier(ctrl)
+
+		tenantQuerier.EXPECT().GetMember(context.TODO(), tenantID, userID).Return(nil, model.ErrNotFound)
+
+		service := svc.New(svc.Params{QrPg: tenantQuerier})
+
+		_, err := service.Resolve(context.TODO(), tenantID, userID)
+
+		require.ErrorIs(t, err, model.ErrPermissionDenied)
+	})
+
+	t.Run("Bad query", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var (
+			tenantQuerier = tenantMock.NewMockQuerier(ctrl)
+
+			someErr = fmt.Errorf("some error")
+		)
+
+		tenantQuerier.EXPECT().GetMember(context.TODO(), tenantID, userID).Return(nil, someErr)
+
+		service := svc.New(svc.Params{QrPg: tenantQuerier})
+
+		_, err := service.Resolve(context.TODO(), tenantID, userID)
+
+		require.ErrorIs(t, err, someErr)
+		require.NotErrorIs(t, err, model.ErrPermissionDenied)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
+
+func newID() uuid.UUID {
+	return uuid.Nil
+}
@@ -0,0 +1,18 @@
+package blob
+
+import (
+	"github.com/krivenkov/order/internal/storage/blob/local"
+	"github.com/krivenkov/order/internal/storage/blob/s3"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+type Config struct {
+	// Driver picks where the content of the attachments is kept, local or s3
+	Driver string       `json:"driver" yaml:"driver" env:"DRIVER" default:"local"`
+	Local  local.Config `json:"local" yaml:"local" envPrefix:"LOCAL_"`
+	S3     s3.Config    `json:"s3" yaml:"s3" envPrefix:"S3_"`
+}
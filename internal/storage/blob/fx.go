@@ -0,0 +1,28 @@
+package blob
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/krivenkov/order/internal/storage/blob/local"
+	"github.com/krivenkov/order/internal/storage/blob/s3"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(New, fx.ResultTags(`name:"attachment_blob"`)),
+	),
+)
+
+func New(cfg Config, now func() time.Time) (attachment.BlobStore, error) {
+	switch cfg.Driver {
+	case DriverLocal:
+		return local.New(cfg.Local), nil
+	case DriverS3:
+		return s3.New(cfg.S3, now)
+	default:
+		return nil, fmt.Errorf("unknown blob driver %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,6 @@
+package local
+
+type Config struct {
+	// Dir keeps a file per key, it is created when missing
+	Dir string `json:"dir" yaml:"dir" env:"DIR" default:"var/attachments"`
+}
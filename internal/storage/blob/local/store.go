@@ -0,0 +1,104 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+)
+
+// Store keeps the content on the local filesystem, it suits a single instance or a shared volume
+type Store struct {
+	dir string
+}
+
+func New(cfg Config) attachment.BlobStore {
+	return &Store{
+		dir: filepath.Clean(cfg.Dir),
+	}
+}
+
+func (s *Store) Put(_ context.Context, key string, content io.Reader, size int64, _ string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return fmt.Errorf("create dir: %w", err)
+	}
+
+	// the content shows up under the key only once it is complete
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, io.LimitReader(content, size+1))
+	if errClose := tmp.Close(); err == nil {
+		err = errClose
+	}
+
+	if err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+
+	if n != size {
+		return fmt.Errorf("%w: read %d bytes, expected %d", attachment.ErrContentSize, n, size)
+	}
+
+	if err = os.Rename(tmp.Name(), name); err != nil {
+		return fmt.Errorf("rename file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, model.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+
+	return f, nil
+}
+
+func (s *Store) Delete(_ context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove file: %w", err)
+	}
+
+	return nil
+}
+
+// path refuses the keys pointing outside of the dir
+func (s *Store) path(key string) (string, error) {
+	name := filepath.Join(s.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(name, s.dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: blob key %q", model.ErrInvalidArgument, key)
+	}
+
+	return name, nil
+}
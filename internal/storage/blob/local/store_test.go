@@ -0,0 +1,65 @@
+package local_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/krivenkov/order/internal/storage/blob/local"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Put, get and delete", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		store := local.New(local.Config{Dir: dir})
+
+		require.NoError(t, store.Put(context.TODO(), "order_id/id", strings.NewReader("%PDF-1.7"), 8, "application/pdf"))
+
+		rc, err := store.Get(context.TODO(), "order_id/id")
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		require.Equal(t, "%PDF-1.7", string(data))
+
+		require.NoError(t, store.Delete(context.TODO(), "order_id/id"))
+		require.NoError(t, store.Delete(context.TODO(), "order_id/id"))
+
+		_, err = store.Get(context.TODO(), "order_id/id")
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("Content of another size", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		store := local.New(local.Config{Dir: dir})
+
+		require.ErrorIs(t, store.Put(context.TODO(), "order_id/id", strings.NewReader("%PDF-1.7"), 4, "application/pdf"), attachment.ErrContentSize)
+		require.ErrorIs(t, store.Put(context.TODO(), "order_id/id", strings.NewReader("%PDF-1.7"), 10, "application/pdf"), attachment.ErrContentSize)
+
+		entries, err := os.ReadDir(filepath.Join(dir, "order_id"))
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("Key outside of the dir", func(t *testing.T) {
+		t.Parallel()
+
+		store := local.New(local.Config{Dir: t.TempDir()})
+
+		_, err := store.Get(context.TODO(), "../secret")
+		require.ErrorIs(t, err, model.ErrInvalidArgument)
+	})
+}
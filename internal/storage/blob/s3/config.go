@@ -0,0 +1,16 @@
+package s3
+
+import "time"
+
+type Config struct {
+	// Endpoint is the base url of the S3-compatible service, e.g. https://s3.eu-central-1.amazonaws.com
+	Endpoint  string `json:"endpoint" yaml:"endpoint" env:"ENDPOINT"`
+	Region    string `json:"region" yaml:"region" env:"REGION" default:"us-east-1"`
+	Bucket    string `json:"bucket" yaml:"bucket" env:"BUCKET"`
+	AccessKey string `json:"access_key" yaml:"access_key" env:"ACCESS_KEY"`
+	SecretKey string `json:"secret_key" yaml:"secret_key" env:"SECRET_KEY"`
+	// VirtualHost addresses the bucket as a subdomain of the endpoint instead of the first segment
+	// of the path, MinIO and most stand-ins only serve the path style
+	VirtualHost bool          `json:"virtual_host" yaml:"virtual_host" env:"VIRTUAL_HOST"`
+	Timeout     time.Duration `json:"timeout" yaml:"timeout" env:"TIMEOUT" default:"30s"`
+}
@@ -0,0 +1,207 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+)
+
+// Store keeps the content as objects of a bucket of an S3-compatible service
+type Store struct {
+	base   *url.URL
+	bucket string
+	vhost  bool
+
+	cli    *http.Client
+	signer *Signer
+	now    func() time.Time
+}
+
+func New(cfg Config, now func() time.Time) (attachment.BlobStore, error) {
+	base, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse s3 endpoint: %w", err)
+	}
+
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("s3 endpoint %q is not an absolute url", cfg.Endpoint)
+	}
+
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+
+	return &Store{
+		base:   base,
+		bucket: cfg.Bucket,
+		vhost:  cfg.VirtualHost,
+		cli:    &http.Client{Timeout: cfg.Timeout},
+		signer: NewSigner(cfg.AccessKey, cfg.SecretKey, cfg.Region),
+		now:    now,
+	}, nil
+}
+
+func (s *Store) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+
+	body := &sizedReader{r: content, size: size, left: size}
+
+	resp, err := s.do(ctx, http.MethodPut, key, body, size, header)
+	if err != nil {
+		if body.err != nil {
+			return body.err
+		}
+
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(http.MethodPut, key, resp)
+	}
+
+	return nil
+}
+
+func (s *Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+
+		return nil, model.ErrNotFound
+	default:
+		defer resp.Body.Close()
+
+		return nil, responseError(http.MethodGet, key, resp)
+	}
+}
+
+func (s *Store) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, 0, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return responseError(http.MethodDelete, key, resp)
+	}
+}
+
+func (s *Store) do(ctx context.Context, method, key string, body io.Reader, size int64, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), body)
+	if err != nil {
+		return nil, fmt.Errorf("prepare s3 request: %w", err)
+	}
+
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	payloadHash := EmptyPayload
+	if body != nil {
+		req.ContentLength = size
+		payloadHash = UnsignedPayload
+	}
+
+	s.signer.Sign(req, payloadHash, s.now())
+
+	resp, err := s.cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s %s: %w", method, key, err)
+	}
+
+	return resp, nil
+}
+
+func (s *Store) objectURL(key string) string {
+	u := *s.base
+	p := strings.TrimSuffix(u.Path, "/")
+
+	if s.vhost {
+		u.Host = s.bucket + "." + u.Host
+	} else {
+		p += "/" + s.bucket
+	}
+
+	u.Path = p + "/" + key
+	u.RawPath = uriEncode(u.Path, false)
+
+	return u.String()
+}
+
+// sizedReader passes the size bytes of the content. A content of another size fails the read
+// before its last byte is sent, so the service never completes the object.
+type sizedReader struct {
+	r    io.Reader
+	size int64
+	left int64
+	err  error
+}
+
+func (r *sizedReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	if r.left == 0 {
+		return 0, io.EOF
+	}
+
+	if int64(len(p)) > r.left {
+		p = p[:r.left]
+	}
+
+	n, err := r.r.Read(p)
+	r.left -= int64(n)
+
+	switch {
+	case r.left == 0:
+		// a byte past the size tells a longer content
+		var extra [1]byte
+
+		m, errExtra := io.ReadFull(r.r, extra[:])
+		if m > 0 {
+			r.err = fmt.Errorf("%w: content is longer than %d bytes", attachment.ErrContentSize, r.size)
+
+			return 0, r.err
+		}
+
+		if !errors.Is(errExtra, io.EOF) {
+			return 0, errExtra
+		}
+
+		return n, nil
+	case errors.Is(err, io.EOF):
+		r.err = fmt.Errorf("%w: read %d bytes, expected %d", attachment.ErrContentSize, r.size-r.left, r.size)
+
+		return n, r.err
+	default:
+		return n, err
+	}
+}
+
+func responseError(method, key string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	return fmt.Errorf("s3 %s %s: %s: %s", method, key, resp.Status, strings.TrimSpace(string(body)))
+}
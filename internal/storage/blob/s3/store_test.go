@@ -0,0 +1,141 @@
+package s3_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/krivenkov/order/internal/storage/blob/s3"
+	"github.com/stretchr/testify/require"
+)
+
+// bucket stands in for an S3-compatible service with path-style addressing
+type bucket struct {
+	t    *testing.T
+	name string
+
+	mu      sync.Mutex
+	objects map[string]string
+	types   map[string]string
+}
+
+func (b *bucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+		w.WriteHeader(http.StatusForbidden)
+
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+b.name+"/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		require.Equal(b.t, s3.UnsignedPayload, r.Header.Get("X-Amz-Content-Sha256"))
+
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			// the client gave up on the request before its last byte
+			return
+		}
+
+		b.objects[key] = string(data)
+		b.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		data, found := b.objects[key]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = io.WriteString(w, data)
+	case http.MethodDelete:
+		delete(b.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now = func() time.Time {
+			return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+		}
+
+		b = &bucket{t: t, name: "attachments", objects: map[string]string{}, types: map[string]string{}}
+
+		srv = httptest.NewServer(b)
+	)
+
+	t.Cleanup(srv.Close)
+
+	store, err := s3.New(s3.Config{
+		Endpoint:  srv.URL,
+		Region:    "us-east-1",
+		Bucket:    "attachments",
+		AccessKey: "access",
+		SecretKey: "secret",
+		Timeout:   time.Second,
+	}, now)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(context.TODO(), "order_id/id", strings.NewReader("%PDF-1.7"), 8, "application/pdf"))
+	require.Equal(t, "application/pdf", b.types["order_id/id"])
+
+	rc, err := store.Get(context.TODO(), "order_id/id")
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	require.Equal(t, "%PDF-1.7", string(data))
+
+	require.NoError(t, store.Delete(context.TODO(), "order_id/id"))
+
+	_, err = store.Get(context.TODO(), "order_id/id")
+	require.ErrorIs(t, err, model.ErrNotFound)
+
+	err = store.Put(context.TODO(), "order_id/short", strings.NewReader("%PDF"), 8, "application/pdf")
+	require.ErrorIs(t, err, attachment.ErrContentSize)
+
+	// a longer content is not cut to the size
+	err = store.Put(context.TODO(), "order_id/long", strings.NewReader("%PDF-1.7"), 4, "application/pdf")
+	require.ErrorIs(t, err, attachment.ErrContentSize)
+
+	// the content streamed past the buffers of the client is stopped before its last byte too
+	err = store.Put(context.TODO(), "order_id/large", strings.NewReader(strings.Repeat("x", 1<<20+1)), 1<<20, "application/pdf")
+	require.ErrorIs(t, err, attachment.ErrContentSize)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	require.NotContains(t, b.objects, "order_id/short")
+	require.NotContains(t, b.objects, "order_id/long")
+	require.NotContains(t, b.objects, "order_id/large")
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := s3.New(s3.Config{Endpoint: "127.0.0.1:9000", Bucket: "attachments"}, time.Now)
+	require.Error(t, err)
+
+	_, err = s3.New(s3.Config{Endpoint: "http://127.0.0.1:9000"}, time.Now)
+	require.Error(t, err)
+}
@@ -0,0 +1,14 @@
+package storage
+
+import (
+	"github.com/krivenkov/order/internal/storage/blob"
+	"github.com/krivenkov/order/internal/storage/es/breaker"
+	"go.uber.org/fx"
+)
+
+type Config struct {
+	fx.Out
+
+	ESBreaker breaker.Config `json:"es_breaker" yaml:"es_breaker" envPrefix:"ES_BREAKER_"`
+	Blob      blob.Config    `json:"blob" yaml:"blob" envPrefix:"BLOB_"`
+}
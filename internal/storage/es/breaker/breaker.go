@@ -0,0 +1,184 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/krivenkov/pkg/clients/es"
+	"github.com/olivere/elastic/v7"
+)
+
+var (
+	ErrOpen        = fmt.Errorf("es circuit breaker is open")
+	ErrUnsupported = fmt.Errorf("es client does not support search")
+)
+
+type state int
+
+const (
+	stateClosed state = iota
+	stateOpen
+	stateHalfOpen
+)
+
+// Client is es.Client guarded by a circuit breaker: after FailureThreshold
+// consecutive failures every call fails fast with ErrOpen until OpenTimeout
+// passes, then a single trial call decides whether to close it again.
+type Client struct {
+	es.Client
+
+	cfg Config
+	now func() time.Time
+
+	mu       sync.Mutex
+	state    state
+	failures int
+	openedAt time.Time
+}
+
+func New(cli es.Client, cfg Config, now func() time.Time) *Client {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 1
+	}
+
+	return &Client{
+		Client: cli,
+		cfg:    cfg,
+		now:    now,
+	}
+}
+
+// Search runs the search of source on the index through the underlying elastic client,
+// es.Client has none for aggregations.
+func (c *Client) Search(ctx context.Context, index string, source *elastic.SearchSource) (*elastic.SearchResult, error) {
+	searcher, ok := c.Client.(interface {
+		Search(indices ...string) *elastic.SearchService
+	})
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	var res *elastic.SearchResult
+
+	err := c.call(func() error {
+		var err error
+		res, err = searcher.Search(index).SearchSource(source).Do(ctx)
+		return err
+	})
+
+	return res, err
+}
+
+func (c *Client) Save(ctx context.Context, req *es.SaveRequest) error {
+	return c.call(func() error {
+		return c.Client.Save(ctx, req)
+	})
+}
+
+func (c *Client) UpdateByScript(ctx context.Context, req *es.UpdateByScriptRequest) error {
+	return c.call(func() error {
+		return c.Client.UpdateByScript(ctx, req)
+	})
+}
+
+func (c *Client) DeleteByID(ctx context.Context, index string, id string) error {
+	return c.call(func() error {
+		return c.Client.DeleteByID(ctx, index, id)
+	})
+}
+
+func (c *Client) GetSearch(ctx context.Context, req *es.GetSearchRequest) (*es.GetSearchResponse, error) {
+	var res *es.GetSearchResponse
+
+	err := c.call(func() error {
+		var err error
+		res, err = c.Client.GetSearch(ctx, req)
+		return err
+	})
+
+	return res, err
+}
+
+func (c *Client) GetCount(ctx context.Context, req *es.GetCountRequest) (int, error) {
+	var res int
+
+	err := c.call(func() error {
+		var err error
+		res, err = c.Client.GetCount(ctx, req)
+		return err
+	})
+
+	return res, err
+}
+
+func (c *Client) OpenPIT(ctx context.Context, indexes []string, keepAlive string) (*es.PIT, error) {
+	var res *es.PIT
+
+	err := c.call(func() error {
+		var err error
+		res, err = c.Client.OpenPIT(ctx, indexes, keepAlive)
+		return err
+	})
+
+	return res, err
+}
+
+func (c *Client) ClosePIT(ctx context.Context, pit *es.PIT) error {
+	return c.call(func() error {
+		return c.Client.ClosePIT(ctx, pit)
+	})
+}
+
+func (c *Client) call(fn func() error) error {
+	if !c.allow() {
+		return ErrOpen
+	}
+
+	err := fn()
+	c.report(err)
+
+	return err
+}
+
+func (c *Client) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case stateOpen:
+		if c.now().Sub(c.openedAt) < c.cfg.OpenTimeout {
+			return false
+		}
+
+		c.state = stateHalfOpen
+
+		return true
+	case stateHalfOpen:
+		// only one trial call at a time
+		return false
+	default:
+		return true
+	}
+}
+
+func (c *Client) report(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil || errors.Is(err, es.ErrNotFound) || errors.Is(err, context.Canceled) {
+		c.state = stateClosed
+		c.failures = 0
+
+		return
+	}
+
+	c.failures++
+
+	if c.state == stateHalfOpen || c.failures >= c.cfg.FailureThreshold {
+		c.state = stateOpen
+		c.openedAt = c.now()
+	}
+}
@@ -0,0 +1,159 @@
+package breaker_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/krivenkov/order/internal/storage/es/breaker"
+	"github.com/krivenkov/pkg/clients/es"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient struct {
+	es.Client
+
+	calls int
+	err   error
+}
+
+func (f *fakeClient) GetCount(_ context.Context, _ *es.GetCountRequest) (int, error) {
+	f.calls++
+
+	if f.err != nil {
+		return 0, f.err
+	}
+
+	return 1, nil
+}
+
+// searchClient searches through an elastic client whose server always fails
+type searchClient struct {
+	es.Client
+
+	elasticCli *elastic.Client
+	calls      int
+}
+
+func (s *searchClient) Search(indices ...string) *elastic.SearchService {
+	s.calls++
+
+	return s.elasticCli.Search(indices...)
+}
+
+func TestClient(t *testing.T) {
+	t.Run("Opens after threshold", func(t *testing.T) {
+		var (
+			ts  = now()
+			cli = &fakeClient{err: fmt.Errorf("connection refused")}
+			brk = breaker.New(cli, breaker.Config{FailureThreshold: 2, OpenTimeout: time.Minute}, func() time.Time { return ts })
+		)
+
+		for i := 0; i < 2; i++ {
+			_, err := brk.GetCount(context.TODO(), &es.GetCountRequest{})
+			require.ErrorIs(t, err, cli.err)
+		}
+
+		_, err := brk.GetCount(context.TODO(), &es.GetCountRequest{})
+		require.ErrorIs(t, err, breaker.ErrOpen)
+		require.Equal(t, 2, cli.calls)
+	})
+
+	t.Run("Closes after successful trial", func(t *testing.T) {
+		var (
+			ts  = now()
+			cli = &fakeClient{err: fmt.Errorf("connection refused")}
+			brk = breaker.New(cli, breaker.Config{FailureThreshold: 1, OpenTimeout: time.Minute}, func() time.Time { return ts })
+		)
+
+		_, err := brk.GetCount(context.TODO(), &es.GetCountRequest{})
+		require.ErrorIs(t, err, cli.err)
+
+		_, err = brk.GetCount(context.TODO(), &es.GetCountRequest{})
+		require.ErrorIs(t, err, breaker.ErrOpen)
+
+		ts = ts.Add(time.Minute)
+		cli.err = nil
+
+		res, err := brk.GetCount(context.TODO(), &es.GetCountRequest{})
+		require.NoError(t, err)
+		require.Equal(t, 1, res)
+
+		_, err = brk.GetCount(context.TODO(), &es.GetCountRequest{})
+		require.NoError(t, err)
+		require.Equal(t, 3, cli.calls)
+	})
+
+	t.Run("Reopens after failed trial", func(t *testing.T) {
+		var (
+			ts  = now()
+			cli = &fakeClient{err: fmt.Errorf("connection refused")}
+			brk = breaker.New(cli, breaker.Config{FailureThreshold: 1, OpenTimeout: time.Minute}, func() time.Time { return ts })
+		)
+
+		_, err := brk.GetCount(context.TODO(), &es.GetCountRequest{})
+		require.ErrorIs(t, err, cli.err)
+
+		ts = ts.Add(time.Minute)
+
+		_, err = brk.GetCount(context.TODO(), &es.GetCountRequest{})
+		require.ErrorIs(t, err, cli.err)
+
+		_, err = brk.GetCount(context.TODO(), &es.GetCountRequest{})
+		require.ErrorIs(t, err, breaker.ErrOpen)
+		require.Equal(t, 2, cli.calls)
+	})
+
+	t.Run("Not found is not a failure", func(t *testing.T) {
+		var (
+			cli = &fakeClient{err: es.ErrNotFound}
+			brk = breaker.New(cli, breaker.Config{FailureThreshold: 1, OpenTimeout: time.Minute}, now)
+		)
+
+		for i := 0; i < 3; i++ {
+			_, err := brk.GetCount(context.TODO(), &es.GetCountRequest{})
+			require.ErrorIs(t, err, es.ErrNotFound)
+		}
+
+		require.Equal(t, 3, cli.calls)
+	})
+
+	t.Run("Search is guarded", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(srv.Close)
+
+		elasticCli, err := elastic.NewSimpleClient(elastic.SetURL(srv.URL))
+		require.NoError(t, err)
+
+		var (
+			cli = &searchClient{elasticCli: elasticCli}
+			brk = breaker.New(cli, breaker.Config{FailureThreshold: 1, OpenTimeout: time.Minute}, now)
+		)
+
+		_, err = brk.Search(context.TODO(), "order", elastic.NewSearchSource())
+		require.Error(t, err)
+		require.NotErrorIs(t, err, breaker.ErrOpen)
+
+		_, err = brk.Search(context.TODO(), "order", elastic.NewSearchSource())
+		require.ErrorIs(t, err, breaker.ErrOpen)
+		require.Equal(t, 1, cli.calls)
+	})
+
+	t.Run("Search unsupported", func(t *testing.T) {
+		brk := breaker.New(&fakeClient{}, breaker.Config{FailureThreshold: 1, OpenTimeout: time.Minute}, now)
+
+		res, err := brk.Search(context.TODO(), "order", elastic.NewSearchSource())
+		require.ErrorIs(t, err, breaker.ErrUnsupported)
+		require.Nil(t, res)
+	})
+}
+
+func now() time.Time {
+	return time.Date(2000, time.January, 1, 15, 24, 11, 0, time.UTC)
+}
@@ -0,0 +1,10 @@
+package breaker
+
+import "time"
+
+type Config struct {
+	// FailureThreshold is the number of consecutive failures that opens the breaker
+	FailureThreshold int `json:"failure_threshold" yaml:"failure_threshold" env:"FAILURE_THRESHOLD" default:"5"`
+	// OpenTimeout is how long the breaker stays open before a trial request is let through
+	OpenTimeout time.Duration `json:"open_timeout" yaml:"open_timeout" env:"OPEN_TIMEOUT" default:"30s"`
+}
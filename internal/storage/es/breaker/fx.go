@@ -0,0 +1,14 @@
+package breaker
+
+import (
+	"time"
+
+	"github.com/krivenkov/pkg/clients/es"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Decorate(func(cli es.Client, cfg Config, now func() time.Time) es.Client {
+		return New(cli, cfg, now)
+	}),
+)
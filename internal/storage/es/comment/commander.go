@@ -0,0 +1,81 @@
+package comment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/pkg/clients/es"
+	"github.com/olivere/elastic/v7"
+)
+
+type commander struct {
+	esCli es.Client
+}
+
+func NewCommander(esCli es.Client) comment.Commander {
+	return &commander{
+		esCli: esCli,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, item *comment.Comment) error {
+	return c.save(ctx, item)
+}
+
+func (c *commander) Update(ctx context.Context, item *comment.Comment) error {
+	return c.save(ctx, item)
+}
+
+func (c *commander) Delete(ctx context.Context, id string) error {
+	if err := c.esCli.DeleteByID(ctx, indexName, id); err != nil {
+		if errors.Is(err, es.ErrNotFound) {
+			return model.ErrNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (c *commander) DeleteByOrders(ctx context.Context, orderIDs ...string) error {
+	if len(orderIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]interface{}, 0, len(orderIDs))
+	for _, id := range orderIDs {
+		ids = append(ids, id)
+	}
+
+	// es.Client has no delete by query, an update by query deletes what its script says to
+	return c.esCli.UpdateByScript(ctx, &es.UpdateByScriptRequest{
+		Index:   indexName,
+		Refresh: es.RefreshTypeWaitFor,
+		Query:   elastic.NewTermsQuery("order_id", ids...),
+		Script:  elastic.NewScriptInline("ctx.op = 'delete'").Lang("painless"),
+	})
+}
+
+func (c *commander) ReplaceAuthor(ctx context.Context, authorID, by string) error {
+	return c.esCli.UpdateByScript(ctx, &es.UpdateByScriptRequest{
+		Index:   indexName,
+		Refresh: es.RefreshTypeWaitFor,
+		Query:   elastic.NewTermQuery("author_id", authorID),
+		Script:  elastic.NewScriptInline("ctx._source.author_id = params.author_id").Lang("painless").Param("author_id", by),
+	})
+}
+
+func (c *commander) save(ctx context.Context, item *comment.Comment) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.esCli.Save(ctx, &es.SaveRequest{
+		Index:   indexName,
+		ID:      item.ID,
+		Obj:     d,
+		Refresh: es.RefreshTypeWaitFor,
+	})
+}
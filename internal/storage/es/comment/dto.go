@@ -0,0 +1,39 @@
+package comment
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/comment"
+)
+
+const indexName = "order_comment"
+
+type dto struct {
+	ID       string     `json:"id"`
+	TSCreate time.Time  `json:"ts_create"`
+	TSEdit   *time.Time `json:"ts_edit,omitempty"`
+	OrderID  string     `json:"order_id"`
+	AuthorID string     `json:"author_id"`
+	Body     string     `json:"body"`
+	Internal bool       `json:"internal"`
+	UserID   string     `json:"user_id"`
+	TenantID string     `json:"tenant_id,omitempty"`
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) fromModel(source *comment.Comment) {
+	*d = dto{
+		ID:       source.ID,
+		TSCreate: source.TSCreate,
+		TSEdit:   source.TSEdit,
+		OrderID:  source.OrderID,
+		AuthorID: source.AuthorID,
+		Body:     source.Body,
+		Internal: source.Internal,
+		UserID:   source.OrderUserID,
+		TenantID: source.OrderTenantID,
+	}
+}
@@ -0,0 +1,12 @@
+package comment
+
+import (
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"comment_es_cmd"`)),
+		fx.Annotate(NewSearcher, fx.ResultTags(`name:"comment_es_searcher"`)),
+	),
+)
@@ -0,0 +1,94 @@
+package comment
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/pkg/clients/es"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/olivere/elastic/v7"
+)
+
+type searcher struct {
+	esCli es.Client
+}
+
+func NewSearcher(esCli es.Client) comment.Searcher {
+	return &searcher{
+		esCli: esCli,
+	}
+}
+
+func (s *searcher) SearchOrders(ctx context.Context, filter *comment.SearchFilter, limit int) ([]string, error) {
+	query := elastic.NewBoolQuery().
+		Must(elastic.NewMatchQuery("body", filter.Q).
+			Operator("and").
+			MaxExpansions(50).
+			PrefixLength(5).
+			Fuzziness("AUTO")).
+		MustNot(elastic.NewTermQuery("internal", true))
+
+	if filter.UserID.IsSet() {
+		query.Filter(s.prepareUser(filter.UserID.Value(), filter.SharedIDs))
+	}
+
+	if filter.TenantID.IsSet() {
+		if filter.TenantID.Value() == "" {
+			query.MustNot(elastic.NewExistsQuery("tenant_id"))
+		} else {
+			query.Filter(elastic.NewTermQuery("tenant_id", filter.TenantID.Value()))
+		}
+	}
+
+	res, err := s.esCli.GetSearch(ctx, &es.GetSearchRequest{
+		Index:         indexName,
+		Query:         query,
+		IncludeFields: option.New([]string{"order_id"}),
+		Pagination:    option.New(paginator.Pagination{Limit: limit}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ids  = make([]string, 0, len(res.Result))
+		seen = make(map[string]struct{}, len(res.Result))
+	)
+
+	for _, val := range res.Result {
+		d := dto{}
+
+		if err = json.Unmarshal(val.Source, &d); err != nil {
+			return nil, err
+		}
+
+		// an order with several matching comments is listed once, at its best match
+		if _, ok := seen[d.OrderID]; ok {
+			continue
+		}
+
+		seen[d.OrderID] = struct{}{}
+		ids = append(ids, d.OrderID)
+	}
+
+	return ids, nil
+}
+
+func (s *searcher) prepareUser(userID string, sharedIDs option.Option[[]string]) elastic.Query {
+	userQuery := elastic.NewTermQuery("user_id", userID)
+
+	if !sharedIDs.IsSet() {
+		return userQuery
+	}
+
+	ids := make([]interface{}, 0, len(sharedIDs.Value()))
+	for _, id := range sharedIDs.Value() {
+		ids = append(ids, id)
+	}
+
+	return elastic.NewBoolQuery().
+		Should(userQuery, elastic.NewTermsQuery("order_id", ids...)).
+		MinimumNumberShouldMatch(1)
+}
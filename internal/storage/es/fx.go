@@ -1,10 +1,14 @@
 package es
 
 import (
+	"github.com/krivenkov/order/internal/storage/es/breaker"
+	"github.com/krivenkov/order/internal/storage/es/comment"
 	"github.com/krivenkov/order/internal/storage/es/order"
 	"go.uber.org/fx"
 )
 
 var FXModule = fx.Options(
+	breaker.FXModule,
 	order.FXModule,
+	comment.FXModule,
 )
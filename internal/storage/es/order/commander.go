@@ -2,7 +2,9 @@ package order
 
 import (
 	"context"
+	"errors"
 
+	"github.com/krivenkov/order/internal/model"
 	orderModel "github.com/krivenkov/order/internal/model/order"
 	"github.com/krivenkov/pkg/clients/es"
 	"github.com/olivere/elastic/v7"
@@ -43,14 +45,54 @@ func (c *commander) Update(ctx context.Context, item *orderModel.Order) error {
 }
 
 func (c *commander) Delete(ctx context.Context, item *orderModel.Order) error {
-	return c.esCli.DeleteByID(ctx, indexName, item.ID)
+	if err := c.esCli.DeleteByID(ctx, indexName, item.ID); err != nil {
+		if errors.Is(err, es.ErrNotFound) {
+			return model.ErrNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Disable returns no transitions, postgres is the source of truth for them
+func (c *commander) Disable(ctx context.Context, userID string) ([]*orderModel.Transition, error) {
+	query := elastic.NewBoolQuery().
+		Must(elastic.NewTermQuery("user_id", userID)).
+		MustNot(
+			elastic.NewExistsQuery("tenant_id"),
+			elastic.NewTermsQuery("status", int(orderModel.StatusDeleted), int(orderModel.StatusDisabled)),
+		)
+
+	script := elastic.NewScriptInline("ctx._source.status_before_disable = ctx._source.status; ctx._source.status = params.status").
+		Lang("painless").
+		Param("status", int(orderModel.StatusDisabled))
+
+	return nil, c.esCli.UpdateByScript(ctx, &es.UpdateByScriptRequest{
+		Index:   indexName,
+		Refresh: es.RefreshTypeWaitFor,
+		Query:   query,
+		Script:  script,
+	})
 }
 
-func (c *commander) Disable(ctx context.Context, userID string) error {
-	return c.esCli.UpdateByScript(ctx, &es.UpdateByScriptRequest{
+func (c *commander) Enable(ctx context.Context, userID string) ([]*orderModel.Transition, error) {
+	query := elastic.NewBoolQuery().
+		Must(
+			elastic.NewTermQuery("user_id", userID),
+			elastic.NewTermQuery("status", int(orderModel.StatusDisabled)),
+			elastic.NewExistsQuery("status_before_disable"),
+		).
+		MustNot(elastic.NewExistsQuery("tenant_id"))
+
+	script := elastic.NewScriptInline("ctx._source.status = ctx._source.status_before_disable; ctx._source.remove('status_before_disable')").
+		Lang("painless")
+
+	return nil, c.esCli.UpdateByScript(ctx, &es.UpdateByScriptRequest{
 		Index:   indexName,
 		Refresh: es.RefreshTypeWaitFor,
-		Query:   elastic.NewTermQuery("user", userID),
-		Script:  elastic.NewScriptInline("ctx._source.status = 2").Lang("painless"),
+		Query:   query,
+		Script:  script,
 	})
 }
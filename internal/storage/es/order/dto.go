@@ -1,7 +1,11 @@
 package order
 
 import (
+	"time"
+
 	"github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/model/tax"
 )
 
 const (
@@ -9,14 +13,79 @@ const (
 
 	nameSortKey = "name.keyword"
 	idSortKey   = "id"
+
+	statusFacetsAgg = "statuses"
+	datesFacetsAgg  = "dates"
+	statusFacetSize = 20
+	tagsFacetsAgg   = "tags"
+
+	shippingCityField       = "shipping_address.city"
+	billingCityField        = "billing_address.city"
+	shippingPostalCodeField = "shipping_address.postal_code"
+	billingPostalCodeField  = "billing_address.postal_code"
+	metadataField           = "metadata"
 )
 
+var includeFields = []string{"id", "status", "state", "number", "tenant_id", "name", "description", "shipping_address", "billing_address", "totals", "discounts", "taxes", "tags", "metadata"}
+
 type dto struct {
-	ID          string `json:"id"`
-	UserID      string `json:"user_id"`
-	Status      int64  `json:"status"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID          string    `json:"id"`
+	TSCreate    time.Time `json:"ts_create"`
+	UserID      string    `json:"user_id"`
+	TenantID    string    `json:"tenant_id,omitempty"`
+	Status      int64     `json:"status"`
+	State       int64     `json:"state"`
+	Number      string    `json:"number"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Tags        []string  `json:"tags,omitempty"`
+
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	ShippingAddress *addressDto `json:"shipping_address,omitempty"`
+	BillingAddress  *addressDto `json:"billing_address,omitempty"`
+
+	// Totals, Discounts and Taxes are stored for the search results only, they are not indexed
+	Totals    totalsDto      `json:"totals"`
+	Discounts []*discountDto `json:"discounts,omitempty"`
+	Taxes     []*taxDto      `json:"taxes,omitempty"`
+}
+
+type totalsDto struct {
+	Currency     string `json:"currency,omitempty"`
+	Subtotal     int64  `json:"subtotal"`
+	Discount     int64  `json:"discount"`
+	Tax          int64  `json:"tax"`
+	TaxInclusive bool   `json:"tax_inclusive"`
+	Total        int64  `json:"total"`
+	Paid         int64  `json:"paid"`
+	Refunded     int64  `json:"refunded"`
+}
+
+type discountDto struct {
+	PromoID string `json:"promo_id"`
+	Code    string `json:"code"`
+	Amount  int64  `json:"amount"`
+}
+
+type taxDto struct {
+	Country  string `json:"country"`
+	Region   string `json:"region,omitempty"`
+	Category string `json:"category,omitempty"`
+	Rate     int64  `json:"rate"`
+	Taxable  int64  `json:"taxable"`
+	Amount   int64  `json:"amount"`
+}
+
+type addressDto struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone"`
 }
 
 func newDto() *dto {
@@ -29,21 +98,130 @@ func (d *dto) toModel() *order.Order {
 	}
 
 	return &order.Order{
-		ID:          d.ID,
-		Status:      order.Status(d.Status),
-		Name:        d.Name,
-		Description: d.Description,
+		ID:              d.ID,
+		Status:          order.Status(d.Status),
+		State:           order.State(d.State),
+		Number:          d.Number,
+		TenantID:        d.TenantID,
+		Name:            d.Name,
+		Description:     d.Description,
+		Tags:            d.Tags,
+		Metadata:        d.Metadata,
+		ShippingAddress: d.ShippingAddress.toModel(),
+		BillingAddress:  d.BillingAddress.toModel(),
+		Totals:          order.Totals(d.Totals),
+		Discounts:       discountsToModel(d.Discounts),
+		Taxes:           taxesToModel(d.Taxes),
 	}
 }
 
 func (d *dto) fromModel(source *order.Order) {
 	target := dto{
 		ID:          source.ID,
+		TSCreate:    source.TSCreate,
 		Status:      int64(source.Status),
+		State:       int64(source.State),
+		Number:      source.Number,
 		UserID:      source.UserID,
+		TenantID:    source.TenantID,
 		Name:        source.Name,
 		Description: source.Description,
+		Tags:        source.Tags,
+		Metadata:    source.Metadata,
+
+		ShippingAddress: newAddressDto(source.ShippingAddress),
+		BillingAddress:  newAddressDto(source.BillingAddress),
+
+		Totals:    totalsDto(source.Totals),
+		Discounts: newDiscountDtos(source.Discounts),
+		Taxes:     newTaxDtos(source.Taxes),
 	}
 
 	*d = target
 }
+
+func newDiscountDtos(source []*promo.Discount) []*discountDto {
+	if len(source) == 0 {
+		return nil
+	}
+
+	target := make([]*discountDto, 0, len(source))
+	for _, s := range source {
+		target = append(target, (*discountDto)(s))
+	}
+
+	return target
+}
+
+func discountsToModel(source []*discountDto) []*promo.Discount {
+	if len(source) == 0 {
+		return nil
+	}
+
+	target := make([]*promo.Discount, 0, len(source))
+	for _, s := range source {
+		target = append(target, (*promo.Discount)(s))
+	}
+
+	return target
+}
+
+func newTaxDtos(source []*tax.Line) []*taxDto {
+	if len(source) == 0 {
+		return nil
+	}
+
+	target := make([]*taxDto, 0, len(source))
+	for _, s := range source {
+		target = append(target, (*taxDto)(s))
+	}
+
+	return target
+}
+
+func taxesToModel(source []*taxDto) []*tax.Line {
+	if len(source) == 0 {
+		return nil
+	}
+
+	target := make([]*tax.Line, 0, len(source))
+	for _, s := range source {
+		target = append(target, (*tax.Line)(s))
+	}
+
+	return target
+}
+
+func newAddressDto(source *order.Address) *addressDto {
+	if source == nil {
+		return nil
+	}
+
+	return &addressDto{
+		Name:       source.Name,
+		Line1:      source.Line1,
+		Line2:      source.Line2,
+		City:       source.City,
+		Region:     source.Region,
+		PostalCode: source.PostalCode,
+		Country:    source.Country,
+		Phone:      source.Phone,
+	}
+}
+
+func (d *addressDto) toModel() *order.Address {
+	if d == nil {
+		return nil
+	}
+
+	return &order.Address{
+		Name:       d.Name,
+		Line1:      d.Line1,
+		Line2:      d.Line2,
+		City:       d.City,
+		Region:     d.Region,
+		PostalCode: d.PostalCode,
+		Country:    d.Country,
+		Phone:      d.Phone,
+	}
+}
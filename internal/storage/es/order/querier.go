@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/krivenkov/order/internal/model"
 	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tenant"
 	"github.com/krivenkov/pkg/clients/es"
 	"github.com/krivenkov/pkg/option"
 	"github.com/krivenkov/pkg/order"
@@ -14,6 +16,12 @@ import (
 	"github.com/olivere/elastic/v7"
 )
 
+// aggregator is satisfied by the breaker guarding the es client:
+// es.Client has no aggregation support of its own.
+type aggregator interface {
+	Search(ctx context.Context, index string, source *elastic.SearchSource) (*elastic.SearchResult, error)
+}
+
 type querier struct {
 	esCli es.Client
 }
@@ -25,12 +33,12 @@ func NewQuerier(esCli es.Client) orderModel.Querier {
 }
 
 func (q *querier) GetItem(ctx context.Context, filter *orderModel.Filter) (*orderModel.Order, error) {
-	boolQuery := q.prepareQuery(filter)
+	boolQuery := q.prepareQuery(ctx, filter)
 
 	res, err := q.esCli.GetSearch(ctx, &es.GetSearchRequest{
 		Index:         indexName,
 		Query:         boolQuery,
-		IncludeFields: option.New([]string{"id", "status", "name", "description"}),
+		IncludeFields: option.New(includeFields),
 	})
 	if err != nil {
 		return nil, err
@@ -54,7 +62,7 @@ func (q *querier) GetItem(ctx context.Context, filter *orderModel.Filter) (*orde
 }
 
 func (q *querier) Count(ctx context.Context, filter *orderModel.Filter) (int, error) {
-	boolQuery := q.prepareQuery(filter)
+	boolQuery := q.prepareQuery(ctx, filter)
 
 	res, err := q.esCli.GetCount(ctx, &es.GetCountRequest{
 		Index: indexName,
@@ -70,7 +78,7 @@ func (q *querier) Count(ctx context.Context, filter *orderModel.Filter) (int, er
 func (q *querier) GetList(ctx context.Context, filter *orderModel.Filter, orders []*order.Order, pagination *paginator.Pagination) ([]*orderModel.Order, error) {
 	objects := make([]*orderModel.Order, 0)
 
-	boolQuery := q.prepareQuery(filter)
+	boolQuery := q.prepareQuery(ctx, filter)
 
 	orderRes := option.Nil[[]*order.Order]()
 	if len(orders) > 0 {
@@ -90,7 +98,7 @@ func (q *querier) GetList(ctx context.Context, filter *orderModel.Filter, orders
 	res, err := q.esCli.GetSearch(ctx, &es.GetSearchRequest{
 		Index:         indexName,
 		Query:         boolQuery,
-		IncludeFields: option.New([]string{"id", "status", "name", "description"}),
+		IncludeFields: option.New(includeFields),
 		Orders:        orderRes,
 		Pagination:    paginationRes,
 	})
@@ -111,9 +119,79 @@ func (q *querier) GetList(ctx context.Context, filter *orderModel.Filter, orders
 	return objects, nil
 }
 
-func (q *querier) prepareQuery(filter *orderModel.Filter) *elastic.BoolQuery {
+func (q *querier) Facets(ctx context.Context, filter *orderModel.Filter, interval orderModel.DateInterval) (*orderModel.Facets, error) {
+	agg, ok := q.esCli.(aggregator)
+	if !ok {
+		return nil, fmt.Errorf("es client does not support aggregations")
+	}
+
+	boolQuery := q.prepareQuery(ctx, filter)
+
+	searchResult, err := agg.Search(ctx, indexName, elastic.NewSearchSource().
+		Query(boolQuery).
+		Size(0).
+		Aggregation(statusFacetsAgg, elastic.NewTermsAggregation().Field("status").Size(statusFacetSize)).
+		Aggregation(datesFacetsAgg, elastic.NewDateHistogramAggregation().Field("ts_create").CalendarInterval(string(interval))).
+		Aggregation(tagsFacetsAgg, elastic.NewTermsAggregation().Field("tags").Size(orderModel.FacetTags).
+			OrderByCountDesc().OrderByKeyAsc()))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &orderModel.Facets{
+		Statuses: make([]*orderModel.StatusFacet, 0),
+		Dates:    make([]*orderModel.DateFacet, 0),
+		Tags:     make([]*orderModel.TagFacet, 0),
+	}
+
+	if statuses, found := searchResult.Aggregations.Terms(statusFacetsAgg); found {
+		for _, bucket := range statuses.Buckets {
+			status, okKey := bucket.Key.(float64)
+			if !okKey {
+				return nil, fmt.Errorf("invalid status bucket key = %v", bucket.Key)
+			}
+
+			res.Statuses = append(res.Statuses, &orderModel.StatusFacet{
+				Status: orderModel.Status(status),
+				Count:  int(bucket.DocCount),
+			})
+		}
+	}
+
+	if dates, found := searchResult.Aggregations.DateHistogram(datesFacetsAgg); found {
+		for _, bucket := range dates.Buckets {
+			res.Dates = append(res.Dates, &orderModel.DateFacet{
+				Date:  time.UnixMilli(int64(bucket.Key)).UTC(),
+				Count: int(bucket.DocCount),
+			})
+		}
+	}
+
+	if tags, found := searchResult.Aggregations.Terms(tagsFacetsAgg); found {
+		for _, bucket := range tags.Buckets {
+			tag, okKey := bucket.Key.(string)
+			if !okKey {
+				return nil, fmt.Errorf("invalid tag bucket key = %v", bucket.Key)
+			}
+
+			res.Tags = append(res.Tags, &orderModel.TagFacet{
+				Tag:   tag,
+				Count: int(bucket.DocCount),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// prepareQuery keeps a request made in a tenant to the orders of that tenant whatever the filter asks
+func (q *querier) prepareQuery(ctx context.Context, filter *orderModel.Filter) *elastic.BoolQuery {
 	boolQuery := elastic.NewBoolQuery()
 
+	if scope, ok := tenant.ScopeFromContext(ctx); ok {
+		q.prepareTenant(boolQuery, scope.TenantID)
+	}
+
 	if filter == nil {
 		return boolQuery
 	}
@@ -135,7 +213,33 @@ func (q *querier) prepareQuery(filter *orderModel.Filter) *elastic.BoolQuery {
 	}
 
 	if filter.UserID.IsSet() {
-		subQueries = append(subQueries, elastic.NewTermQuery("user_id", filter.UserID.Value()))
+		subQueries = append(subQueries, q.prepareUser(filter.UserID.Value(), filter.SharedIDs))
+	}
+
+	if filter.TenantID.IsSet() {
+		q.prepareTenant(boolQuery, filter.TenantID.Value())
+	}
+
+	if filter.Number.IsSet() {
+		subQueries = append(subQueries, elastic.NewTermQuery("number", filter.Number.Value()))
+		q.prepareTenant(boolQuery, orderModel.NumberTenant(ctx, filter))
+	}
+
+	for _, tag := range filter.Tags.Value() {
+		subQueries = append(subQueries, elastic.NewTermQuery("tags", tag))
+	}
+
+	if len(filter.TagsAny.Value()) > 0 {
+		tags := make([]interface{}, 0, len(filter.TagsAny.Value()))
+		for _, tag := range filter.TagsAny.Value() {
+			tags = append(tags, tag)
+		}
+
+		subQueries = append(subQueries, elastic.NewTermsQuery("tags", tags...))
+	}
+
+	for key, value := range filter.Metadata.Value() {
+		subQueries = append(subQueries, elastic.NewTermQuery(metadataField+"."+key, value))
 	}
 
 	if filter.Q.IsSet() {
@@ -156,14 +260,53 @@ func (q *querier) prepareQuery(filter *orderModel.Filter) *elastic.BoolQuery {
 			PrefixLength(5).
 			Fuzziness("AUTO")
 
+		matchCityQuery := elastic.NewMultiMatchQuery(value, shippingCityField, billingCityField).
+			Boost(2)
+
+		matchPostalCodeQuery := elastic.NewMultiMatchQuery(value, shippingPostalCodeField, billingPostalCodeField).
+			Boost(5)
+
+		// the root of a flattened field matches any of its values exactly
+		metadataQuery := elastic.NewTermQuery(metadataField, value).
+			Boost(20)
+
 		searchQuery := elastic.NewBoolQuery().Should(
 			prefixNameQuery,
 			matchNameQuery,
-			matchDescriptionQuery)
+			matchDescriptionQuery,
+			matchCityQuery,
+			matchPostalCodeQuery,
+			metadataQuery)
+
+		if len(filter.CommentedIDs.Value()) > 0 {
+			ids := make([]interface{}, 0, len(filter.CommentedIDs.Value()))
+			for _, id := range filter.CommentedIDs.Value() {
+				ids = append(ids, id)
+			}
+
+			searchQuery.Should(elastic.NewTermsQuery("id", ids...))
+		}
+
+		if number, ok := orderModel.ParseNumber(value); ok {
+			numberQuery := elastic.NewBoolQuery().Must(elastic.NewTermQuery("number", number).Boost(100))
+			q.prepareTenant(numberQuery, orderModel.NumberTenant(ctx, filter))
+
+			searchQuery.Should(numberQuery)
+		}
 
 		subQueries = append(subQueries, searchQuery)
 	}
 
+	if filter.Draft.IsSet() {
+		draftQuery := elastic.NewTermQuery("state", int(orderModel.StateDraft))
+
+		if filter.Draft.Value() {
+			subQueries = append(subQueries, draftQuery)
+		} else {
+			boolQuery.MustNot(draftQuery)
+		}
+	}
+
 	if len(subQueries) > 0 {
 		boolQuery.Must(subQueries...)
 	}
@@ -171,6 +314,35 @@ func (q *querier) prepareQuery(filter *orderModel.Filter) *elastic.BoolQuery {
 	return boolQuery
 }
 
+// prepareUser matches the orders of the user and the ones shared with it
+func (q *querier) prepareUser(userID string, sharedIDs option.Option[[]string]) elastic.Query {
+	userQuery := elastic.NewTermQuery("user_id", userID)
+
+	if !sharedIDs.IsSet() {
+		return userQuery
+	}
+
+	ids := make([]interface{}, 0, len(sharedIDs.Value()))
+	for _, id := range sharedIDs.Value() {
+		ids = append(ids, id)
+	}
+
+	return elastic.NewBoolQuery().
+		Should(userQuery, elastic.NewTermsQuery("id", ids...)).
+		MinimumNumberShouldMatch(1)
+}
+
+// prepareTenant matches the personal orders for an empty tenant
+func (q *querier) prepareTenant(boolQuery *elastic.BoolQuery, tenantID string) {
+	if tenantID == "" {
+		boolQuery.MustNot(elastic.NewExistsQuery("tenant_id"))
+
+		return
+	}
+
+	boolQuery.Filter(elastic.NewTermQuery("tenant_id", tenantID))
+}
+
 func (q *querier) prepareOrder(orders []*order.Order) ([]*order.Order, error) {
 	esOrders := make([]*order.Order, 0, len(orders))
 
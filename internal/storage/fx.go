@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"github.com/krivenkov/order/internal/storage/blob"
 	"github.com/krivenkov/order/internal/storage/es"
 	"github.com/krivenkov/order/internal/storage/pg"
 	"go.uber.org/fx"
@@ -9,4 +10,5 @@ import (
 var FXModule = fx.Options(
 	pg.FXModule,
 	es.FXModule,
+	blob.FXModule,
 )
@@ -0,0 +1,89 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/approval"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) approval.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, item *approval.Approval) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Insert(tableName).
+		Columns(d.columns()...).
+		Values(d.id, d.tsCreate, d.tsModify, d.orderID, d.tenantID, d.requestedBy, d.approvers, d.status, d.level, d.tsDue,
+			d.comment, d.decidedBy),
+		model.ErrConflict)
+}
+
+func (c *commander) Decide(ctx context.Context, item *approval.Approval) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Update(tableName).
+		Set("ts_modify", d.tsModify).
+		Set("status", d.status).
+		Set("comment", d.comment).
+		Set("decided_by", d.decidedBy).
+		Where(squirrel.Eq{"id": d.id, "status": int64(approval.StatusPending)}),
+		model.ErrConflict)
+}
+
+// Escalate compares the due time with the one the job read, so of two instances
+// which read the same request only one escalates it
+func (c *commander) Escalate(ctx context.Context, item *approval.Approval, before time.Time) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Update(tableName).
+		Set("ts_modify", d.tsModify).
+		Set("approvers", d.approvers).
+		Set("level", d.level).
+		Set("ts_due", d.tsDue).
+		Where(squirrel.Eq{"id": d.id, "status": int64(approval.StatusPending)}).
+		Where(squirrel.LtOrEq{"ts_due": before}),
+		model.ErrConflict)
+}
+
+// exec runs the query, when errNoRows is set the query must affect a row
+func (c *commander) exec(ctx context.Context, query squirrel.Sqlizer, errNoRows error) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		tag, errExec := tx.Exec(ctx, sql, args...)
+		if errExec != nil {
+			return fmt.Errorf("exec: %w", errExec)
+		}
+
+		if errNoRows != nil && tag.RowsAffected() == 0 {
+			return errNoRows
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
@@ -0,0 +1,85 @@
+package approval
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/approval"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.approval.dto: len(columns) != len(values)")
+	}
+}
+
+const tableName = `"order".approvals`
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+	tsModify time.Time
+
+	orderID     string
+	tenantID    string
+	requestedBy string
+	approvers   []string
+	status      int64
+	level       int
+	tsDue       time.Time
+	comment     string
+	decidedBy   string
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "ts_modify", "order_id", "tenant_id", "requested_by", "approvers", "status", "level", "ts_due",
+		"comment", "decided_by"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.tsModify, &d.orderID, &d.tenantID, &d.requestedBy, &d.approvers, &d.status, &d.level, &d.tsDue,
+		&d.comment, &d.decidedBy}
+}
+
+func (d *dto) toModel() *approval.Approval {
+	return &approval.Approval{
+		ID:          d.id,
+		TSCreate:    d.tsCreate,
+		TSModify:    d.tsModify,
+		OrderID:     d.orderID,
+		TenantID:    d.tenantID,
+		RequestedBy: d.requestedBy,
+		Approvers:   d.approvers,
+		Status:      approval.Status(d.status),
+		Level:       d.level,
+		TSDue:       d.tsDue,
+		Comment:     d.comment,
+		DecidedBy:   d.decidedBy,
+	}
+}
+
+func (d *dto) fromModel(source *approval.Approval) {
+	approvers := source.Approvers
+	if approvers == nil {
+		approvers = []string{}
+	}
+
+	*d = dto{
+		id:          source.ID,
+		tsCreate:    source.TSCreate,
+		tsModify:    source.TSModify,
+		orderID:     source.OrderID,
+		tenantID:    source.TenantID,
+		requestedBy: source.RequestedBy,
+		approvers:   approvers,
+		status:      int64(source.Status),
+		level:       source.Level,
+		tsDue:       source.TSDue,
+		comment:     source.Comment,
+		decidedBy:   source.DecidedBy,
+	}
+}
@@ -0,0 +1,10 @@
+package approval
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"approval_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"approval_pg_qr"`)),
+	),
+)
@@ -0,0 +1,109 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/approval"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) approval.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetItem(ctx context.Context, filter *approval.Filter) (*approval.Approval, error) {
+	sql, args, err := pgBuilder.Select(newDto().columns()...).
+		From(tableName).
+		Where(prepareWhere(filter)).
+		OrderBy("ts_create desc", "id").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	d := newDto()
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if errScan := tx.QueryRow(ctx, sql, args...).Scan(d.values()...); errScan != nil {
+			if errors.Is(errScan, pgx.ErrNoRows) {
+				return model.ErrNotFound
+			}
+
+			return fmt.Errorf("scan: %w", errScan)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return d.toModel(), nil
+}
+
+func (q *querier) GetList(ctx context.Context, filter *approval.Filter) ([]*approval.Approval, error) {
+	sql, args, err := pgBuilder.Select(newDto().columns()...).
+		From(tableName).
+		Where(prepareWhere(filter)).
+		OrderBy("ts_due", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*approval.Approval
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func prepareWhere(filter *approval.Filter) squirrel.And {
+	where := squirrel.And{}
+
+	if filter != nil {
+		if filter.OrderID.IsSet() {
+			where = append(where, squirrel.Eq{"order_id": filter.OrderID.Value()})
+		}
+
+		if filter.Status.IsSet() {
+			where = append(where, squirrel.Eq{"status": int64(filter.Status.Value())})
+		}
+
+		if filter.DueBefore.IsSet() {
+			where = append(where, squirrel.LtOrEq{"ts_due": filter.DueBefore.Value()})
+		}
+	}
+
+	return where
+}
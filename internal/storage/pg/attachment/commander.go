@@ -0,0 +1,71 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) attachment.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, item *attachment.Attachment) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Insert(tableName).
+		Columns(d.columns()...).
+		Values(d.id, d.tsCreate, d.orderID, d.userID, d.fileName, d.contentType, d.size),
+		nil)
+}
+
+func (c *commander) Delete(ctx context.Context, id string) error {
+	return c.exec(ctx, pgBuilder.
+		Delete(tableName).
+		Where(squirrel.Eq{"id": id}),
+		model.ErrNotFound)
+}
+
+func (c *commander) ReplaceUser(ctx context.Context, userID, by string) error {
+	return c.exec(ctx, pgBuilder.
+		Update(tableName).
+		Set("user_id", by).
+		Where(squirrel.Eq{"user_id": userID}),
+		nil)
+}
+
+// exec runs the query, when errNoRows is set the query must affect a row
+func (c *commander) exec(ctx context.Context, query squirrel.Sqlizer, errNoRows error) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		tag, errExec := tx.Exec(ctx, sql, args...)
+		if errExec != nil {
+			return fmt.Errorf("exec: %w", errExec)
+		}
+
+		if errNoRows != nil && tag.RowsAffected() == 0 {
+			return errNoRows
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
@@ -0,0 +1,62 @@
+package attachment
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/attachment"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.attachment.dto: len(columns) != len(values)")
+	}
+}
+
+const tableName = `"order".attachments`
+
+type dto struct {
+	id          string
+	tsCreate    time.Time
+	orderID     string
+	userID      string
+	fileName    string
+	contentType string
+	size        int64
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "order_id", "user_id", "file_name", "content_type", "size"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.orderID, &d.userID, &d.fileName, &d.contentType, &d.size}
+}
+
+func (d *dto) toModel() *attachment.Attachment {
+	return &attachment.Attachment{
+		ID:          d.id,
+		TSCreate:    d.tsCreate,
+		OrderID:     d.orderID,
+		UserID:      d.userID,
+		FileName:    d.fileName,
+		ContentType: d.contentType,
+		Size:        d.size,
+	}
+}
+
+func (d *dto) fromModel(source *attachment.Attachment) {
+	*d = dto{
+		id:          source.ID,
+		tsCreate:    source.TSCreate,
+		orderID:     source.OrderID,
+		userID:      source.UserID,
+		fileName:    source.FileName,
+		contentType: source.ContentType,
+		size:        source.Size,
+	}
+}
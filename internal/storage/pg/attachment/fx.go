@@ -0,0 +1,10 @@
+package attachment
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"attachment_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"attachment_pg_qr"`)),
+	),
+)
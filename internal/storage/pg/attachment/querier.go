@@ -0,0 +1,73 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/attachment"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) attachment.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetList(ctx context.Context, filter *attachment.Filter) ([]*attachment.Attachment, error) {
+	sql, args, err := pgBuilder.Select(newDto().columns()...).
+		From(tableName).
+		Where(prepareWhere(filter)).
+		OrderBy("ts_create", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*attachment.Attachment
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func prepareWhere(filter *attachment.Filter) squirrel.And {
+	where := squirrel.And{}
+
+	if filter != nil {
+		if filter.IDs.IsSet() {
+			where = append(where, squirrel.Eq{"id": filter.IDs.Value()})
+		}
+
+		if filter.OrderIDs.IsSet() {
+			where = append(where, squirrel.Eq{"order_id": filter.OrderIDs.Value()})
+		}
+	}
+
+	return where
+}
@@ -0,0 +1,95 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) comment.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, item *comment.Comment) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Insert(tableName).
+		Columns(d.columns()...).
+		Values(d.id, d.tsCreate, d.tsEdit, d.orderID, d.authorID, d.body, d.internal),
+		nil)
+}
+
+// Update changes the body of the comment, the rest of it never changes
+func (c *commander) Update(ctx context.Context, item *comment.Comment) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Update(tableName).
+		Set("body", d.body).
+		Set("ts_edit", d.tsEdit).
+		Where(squirrel.Eq{"id": d.id}),
+		model.ErrNotFound)
+}
+
+func (c *commander) Delete(ctx context.Context, id string) error {
+	return c.exec(ctx, pgBuilder.
+		Delete(tableName).
+		Where(squirrel.Eq{"id": id}),
+		model.ErrNotFound)
+}
+
+func (c *commander) DeleteByOrders(ctx context.Context, orderIDs ...string) error {
+	if len(orderIDs) == 0 {
+		return nil
+	}
+
+	return c.exec(ctx, pgBuilder.
+		Delete(tableName).
+		Where(squirrel.Eq{"order_id": orderIDs}),
+		nil)
+}
+
+func (c *commander) ReplaceAuthor(ctx context.Context, authorID, by string) error {
+	return c.exec(ctx, pgBuilder.
+		Update(tableName).
+		Set("author_id", by).
+		Where(squirrel.Eq{"author_id": authorID}),
+		nil)
+}
+
+// exec runs the query, when errNoRows is set the query must affect a row
+func (c *commander) exec(ctx context.Context, query squirrel.Sqlizer, errNoRows error) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		tag, errExec := tx.Exec(ctx, sql, args...)
+		if errExec != nil {
+			return fmt.Errorf("exec: %w", errExec)
+		}
+
+		if errNoRows != nil && tag.RowsAffected() == 0 {
+			return errNoRows
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
@@ -0,0 +1,74 @@
+package comment_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/order/internal/model/erasure"
+	pgComment "github.com/krivenkov/order/internal/storage/pg/comment"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/option"
+	"github.com/stretchr/testify/require"
+)
+
+// dsnEnv points the test to a migrated database, e.g. the one of make migrate.local.up
+const dsnEnv = "ORDER_TEST_DB_DSN"
+
+// TestReplaceAuthor checks the comments of an erased user are kept under the erased user
+func TestReplaceAuthor(t *testing.T) {
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", dsnEnv)
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var (
+		tXer      = database.NewTXer(pool)
+		commander = pgComment.NewCommander(tXer)
+		querier   = pgComment.NewQuerier(tXer)
+
+		orderID  = uuid.NewString()
+		authorID = uuid.NewString()
+		otherID  = uuid.NewString()
+	)
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".items (id, status, name, description, user_id, number) VALUES ($1, 1, '', '', $2, $3)`,
+		orderID, otherID, "ERASE-"+orderID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".items WHERE id = $1`, orderID)
+	})
+
+	for _, author := range []string{authorID, otherID} {
+		require.NoError(t, commander.Create(ctx, &comment.Comment{
+			ID:       uuid.NewString(),
+			TSCreate: time.Now(),
+			OrderID:  orderID,
+			AuthorID: author,
+			Body:     "hello",
+		}))
+	}
+
+	require.NoError(t, commander.ReplaceAuthor(ctx, authorID, erasure.ErasedUser))
+
+	items, err := querier.GetList(ctx, &comment.Filter{OrderID: option.New(orderID)}, nil)
+	require.NoError(t, err)
+
+	authors := make([]string, 0, len(items))
+	for _, item := range items {
+		authors = append(authors, item.AuthorID)
+	}
+
+	require.ElementsMatch(t, []string{erasure.ErasedUser, otherID}, authors)
+}
@@ -0,0 +1,62 @@
+package comment
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/comment"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.comment.dto: len(columns) != len(values)")
+	}
+}
+
+const tableName = `"order".comments`
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+	tsEdit   *time.Time
+	orderID  string
+	authorID string
+	body     string
+	internal bool
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "ts_edit", "order_id", "author_id", "body", "internal"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.tsEdit, &d.orderID, &d.authorID, &d.body, &d.internal}
+}
+
+func (d *dto) toModel() *comment.Comment {
+	return &comment.Comment{
+		ID:       d.id,
+		TSCreate: d.tsCreate,
+		TSEdit:   d.tsEdit,
+		OrderID:  d.orderID,
+		AuthorID: d.authorID,
+		Body:     d.body,
+		Internal: d.internal,
+	}
+}
+
+func (d *dto) fromModel(source *comment.Comment) {
+	*d = dto{
+		id:       source.ID,
+		tsCreate: source.TSCreate,
+		tsEdit:   source.TSEdit,
+		orderID:  source.OrderID,
+		authorID: source.AuthorID,
+		body:     source.Body,
+		internal: source.Internal,
+	}
+}
@@ -0,0 +1,10 @@
+package comment
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"comment_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"comment_pg_qr"`)),
+	),
+)
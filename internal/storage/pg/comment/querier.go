@@ -0,0 +1,98 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/comment"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) comment.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetList(ctx context.Context, filter *comment.Filter, pagination *paginator.Pagination) ([]*comment.Comment, error) {
+	sb := q.prepareBase(pgBuilder.Select(newDto().columns()...), filter).
+		OrderBy("ts_create", "id")
+
+	if pagination != nil {
+		sb = sb.Offset(uint64(pagination.Offset)).Limit(uint64(pagination.Limit))
+	}
+
+	sql, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*comment.Comment
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (q *querier) Count(ctx context.Context, filter *comment.Filter) (int, error) {
+	sql, args, err := q.prepareBase(pgBuilder.Select("count(*)"), filter).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var count int
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return tx.QueryRow(ctx, sql, args...).Scan(&count)
+	}); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q *querier) prepareBase(builder squirrel.SelectBuilder, filter *comment.Filter) squirrel.SelectBuilder {
+	where := squirrel.And{}
+
+	if filter != nil {
+		if filter.IDs.IsSet() {
+			where = append(where, squirrel.Eq{"id": filter.IDs.Value()})
+		}
+
+		if filter.OrderID.IsSet() {
+			where = append(where, squirrel.Eq{"order_id": filter.OrderID.Value()})
+		}
+
+		if filter.Internal.IsSet() {
+			where = append(where, squirrel.Eq{"internal": filter.Internal.Value()})
+		}
+	}
+
+	return builder.From(tableName).Where(where)
+}
@@ -0,0 +1,40 @@
+package erasure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/erasure"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) erasure.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, item *erasure.Erasure) error {
+	d := newDto()
+	d.fromModel(item)
+
+	ib := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).
+		Insert(tableName).
+		SetMap(d.toMap())
+
+	sql, args, err := ib.ToSql()
+	if err != nil {
+		return fmt.Errorf("create query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, errExec := tx.Exec(ctx, sql, args...)
+		return errExec
+	})
+}
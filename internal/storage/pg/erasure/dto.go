@@ -0,0 +1,62 @@
+package erasure
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/erasure"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.erasure.dto: len(columns) != len(values)")
+	}
+}
+
+const tableName = `"order".erasures`
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+
+	userID      string
+	source      string
+	requestedBy string
+
+	orders int64
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "user_id", "source", "requested_by", "orders"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.userID, &d.source, &d.requestedBy, &d.orders}
+}
+
+func (d *dto) toMap() map[string]interface{} {
+	columns, values := d.columns(), d.values()
+
+	dm := make(map[string]interface{}, len(columns))
+	for i, c := range columns {
+		dm[c] = values[i]
+	}
+	return dm
+}
+
+func (d *dto) fromModel(source *erasure.Erasure) {
+	target := dto{
+		id:          source.ID,
+		tsCreate:    source.TSCreate,
+		userID:      source.UserID,
+		source:      string(source.Source),
+		requestedBy: source.RequestedBy,
+		orders:      int64(source.Orders),
+	}
+
+	*d = target
+}
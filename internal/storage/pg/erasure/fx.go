@@ -0,0 +1,9 @@
+package erasure
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"erasure_pg_cmd"`)),
+	),
+)
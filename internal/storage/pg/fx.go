@@ -1,10 +1,42 @@
 package pg
 
 import (
+	"github.com/krivenkov/order/internal/storage/pg/approval"
+	"github.com/krivenkov/order/internal/storage/pg/attachment"
+	"github.com/krivenkov/order/internal/storage/pg/comment"
+	"github.com/krivenkov/order/internal/storage/pg/erasure"
+	"github.com/krivenkov/order/internal/storage/pg/grant"
+	"github.com/krivenkov/order/internal/storage/pg/history"
+	"github.com/krivenkov/order/internal/storage/pg/inventory"
+	"github.com/krivenkov/order/internal/storage/pg/leader"
+	"github.com/krivenkov/order/internal/storage/pg/ledger"
+	"github.com/krivenkov/order/internal/storage/pg/line"
 	"github.com/krivenkov/order/internal/storage/pg/order"
+	"github.com/krivenkov/order/internal/storage/pg/payment"
+	"github.com/krivenkov/order/internal/storage/pg/promo"
+	"github.com/krivenkov/order/internal/storage/pg/recurring"
+	"github.com/krivenkov/order/internal/storage/pg/refund"
+	"github.com/krivenkov/order/internal/storage/pg/shipment"
+	"github.com/krivenkov/order/internal/storage/pg/tenant"
 	"go.uber.org/fx"
 )
 
 var FXModule = fx.Options(
 	order.FXModule,
+	erasure.FXModule,
+	ledger.FXModule,
+	history.FXModule,
+	line.FXModule,
+	shipment.FXModule,
+	payment.FXModule,
+	refund.FXModule,
+	promo.FXModule,
+	inventory.FXModule,
+	recurring.FXModule,
+	leader.FXModule,
+	tenant.FXModule,
+	approval.FXModule,
+	grant.FXModule,
+	attachment.FXModule,
+	comment.FXModule,
 )
@@ -0,0 +1,79 @@
+package grant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/grant"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) grant.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Save(ctx context.Context, item *grant.Grant) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Insert(tableName).
+		Columns(d.columns()...).
+		Values(d.orderID, d.userID, d.permission, d.grantedBy, d.tsCreate, d.tsExpire).
+		Suffix("ON CONFLICT (order_id, user_id) DO UPDATE SET permission = excluded.permission, granted_by = excluded.granted_by, ts_expire = excluded.ts_expire"),
+		nil)
+}
+
+func (c *commander) Delete(ctx context.Context, orderID, userID string) error {
+	return c.exec(ctx, pgBuilder.
+		Delete(tableName).
+		Where(squirrel.Eq{"order_id": orderID, "user_id": userID}),
+		model.ErrNotFound)
+}
+
+func (c *commander) DeleteByUser(ctx context.Context, userID string) error {
+	return c.exec(ctx, pgBuilder.
+		Delete(tableName).
+		Where(squirrel.Eq{"user_id": userID}),
+		nil)
+}
+
+func (c *commander) ReplaceGrantor(ctx context.Context, grantedBy, by string) error {
+	return c.exec(ctx, pgBuilder.
+		Update(tableName).
+		Set("granted_by", by).
+		Where(squirrel.Eq{"granted_by": grantedBy}),
+		nil)
+}
+
+// exec runs the query, when errNoRows is set the query must affect a row
+func (c *commander) exec(ctx context.Context, query squirrel.Sqlizer, errNoRows error) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		tag, errExec := tx.Exec(ctx, sql, args...)
+		if errExec != nil {
+			return fmt.Errorf("exec: %w", errExec)
+		}
+
+		if errNoRows != nil && tag.RowsAffected() == 0 {
+			return errNoRows
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
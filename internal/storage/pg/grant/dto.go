@@ -0,0 +1,59 @@
+package grant
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/grant"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.grant.dto: len(columns) != len(values)")
+	}
+}
+
+const tableName = `"order".grants`
+
+type dto struct {
+	orderID    string
+	userID     string
+	permission string
+	grantedBy  string
+	tsCreate   time.Time
+	tsExpire   *time.Time
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"order_id", "user_id", "permission", "granted_by", "ts_create", "ts_expire"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.orderID, &d.userID, &d.permission, &d.grantedBy, &d.tsCreate, &d.tsExpire}
+}
+
+func (d *dto) toModel() *grant.Grant {
+	return &grant.Grant{
+		OrderID:    d.orderID,
+		UserID:     d.userID,
+		Permission: grant.Permission(d.permission),
+		GrantedBy:  d.grantedBy,
+		TSCreate:   d.tsCreate,
+		TSExpire:   d.tsExpire,
+	}
+}
+
+func (d *dto) fromModel(source *grant.Grant) {
+	*d = dto{
+		orderID:    source.OrderID,
+		userID:     source.UserID,
+		permission: string(source.Permission),
+		grantedBy:  source.GrantedBy,
+		tsCreate:   source.TSCreate,
+		tsExpire:   source.TSExpire,
+	}
+}
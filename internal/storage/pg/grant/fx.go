@@ -0,0 +1,10 @@
+package grant
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"grant_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"grant_pg_qr"`)),
+	),
+)
@@ -0,0 +1,80 @@
+package grant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/grant"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) grant.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetList(ctx context.Context, filter *grant.Filter) ([]*grant.Grant, error) {
+	sql, args, err := pgBuilder.Select(newDto().columns()...).
+		From(tableName).
+		Where(prepareWhere(filter)).
+		OrderBy("ts_create", "order_id", "user_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*grant.Grant
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func prepareWhere(filter *grant.Filter) squirrel.And {
+	where := squirrel.And{}
+
+	if filter != nil {
+		if filter.OrderID.IsSet() {
+			where = append(where, squirrel.Eq{"order_id": filter.OrderID.Value()})
+		}
+
+		if filter.UserID.IsSet() {
+			where = append(where, squirrel.Eq{"user_id": filter.UserID.Value()})
+		}
+
+		if filter.ActiveAt.IsSet() {
+			where = append(where, squirrel.Or{
+				squirrel.Eq{"ts_expire": nil},
+				squirrel.Gt{"ts_expire": filter.ActiveAt.Value()},
+			})
+		}
+	}
+
+	return where
+}
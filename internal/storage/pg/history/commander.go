@@ -0,0 +1,66 @@
+package history
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) history.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, items ...*history.Entry) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	ib := pgBuilder.Insert(tableName).Columns(newDto().columns()...)
+
+	for _, item := range items {
+		d := newDto()
+		if err := d.fromModel(item); err != nil {
+			return err
+		}
+
+		ib = ib.Values(d.id, d.tsCreate, d.orderID, d.actor, d.source, d.action, d.changes)
+	}
+
+	sql, args, err := ib.ToSql()
+	if err != nil {
+		return fmt.Errorf("create query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, errExec := tx.Exec(ctx, sql, args...)
+		return errExec
+	})
+}
+
+func (c *commander) ReplaceActor(ctx context.Context, actor, by string) error {
+	sql, args, err := pgBuilder.
+		Update(tableName).
+		Set("actor", by).
+		Where(squirrel.Eq{"actor": actor}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("update query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, errExec := tx.Exec(ctx, sql, args...)
+		return errExec
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
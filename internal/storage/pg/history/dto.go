@@ -0,0 +1,94 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/model/history"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.history.dto: len(columns) != len(values)")
+	}
+}
+
+const tableName = `"order".history`
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+
+	orderID string
+	actor   string
+	source  string
+	action  string
+	changes []byte
+}
+
+type changeDto struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "order_id", "actor", "source", "action", "changes"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.orderID, &d.actor, &d.source, &d.action, &d.changes}
+}
+
+func (d *dto) toModel() (*history.Entry, error) {
+	var changes []*changeDto
+	if err := json.Unmarshal(d.changes, &changes); err != nil {
+		return nil, fmt.Errorf("unmarshal changes: %w", err)
+	}
+
+	target := &history.Entry{
+		ID:       d.id,
+		TSCreate: d.tsCreate,
+		OrderID:  d.orderID,
+		Actor:    d.actor,
+		Source:   history.Source(d.source),
+		Action:   history.Action(d.action),
+		Changes:  make([]*history.Change, 0, len(changes)),
+	}
+
+	for _, c := range changes {
+		target.Changes = append(target.Changes, &history.Change{Field: c.Field, Old: c.Old, New: c.New})
+	}
+
+	return target, nil
+}
+
+func (d *dto) fromModel(source *history.Entry) error {
+	changes := make([]*changeDto, 0, len(source.Changes))
+	for _, c := range source.Changes {
+		changes = append(changes, &changeDto{Field: c.Field, Old: c.Old, New: c.New})
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("marshal changes: %w", err)
+	}
+
+	*d = dto{
+		id:       source.ID,
+		tsCreate: source.TSCreate,
+		orderID:  source.OrderID,
+		actor:    source.Actor,
+		source:   string(source.Source),
+		action:   string(source.Action),
+		changes:  data,
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+package history
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"history_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"history_pg_qr"`)),
+	),
+)
@@ -0,0 +1,94 @@
+package history
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/history"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) history.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+// GetList returns the newest entries first
+func (q *querier) GetList(ctx context.Context, filter *history.Filter, pagination *paginator.Pagination) ([]*history.Entry, error) {
+	sb := q.prepareBase(pgBuilder.Select(newDto().columns()...), filter).
+		OrderBy("ts_create desc", "id desc")
+
+	if pagination != nil {
+		sb = sb.Offset(uint64(pagination.Offset)).Limit(uint64(pagination.Limit))
+	}
+
+	sql, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*history.Entry
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			item, errModel := d.toModel()
+			if errModel != nil {
+				return errModel
+			}
+
+			res = append(res, item)
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (q *querier) Count(ctx context.Context, filter *history.Filter) (int, error) {
+	sql, args, err := q.prepareBase(pgBuilder.Select("count(*)"), filter).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var count int
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return tx.QueryRow(ctx, sql, args...).Scan(&count)
+	}); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q *querier) prepareBase(builder squirrel.SelectBuilder, filter *history.Filter) squirrel.SelectBuilder {
+	where := squirrel.And{}
+
+	if filter != nil && filter.OrderID.IsSet() {
+		where = append(where, squirrel.Eq{"order_id": filter.OrderID.Value()})
+	}
+
+	return builder.From(tableName).Where(where)
+}
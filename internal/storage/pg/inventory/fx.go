@@ -0,0 +1,9 @@
+package inventory
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewReserver, fx.ResultTags(`name:"inventory_pg_reserver"`)),
+	),
+)
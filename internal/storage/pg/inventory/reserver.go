@@ -0,0 +1,197 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/inventory"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+const (
+	stockTableName        = `"order".stock`
+	reservationsTableName = `"order".reservations`
+)
+
+type reserver struct {
+	tXer *database.TXer
+}
+
+func NewReserver(tXer *database.TXer) inventory.Reserver {
+	return &reserver{
+		tXer: tXer,
+	}
+}
+
+// Reserve decrements the available units with a conditional update, the row lock it takes
+// makes concurrent placements of the SKU wait and recheck what is left, so the stock is
+// never oversold. The items come sorted by SKU, which keeps the lock order deadlock free.
+func (r *reserver) Reserve(ctx context.Context, items ...*inventory.Reservation) error {
+	return r.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, item := range items {
+			n, err := exec(ctx, tx, pgBuilder.
+				Update(stockTableName).
+				Set("available", squirrel.Expr("available - ?", item.Quantity)).
+				Set("reserved", squirrel.Expr("reserved + ?", item.Quantity)).
+				Set("ts_modify", item.TSCreate).
+				Where(squirrel.Eq{"sku": item.SKU}).
+				Where(squirrel.GtOrEq{"available": item.Quantity}))
+			if err != nil {
+				return fmt.Errorf("reserve stock: %w", err)
+			}
+
+			if n == 0 {
+				var tracked bool
+				if err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM `+stockTableName+` WHERE sku = $1)`, item.SKU).Scan(&tracked); err != nil {
+					return fmt.Errorf("check stock: %w", err)
+				}
+
+				if tracked {
+					return fmt.Errorf("%w: %s", inventory.ErrOutOfStock, item.SKU)
+				}
+
+				continue
+			}
+
+			if _, err = exec(ctx, tx, pgBuilder.
+				Insert(reservationsTableName).
+				Columns("id", "ts_create", "ts_expire", "order_id", "sku", "quantity", "status").
+				Values(item.ID, item.TSCreate, item.TSExpire, item.OrderID, item.SKU, item.Quantity, int(item.Status))); err != nil {
+				return fmt.Errorf("create reservation: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *reserver) Keep(ctx context.Context, orderID string) error {
+	return r.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := exec(ctx, tx, pgBuilder.
+			Update(reservationsTableName).
+			Set("ts_expire", nil).
+			Where(squirrel.Eq{"order_id": orderID, "status": int(inventory.StatusHeld)})); err != nil {
+			return fmt.Errorf("keep reservations: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *reserver) Confirm(ctx context.Context, orderID string) error {
+	_, err := r.settle(ctx, inventory.StatusConfirmed, squirrel.Eq{"order_id": orderID})
+
+	return err
+}
+
+func (r *reserver) Release(ctx context.Context, orderIDs ...string) error {
+	if len(orderIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.settle(ctx, inventory.StatusReleased, squirrel.Eq{"order_id": orderIDs})
+
+	return err
+}
+
+func (r *reserver) Expire(ctx context.Context, before time.Time) (int, error) {
+	return r.settle(ctx, inventory.StatusExpired, squirrel.LtOrEq{"ts_expire": before})
+}
+
+// settle moves the held reservations matching the condition to the final status and
+// updates the stock of their SKUs, the stock rows are locked in the SKU order first
+// as Reserve does. A confirmed reservation leaves the stock, the others go back to it.
+func (r *reserver) settle(ctx context.Context, to inventory.Status, where squirrel.Sqlizer) (int, error) {
+	held := squirrel.And{squirrel.Eq{"status": int(inventory.StatusHeld)}, where}
+
+	available := "s.available + t.quantity"
+	if to == inventory.StatusConfirmed {
+		available = "s.available"
+	}
+
+	var n int
+
+	err := r.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := exec(ctx, tx, pgBuilder.
+			Select("sku").
+			From(stockTableName).
+			Where(squirrel.Expr("sku IN (?)", pgBuilder.Select("sku").From(reservationsTableName).Where(held))).
+			OrderBy("sku").
+			Suffix("FOR UPDATE")); err != nil {
+			return fmt.Errorf("lock stock: %w", err)
+		}
+
+		settled := squirrel.
+			Update(reservationsTableName).
+			Set("status", int(to)).
+			Where(held).
+			Suffix("RETURNING sku, quantity")
+
+		totals := squirrel.
+			Select("sku", "sum(quantity) AS quantity", "count(*) AS n").
+			From("settled").
+			GroupBy("sku")
+
+		query, args, err := squirrel.ConcatExpr(
+			"WITH settled AS (", settled, "), totals AS (", totals, ") ",
+			squirrel.
+				Update(stockTableName+" s").
+				Set("available", squirrel.Expr(available)).
+				Set("reserved", squirrel.Expr("s.reserved - t.quantity")).
+				Set("ts_modify", squirrel.Expr("now()")).
+				From("totals t").
+				Where("s.sku = t.sku").
+				Suffix("RETURNING t.n"),
+		).ToSql()
+		if err != nil {
+			return fmt.Errorf("prepare query: %w", err)
+		}
+
+		// the parts keep the question placeholders, they are numbered once for the whole query
+		if query, err = squirrel.Dollar.ReplacePlaceholders(query); err != nil {
+			return fmt.Errorf("prepare query: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("settle reservations: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var count int
+			if err = rows.Scan(&count); err != nil {
+				return fmt.Errorf("scan: %w", err)
+			}
+
+			n += count
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// exec runs the query and returns the number of affected rows
+func exec(ctx context.Context, tx pgx.Tx, query squirrel.Sqlizer) (int64, error) {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("prepare query: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
@@ -0,0 +1,141 @@
+package inventory_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/krivenkov/order/internal/model/inventory"
+	"github.com/krivenkov/order/internal/model/line"
+	pgInventory "github.com/krivenkov/order/internal/storage/pg/inventory"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dsnEnv points the test to a migrated database, e.g. the one of make migrate.local.up
+const dsnEnv = "ORDER_TEST_DB_DSN"
+
+func TestReserverRace(t *testing.T) {
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", dsnEnv)
+	}
+
+	const (
+		orders = 50
+		stock  = 20
+	)
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var (
+		reserver = pgInventory.NewReserver(database.NewTXer(pool))
+
+		skus = []string{"RACE-A-" + uuid.NewString(), "RACE-B-" + uuid.NewString()}
+		ids  = make([]string, 0, orders)
+	)
+
+	for _, sku := range skus {
+		_, err = pool.Exec(ctx, `INSERT INTO "order".stock (sku, available) VALUES ($1, $2)`, sku, stock)
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < orders; i++ {
+		id := uuid.NewString()
+		ids = append(ids, id)
+
+		_, err = pool.Exec(ctx, `INSERT INTO "order".items (id, status, name, description, user_id, number) VALUES ($1, 1, '', '', $2, $3)`,
+			id, uuid.NewString(), "RACE-"+id)
+		require.NoError(t, err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".items WHERE id = ANY($1)`, ids)
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".stock WHERE sku = ANY($1)`, skus)
+	})
+
+	// every order takes one unit of each SKU, listed in the opposite order to the other orders
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		placed []string
+	)
+
+	for i, id := range ids {
+		wg.Add(1)
+
+		go func(i int, id string) {
+			defer wg.Done()
+
+			lines := []*line.Line{{SKU: skus[i%2], Quantity: 1}, {SKU: skus[(i+1)%2], Quantity: 1}}
+			expire := time.Now().Add(time.Hour)
+
+			err := reserver.Reserve(ctx, inventory.NewReservations(id, lines, &expire, time.Now, uuid.New)...)
+			if errors.Is(err, inventory.ErrOutOfStock) {
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			mu.Lock()
+			placed = append(placed, id)
+			mu.Unlock()
+		}(i, id)
+	}
+
+	wg.Wait()
+
+	require.Len(t, placed, stock)
+	requireStock(ctx, t, pool, skus, 0, stock)
+
+	// releases, confirmations and expiry running together never lose a unit
+	for i, id := range placed {
+		wg.Add(1)
+
+		go func(i int, id string) {
+			defer wg.Done()
+
+			if i%2 == 0 {
+				assert.NoError(t, reserver.Release(ctx, id))
+			} else {
+				assert.NoError(t, reserver.Confirm(ctx, id))
+			}
+		}(i, id)
+	}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		_, err := reserver.Expire(ctx, time.Now())
+		assert.NoError(t, err)
+	}()
+
+	wg.Wait()
+
+	requireStock(ctx, t, pool, skus, stock/2, 0)
+}
+
+func requireStock(ctx context.Context, t *testing.T, pool *pgxpool.Pool, skus []string, available, reserved int) {
+	t.Helper()
+
+	for _, sku := range skus {
+		var a, r int
+		require.NoError(t, pool.QueryRow(ctx, `SELECT available, reserved FROM "order".stock WHERE sku = $1`, sku).Scan(&a, &r))
+		require.Equal(t, available, a, sku)
+		require.Equal(t, reserved, r, sku)
+	}
+}
@@ -0,0 +1,85 @@
+package leader
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/krivenkov/order/internal/model/leader"
+)
+
+// elector leads while it holds a session advisory lock, the connection with the lock is taken out
+// of the pool, so the lock goes with the connection when the instance dies or loses the database
+type elector struct {
+	pool *pgxpool.Pool
+	key  string
+
+	mu   sync.Mutex
+	conn *pgx.Conn
+}
+
+func NewElector(pool *pgxpool.Pool, key string) leader.Elector {
+	return &elector{
+		pool: pool,
+		key:  key,
+	}
+}
+
+func (e *elector) Lead(ctx context.Context) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn != nil {
+		if err := e.conn.Ping(ctx); err != nil {
+			_ = e.close(ctx)
+			return false, fmt.Errorf("check lead: %w", err)
+		}
+
+		return true, nil
+	}
+
+	conn, err := e.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("acquire connection: %w", err)
+	}
+
+	var locked bool
+	if err = conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, e.key).Scan(&locked); err != nil {
+		conn.Release()
+		return false, fmt.Errorf("take lead: %w", err)
+	}
+
+	if !locked {
+		conn.Release()
+		return false, nil
+	}
+
+	e.conn = conn.Hijack()
+
+	return true, nil
+}
+
+func (e *elector) Resign(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.close(ctx)
+}
+
+// close ends the session, which releases the lock
+func (e *elector) close(ctx context.Context) error {
+	if e.conn == nil {
+		return nil
+	}
+
+	err := e.conn.Close(ctx)
+	e.conn = nil
+
+	if err != nil {
+		return fmt.Errorf("resign: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package leader
+
+import (
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/krivenkov/order/internal/model/leader"
+	"go.uber.org/fx"
+)
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(func(pool *pgxpool.Pool) leader.Elector {
+			return NewElector(pool, "order.recurring")
+		}, fx.ResultTags(`name:"recurring_pg_elector"`)),
+	),
+)
@@ -0,0 +1,96 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/ledger"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+const (
+	messagesTableName = `"order".processed_messages`
+	versionsTableName = `"order".user_event_versions`
+)
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) ledger.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+// step is a query which must affect a row, otherwise the message is skipped with err
+type step struct {
+	query squirrel.Sqlizer
+	err   error
+}
+
+func (c *commander) Register(ctx context.Context, entry *ledger.Entry) error {
+	var steps []step
+
+	if entry.MessageID != "" {
+		steps = append(steps, step{
+			query: pgBuilder.
+				Insert(messagesTableName).
+				Columns("topic", "message_id", "key", "ts_processed").
+				Values(string(entry.Topic), entry.MessageID, entry.Key, entry.TSProcessed).
+				Suffix("ON CONFLICT (topic, message_id) DO NOTHING"),
+			err: ledger.ErrDuplicate,
+		})
+	}
+
+	if !entry.Version.IsZero() {
+		steps = append(steps, step{
+			query: pgBuilder.
+				Insert(versionsTableName).
+				Columns("topic", "user_id", "version").
+				Values(string(entry.Topic), entry.UserID, entry.Version).
+				Suffix("ON CONFLICT (topic, user_id) DO UPDATE SET version = excluded.version " +
+					"WHERE user_event_versions.version <= excluded.version"),
+			err: ledger.ErrOutdated,
+		})
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, st := range steps {
+			sql, args, err := st.query.ToSql()
+			if err != nil {
+				return fmt.Errorf("create query: %w", err)
+			}
+
+			tag, err := tx.Exec(ctx, sql, args...)
+			if err != nil {
+				return fmt.Errorf("exec: %w", err)
+			}
+
+			if tag.RowsAffected() == 0 {
+				return st.err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (c *commander) Forget(ctx context.Context, userID string) error {
+	sql, args, err := pgBuilder.
+		Delete(versionsTableName).
+		Where(squirrel.Eq{"user_id": userID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, errExec := tx.Exec(ctx, sql, args...)
+		return errExec
+	})
+}
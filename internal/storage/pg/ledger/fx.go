@@ -0,0 +1,9 @@
+package ledger
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"ledger_pg_cmd"`)),
+	),
+)
@@ -0,0 +1,102 @@
+package line
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) line.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, items ...*line.Line) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	ib := pgBuilder.Insert(tableName).Columns(newDto().columns()...)
+
+	for _, item := range items {
+		d := newDto()
+		d.fromModel(item)
+
+		ib = ib.Values(d.id, d.tsCreate, d.orderID, d.sku, d.name, d.quantity, d.unitPrice, d.discount, d.taxCategory, d.tax)
+	}
+
+	sql, args, err := ib.ToSql()
+	if err != nil {
+		return fmt.Errorf("create query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, errExec := tx.Exec(ctx, sql, args...)
+		return errExec
+	})
+}
+
+func (c *commander) Update(ctx context.Context, items ...*line.Line) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, item := range items {
+			d := newDto()
+			d.fromModel(item)
+
+			sql, args, err := pgBuilder.
+				Update(tableName).
+				Set("quantity", d.quantity).
+				Set("discount", d.discount).
+				Set("tax", d.tax).
+				Where(squirrel.Eq{"id": d.id}).
+				ToSql()
+			if err != nil {
+				return fmt.Errorf("update query: %w", err)
+			}
+
+			if _, err = tx.Exec(ctx, sql, args...); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (c *commander) Delete(ctx context.Context, orderID, id string) error {
+	sql, args, err := pgBuilder.
+		Delete(tableName).
+		Where(squirrel.Eq{"id": id, "order_id": orderID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("delete query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		tag, errExec := tx.Exec(ctx, sql, args...)
+		if errExec != nil {
+			return errExec
+		}
+
+		if tag.RowsAffected() == 0 {
+			return model.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
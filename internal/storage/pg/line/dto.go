@@ -0,0 +1,78 @@
+package line
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/line"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.line.dto: len(columns) != len(values)")
+	}
+}
+
+const tableName = `"order".lines`
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+
+	orderID  string
+	sku      string
+	name     string
+	quantity int
+
+	unitPrice int64
+	discount  int64
+
+	taxCategory string
+	tax         int64
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "order_id", "sku", "name", "quantity", "unit_price", "discount", "tax_category", "tax"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.orderID, &d.sku, &d.name, &d.quantity, &d.unitPrice, &d.discount, &d.taxCategory, &d.tax}
+}
+
+func (d *dto) toModel() *line.Line {
+	return &line.Line{
+		ID:        d.id,
+		TSCreate:  d.tsCreate,
+		OrderID:   d.orderID,
+		SKU:       d.sku,
+		Name:      d.name,
+		Quantity:  d.quantity,
+		UnitPrice: d.unitPrice,
+		Discount:  d.discount,
+
+		TaxCategory: d.taxCategory,
+		Tax:         d.tax,
+	}
+}
+
+func (d *dto) fromModel(source *line.Line) {
+	target := dto{
+		id:        source.ID,
+		tsCreate:  source.TSCreate,
+		orderID:   source.OrderID,
+		sku:       source.SKU,
+		name:      source.Name,
+		quantity:  source.Quantity,
+		unitPrice: source.UnitPrice,
+		discount:  source.Discount,
+
+		taxCategory: source.TaxCategory,
+		tax:         source.Tax,
+	}
+
+	*d = target
+}
@@ -0,0 +1,10 @@
+package line
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"line_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"line_pg_qr"`)),
+	),
+)
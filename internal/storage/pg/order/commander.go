@@ -25,7 +25,9 @@ func (c *commander) Create(ctx context.Context, item *order.Order) error {
 		Insert(tableName)
 
 	d := newDto()
-	d.fromModel(item)
+	if err := d.fromModel(item); err != nil {
+		return fmt.Errorf("prepare item: %w", err)
+	}
 
 	ib = ib.SetMap(d.toMap())
 
@@ -37,7 +39,10 @@ func (c *commander) Update(ctx context.Context, item *order.Order) error {
 		Update(tableName)
 
 	d := newDto()
-	d.fromModel(item)
+	if err := d.fromModel(item); err != nil {
+		return fmt.Errorf("prepare item: %w", err)
+	}
+
 	ub = ub.SetMap(d.toMap()).
 		Where(squirrel.Eq{"id": d.id})
 
@@ -52,13 +57,95 @@ func (c *commander) Delete(ctx context.Context, item *order.Order) error {
 	return c.exec(ctx, b)
 }
 
-func (c *commander) Disable(ctx context.Context, userID string) error {
+func (c *commander) Disable(ctx context.Context, userID string) ([]*order.Transition, error) {
 	b := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).
 		Update(tableName).
-		Set("status", order.StatusDeleted).
-		Where(squirrel.Eq{"user_id": userID})
+		Set("status_before_disable", squirrel.Expr("status")).
+		Set("status", order.StatusDisabled).
+		Where(squirrel.And{
+			squirrel.Eq{"user_id": userID, "tenant_id": nil},
+			squirrel.NotEq{"status": []order.Status{order.StatusDeleted, order.StatusDisabled}},
+		}).
+		Suffix("RETURNING id, status_before_disable")
+
+	statuses, err := c.queryStatuses(ctx, b)
+	if err != nil {
+		return nil, err
+	}
 
-	return c.exec(ctx, b)
+	res := make([]*order.Transition, 0, len(statuses))
+	for _, s := range statuses {
+		res = append(res, &order.Transition{ID: s.id, From: s.status, To: order.StatusDisabled})
+	}
+
+	return res, nil
+}
+
+func (c *commander) Enable(ctx context.Context, userID string) ([]*order.Transition, error) {
+	b := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).
+		Update(tableName).
+		Set("status", squirrel.Expr("status_before_disable")).
+		Set("status_before_disable", nil).
+		Where(squirrel.And{
+			squirrel.Eq{"user_id": userID, "tenant_id": nil},
+			squirrel.Eq{"status": order.StatusDisabled},
+			squirrel.NotEq{"status_before_disable": nil},
+		}).
+		Suffix("RETURNING id, status")
+
+	statuses, err := c.queryStatuses(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*order.Transition, 0, len(statuses))
+	for _, s := range statuses {
+		res = append(res, &order.Transition{ID: s.id, From: order.StatusDisabled, To: s.status})
+	}
+
+	return res, nil
+}
+
+type idStatus struct {
+	id     string
+	status order.Status
+}
+
+// queryStatuses runs the statement returning (id, status) rows
+func (c *commander) queryStatuses(ctx context.Context, sq squirrel.Sqlizer) ([]*idStatus, error) {
+	sql, args, err := sq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("create query: %w", err)
+	}
+
+	var res []*idStatus
+
+	if err = c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				id     string
+				status int64
+			)
+
+			if errScan := rows.Scan(&id, &status); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, &idStatus{id: id, status: order.Status(status)})
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 func (c *commander) exec(ctx context.Context, sq squirrel.Sqlizer) error {
@@ -0,0 +1,89 @@
+package order_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	pgOrder "github.com/krivenkov/order/internal/storage/pg/order"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/stretchr/testify/require"
+)
+
+// dsnEnv points the test to a migrated database, e.g. the one of make migrate.local.up
+const dsnEnv = "ORDER_TEST_DB_DSN"
+
+// TestTenantOrdersOfMember checks a disabled or erased member leaves the orders created in the tenant alone
+func TestTenantOrdersOfMember(t *testing.T) {
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", dsnEnv)
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var (
+		tXer      = database.NewTXer(pool)
+		commander = pgOrder.NewCommander(tXer)
+		querier   = pgOrder.NewQuerier(tXer)
+
+		userID   = uuid.NewString()
+		tenantID = uuid.NewString()
+		personal = uuid.NewString()
+		shared   = uuid.NewString()
+	)
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".tenants (id, name) VALUES ($1, 'member test')`, tenantID)
+	require.NoError(t, err)
+
+	for id, tenant := range map[string]*string{personal: nil, shared: &tenantID} {
+		_, err = pool.Exec(ctx, `INSERT INTO "order".items (id, status, name, description, user_id, tenant_id, number) VALUES ($1, 1, '', '', $2, $3, $4)`,
+			id, userID, tenant, "MEMBER-"+id)
+		require.NoError(t, err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".items WHERE id = ANY($1)`, []string{personal, shared})
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".tenants WHERE id = $1`, tenantID)
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		transitions, err := commander.Disable(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, []*orderModel.Transition{{ID: personal, From: orderModel.StatusCreated, To: orderModel.StatusDisabled}}, transitions)
+		requireStatus(ctx, t, pool, shared, orderModel.StatusCreated)
+
+		transitions, err = commander.Enable(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, []*orderModel.Transition{{ID: personal, From: orderModel.StatusDisabled, To: orderModel.StatusCreated}}, transitions)
+		requireStatus(ctx, t, pool, shared, orderModel.StatusCreated)
+	})
+
+	t.Run("Erase", func(t *testing.T) {
+		// the filter the erasure deletes by
+		items, err := querier.GetList(ctx, &orderModel.Filter{
+			UserID:   option.New(userID),
+			TenantID: option.New(""),
+		}, nil, &paginator.Pagination{Limit: 100})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		require.Equal(t, personal, items[0].ID)
+	})
+}
+
+func requireStatus(ctx context.Context, t *testing.T, pool *pgxpool.Pool, id string, status orderModel.Status) {
+	t.Helper()
+
+	var s int
+	require.NoError(t, pool.QueryRow(ctx, `SELECT status FROM "order".items WHERE id = $1`, id).Scan(&s))
+	require.Equal(t, status, orderModel.Status(s))
+}
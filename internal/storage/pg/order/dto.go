@@ -1,9 +1,13 @@
 package order
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/order/internal/model/tax"
 )
 
 func init() {
@@ -16,8 +20,9 @@ func init() {
 const (
 	tableName = `"order".items`
 
-	nameSortKey = "name"
-	idSortKey   = "id"
+	nameSortKey   = "name"
+	idSortKey     = "id"
+	modifySortKey = "ts_modify"
 )
 
 type dto struct {
@@ -25,10 +30,58 @@ type dto struct {
 	tsCreate time.Time
 	tsModify time.Time
 	status   int64
+	state    int64
+	number   string
 
 	userID      string
+	tenantID    *string
 	name        string
 	description string
+
+	shippingAddress []byte
+	billingAddress  []byte
+
+	currency string
+	subtotal int64
+	discount int64
+	tax      int64
+	total    int64
+	paid     int64
+	refunded int64
+
+	taxInclusive bool
+
+	discounts []byte
+	taxes     []byte
+
+	tags     []string
+	metadata []byte
+}
+
+type discountDto struct {
+	PromoID string `json:"promo_id"`
+	Code    string `json:"code"`
+	Amount  int64  `json:"amount"`
+}
+
+type taxDto struct {
+	Country  string `json:"country"`
+	Region   string `json:"region,omitempty"`
+	Category string `json:"category,omitempty"`
+	Rate     int64  `json:"rate"`
+	Taxable  int64  `json:"taxable"`
+	Amount   int64  `json:"amount"`
+}
+
+type addressDto struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone"`
 }
 
 func newDto() *dto {
@@ -36,11 +89,13 @@ func newDto() *dto {
 }
 
 func (d *dto) columns() []string {
-	return []string{"id", "ts_create", "ts_modify", "status", "user_id", "name", "description"}
+	return []string{"id", "ts_create", "ts_modify", "status", "state", "number", "user_id", "tenant_id", "name", "description", "shipping_address", "billing_address",
+		"currency", "subtotal", "discount", "tax", "total", "paid", "refunded", "tax_inclusive", "discounts", "taxes", "tags", "metadata"}
 }
 
 func (d *dto) values() []interface{} {
-	return []interface{}{&d.id, &d.tsCreate, &d.tsModify, &d.status, &d.userID, &d.name, &d.description}
+	return []interface{}{&d.id, &d.tsCreate, &d.tsModify, &d.status, &d.state, &d.number, &d.userID, &d.tenantID, &d.name, &d.description, &d.shippingAddress, &d.billingAddress,
+		&d.currency, &d.subtotal, &d.discount, &d.tax, &d.total, &d.paid, &d.refunded, &d.taxInclusive, &d.discounts, &d.taxes, &d.tags, &d.metadata}
 }
 
 func (d *dto) toMap() map[string]interface{} {
@@ -53,28 +108,265 @@ func (d *dto) toMap() map[string]interface{} {
 	return dm
 }
 
-func (d *dto) toModel() *order.Order {
-	return &order.Order{
-		ID:          d.id,
-		TSCreate:    d.tsCreate,
-		TSModify:    d.tsModify,
-		Status:      order.Status(d.status),
-		UserID:      d.userID,
-		Name:        d.name,
-		Description: d.description,
+func (d *dto) toModel() (*order.Order, error) {
+	shipping, err := addressToModel(d.shippingAddress)
+	if err != nil {
+		return nil, fmt.Errorf("shipping address: %w", err)
+	}
+
+	billing, err := addressToModel(d.billingAddress)
+	if err != nil {
+		return nil, fmt.Errorf("billing address: %w", err)
+	}
+
+	discounts, err := discountsToModel(d.discounts)
+	if err != nil {
+		return nil, fmt.Errorf("discounts: %w", err)
+	}
+
+	taxes, err := taxesToModel(d.taxes)
+	if err != nil {
+		return nil, fmt.Errorf("taxes: %w", err)
+	}
+
+	metadata, err := metadataToModel(d.metadata)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: %w", err)
+	}
+
+	res := &order.Order{
+		ID:              d.id,
+		TSCreate:        d.tsCreate,
+		TSModify:        d.tsModify,
+		Status:          order.Status(d.status),
+		State:           order.State(d.state),
+		Number:          d.number,
+		UserID:          d.userID,
+		Name:            d.name,
+		Description:     d.description,
+		ShippingAddress: shipping,
+		BillingAddress:  billing,
+		Totals: order.Totals{
+			Currency:     d.currency,
+			Subtotal:     d.subtotal,
+			Discount:     d.discount,
+			Tax:          d.tax,
+			TaxInclusive: d.taxInclusive,
+			Total:        d.total,
+			Paid:         d.paid,
+			Refunded:     d.refunded,
+		},
+		Discounts: discounts,
+		Taxes:     taxes,
+		Metadata:  metadata,
 	}
+
+	if len(d.tags) > 0 {
+		res.Tags = d.tags
+	}
+
+	if d.tenantID != nil {
+		res.TenantID = *d.tenantID
+	}
+
+	return res, nil
 }
 
-func (d *dto) fromModel(source *order.Order) {
+func (d *dto) fromModel(source *order.Order) error {
+	shipping, err := addressFromModel(source.ShippingAddress)
+	if err != nil {
+		return fmt.Errorf("shipping address: %w", err)
+	}
+
+	billing, err := addressFromModel(source.BillingAddress)
+	if err != nil {
+		return fmt.Errorf("billing address: %w", err)
+	}
+
+	discounts, err := discountsFromModel(source.Discounts)
+	if err != nil {
+		return fmt.Errorf("discounts: %w", err)
+	}
+
+	taxes, err := taxesFromModel(source.Taxes)
+	if err != nil {
+		return fmt.Errorf("taxes: %w", err)
+	}
+
+	metadata, err := metadataFromModel(source.Metadata)
+	if err != nil {
+		return fmt.Errorf("metadata: %w", err)
+	}
+
 	target := dto{
-		id:          source.ID,
-		tsCreate:    source.TSCreate,
-		tsModify:    source.TSModify,
-		status:      int64(source.Status),
-		userID:      source.UserID,
-		name:        source.Name,
-		description: source.Description,
+		id:              source.ID,
+		tsCreate:        source.TSCreate,
+		tsModify:        source.TSModify,
+		status:          int64(source.Status),
+		state:           int64(source.State),
+		number:          source.Number,
+		userID:          source.UserID,
+		name:            source.Name,
+		description:     source.Description,
+		shippingAddress: shipping,
+		billingAddress:  billing,
+		currency:        source.Totals.Currency,
+		subtotal:        source.Totals.Subtotal,
+		discount:        source.Totals.Discount,
+		tax:             source.Totals.Tax,
+		total:           source.Totals.Total,
+		paid:            source.Totals.Paid,
+		refunded:        source.Totals.Refunded,
+		taxInclusive:    source.Totals.TaxInclusive,
+		discounts:       discounts,
+		taxes:           taxes,
+		tags:            source.Tags,
+		metadata:        metadata,
+	}
+
+	if target.tags == nil {
+		target.tags = []string{}
+	}
+
+	if source.TenantID != "" {
+		target.tenantID = &source.TenantID
 	}
 
 	*d = target
+
+	return nil
+}
+
+func addressToModel(data []byte) (*order.Address, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var a addressDto
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	return &order.Address{
+		Name:       a.Name,
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+		Phone:      a.Phone,
+	}, nil
+}
+
+func addressFromModel(source *order.Address) ([]byte, error) {
+	if source == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(&addressDto{
+		Name:       source.Name,
+		Line1:      source.Line1,
+		Line2:      source.Line2,
+		City:       source.City,
+		Region:     source.Region,
+		PostalCode: source.PostalCode,
+		Country:    source.Country,
+		Phone:      source.Phone,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	return data, nil
+}
+
+func discountsToModel(data []byte) ([]*promo.Discount, error) {
+	var items []*discountDto
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	res := make([]*promo.Discount, 0, len(items))
+	for _, item := range items {
+		res = append(res, (*promo.Discount)(item))
+	}
+
+	return res, nil
+}
+
+func discountsFromModel(source []*promo.Discount) ([]byte, error) {
+	items := make([]*discountDto, 0, len(source))
+	for _, s := range source {
+		items = append(items, (*discountDto)(s))
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	return data, nil
+}
+
+func taxesToModel(data []byte) ([]*tax.Line, error) {
+	var items []*taxDto
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	res := make([]*tax.Line, 0, len(items))
+	for _, item := range items {
+		res = append(res, (*tax.Line)(item))
+	}
+
+	return res, nil
+}
+
+func taxesFromModel(source []*tax.Line) ([]byte, error) {
+	items := make([]*taxDto, 0, len(source))
+	for _, s := range source {
+		items = append(items, (*taxDto)(s))
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	return data, nil
+}
+
+func metadataToModel(data []byte) (map[string]string, error) {
+	var metadata map[string]string
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+
+	return metadata, nil
+}
+
+func metadataFromModel(source map[string]string) ([]byte, error) {
+	if source == nil {
+		source = map[string]string{}
+	}
+
+	data, err := json.Marshal(source)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	return data, nil
 }
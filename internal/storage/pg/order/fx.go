@@ -6,5 +6,7 @@ var FXModule = fx.Options(
 	fx.Provide(
 		fx.Annotate(NewCommander, fx.ResultTags(`name:"order_pg_cmd"`)),
 		fx.Annotate(NewQuerier, fx.ResultTags(`name:"order_pg_qr"`)),
+		fx.Annotate(NewTagger, fx.ResultTags(`name:"order_pg_tagger"`)),
+		fx.Annotate(NewNumberer, fx.ResultTags(`name:"order_pg_numberer"`)),
 	),
 )
@@ -0,0 +1,38 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+// nextNumberQuery takes the next value of the number sequence of the tenant, see migration 000025.
+// nextval is not rolled back with the create transaction, so the numbers may have gaps but no
+// create waits for the transaction of another one.
+const nextNumberQuery = `SELECT nextval("order".number_sequence($1)::regclass)`
+
+type numberer struct {
+	tXer *database.TXer
+}
+
+func NewNumberer(tXer *database.TXer) order.Numberer {
+	return &numberer{
+		tXer: tXer,
+	}
+}
+
+func (n *numberer) Next(ctx context.Context, tenantID string, tsCreate time.Time) (string, error) {
+	var seq int64
+
+	if err := n.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return tx.QueryRow(ctx, nextNumberQuery, tenantID).Scan(&seq)
+	}); err != nil {
+		return "", fmt.Errorf("next order number: %w", err)
+	}
+
+	return order.FormatNumber(tsCreate.Year(), seq), nil
+}
@@ -0,0 +1,61 @@
+package order_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	pgOrder "github.com/krivenkov/order/internal/storage/pg/order"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNumberPerTenant checks a new tenant counts its orders from one without waiting for the creates of others
+func TestNumberPerTenant(t *testing.T) {
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", dsnEnv)
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var (
+		tXer     = database.NewTXer(pool)
+		numberer = pgOrder.NewNumberer(tXer)
+
+		tenantID = uuid.NewString()
+		tsCreate = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".tenants (id, name) VALUES ($1, 'number test')`, tenantID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".tenants WHERE id = $1`, tenantID)
+	})
+
+	// a create holding its transaction open keeps no other create from its number
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err)
+
+	_, err = tx.Exec(ctx, `SELECT nextval("order".number_sequence($1)::regclass)`, tenantID)
+	require.NoError(t, err)
+
+	number, err := numberer.Next(ctx, tenantID, tsCreate)
+	require.NoError(t, err)
+	require.Equal(t, "ORD-2026-000002", number)
+
+	// the number of a rolled back create is not given back
+	require.NoError(t, tx.Rollback(ctx))
+
+	number, err = numberer.Next(ctx, tenantID, tsCreate)
+	require.NoError(t, err)
+	require.Equal(t, "ORD-2026-000003", number)
+}
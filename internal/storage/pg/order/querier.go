@@ -3,11 +3,14 @@ package order
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v4"
 	"github.com/krivenkov/order/internal/model"
 	orderModel "github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/tenant"
 	"github.com/krivenkov/pkg/clients/database"
 	"github.com/krivenkov/pkg/order"
 	"github.com/krivenkov/pkg/paginator"
@@ -23,9 +26,19 @@ func NewQuerier(tXer *database.TXer) orderModel.Querier {
 	}
 }
 
+func NewTagger(tXer *database.TXer) orderModel.Tagger {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
 func (q *querier) GetItem(ctx context.Context, filter *orderModel.Filter) (*orderModel.Order, error) {
 	sb := pgBuilder.Select(newDto().columns()...)
-	sb = q.prepareBase(sb, filter)
+	sb = q.prepareBase(ctx, sb, filter)
+
+	if filter != nil && filter.ForUpdate.Value() {
+		sb = sb.Suffix("FOR UPDATE")
+	}
 
 	sql, args, errPrep := sb.ToSql()
 	if errPrep != nil {
@@ -60,12 +73,12 @@ func (q *querier) GetItem(ctx context.Context, filter *orderModel.Filter) (*orde
 		return nil, err
 	}
 
-	return d.toModel(), nil
+	return d.toModel()
 }
 
 func (q *querier) GetList(ctx context.Context, filter *orderModel.Filter, orders []*order.Order, pagination *paginator.Pagination) ([]*orderModel.Order, error) {
 	sb := pgBuilder.Select(newDto().columns()...)
-	sb, errPrep := q.prepare(sb, filter, orders, pagination)
+	sb, errPrep := q.prepare(ctx, sb, filter, orders, pagination)
 	if errPrep != nil {
 		return nil, fmt.Errorf("prepare query: %w", errPrep)
 	}
@@ -91,7 +104,12 @@ func (q *querier) GetList(ctx context.Context, filter *orderModel.Filter, orders
 				return fmt.Errorf("scan: %w", err)
 			}
 
-			res = append(res, d.toModel())
+			item, err := d.toModel()
+			if err != nil {
+				return fmt.Errorf("convert: %w", err)
+			}
+
+			res = append(res, item)
 		}
 
 		return rows.Err()
@@ -104,7 +122,7 @@ func (q *querier) GetList(ctx context.Context, filter *orderModel.Filter, orders
 
 func (q *querier) Count(ctx context.Context, filter *orderModel.Filter) (int, error) {
 	sb := pgBuilder.Select("COUNT(*)")
-	sb = q.prepareBase(sb, filter)
+	sb = q.prepareBase(ctx, sb, filter)
 
 	sql, args, errPrep := sb.ToSql()
 	if errPrep != nil {
@@ -128,10 +146,159 @@ func (q *querier) Count(ctx context.Context, filter *orderModel.Filter) (int, er
 	return count, nil
 }
 
-var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+func (q *querier) Facets(ctx context.Context, filter *orderModel.Filter, interval orderModel.DateInterval) (*orderModel.Facets, error) {
+	statusSb := pgBuilder.Select("status", "COUNT(*)")
+	statusSb = q.prepareBase(ctx, statusSb, filter).
+		GroupBy("status").
+		OrderBy("status")
+
+	dateSb := pgBuilder.Select().
+		Column(squirrel.Expr("date_trunc(?, ts_create) AS bucket", string(interval))).
+		Column("COUNT(*)")
+	dateSb = q.prepareBase(ctx, dateSb, filter).
+		GroupBy("bucket").
+		OrderBy("bucket")
+
+	tagSb := q.prepareTags(ctx, filter).
+		Limit(orderModel.FacetTags)
+
+	statusSQL, statusArgs, errPrep := statusSb.ToSql()
+	if errPrep != nil {
+		return nil, fmt.Errorf("prepare query: %w", errPrep)
+	}
+
+	dateSQL, dateArgs, errPrep := dateSb.ToSql()
+	if errPrep != nil {
+		return nil, fmt.Errorf("prepare query: %w", errPrep)
+	}
+
+	tagSQL, tagArgs, errPrep := tagSb.ToSql()
+	if errPrep != nil {
+		return nil, fmt.Errorf("prepare query: %w", errPrep)
+	}
+
+	res := &orderModel.Facets{
+		Statuses: make([]*orderModel.StatusFacet, 0),
+		Dates:    make([]*orderModel.DateFacet, 0),
+	}
+
+	if err := q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, statusSQL, statusArgs...)
+		if err != nil {
+			return fmt.Errorf("query: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				status int64
+				count  int
+			)
+
+			if err = rows.Scan(&status, &count); err != nil {
+				return fmt.Errorf("scan: %w", err)
+			}
+
+			res.Statuses = append(res.Statuses, &orderModel.StatusFacet{
+				Status: orderModel.Status(status),
+				Count:  count,
+			})
+		}
+
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		rows, err = tx.Query(ctx, dateSQL, dateArgs...)
+		if err != nil {
+			return fmt.Errorf("query: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			facet := &orderModel.DateFacet{}
+
+			if err = rows.Scan(&facet.Date, &facet.Count); err != nil {
+				return fmt.Errorf("scan: %w", err)
+			}
+
+			res.Dates = append(res.Dates, facet)
+		}
+
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		res.Tags, err = queryTags(ctx, tx, tagSQL, tagArgs)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (q *querier) Tags(ctx context.Context, filter *orderModel.Filter) ([]*orderModel.TagFacet, error) {
+	sql, args, errPrep := q.prepareTags(ctx, filter).ToSql()
+	if errPrep != nil {
+		return nil, fmt.Errorf("prepare query: %w", errPrep)
+	}
 
-func (q *querier) prepare(sb squirrel.SelectBuilder, filter *orderModel.Filter, orders []*order.Order, pagination *paginator.Pagination) (squirrel.SelectBuilder, error) {
-	sb = q.prepareBase(sb, filter)
+	var res []*orderModel.TagFacet
+
+	if err := q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+
+		res, err = queryTags(ctx, tx, sql, args)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (q *querier) prepareTags(ctx context.Context, filter *orderModel.Filter) squirrel.SelectBuilder {
+	sb := pgBuilder.Select().
+		Column("unnest(tags) AS tag").
+		Column("COUNT(*) AS cnt")
+
+	return q.prepareBase(ctx, sb, filter).
+		GroupBy("tag").
+		OrderBy("cnt DESC", "tag")
+}
+
+func queryTags(ctx context.Context, tx pgx.Tx, sql string, args []interface{}) ([]*orderModel.TagFacet, error) {
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]*orderModel.TagFacet, 0)
+
+	for rows.Next() {
+		facet := &orderModel.TagFacet{}
+
+		if err = rows.Scan(&facet.Tag, &facet.Count); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		res = append(res, facet)
+	}
+
+	return res, rows.Err()
+}
+
+var (
+	pgBuilder   = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+)
+
+func (q *querier) prepare(ctx context.Context, sb squirrel.SelectBuilder, filter *orderModel.Filter, orders []*order.Order, pagination *paginator.Pagination) (squirrel.SelectBuilder, error) {
+	sb = q.prepareBase(ctx, sb, filter)
 
 	if len(orders) > 0 {
 		prepareOrders, err := q.prepareOrder(orders)
@@ -154,9 +321,14 @@ func (q *querier) prepare(sb squirrel.SelectBuilder, filter *orderModel.Filter,
 	return sb, nil
 }
 
-func (q *querier) prepareBase(builder squirrel.SelectBuilder, filter *orderModel.Filter) squirrel.SelectBuilder {
+// prepareBase keeps a request made in a tenant to the orders of that tenant whatever the filter asks
+func (q *querier) prepareBase(ctx context.Context, builder squirrel.SelectBuilder, filter *orderModel.Filter) squirrel.SelectBuilder {
 	where := squirrel.And{}
 
+	if scope, ok := tenant.ScopeFromContext(ctx); ok {
+		where = append(where, tenantCondition(scope.TenantID))
+	}
+
 	if filter != nil {
 		if filter.IDs.IsSet() {
 			where = append(where, squirrel.Eq{"id": filter.IDs.Value()})
@@ -167,7 +339,66 @@ func (q *querier) prepareBase(builder squirrel.SelectBuilder, filter *orderModel
 		}
 
 		if filter.UserID.IsSet() {
-			where = append(where, squirrel.Eq{"user_id": filter.UserID.Value()})
+			if filter.SharedIDs.IsSet() {
+				where = append(where, squirrel.Or{
+					squirrel.Eq{"user_id": filter.UserID.Value()},
+					squirrel.Eq{"id": filter.SharedIDs.Value()},
+				})
+			} else {
+				where = append(where, squirrel.Eq{"user_id": filter.UserID.Value()})
+			}
+		}
+
+		if filter.TenantID.IsSet() {
+			where = append(where, tenantCondition(filter.TenantID.Value()))
+		}
+
+		if filter.Number.IsSet() {
+			where = append(where,
+				squirrel.Eq{"number": filter.Number.Value()},
+				tenantCondition(orderModel.NumberTenant(ctx, filter)))
+		}
+
+		if filter.Q.IsSet() {
+			search := q.prepareSearch(filter.Q.Value())
+
+			if len(filter.CommentedIDs.Value()) > 0 {
+				search = append(search, squirrel.Eq{"id": filter.CommentedIDs.Value()})
+			}
+
+			// an order number matches in the scope the es number query takes
+			if number, ok := orderModel.ParseNumber(filter.Q.Value()); ok {
+				search = append(search, squirrel.And{
+					squirrel.Eq{"number": number},
+					tenantCondition(orderModel.NumberTenant(ctx, filter)),
+				})
+			}
+
+			where = append(where, search)
+		}
+
+		if filter.ModifiedBefore.IsSet() {
+			where = append(where, squirrel.Lt{"ts_modify": filter.ModifiedBefore.Value()})
+		}
+
+		if filter.Tags.IsSet() {
+			where = append(where, squirrel.Expr("tags @> ?", filter.Tags.Value()))
+		}
+
+		if filter.TagsAny.IsSet() {
+			where = append(where, squirrel.Expr("tags && ?", filter.TagsAny.Value()))
+		}
+
+		if filter.Metadata.IsSet() {
+			where = append(where, metadataCondition(filter.Metadata.Value()))
+		}
+
+		if filter.Draft.IsSet() {
+			if filter.Draft.Value() {
+				where = append(where, squirrel.Eq{"state": int(orderModel.StateDraft)})
+			} else {
+				where = append(where, squirrel.NotEq{"state": int(orderModel.StateDraft)})
+			}
 		}
 	}
 
@@ -177,6 +408,50 @@ func (q *querier) prepareBase(builder squirrel.SelectBuilder, filter *orderModel
 	return builder
 }
 
+// tenantCondition matches the personal orders for an empty tenant
+func tenantCondition(tenantID string) squirrel.Sqlizer {
+	if tenantID == "" {
+		return squirrel.Eq{"tenant_id": nil}
+	}
+
+	return squirrel.Eq{"tenant_id": tenantID}
+}
+
+// prepareSearch mirrors the es multi-language_analyzer with the english and
+// russian text search configurations, plus a name prefix match and
+// address city and postal code matches.
+func (q *querier) prepareSearch(value string) squirrel.Or {
+	return squirrel.Or{
+		squirrel.Expr(
+			"search @@ (websearch_to_tsquery('english', ?) || websearch_to_tsquery('russian', ?))",
+			value, value,
+		),
+		squirrel.ILike{"name": likeEscaper.Replace(value) + "%"},
+		squirrel.Expr("lower(shipping_address->>'city') = lower(?)", value),
+		squirrel.Expr("lower(billing_address->>'city') = lower(?)", value),
+		squirrel.Expr("lower(shipping_address->>'postal_code') = lower(?)", value),
+		squirrel.Expr("lower(billing_address->>'postal_code') = lower(?)", value),
+		squirrel.Expr("EXISTS (SELECT 1 FROM jsonb_each_text(metadata) m WHERE m.value = ?)", value),
+	}
+}
+
+// metadataCondition matches every pair by containment, which the gin index of metadata serves
+func metadataCondition(metadata map[string]string) squirrel.Sqlizer {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	cond := make(squirrel.And, 0, len(keys))
+	for _, key := range keys {
+		cond = append(cond, squirrel.Expr("metadata @> jsonb_build_object(?::text, ?::text)", key, metadata[key]))
+	}
+
+	return cond
+}
+
 func (q *querier) prepareOrder(orders []*order.Order) ([]*order.Order, error) {
 	esOrders := make([]*order.Order, 0, len(orders))
 
@@ -192,6 +467,11 @@ func (q *querier) prepareOrder(orders []*order.Order) ([]*order.Order, error) {
 				Column:    idSortKey,
 				Direction: val.Direction,
 			})
+		case orderModel.ModifySortKey:
+			esOrders = append(esOrders, &order.Order{
+				Column:    modifySortKey,
+				Direction: val.Direction,
+			})
 		default:
 			return nil, fmt.Errorf("invalid sort column = %s", val.Column)
 		}
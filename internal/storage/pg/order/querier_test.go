@@ -0,0 +1,69 @@
+package order_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	orderModel "github.com/krivenkov/order/internal/model/order"
+	pgOrder "github.com/krivenkov/order/internal/storage/pg/order"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/option"
+	"github.com/krivenkov/pkg/paginator"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSearchNumber checks the search finds an order by its number like the es one, within the tenant asked
+func TestSearchNumber(t *testing.T) {
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", dsnEnv)
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var (
+		querier = pgOrder.NewQuerier(database.NewTXer(pool))
+
+		userID   = uuid.NewString()
+		tenantID = uuid.NewString()
+		personal = uuid.NewString()
+		shared   = uuid.NewString()
+		number   = fmt.Sprintf("ORD-1999-%09d", time.Now().UnixNano()%1e9)
+	)
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".tenants (id, name) VALUES ($1, 'search test')`, tenantID)
+	require.NoError(t, err)
+
+	// both orders have the same number, each in its own tenant
+	for id, tenant := range map[string]*string{personal: nil, shared: &tenantID} {
+		_, err = pool.Exec(ctx, `INSERT INTO "order".items (id, status, name, description, user_id, tenant_id, number) VALUES ($1, 1, '', '', $2, $3, $4)`,
+			id, userID, tenant, number)
+		require.NoError(t, err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".items WHERE id = ANY($1)`, []string{personal, shared})
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".tenants WHERE id = $1`, tenantID)
+	})
+
+	for tenant, id := range map[string]string{"": personal, tenantID: shared} {
+		items, err := querier.GetList(ctx, &orderModel.Filter{
+			UserID:   option.New(userID),
+			TenantID: option.New(tenant),
+			Q:        option.New(strings.ToLower(number)),
+		}, nil, &paginator.Pagination{Limit: 100})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		require.Equal(t, id, items[0].ID)
+	}
+}
@@ -0,0 +1,61 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) payment.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, item *payment.Payment) error {
+	d := newDto()
+	d.fromModel(item)
+
+	// a success after a failure of the same reference is a retry which went through,
+	// the failed row takes the success and keeps its id
+	sql, args, err := pgBuilder.
+		Insert(tableName).
+		Columns(d.columns()...).
+		Values(d.id, d.tsCreate, d.orderID, d.provider, d.externalRef, d.amount, d.currency, d.status, d.reason).
+		Suffix(`ON CONFLICT (provider, external_ref) DO UPDATE
+			SET ts_create = excluded.ts_create, amount = excluded.amount, currency = excluded.currency,
+				status = excluded.status, reason = excluded.reason
+			WHERE `+tableName+`.order_id = excluded.order_id AND `+tableName+`.status = ? AND excluded.status = ?
+			RETURNING id`, int(payment.StatusFailed), int(payment.StatusSucceeded)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("create query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var id string
+
+		if errScan := tx.QueryRow(ctx, sql, args...).Scan(&id); errScan != nil {
+			if errors.Is(errScan, pgx.ErrNoRows) {
+				return payment.ErrDuplicate
+			}
+
+			return errScan
+		}
+
+		item.ID = id
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
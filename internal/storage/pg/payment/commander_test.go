@@ -0,0 +1,77 @@
+package payment_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/krivenkov/order/internal/model/payment"
+	pgPayment "github.com/krivenkov/order/internal/storage/pg/payment"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/option"
+	"github.com/stretchr/testify/require"
+)
+
+// dsnEnv points the test to a migrated database, e.g. the one of make migrate.local.up
+const dsnEnv = "ORDER_TEST_DB_DSN"
+
+// TestCreateRetried checks a success of a reference takes the place of its failure and nothing else does
+func TestCreateRetried(t *testing.T) {
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", dsnEnv)
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var (
+		tXer      = database.NewTXer(pool)
+		commander = pgPayment.NewCommander(tXer)
+		querier   = pgPayment.NewQuerier(tXer)
+
+		orderID = uuid.NewString()
+		attempt = func(status payment.Status) *payment.Payment {
+			return &payment.Payment{
+				ID:          uuid.NewString(),
+				TSCreate:    time.Now(),
+				OrderID:     orderID,
+				Provider:    "test",
+				ExternalRef: "retry-" + orderID,
+				Amount:      100,
+				Currency:    "EUR",
+				Status:      status,
+			}
+		}
+	)
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".items (id, status, name, description, user_id, number) VALUES ($1, 1, '', '', $2, $3)`,
+		orderID, uuid.NewString(), "RETRY-"+orderID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".items WHERE id = $1`, orderID)
+	})
+
+	failed := attempt(payment.StatusFailed)
+	require.NoError(t, commander.Create(ctx, failed))
+	require.ErrorIs(t, commander.Create(ctx, attempt(payment.StatusFailed)), payment.ErrDuplicate)
+
+	succeeded := attempt(payment.StatusSucceeded)
+	require.NoError(t, commander.Create(ctx, succeeded))
+	require.Equal(t, failed.ID, succeeded.ID)
+
+	require.ErrorIs(t, commander.Create(ctx, attempt(payment.StatusSucceeded)), payment.ErrDuplicate)
+	require.ErrorIs(t, commander.Create(ctx, attempt(payment.StatusFailed)), payment.ErrDuplicate)
+
+	payments, err := querier.GetList(ctx, &payment.Filter{OrderID: option.New(orderID)})
+	require.NoError(t, err)
+	require.Len(t, payments, 1)
+	require.Equal(t, payment.StatusSucceeded, payments[0].Status)
+}
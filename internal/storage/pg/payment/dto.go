@@ -0,0 +1,71 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/payment"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.payment.dto: len(columns) != len(values)")
+	}
+}
+
+const tableName = `"order".payments`
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+
+	orderID     string
+	provider    string
+	externalRef string
+	amount      int64
+	currency    string
+	status      int
+	reason      string
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "order_id", "provider", "external_ref", "amount", "currency", "status", "reason"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.orderID, &d.provider, &d.externalRef, &d.amount, &d.currency, &d.status, &d.reason}
+}
+
+func (d *dto) toModel() *payment.Payment {
+	return &payment.Payment{
+		ID:          d.id,
+		TSCreate:    d.tsCreate,
+		OrderID:     d.orderID,
+		Provider:    d.provider,
+		ExternalRef: d.externalRef,
+		Amount:      d.amount,
+		Currency:    d.currency,
+		Status:      payment.Status(d.status),
+		Reason:      d.reason,
+	}
+}
+
+func (d *dto) fromModel(source *payment.Payment) {
+	target := dto{
+		id:          source.ID,
+		tsCreate:    source.TSCreate,
+		orderID:     source.OrderID,
+		provider:    source.Provider,
+		externalRef: source.ExternalRef,
+		amount:      source.Amount,
+		currency:    source.Currency,
+		status:      int(source.Status),
+		reason:      source.Reason,
+	}
+
+	*d = target
+}
@@ -0,0 +1,10 @@
+package payment
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"payment_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"payment_pg_qr"`)),
+	),
+)
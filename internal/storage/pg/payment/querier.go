@@ -0,0 +1,67 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/payment"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) payment.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetList(ctx context.Context, filter *payment.Filter) ([]*payment.Payment, error) {
+	sql, args, err := q.prepareBase(pgBuilder.Select(newDto().columns()...), filter).
+		OrderBy("ts_create", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*payment.Payment
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (q *querier) prepareBase(builder squirrel.SelectBuilder, filter *payment.Filter) squirrel.SelectBuilder {
+	where := squirrel.And{}
+
+	if filter != nil {
+		if filter.OrderID.IsSet() {
+			where = append(where, squirrel.Eq{"order_id": filter.OrderID.Value()})
+		}
+	}
+
+	return builder.From(tableName).Where(where)
+}
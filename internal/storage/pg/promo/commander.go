@@ -0,0 +1,148 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+var errCodeTaken = fmt.Errorf("%w: promo code already exists", model.ErrConflict)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) promo.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+// step is a query which must affect a row, otherwise the command fails with err
+type step struct {
+	query squirrel.Sqlizer
+	err   error
+}
+
+func (c *commander) Create(ctx context.Context, item *promo.Promo) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, step{
+		query: pgBuilder.
+			Insert(tableName).
+			Columns(d.columns()...).
+			Values(d.id, d.tsCreate, d.tsModify, d.code, d.kind, d.scope, d.value, d.currency, d.skus, d.minAmount,
+				d.validFrom, d.validTo, d.maxUses, d.maxUsesPerUser, d.uses).
+			Suffix("ON CONFLICT (code) DO NOTHING"),
+		err: errCodeTaken,
+	})
+}
+
+func (c *commander) Update(ctx context.Context, item *promo.Promo) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, step{
+		query: pgBuilder.
+			Update(tableName).
+			Set("ts_modify", d.tsModify).
+			Set("code", d.code).
+			Set("kind", d.kind).
+			Set("scope", d.scope).
+			Set("value", d.value).
+			Set("currency", d.currency).
+			Set("skus", d.skus).
+			Set("min_amount", d.minAmount).
+			Set("valid_from", d.validFrom).
+			Set("valid_to", d.validTo).
+			Set("max_uses", d.maxUses).
+			Set("max_uses_per_user", d.maxUsesPerUser).
+			Where(squirrel.Eq{"id": d.id}).
+			Where(`NOT EXISTS (SELECT 1 FROM "order".promos p WHERE p.code = ? AND p.id <> ?)`, d.code, d.id),
+		err: errCodeTaken,
+	})
+}
+
+func (c *commander) Delete(ctx context.Context, id string) error {
+	return c.exec(ctx, step{
+		query: pgBuilder.Delete(tableName).Where(squirrel.Eq{"id": id}),
+		err:   model.ErrNotFound,
+	})
+}
+
+// Redeem locks the promo row with the uses increment first, so the per-user count
+// which follows sees the redemptions of every concurrent order with the code
+func (c *commander) Redeem(ctx context.Context, items ...*promo.Redemption) error {
+	steps := make([]step, 0, 2*len(items))
+
+	for _, item := range items {
+		steps = append(steps, step{
+			query: pgBuilder.
+				Update(tableName).
+				Set("uses", squirrel.Expr("uses + 1")).
+				Where(squirrel.Eq{"id": item.PromoID}).
+				Where("(max_uses = 0 OR uses < max_uses)"),
+			err: promo.ErrLimitReached,
+		}, step{
+			query: pgBuilder.
+				Insert(redemptionsTableName).
+				Columns("id", "ts_create", "promo_id", "order_id", "user_id", "amount").
+				Select(squirrel.Select().
+					Column(squirrel.Expr("?::uuid, ?::timestamp, p.id, ?::uuid, ?::uuid, ?::bigint",
+						item.ID, item.TSCreate, item.OrderID, item.UserID, item.Amount)).
+					From(tableName+" p").
+					Where(squirrel.Eq{"p.id": item.PromoID}).
+					Where("(p.max_uses_per_user = 0 OR p.max_uses_per_user > "+
+						"(SELECT count(*) FROM "+redemptionsTableName+" r WHERE r.promo_id = p.id AND r.user_id = ?))", item.UserID)),
+			err: promo.ErrLimitReached,
+		})
+	}
+
+	return c.exec(ctx, steps...)
+}
+
+func (c *commander) ReplaceUser(ctx context.Context, userID, by string) error {
+	sql, args, err := pgBuilder.
+		Update(redemptionsTableName).
+		Set("user_id", by).
+		Where(squirrel.Eq{"user_id": userID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, errExec := tx.Exec(ctx, sql, args...)
+		return errExec
+	})
+}
+
+func (c *commander) exec(ctx context.Context, steps ...step) error {
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, st := range steps {
+			sql, args, err := st.query.ToSql()
+			if err != nil {
+				return fmt.Errorf("prepare query: %w", err)
+			}
+
+			tag, err := tx.Exec(ctx, sql, args...)
+			if err != nil {
+				return fmt.Errorf("exec: %w", err)
+			}
+
+			if tag.RowsAffected() == 0 {
+				return st.err
+			}
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
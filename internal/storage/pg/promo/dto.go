@@ -0,0 +1,101 @@
+package promo
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/promo"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.promo.dto: len(columns) != len(values)")
+	}
+}
+
+const (
+	tableName            = `"order".promos`
+	redemptionsTableName = `"order".promo_redemptions`
+)
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+	tsModify time.Time
+
+	code      string
+	kind      int64
+	scope     int64
+	value     int64
+	currency  string
+	skus      []string
+	minAmount int64
+
+	validFrom *time.Time
+	validTo   *time.Time
+
+	maxUses        int
+	maxUsesPerUser int
+	uses           int
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "ts_modify", "code", "kind", "scope", "value", "currency", "skus", "min_amount",
+		"valid_from", "valid_to", "max_uses", "max_uses_per_user", "uses"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.tsModify, &d.code, &d.kind, &d.scope, &d.value, &d.currency, &d.skus, &d.minAmount,
+		&d.validFrom, &d.validTo, &d.maxUses, &d.maxUsesPerUser, &d.uses}
+}
+
+func (d *dto) toModel() *promo.Promo {
+	return &promo.Promo{
+		ID:             d.id,
+		TSCreate:       d.tsCreate,
+		TSModify:       d.tsModify,
+		Code:           d.code,
+		Kind:           promo.Kind(d.kind),
+		Scope:          promo.Scope(d.scope),
+		Value:          d.value,
+		Currency:       d.currency,
+		SKUs:           d.skus,
+		MinAmount:      d.minAmount,
+		ValidFrom:      d.validFrom,
+		ValidTo:        d.validTo,
+		MaxUses:        d.maxUses,
+		MaxUsesPerUser: d.maxUsesPerUser,
+		Uses:           d.uses,
+	}
+}
+
+func (d *dto) fromModel(source *promo.Promo) {
+	skus := source.SKUs
+	if skus == nil {
+		skus = []string{}
+	}
+
+	target := dto{
+		id:             source.ID,
+		tsCreate:       source.TSCreate,
+		tsModify:       source.TSModify,
+		code:           source.Code,
+		kind:           int64(source.Kind),
+		scope:          int64(source.Scope),
+		value:          source.Value,
+		currency:       source.Currency,
+		skus:           skus,
+		minAmount:      source.MinAmount,
+		validFrom:      source.ValidFrom,
+		validTo:        source.ValidTo,
+		maxUses:        source.MaxUses,
+		maxUsesPerUser: source.MaxUsesPerUser,
+		uses:           source.Uses,
+	}
+
+	*d = target
+}
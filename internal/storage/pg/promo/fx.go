@@ -0,0 +1,10 @@
+package promo
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"promo_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"promo_pg_qr"`)),
+	),
+)
@@ -0,0 +1,120 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/promo"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) promo.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetItem(ctx context.Context, filter *promo.Filter) (*promo.Promo, error) {
+	res, err := q.GetList(ctx, filter, &paginator.Pagination{Limit: 2})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(res) {
+	case 0:
+		return nil, model.ErrNotFound
+	case 1:
+		return res[0], nil
+	default:
+		return nil, model.ErrMultiItems
+	}
+}
+
+func (q *querier) GetList(ctx context.Context, filter *promo.Filter, pagination *paginator.Pagination) ([]*promo.Promo, error) {
+	sb := pgBuilder.Select(newDto().columns()...).
+		From(tableName).
+		Where(prepareWhere(filter)).
+		OrderBy("code")
+
+	if pagination != nil {
+		sb = sb.Offset(uint64(pagination.Offset)).Limit(uint64(pagination.Limit))
+	}
+
+	sql, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*promo.Promo
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (q *querier) Count(ctx context.Context, filter *promo.Filter) (int, error) {
+	sql, args, err := pgBuilder.Select("COUNT(*)").
+		From(tableName).
+		Where(prepareWhere(filter)).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var count int
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if errScan := tx.QueryRow(ctx, sql, args...).Scan(&count); errScan != nil {
+			return fmt.Errorf("scan: %w", errScan)
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func prepareWhere(filter *promo.Filter) squirrel.And {
+	where := squirrel.And{}
+
+	if filter != nil {
+		if filter.IDs.IsSet() {
+			where = append(where, squirrel.Eq{"id": filter.IDs.Value()})
+		}
+
+		if filter.Codes.IsSet() {
+			where = append(where, squirrel.Eq{"code": filter.Codes.Value()})
+		}
+	}
+
+	return where
+}
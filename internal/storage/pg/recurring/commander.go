@@ -0,0 +1,107 @@
+package recurring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/recurring"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) recurring.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, item *recurring.Template) error {
+	d := newDto()
+	if err := d.fromModel(item); err != nil {
+		return err
+	}
+
+	return c.exec(ctx, pgBuilder.
+		Insert(tableName).
+		Columns(d.columns()...).
+		Values(d.id, d.tsCreate, d.tsModify, d.userID, d.name, d.cron, d.interval, d.nextRun, d.paused, d.form),
+		model.ErrConflict)
+}
+
+func (c *commander) Update(ctx context.Context, item *recurring.Template) error {
+	d := newDto()
+	if err := d.fromModel(item); err != nil {
+		return err
+	}
+
+	return c.exec(ctx, pgBuilder.
+		Update(tableName).
+		Set("ts_modify", d.tsModify).
+		Set("name", d.name).
+		Set("cron", d.cron).
+		Set("interval", d.interval).
+		Set("next_run", d.nextRun).
+		Set("paused", d.paused).
+		Set("form", d.form).
+		Where(squirrel.Eq{"id": d.id}),
+		model.ErrNotFound)
+}
+
+func (c *commander) Delete(ctx context.Context, id string) error {
+	return c.exec(ctx, pgBuilder.Delete(tableName).Where(squirrel.Eq{"id": id}), model.ErrNotFound)
+}
+
+func (c *commander) DeleteByUser(ctx context.Context, userID string) error {
+	return c.exec(ctx, pgBuilder.Delete(tableName).Where(squirrel.Eq{"user_id": userID}), nil)
+}
+
+// Claim compares the next run with the one the scheduler read, so of two schedulers
+// which read the same template only one materialises the order
+func (c *commander) Claim(ctx context.Context, id string, scheduledAt, nextRun time.Time) error {
+	return c.exec(ctx, pgBuilder.
+		Update(tableName).
+		Set("next_run", nextRun).
+		Where(squirrel.Eq{"id": id, "next_run": scheduledAt, "paused": false}),
+		model.ErrConflict)
+}
+
+func (c *commander) CreateRun(ctx context.Context, item *recurring.Run) error {
+	d := newRunDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Insert(runsTableName).
+		Columns(d.columns()...).
+		Values(d.id, d.tsCreate, d.templateID, d.scheduledAt, d.orderID, d.error),
+		model.ErrNotFound)
+}
+
+// exec runs the query, when errNoRows is set the query must affect a row
+func (c *commander) exec(ctx context.Context, query squirrel.Sqlizer, errNoRows error) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		tag, errExec := tx.Exec(ctx, sql, args...)
+		if errExec != nil {
+			return fmt.Errorf("exec: %w", errExec)
+		}
+
+		if errNoRows != nil && tag.RowsAffected() == 0 {
+			return errNoRows
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
@@ -0,0 +1,280 @@
+package recurring
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/krivenkov/order/internal/model/line"
+	"github.com/krivenkov/order/internal/model/order"
+	"github.com/krivenkov/order/internal/model/recurring"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.recurring.dto: len(columns) != len(values)")
+	}
+
+	r := newRunDto()
+	if len(r.columns()) != len(r.values()) {
+		panic("order.recurring.runDto: len(columns) != len(values)")
+	}
+}
+
+const (
+	tableName     = `"order".recurring_templates`
+	runsTableName = `"order".recurring_runs`
+)
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+	tsModify time.Time
+
+	userID   string
+	name     string
+	cron     string
+	interval int64
+	nextRun  time.Time
+	paused   bool
+	form     []byte
+}
+
+// formDto is the order form of the template, it is kept as the API accepts it and validated again on every run
+type formDto struct {
+	Name            *string           `json:"name,omitempty"`
+	Description     *string           `json:"description,omitempty"`
+	ShippingAddress *addressDto       `json:"shipping_address,omitempty"`
+	BillingAddress  *addressDto       `json:"billing_address,omitempty"`
+	Currency        *string           `json:"currency,omitempty"`
+	Lines           []*lineDto        `json:"lines"`
+	PromoCodes      []string          `json:"promo_codes,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+}
+
+type addressDto struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+	Phone      string `json:"phone"`
+}
+
+type lineDto struct {
+	SKU         string `json:"sku"`
+	Name        string `json:"name,omitempty"`
+	Quantity    int    `json:"quantity"`
+	UnitPrice   int64  `json:"unit_price,omitempty"`
+	TaxCategory string `json:"tax_category,omitempty"`
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "ts_modify", "user_id", "name", "cron", "interval", "next_run", "paused", "form"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.tsModify, &d.userID, &d.name, &d.cron, &d.interval, &d.nextRun, &d.paused, &d.form}
+}
+
+func (d *dto) toModel() (*recurring.Template, error) {
+	form, err := formToModel(d.form)
+	if err != nil {
+		return nil, fmt.Errorf("form: %w", err)
+	}
+
+	return &recurring.Template{
+		ID:       d.id,
+		TSCreate: d.tsCreate,
+		TSModify: d.tsModify,
+		UserID:   d.userID,
+		Name:     d.name,
+		Schedule: recurring.Schedule{
+			Cron:     d.cron,
+			Interval: time.Duration(d.interval) * time.Second,
+		},
+		NextRun: d.nextRun,
+		Paused:  d.paused,
+		Order:   form,
+	}, nil
+}
+
+func (d *dto) fromModel(source *recurring.Template) error {
+	form, err := formFromModel(source.Order)
+	if err != nil {
+		return fmt.Errorf("form: %w", err)
+	}
+
+	target := dto{
+		id:       source.ID,
+		tsCreate: source.TSCreate,
+		tsModify: source.TSModify,
+		userID:   source.UserID,
+		name:     source.Name,
+		cron:     source.Schedule.Cron,
+		interval: int64(source.Schedule.Interval / time.Second),
+		nextRun:  source.NextRun,
+		paused:   source.Paused,
+		form:     form,
+	}
+
+	*d = target
+
+	return nil
+}
+
+func formToModel(data []byte) (*order.Form, error) {
+	var f formDto
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	lines := make([]*line.Form, 0, len(f.Lines))
+	for _, l := range f.Lines {
+		lines = append(lines, &line.Form{
+			SKU:         l.SKU,
+			Name:        l.Name,
+			Quantity:    l.Quantity,
+			UnitPrice:   l.UnitPrice,
+			TaxCategory: l.TaxCategory,
+		})
+	}
+
+	return &order.Form{
+		Name:            f.Name,
+		Description:     f.Description,
+		ShippingAddress: addressToModel(f.ShippingAddress),
+		BillingAddress:  addressToModel(f.BillingAddress),
+		Currency:        f.Currency,
+		Lines:           lines,
+		PromoCodes:      f.PromoCodes,
+		Tags:            f.Tags,
+		Metadata:        f.Metadata,
+	}, nil
+}
+
+func formFromModel(source *order.Form) ([]byte, error) {
+	lines := make([]*lineDto, 0, len(source.Lines))
+	for _, l := range source.Lines {
+		lines = append(lines, &lineDto{
+			SKU:         l.SKU,
+			Name:        l.Name,
+			Quantity:    l.Quantity,
+			UnitPrice:   l.UnitPrice,
+			TaxCategory: l.TaxCategory,
+		})
+	}
+
+	data, err := json.Marshal(&formDto{
+		Name:            source.Name,
+		Description:     source.Description,
+		ShippingAddress: addressFromModel(source.ShippingAddress),
+		BillingAddress:  addressFromModel(source.BillingAddress),
+		Currency:        source.Currency,
+		Lines:           lines,
+		PromoCodes:      source.PromoCodes,
+		Tags:            source.Tags,
+		Metadata:        source.Metadata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	return data, nil
+}
+
+func addressToModel(a *addressDto) *order.Address {
+	if a == nil {
+		return nil
+	}
+
+	return &order.Address{
+		Name:       a.Name,
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+		Phone:      a.Phone,
+	}
+}
+
+func addressFromModel(source *order.Address) *addressDto {
+	if source == nil {
+		return nil
+	}
+
+	return &addressDto{
+		Name:       source.Name,
+		Line1:      source.Line1,
+		Line2:      source.Line2,
+		City:       source.City,
+		Region:     source.Region,
+		PostalCode: source.PostalCode,
+		Country:    source.Country,
+		Phone:      source.Phone,
+	}
+}
+
+type runDto struct {
+	id       string
+	tsCreate time.Time
+
+	templateID  string
+	scheduledAt time.Time
+	orderID     *string
+	error       string
+}
+
+func newRunDto() *runDto {
+	return &runDto{}
+}
+
+func (d *runDto) columns() []string {
+	return []string{"id", "ts_create", "template_id", "scheduled_at", "order_id", "error"}
+}
+
+func (d *runDto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.templateID, &d.scheduledAt, &d.orderID, &d.error}
+}
+
+func (d *runDto) toModel() *recurring.Run {
+	res := &recurring.Run{
+		ID:          d.id,
+		TSCreate:    d.tsCreate,
+		TemplateID:  d.templateID,
+		ScheduledAt: d.scheduledAt,
+		Error:       d.error,
+	}
+
+	if d.orderID != nil {
+		res.OrderID = *d.orderID
+	}
+
+	return res
+}
+
+func (d *runDto) fromModel(source *recurring.Run) {
+	target := runDto{
+		id:          source.ID,
+		tsCreate:    source.TSCreate,
+		templateID:  source.TemplateID,
+		scheduledAt: source.ScheduledAt,
+		error:       source.Error,
+	}
+
+	if source.OrderID != "" {
+		target.orderID = &source.OrderID
+	}
+
+	*d = target
+}
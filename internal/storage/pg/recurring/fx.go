@@ -0,0 +1,10 @@
+package recurring
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"recurring_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"recurring_pg_qr"`)),
+	),
+)
@@ -0,0 +1,187 @@
+package recurring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/recurring"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/paginator"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) recurring.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetItem(ctx context.Context, filter *recurring.Filter) (*recurring.Template, error) {
+	res, err := q.GetList(ctx, filter, &paginator.Pagination{Limit: 2})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(res) {
+	case 0:
+		return nil, model.ErrNotFound
+	case 1:
+		return res[0], nil
+	default:
+		return nil, model.ErrMultiItems
+	}
+}
+
+func (q *querier) GetList(ctx context.Context, filter *recurring.Filter, pagination *paginator.Pagination) ([]*recurring.Template, error) {
+	sb := pgBuilder.Select(newDto().columns()...).
+		From(tableName).
+		Where(prepareWhere(filter)).
+		OrderBy("next_run", "id")
+
+	if pagination != nil {
+		sb = sb.Offset(uint64(pagination.Offset)).Limit(uint64(pagination.Limit))
+	}
+
+	sql, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*recurring.Template
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			item, errModel := d.toModel()
+			if errModel != nil {
+				return fmt.Errorf("template %s: %w", d.id, errModel)
+			}
+
+			res = append(res, item)
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (q *querier) Count(ctx context.Context, filter *recurring.Filter) (int, error) {
+	return q.count(ctx, pgBuilder.Select("COUNT(*)").
+		From(tableName).
+		Where(prepareWhere(filter)))
+}
+
+func (q *querier) GetRuns(ctx context.Context, templateID string, pagination *paginator.Pagination) ([]*recurring.Run, error) {
+	sb := pgBuilder.Select(newRunDto().columns()...).
+		From(runsTableName).
+		Where(squirrel.Eq{"template_id": templateID}).
+		OrderBy("ts_create DESC", "id")
+
+	if pagination != nil {
+		sb = sb.Offset(uint64(pagination.Offset)).Limit(uint64(pagination.Limit))
+	}
+
+	sql, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*recurring.Run
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newRunDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (q *querier) CountRuns(ctx context.Context, templateID string) (int, error) {
+	return q.count(ctx, pgBuilder.Select("COUNT(*)").
+		From(runsTableName).
+		Where(squirrel.Eq{"template_id": templateID}))
+}
+
+func (q *querier) count(ctx context.Context, query squirrel.Sqlizer) (int, error) {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var count int
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if errScan := tx.QueryRow(ctx, sql, args...).Scan(&count); errScan != nil {
+			return fmt.Errorf("scan: %w", errScan)
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func prepareWhere(filter *recurring.Filter) squirrel.And {
+	where := squirrel.And{}
+
+	if filter != nil {
+		if filter.IDs.IsSet() {
+			where = append(where, squirrel.Eq{"id": filter.IDs.Value()})
+		}
+
+		if filter.UserID.IsSet() {
+			where = append(where, squirrel.Eq{"user_id": filter.UserID.Value()})
+		}
+
+		if filter.Paused.IsSet() {
+			where = append(where, squirrel.Eq{"paused": filter.Paused.Value()})
+		}
+
+		if filter.DueBefore.IsSet() {
+			where = append(where, squirrel.LtOrEq{"next_run": filter.DueBefore.Value()})
+		}
+
+		if filter.ExcludeIDs.IsSet() {
+			where = append(where, squirrel.NotEq{"id": filter.ExcludeIDs.Value()})
+		}
+	}
+
+	return where
+}
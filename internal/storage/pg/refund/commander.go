@@ -0,0 +1,112 @@
+package refund
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) refund.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) CreateReturn(ctx context.Context, item *refund.Return) error {
+	d := newReturnDto()
+	d.fromModel(item)
+
+	ib := pgBuilder.Insert(returnsTableName).
+		Columns(d.columns()...).
+		Values(d.id, d.tsCreate, d.tsModify, d.orderID, d.lineID, d.quantity, d.reason, d.status, d.comment, d.decidedBy)
+
+	return c.exec(ctx, ib)
+}
+
+func (c *commander) UpdateReturn(ctx context.Context, item *refund.Return) error {
+	d := newReturnDto()
+	d.fromModel(item)
+
+	ub := pgBuilder.Update(returnsTableName).
+		Set("ts_modify", d.tsModify).
+		Set("status", d.status).
+		Set("comment", d.comment).
+		Set("decided_by", d.decidedBy).
+		Where(squirrel.Eq{"id": d.id, "status": int64(refund.StatusRequested)})
+
+	sql, args, err := ub.ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		tag, errExec := tx.Exec(ctx, sql, args...)
+		if errExec != nil {
+			return fmt.Errorf("exec: %w", errExec)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: return %s is decided already", model.ErrConflict, d.id)
+		}
+
+		return nil
+	})
+}
+
+func (c *commander) CreateRefunds(ctx context.Context, items ...*refund.Refund) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	ib := pgBuilder.Insert(refundsTableName).Columns(newRefundDto().columns()...)
+
+	for _, item := range items {
+		d := newRefundDto()
+		d.fromModel(item)
+
+		ib = ib.Values(d.id, d.tsCreate, d.orderID, d.returnID, d.paymentID, d.amount, d.currency)
+	}
+
+	return c.exec(ctx, ib)
+}
+
+func (c *commander) SetPublished(ctx context.Context, ts time.Time, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	ub := pgBuilder.Update(refundsTableName).
+		Set("ts_published", ts).
+		Where(squirrel.Eq{"id": ids})
+
+	return c.exec(ctx, ub)
+}
+
+func (c *commander) exec(ctx context.Context, builders ...squirrel.Sqlizer) error {
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, b := range builders {
+			sql, args, err := b.ToSql()
+			if err != nil {
+				return fmt.Errorf("prepare query: %w", err)
+			}
+
+			if _, err = tx.Exec(ctx, sql, args...); err != nil {
+				return fmt.Errorf("exec: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
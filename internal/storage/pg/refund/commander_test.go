@@ -0,0 +1,179 @@
+package refund_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/refund"
+	pgRefund "github.com/krivenkov/order/internal/storage/pg/refund"
+	"github.com/krivenkov/pkg/clients/database"
+	"github.com/krivenkov/pkg/option"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dsnEnv points the test to a migrated database, e.g. the one of make migrate.local.up
+const dsnEnv = "ORDER_TEST_DB_DSN"
+
+func TestUpdateReturnRace(t *testing.T) {
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", dsnEnv)
+	}
+
+	const deciders = 10
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var (
+		commander = pgRefund.NewCommander(database.NewTXer(pool))
+
+		orderID = uuid.NewString()
+		lineID  = uuid.NewString()
+		ret     = &refund.Return{
+			ID:       uuid.NewString(),
+			TSCreate: time.Now(),
+			TSModify: time.Now(),
+			OrderID:  orderID,
+			LineID:   lineID,
+			Quantity: 1,
+			Reason:   "broken",
+			Status:   refund.StatusRequested,
+		}
+	)
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".items (id, status, name, description, user_id, number) VALUES ($1, 1, '', '', $2, $3)`,
+		orderID, uuid.NewString(), "RACE-"+orderID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".items WHERE id = $1`, orderID)
+	})
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".lines (id, order_id, sku, name, quantity) VALUES ($1, $2, 'RACE', '', 1)`, lineID, orderID)
+	require.NoError(t, err)
+	require.NoError(t, commander.CreateReturn(ctx, ret))
+
+	// every decider approves the same return, only one decision is stored
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		decided int
+	)
+
+	for i := 0; i < deciders; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			decision := *ret
+			decision.Status = refund.StatusApproved
+			decision.TSModify = time.Now()
+
+			err := commander.UpdateReturn(ctx, &decision)
+			if errors.Is(err, model.ErrConflict) {
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			mu.Lock()
+			decided++
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, 1, decided)
+}
+
+// TestSetPublished checks a refund is left to the relay until it is marked as published
+func TestSetPublished(t *testing.T) {
+	dsn := os.Getenv(dsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", dsnEnv)
+	}
+
+	ctx := context.Background()
+
+	pool, err := pgxpool.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var (
+		tXer      = database.NewTXer(pool)
+		commander = pgRefund.NewCommander(tXer)
+		querier   = pgRefund.NewQuerier(tXer)
+
+		orderID   = uuid.NewString()
+		lineID    = uuid.NewString()
+		paymentID = uuid.NewString()
+		ret       = &refund.Return{
+			ID:       uuid.NewString(),
+			TSCreate: time.Now(),
+			TSModify: time.Now(),
+			OrderID:  orderID,
+			LineID:   lineID,
+			Quantity: 1,
+			Reason:   "broken",
+			Status:   refund.StatusApproved,
+		}
+		item = &refund.Refund{
+			ID:        uuid.NewString(),
+			TSCreate:  time.Now(),
+			OrderID:   orderID,
+			ReturnID:  ret.ID,
+			PaymentID: paymentID,
+			Amount:    100,
+			Currency:  "EUR",
+		}
+		unpublished = &refund.Filter{
+			OrderID:   option.New(orderID),
+			Published: option.New(false),
+		}
+	)
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".items (id, status, name, description, user_id, number) VALUES ($1, 1, '', '', $2, $3)`,
+		orderID, uuid.NewString(), "PUBLISH-"+orderID)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, `DELETE FROM "order".items WHERE id = $1`, orderID)
+	})
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".lines (id, order_id, sku, name, quantity) VALUES ($1, $2, 'PUBLISH', '', 1)`, lineID, orderID)
+	require.NoError(t, err)
+
+	_, err = pool.Exec(ctx, `INSERT INTO "order".payments (id, order_id, provider, external_ref, amount, currency, status) VALUES ($1, $2, 'test', $1, 100, 'EUR', 1)`,
+		paymentID, orderID)
+	require.NoError(t, err)
+
+	require.NoError(t, commander.CreateReturn(ctx, ret))
+	require.NoError(t, commander.CreateRefunds(ctx, item))
+
+	refunds, err := querier.GetRefunds(ctx, unpublished)
+	require.NoError(t, err)
+	require.Len(t, refunds, 1)
+	require.Equal(t, item.ID, refunds[0].ID)
+
+	require.NoError(t, commander.SetPublished(ctx, time.Now(), item.ID))
+
+	refunds, err = querier.GetRefunds(ctx, unpublished)
+	require.NoError(t, err)
+	require.Empty(t, refunds)
+}
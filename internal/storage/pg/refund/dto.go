@@ -0,0 +1,131 @@
+package refund
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/refund"
+)
+
+func init() {
+	r := newReturnDto()
+	if len(r.columns()) != len(r.values()) {
+		panic("order.return.dto: len(columns) != len(values)")
+	}
+
+	d := newRefundDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.refund.dto: len(columns) != len(values)")
+	}
+}
+
+const (
+	returnsTableName = `"order".returns`
+	refundsTableName = `"order".refunds`
+)
+
+type returnDto struct {
+	id       string
+	tsCreate time.Time
+	tsModify time.Time
+
+	orderID   string
+	lineID    string
+	quantity  int
+	reason    string
+	status    int64
+	comment   string
+	decidedBy string
+}
+
+func newReturnDto() *returnDto {
+	return &returnDto{}
+}
+
+func (d *returnDto) columns() []string {
+	return []string{"id", "ts_create", "ts_modify", "order_id", "line_id", "quantity", "reason", "status", "comment", "decided_by"}
+}
+
+func (d *returnDto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.tsModify, &d.orderID, &d.lineID, &d.quantity, &d.reason, &d.status, &d.comment, &d.decidedBy}
+}
+
+func (d *returnDto) toModel() *refund.Return {
+	return &refund.Return{
+		ID:        d.id,
+		TSCreate:  d.tsCreate,
+		TSModify:  d.tsModify,
+		OrderID:   d.orderID,
+		LineID:    d.lineID,
+		Quantity:  d.quantity,
+		Reason:    d.reason,
+		Status:    refund.Status(d.status),
+		Comment:   d.comment,
+		DecidedBy: d.decidedBy,
+	}
+}
+
+func (d *returnDto) fromModel(source *refund.Return) {
+	target := returnDto{
+		id:        source.ID,
+		tsCreate:  source.TSCreate,
+		tsModify:  source.TSModify,
+		orderID:   source.OrderID,
+		lineID:    source.LineID,
+		quantity:  source.Quantity,
+		reason:    source.Reason,
+		status:    int64(source.Status),
+		comment:   source.Comment,
+		decidedBy: source.DecidedBy,
+	}
+
+	*d = target
+}
+
+type refundDto struct {
+	id       string
+	tsCreate time.Time
+
+	orderID   string
+	returnID  string
+	paymentID string
+	amount    int64
+	currency  string
+}
+
+func newRefundDto() *refundDto {
+	return &refundDto{}
+}
+
+func (d *refundDto) columns() []string {
+	return []string{"id", "ts_create", "order_id", "return_id", "payment_id", "amount", "currency"}
+}
+
+func (d *refundDto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.orderID, &d.returnID, &d.paymentID, &d.amount, &d.currency}
+}
+
+func (d *refundDto) toModel() *refund.Refund {
+	return &refund.Refund{
+		ID:        d.id,
+		TSCreate:  d.tsCreate,
+		OrderID:   d.orderID,
+		ReturnID:  d.returnID,
+		PaymentID: d.paymentID,
+		Amount:    d.amount,
+		Currency:  d.currency,
+	}
+}
+
+func (d *refundDto) fromModel(source *refund.Refund) {
+	target := refundDto{
+		id:        source.ID,
+		tsCreate:  source.TSCreate,
+		orderID:   source.OrderID,
+		returnID:  source.ReturnID,
+		paymentID: source.PaymentID,
+		amount:    source.Amount,
+		currency:  source.Currency,
+	}
+
+	*d = target
+}
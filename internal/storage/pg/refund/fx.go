@@ -0,0 +1,10 @@
+package refund
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"refund_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"refund_pg_qr"`)),
+	),
+)
@@ -0,0 +1,146 @@
+package refund
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/refund"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) refund.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetReturns(ctx context.Context, filter *refund.Filter) ([]*refund.Return, error) {
+	sql, args, err := pgBuilder.Select(newReturnDto().columns()...).
+		From(returnsTableName).
+		Where(prepareWhere(filter)).
+		OrderBy("ts_create", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*refund.Return
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newReturnDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return res, nil
+	}
+
+	byID := make(map[string]*refund.Return, len(res))
+	ids := make([]string, 0, len(res))
+
+	for _, item := range res {
+		byID[item.ID] = item
+		ids = append(ids, item.ID)
+	}
+
+	refunds, err := q.getRefunds(ctx, squirrel.Eq{"return_id": ids})
+	if err != nil {
+		return nil, fmt.Errorf("get refunds: %w", err)
+	}
+
+	for _, r := range refunds {
+		byID[r.ReturnID].Refunds = append(byID[r.ReturnID].Refunds, r)
+	}
+
+	return res, nil
+}
+
+func (q *querier) GetRefunds(ctx context.Context, filter *refund.Filter) ([]*refund.Refund, error) {
+	return q.getRefunds(ctx, prepareWhere(filter))
+}
+
+func (q *querier) getRefunds(ctx context.Context, where squirrel.Sqlizer) ([]*refund.Refund, error) {
+	sql, args, err := pgBuilder.Select(newRefundDto().columns()...).
+		From(refundsTableName).
+		Where(where).
+		OrderBy("ts_create", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*refund.Refund
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newRefundDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func prepareWhere(filter *refund.Filter) squirrel.And {
+	where := squirrel.And{}
+
+	if filter != nil {
+		if filter.IDs.IsSet() {
+			where = append(where, squirrel.Eq{"id": filter.IDs.Value()})
+		}
+
+		if filter.OrderID.IsSet() {
+			where = append(where, squirrel.Eq{"order_id": filter.OrderID.Value()})
+		}
+
+		if filter.Published.IsSet() {
+			if filter.Published.Value() {
+				where = append(where, squirrel.NotEq{"ts_published": nil})
+			} else {
+				where = append(where, squirrel.Eq{"ts_published": nil})
+			}
+		}
+
+		if filter.CreatedBefore.IsSet() {
+			where = append(where, squirrel.Lt{"ts_create": filter.CreatedBefore.Value()})
+		}
+	}
+
+	return where
+}
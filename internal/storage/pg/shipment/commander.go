@@ -0,0 +1,82 @@
+package shipment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) shipment.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, item *shipment.Shipment) error {
+	d := newDto()
+	d.fromModel(item)
+
+	ib := pgBuilder.Insert(tableName).
+		Columns(d.columns()...).
+		Values(d.id, d.tsCreate, d.tsModify, d.orderID, d.carrier, d.trackingNumber, d.status)
+
+	lb := pgBuilder.Insert(linesTableName).Columns("shipment_id", "line_id", "quantity")
+	for _, l := range item.Lines {
+		lb = lb.Values(item.ID, l.LineID, l.Quantity)
+	}
+
+	eb := pgBuilder.Insert(eventsTableName).Columns("shipment_id", "ts_create", "status")
+	for _, e := range item.Events {
+		eb = eb.Values(item.ID, e.TSCreate, e.Status)
+	}
+
+	builders := []squirrel.Sqlizer{ib}
+	if len(item.Lines) > 0 {
+		builders = append(builders, lb)
+	}
+	if len(item.Events) > 0 {
+		builders = append(builders, eb)
+	}
+
+	return c.exec(ctx, builders...)
+}
+
+func (c *commander) AddEvent(ctx context.Context, id string, event *shipment.Event) error {
+	ub := pgBuilder.Update(tableName).
+		Set("status", event.Status).
+		Set("ts_modify", event.TSCreate).
+		Where(squirrel.Eq{"id": id})
+
+	eb := pgBuilder.Insert(eventsTableName).
+		Columns("shipment_id", "ts_create", "status").
+		Values(id, event.TSCreate, event.Status)
+
+	return c.exec(ctx, ub, eb)
+}
+
+func (c *commander) exec(ctx context.Context, builders ...squirrel.Sqlizer) error {
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, b := range builders {
+			sql, args, err := b.ToSql()
+			if err != nil {
+				return fmt.Errorf("prepare query: %w", err)
+			}
+
+			if _, err = tx.Exec(ctx, sql, args...); err != nil {
+				return fmt.Errorf("exec: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
@@ -0,0 +1,69 @@
+package shipment
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/shipment"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.shipment.dto: len(columns) != len(values)")
+	}
+}
+
+const (
+	tableName       = `"order".shipments`
+	linesTableName  = `"order".shipment_lines`
+	eventsTableName = `"order".shipment_events`
+)
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+	tsModify time.Time
+
+	orderID        string
+	carrier        string
+	trackingNumber string
+	status         int64
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "ts_modify", "order_id", "carrier", "tracking_number", "status"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.tsModify, &d.orderID, &d.carrier, &d.trackingNumber, &d.status}
+}
+
+func (d *dto) toModel() *shipment.Shipment {
+	return &shipment.Shipment{
+		ID:             d.id,
+		TSCreate:       d.tsCreate,
+		TSModify:       d.tsModify,
+		OrderID:        d.orderID,
+		Carrier:        d.carrier,
+		TrackingNumber: d.trackingNumber,
+		Status:         shipment.Status(d.status),
+	}
+}
+
+func (d *dto) fromModel(source *shipment.Shipment) {
+	target := dto{
+		id:             source.ID,
+		tsCreate:       source.TSCreate,
+		tsModify:       source.TSModify,
+		orderID:        source.OrderID,
+		carrier:        source.Carrier,
+		trackingNumber: source.TrackingNumber,
+		status:         int64(source.Status),
+	}
+
+	*d = target
+}
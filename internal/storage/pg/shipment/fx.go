@@ -0,0 +1,10 @@
+package shipment
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"shipment_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"shipment_pg_qr"`)),
+	),
+)
@@ -0,0 +1,157 @@
+package shipment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model/shipment"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) shipment.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetList(ctx context.Context, filter *shipment.Filter) ([]*shipment.Shipment, error) {
+	sql, args, err := q.prepareBase(pgBuilder.Select(newDto().columns()...), filter).
+		OrderBy("ts_create", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*shipment.Shipment
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		if errRows := rows.Err(); errRows != nil {
+			return errRows
+		}
+
+		return q.fill(ctx, tx, res)
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// fill loads lines and events of the shipments
+func (q *querier) fill(ctx context.Context, tx pgx.Tx, items []*shipment.Shipment) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*shipment.Shipment, len(items))
+	ids := make([]string, 0, len(items))
+
+	for _, item := range items {
+		byID[item.ID] = item
+		ids = append(ids, item.ID)
+	}
+
+	linesSQL, linesArgs, err := pgBuilder.Select("shipment_id", "line_id", "quantity").
+		From(linesTableName).
+		Where(squirrel.Eq{"shipment_id": ids}).
+		OrderBy("shipment_id", "line_id").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare lines query: %w", err)
+	}
+
+	eventsSQL, eventsArgs, err := pgBuilder.Select("shipment_id", "ts_create", "status").
+		From(eventsTableName).
+		Where(squirrel.Eq{"shipment_id": ids}).
+		OrderBy("ts_create", "status").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare events query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, linesSQL, linesArgs...)
+	if err != nil {
+		return fmt.Errorf("query lines: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			shipmentID string
+			l          shipment.Line
+		)
+
+		if err = rows.Scan(&shipmentID, &l.LineID, &l.Quantity); err != nil {
+			return fmt.Errorf("scan line: %w", err)
+		}
+
+		byID[shipmentID].Lines = append(byID[shipmentID].Lines, &l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = tx.Query(ctx, eventsSQL, eventsArgs...)
+	if err != nil {
+		return fmt.Errorf("query events: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			shipmentID string
+			tsCreate   time.Time
+			status     int64
+		)
+
+		if err = rows.Scan(&shipmentID, &tsCreate, &status); err != nil {
+			return fmt.Errorf("scan event: %w", err)
+		}
+
+		byID[shipmentID].Events = append(byID[shipmentID].Events, &shipment.Event{
+			Status:   shipment.Status(status),
+			TSCreate: tsCreate,
+		})
+	}
+
+	return rows.Err()
+}
+
+func (q *querier) prepareBase(builder squirrel.SelectBuilder, filter *shipment.Filter) squirrel.SelectBuilder {
+	where := squirrel.And{}
+
+	if filter != nil {
+		if filter.IDs.IsSet() {
+			where = append(where, squirrel.Eq{"id": filter.IDs.Value()})
+		}
+
+		if filter.OrderID.IsSet() {
+			where = append(where, squirrel.Eq{"order_id": filter.OrderID.Value()})
+		}
+	}
+
+	return builder.From(tableName).Where(where)
+}
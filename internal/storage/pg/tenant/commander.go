@@ -0,0 +1,83 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type commander struct {
+	tXer *database.TXer
+}
+
+func NewCommander(tXer *database.TXer) tenant.Commander {
+	return &commander{
+		tXer: tXer,
+	}
+}
+
+func (c *commander) Create(ctx context.Context, item *tenant.Tenant) error {
+	d := newDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Insert(tableName).
+		Columns(d.columns()...).
+		Values(d.id, d.tsCreate, d.name),
+		model.ErrConflict)
+}
+
+// SetMember keeps the date the user joined when only the role changes
+func (c *commander) SetMember(ctx context.Context, item *tenant.Member) error {
+	d := newMemberDto()
+	d.fromModel(item)
+
+	return c.exec(ctx, pgBuilder.
+		Insert(membersTableName).
+		Columns(d.columns()...).
+		Values(d.tenantID, d.userID, d.role, d.tsCreate).
+		Suffix("ON CONFLICT (tenant_id, user_id) DO UPDATE SET role = excluded.role"),
+		nil)
+}
+
+func (c *commander) RemoveMember(ctx context.Context, tenantID, userID string) error {
+	return c.exec(ctx, pgBuilder.
+		Delete(membersTableName).
+		Where(squirrel.Eq{"tenant_id": tenantID, "user_id": userID}),
+		model.ErrNotFound)
+}
+
+func (c *commander) RemoveUser(ctx context.Context, userID string) error {
+	return c.exec(ctx, pgBuilder.
+		Delete(membersTableName).
+		Where(squirrel.Eq{"user_id": userID}),
+		nil)
+}
+
+// exec runs the query, when errNoRows is set the query must affect a row
+func (c *commander) exec(ctx context.Context, query squirrel.Sqlizer, errNoRows error) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare query: %w", err)
+	}
+
+	return c.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		tag, errExec := tx.Exec(ctx, sql, args...)
+		if errExec != nil {
+			return fmt.Errorf("exec: %w", errExec)
+		}
+
+		if errNoRows != nil && tag.RowsAffected() == 0 {
+			return errNoRows
+		}
+
+		return nil
+	})
+}
+
+var pgBuilder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
@@ -0,0 +1,95 @@
+package tenant
+
+import (
+	"time"
+
+	"github.com/krivenkov/order/internal/model/tenant"
+)
+
+func init() {
+	d := newDto()
+	if len(d.columns()) != len(d.values()) {
+		panic("order.tenant.dto: len(columns) != len(values)")
+	}
+
+	m := newMemberDto()
+	if len(m.columns()) != len(m.values()) {
+		panic("order.tenant.memberDto: len(columns) != len(values)")
+	}
+}
+
+const (
+	tableName        = `"order".tenants`
+	membersTableName = `"order".tenant_members`
+)
+
+type dto struct {
+	id       string
+	tsCreate time.Time
+	name     string
+}
+
+func newDto() *dto {
+	return &dto{}
+}
+
+func (d *dto) columns() []string {
+	return []string{"id", "ts_create", "name"}
+}
+
+func (d *dto) values() []interface{} {
+	return []interface{}{&d.id, &d.tsCreate, &d.name}
+}
+
+func (d *dto) toModel() *tenant.Tenant {
+	return &tenant.Tenant{
+		ID:       d.id,
+		TSCreate: d.tsCreate,
+		Name:     d.name,
+	}
+}
+
+func (d *dto) fromModel(source *tenant.Tenant) {
+	*d = dto{
+		id:       source.ID,
+		tsCreate: source.TSCreate,
+		name:     source.Name,
+	}
+}
+
+type memberDto struct {
+	tenantID string
+	userID   string
+	role     string
+	tsCreate time.Time
+}
+
+func newMemberDto() *memberDto {
+	return &memberDto{}
+}
+
+func (d *memberDto) columns() []string {
+	return []string{"tenant_id", "user_id", "role", "ts_create"}
+}
+
+func (d *memberDto) values() []interface{} {
+	return []interface{}{&d.tenantID, &d.userID, &d.role, &d.tsCreate}
+}
+
+func (d *memberDto) toModel() *tenant.Member {
+	return &tenant.Member{
+		TenantID: d.tenantID,
+		UserID:   d.userID,
+		Role:     tenant.Role(d.role),
+		TSCreate: d.tsCreate,
+	}
+}
+
+func (d *memberDto) fromModel(source *tenant.Member) {
+	*d = memberDto{
+		tenantID: source.TenantID,
+		userID:   source.UserID,
+		role:     string(source.Role),
+		tsCreate: source.TSCreate,
+	}
+}
@@ -0,0 +1,10 @@
+package tenant
+
+import "go.uber.org/fx"
+
+var FXModule = fx.Options(
+	fx.Provide(
+		fx.Annotate(NewCommander, fx.ResultTags(`name:"tenant_pg_cmd"`)),
+		fx.Annotate(NewQuerier, fx.ResultTags(`name:"tenant_pg_qr"`)),
+	),
+)
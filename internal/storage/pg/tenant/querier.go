@@ -0,0 +1,106 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/krivenkov/order/internal/model"
+	"github.com/krivenkov/order/internal/model/tenant"
+	"github.com/krivenkov/pkg/clients/database"
+)
+
+type querier struct {
+	tXer *database.TXer
+}
+
+func NewQuerier(tXer *database.TXer) tenant.Querier {
+	return &querier{
+		tXer: tXer,
+	}
+}
+
+func (q *querier) GetItem(ctx context.Context, id string) (*tenant.Tenant, error) {
+	d := newDto()
+
+	if err := q.queryRow(ctx, pgBuilder.
+		Select(d.columns()...).
+		From(tableName).
+		Where(squirrel.Eq{"id": id}),
+		d.values()...); err != nil {
+		return nil, err
+	}
+
+	return d.toModel(), nil
+}
+
+func (q *querier) GetMember(ctx context.Context, tenantID, userID string) (*tenant.Member, error) {
+	d := newMemberDto()
+
+	if err := q.queryRow(ctx, pgBuilder.
+		Select(d.columns()...).
+		From(membersTableName).
+		Where(squirrel.Eq{"tenant_id": tenantID, "user_id": userID}),
+		d.values()...); err != nil {
+		return nil, err
+	}
+
+	return d.toModel(), nil
+}
+
+func (q *querier) GetMembers(ctx context.Context, tenantID string, role tenant.Role) ([]*tenant.Member, error) {
+	sql, args, err := pgBuilder.Select(newMemberDto().columns()...).
+		From(membersTableName).
+		Where(squirrel.Eq{"tenant_id": tenantID, "role": string(role)}).
+		OrderBy("ts_create", "user_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("prepare query: %w", err)
+	}
+
+	var res []*tenant.Member
+
+	if err = q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, errQuery := tx.Query(ctx, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("query: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			d := newMemberDto()
+			if errScan := rows.Scan(d.values()...); errScan != nil {
+				return fmt.Errorf("scan: %w", errScan)
+			}
+
+			res = append(res, d.toModel())
+		}
+
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (q *querier) queryRow(ctx context.Context, query squirrel.Sqlizer, dest ...interface{}) error {
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("prepare query: %w", err)
+	}
+
+	return q.tXer.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if errScan := tx.QueryRow(ctx, sql, args...).Scan(dest...); errScan != nil {
+			if errors.Is(errScan, pgx.ErrNoRows) {
+				return model.ErrNotFound
+			}
+
+			return fmt.Errorf("scan: %w", errScan)
+		}
+
+		return nil
+	})
+}
@@ -6,6 +6,8 @@ import (
 )
 
 var (
-	ErrNotFound   = status.Error(codes.NotFound, "not found")
-	ErrMultiItems = status.Error(codes.InvalidArgument, "multi items")
+	ErrNotFound         = status.Error(codes.NotFound, "not found")
+	ErrMultiItems       = status.Error(codes.InvalidArgument, "multi items")
+	ErrInvalidArgument  = status.Error(codes.InvalidArgument, "invalid argument")
+	ErrPermissionDenied = status.Error(codes.PermissionDenied, "permission denied")
 )
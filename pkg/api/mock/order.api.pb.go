@@ -36,6 +36,106 @@ func (m *MockOrderServiceClient) EXPECT() *MockOrderServiceClientMockRecorder {
 	return m.recorder
 }
 
+// CreateOrderComment mocks base method.
+func (m *MockOrderServiceClient) CreateOrderComment(ctx context.Context, in *api.CreateOrderCommentRequest, opts ...grpc.CallOption) (*api.OrderCommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateOrderComment", varargs...)
+	ret0, _ := ret[0].(*api.OrderCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrderComment indicates an expected call of CreateOrderComment.
+func (mr *MockOrderServiceClientMockRecorder) CreateOrderComment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderComment", reflect.TypeOf((*MockOrderServiceClient)(nil).CreateOrderComment), varargs...)
+}
+
+// DeleteOrderComment mocks base method.
+func (m *MockOrderServiceClient) DeleteOrderComment(ctx context.Context, in *api.DeleteOrderCommentRequest, opts ...grpc.CallOption) (*api.DeleteOrderCommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOrderComment", varargs...)
+	ret0, _ := ret[0].(*api.DeleteOrderCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrderComment indicates an expected call of DeleteOrderComment.
+func (mr *MockOrderServiceClientMockRecorder) DeleteOrderComment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrderComment", reflect.TypeOf((*MockOrderServiceClient)(nil).DeleteOrderComment), varargs...)
+}
+
+// GetOrderComments mocks base method.
+func (m *MockOrderServiceClient) GetOrderComments(ctx context.Context, in *api.OrderCommentsRequest, opts ...grpc.CallOption) (*api.OrderCommentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrderComments", varargs...)
+	ret0, _ := ret[0].(*api.OrderCommentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderComments indicates an expected call of GetOrderComments.
+func (mr *MockOrderServiceClientMockRecorder) GetOrderComments(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderComments", reflect.TypeOf((*MockOrderServiceClient)(nil).GetOrderComments), varargs...)
+}
+
+// GetOrderFacets mocks base method.
+func (m *MockOrderServiceClient) GetOrderFacets(ctx context.Context, in *api.OrderFacetsRequest, opts ...grpc.CallOption) (*api.OrderFacetsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrderFacets", varargs...)
+	ret0, _ := ret[0].(*api.OrderFacetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderFacets indicates an expected call of GetOrderFacets.
+func (mr *MockOrderServiceClientMockRecorder) GetOrderFacets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderFacets", reflect.TypeOf((*MockOrderServiceClient)(nil).GetOrderFacets), varargs...)
+}
+
+// GetOrderHistory mocks base method.
+func (m *MockOrderServiceClient) GetOrderHistory(ctx context.Context, in *api.OrderHistoryRequest, opts ...grpc.CallOption) (*api.OrderHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrderHistory", varargs...)
+	ret0, _ := ret[0].(*api.OrderHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderHistory indicates an expected call of GetOrderHistory.
+func (mr *MockOrderServiceClientMockRecorder) GetOrderHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderHistory", reflect.TypeOf((*MockOrderServiceClient)(nil).GetOrderHistory), varargs...)
+}
+
 // GetOrderItem mocks base method.
 func (m *MockOrderServiceClient) GetOrderItem(ctx context.Context, in *api.OrderItemRequest, opts ...grpc.CallOption) (*api.OrderItemResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +176,66 @@ func (mr *MockOrderServiceClientMockRecorder) GetOrderItemList(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemList", reflect.TypeOf((*MockOrderServiceClient)(nil).GetOrderItemList), varargs...)
 }
 
+// GetOrderReturns mocks base method.
+func (m *MockOrderServiceClient) GetOrderReturns(ctx context.Context, in *api.OrderReturnsRequest, opts ...grpc.CallOption) (*api.OrderReturnsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrderReturns", varargs...)
+	ret0, _ := ret[0].(*api.OrderReturnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderReturns indicates an expected call of GetOrderReturns.
+func (mr *MockOrderServiceClientMockRecorder) GetOrderReturns(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderReturns", reflect.TypeOf((*MockOrderServiceClient)(nil).GetOrderReturns), varargs...)
+}
+
+// GetOrderShipments mocks base method.
+func (m *MockOrderServiceClient) GetOrderShipments(ctx context.Context, in *api.OrderShipmentsRequest, opts ...grpc.CallOption) (*api.OrderShipmentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrderShipments", varargs...)
+	ret0, _ := ret[0].(*api.OrderShipmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderShipments indicates an expected call of GetOrderShipments.
+func (mr *MockOrderServiceClientMockRecorder) GetOrderShipments(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderShipments", reflect.TypeOf((*MockOrderServiceClient)(nil).GetOrderShipments), varargs...)
+}
+
+// UpdateOrderComment mocks base method.
+func (m *MockOrderServiceClient) UpdateOrderComment(ctx context.Context, in *api.UpdateOrderCommentRequest, opts ...grpc.CallOption) (*api.OrderCommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateOrderComment", varargs...)
+	ret0, _ := ret[0].(*api.OrderCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderComment indicates an expected call of UpdateOrderComment.
+func (mr *MockOrderServiceClientMockRecorder) UpdateOrderComment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderComment", reflect.TypeOf((*MockOrderServiceClient)(nil).UpdateOrderComment), varargs...)
+}
+
 // MockOrderServiceServer is a mock of OrderServiceServer interface.
 type MockOrderServiceServer struct {
 	ctrl     *gomock.Controller
@@ -99,6 +259,81 @@ func (m *MockOrderServiceServer) EXPECT() *MockOrderServiceServerMockRecorder {
 	return m.recorder
 }
 
+// CreateOrderComment mocks base method.
+func (m *MockOrderServiceServer) CreateOrderComment(arg0 context.Context, arg1 *api.CreateOrderCommentRequest) (*api.OrderCommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderComment", arg0, arg1)
+	ret0, _ := ret[0].(*api.OrderCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrderComment indicates an expected call of CreateOrderComment.
+func (mr *MockOrderServiceServerMockRecorder) CreateOrderComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderComment", reflect.TypeOf((*MockOrderServiceServer)(nil).CreateOrderComment), arg0, arg1)
+}
+
+// DeleteOrderComment mocks base method.
+func (m *MockOrderServiceServer) DeleteOrderComment(arg0 context.Context, arg1 *api.DeleteOrderCommentRequest) (*api.DeleteOrderCommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrderComment", arg0, arg1)
+	ret0, _ := ret[0].(*api.DeleteOrderCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrderComment indicates an expected call of DeleteOrderComment.
+func (mr *MockOrderServiceServerMockRecorder) DeleteOrderComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrderComment", reflect.TypeOf((*MockOrderServiceServer)(nil).DeleteOrderComment), arg0, arg1)
+}
+
+// GetOrderComments mocks base method.
+func (m *MockOrderServiceServer) GetOrderComments(arg0 context.Context, arg1 *api.OrderCommentsRequest) (*api.OrderCommentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderComments", arg0, arg1)
+	ret0, _ := ret[0].(*api.OrderCommentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderComments indicates an expected call of GetOrderComments.
+func (mr *MockOrderServiceServerMockRecorder) GetOrderComments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderComments", reflect.TypeOf((*MockOrderServiceServer)(nil).GetOrderComments), arg0, arg1)
+}
+
+// GetOrderFacets mocks base method.
+func (m *MockOrderServiceServer) GetOrderFacets(arg0 context.Context, arg1 *api.OrderFacetsRequest) (*api.OrderFacetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderFacets", arg0, arg1)
+	ret0, _ := ret[0].(*api.OrderFacetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderFacets indicates an expected call of GetOrderFacets.
+func (mr *MockOrderServiceServerMockRecorder) GetOrderFacets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderFacets", reflect.TypeOf((*MockOrderServiceServer)(nil).GetOrderFacets), arg0, arg1)
+}
+
+// GetOrderHistory mocks base method.
+func (m *MockOrderServiceServer) GetOrderHistory(arg0 context.Context, arg1 *api.OrderHistoryRequest) (*api.OrderHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderHistory", arg0, arg1)
+	ret0, _ := ret[0].(*api.OrderHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderHistory indicates an expected call of GetOrderHistory.
+func (mr *MockOrderServiceServerMockRecorder) GetOrderHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderHistory", reflect.TypeOf((*MockOrderServiceServer)(nil).GetOrderHistory), arg0, arg1)
+}
+
 // GetOrderItem mocks base method.
 func (m *MockOrderServiceServer) GetOrderItem(arg0 context.Context, arg1 *api.OrderItemRequest) (*api.OrderItemResponse, error) {
 	m.ctrl.T.Helper()
@@ -128,3 +363,48 @@ func (mr *MockOrderServiceServerMockRecorder) GetOrderItemList(arg0, arg1 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemList", reflect.TypeOf((*MockOrderServiceServer)(nil).GetOrderItemList), arg0, arg1)
 }
+
+// GetOrderReturns mocks base method.
+func (m *MockOrderServiceServer) GetOrderReturns(arg0 context.Context, arg1 *api.OrderReturnsRequest) (*api.OrderReturnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderReturns", arg0, arg1)
+	ret0, _ := ret[0].(*api.OrderReturnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderReturns indicates an expected call of GetOrderReturns.
+func (mr *MockOrderServiceServerMockRecorder) GetOrderReturns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderReturns", reflect.TypeOf((*MockOrderServiceServer)(nil).GetOrderReturns), arg0, arg1)
+}
+
+// GetOrderShipments mocks base method.
+func (m *MockOrderServiceServer) GetOrderShipments(arg0 context.Context, arg1 *api.OrderShipmentsRequest) (*api.OrderShipmentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderShipments", arg0, arg1)
+	ret0, _ := ret[0].(*api.OrderShipmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderShipments indicates an expected call of GetOrderShipments.
+func (mr *MockOrderServiceServerMockRecorder) GetOrderShipments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderShipments", reflect.TypeOf((*MockOrderServiceServer)(nil).GetOrderShipments), arg0, arg1)
+}
+
+// UpdateOrderComment mocks base method.
+func (m *MockOrderServiceServer) UpdateOrderComment(arg0 context.Context, arg1 *api.UpdateOrderCommentRequest) (*api.OrderCommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderComment", arg0, arg1)
+	ret0, _ := ret[0].(*api.OrderCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderComment indicates an expected call of UpdateOrderComment.
+func (mr *MockOrderServiceServerMockRecorder) UpdateOrderComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderComment", reflect.TypeOf((*MockOrderServiceServer)(nil).UpdateOrderComment), arg0, arg1)
+}